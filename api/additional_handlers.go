@@ -0,0 +1,134 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/insolar/insolar/pulse"
+	"github.com/insolar/spec-insolar-block-explorer-api/v1/server"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/block-explorer/etl/models"
+)
+
+// callTreeMaxDepth limits the nesting of requests in the call tree
+const callTreeMaxDepth = 20
+
+// RegisterAdditionalHandlers adds handlers which are not described in the API specification
+func RegisterAdditionalHandlers(router server.EchoRouter, s *Server) {
+	router.GET("/api/v1/records/:reference", s.Record)
+	router.GET("/api/v1/records/:reference/verification", s.RecordVerification)
+	router.GET("/api/v1/requests/:reference/call-tree", s.CallTree)
+	router.GET("/api/v1/pulses/:pulse_number/jets", s.PulseJets)
+}
+
+// Record returns the extended record by its reference, the payload is decoded if the decode parameter is true,
+// the extensions are returned if the extensions parameter is true
+func (s *Server) Record(ctx echo.Context) error {
+	var failures []server.CodeValidationFailures
+	ref, err := checkReference(ctx.Param("reference"))
+	if err != nil {
+		failures = append(failures, server.CodeValidationFailures{
+			FailureReason: NullableString(err.Error()),
+			Property:      NullableString("reference"),
+		})
+	}
+	decode, decodeFailures := checkDecode(ctx)
+	failures = append(failures, decodeFailures...)
+	extensions, extensionsFailures := checkExtensions(ctx)
+	failures = append(failures, extensionsFailures...)
+
+	if failures != nil {
+		apiErr := server.CodeValidationError{
+			Code:               NullableString(http.StatusText(http.StatusBadRequest)),
+			Message:            NullableString(InvalidParamsMessage),
+			ValidationFailures: &failures,
+		}
+		return ctx.JSON(http.StatusBadRequest, apiErr)
+	}
+
+	record, err := s.storage.GetRecord(ref.GetLocal().Bytes())
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return ctx.JSON(http.StatusNotFound, struct{}{})
+		}
+		s.logger.Error(errors.Wrapf(err, "error while select record from db by reference %s", ref.String()))
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+
+	response := RecordToExtendedAPI(record, decode)
+	if extensions {
+		recordExtensions, err := s.storage.GetRecordExtensions([]models.Reference{record.Reference})
+		if err != nil {
+			s.logger.Error(errors.Wrapf(err, "error while select record extensions from db by reference %s", ref.String()))
+			return ctx.JSON(http.StatusInternalServerError, struct{}{})
+		}
+		response.Extensions = RecordExtensionsToAPI(recordExtensions)
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// CallTree returns the request with its result, requests caused by it and their results recursively
+func (s *Server) CallTree(ctx echo.Context) error {
+	ref, err := checkReference(ctx.Param("reference"))
+	if err != nil {
+		apiErr := server.CodeValidationError{
+			Code:    NullableString(http.StatusText(http.StatusBadRequest)),
+			Message: NullableString(InvalidParamsMessage),
+			ValidationFailures: &[]server.CodeValidationFailures{{
+				FailureReason: NullableString(err.Error()),
+				Property:      NullableString("reference"),
+			}},
+		}
+		return ctx.JSON(http.StatusBadRequest, apiErr)
+	}
+
+	records, err := s.storage.GetCallTree(ref.GetLocal().Bytes(), callTreeMaxDepth)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return ctx.JSON(http.StatusNotFound, struct{}{})
+		}
+		s.logger.Error(errors.Wrapf(err, "error while select call tree from db by reference %s", ref.String()))
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	return ctx.JSON(http.StatusOK, RecordsToCallTree(records))
+}
+
+// PulseJets returns the jet tree of the pulse with jets split and merged since the previous pulse
+func (s *Server) PulseJets(ctx echo.Context) error {
+	pulseNumber, err := strconv.ParseInt(ctx.Param("pulse_number"), 10, 64)
+	if err != nil || !pulse.IsValidAsPulseNumber(int(pulseNumber)) {
+		apiErr := server.CodeValidationError{
+			Code:    NullableString(http.StatusText(http.StatusBadRequest)),
+			Message: NullableString(InvalidParamsMessage),
+			ValidationFailures: &[]server.CodeValidationFailures{{
+				FailureReason: NullableString("invalid"),
+				Property:      NullableString("pulse_number"),
+			}},
+		}
+		return ctx.JSON(http.StatusBadRequest, apiErr)
+	}
+
+	p, err := s.storage.GetPulse(pulseNumber)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return ctx.JSON(http.StatusNotFound, struct{}{})
+		}
+		s.logger.Error(errors.Wrapf(err, "error while select pulse from db by pulse number %d", pulseNumber))
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+
+	jets, err := s.storage.GetPulseJets(p.PulseNumber)
+	if err != nil {
+		s.logger.Error(errors.Wrapf(err, "error while select jets from db by pulse number %d", p.PulseNumber))
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	prevJets, err := s.storage.GetPulseJets(p.PrevPulseNumber)
+	if err != nil {
+		s.logger.Error(errors.Wrapf(err, "error while select jets from db by pulse number %d", p.PrevPulseNumber))
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	return ctx.JSON(http.StatusOK, PulseJetsToAPI(p.PulseNumber, jets, prevJets))
+}
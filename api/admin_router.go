@@ -0,0 +1,67 @@
+package api
+
+import (
+	"crypto/subtle"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/block-explorer/configuration"
+)
+
+// NewAdminRouter returns the router of the admin endpoints. They change the stored data,
+// so the router requires a bearer token from cfg.Tokens or listens only on the loopback interface.
+func NewAdminRouter(cfg configuration.Admin) (*Router, error) {
+	var tokens [][]byte
+	for _, t := range cfg.Tokens {
+		if t != "" {
+			tokens = append(tokens, []byte(t))
+		}
+	}
+	if len(tokens) == 0 && !isLoopback(cfg.Listen) {
+		return nil, errors.Errorf("admin server on %s has to require tokens, only a loopback address is allowed without them", cfg.Listen)
+	}
+
+	mux := http.NewServeMux()
+	var handler http.Handler = mux
+	if len(tokens) > 0 {
+		handler = requireToken(tokens, mux)
+	}
+	r := &Router{mux: mux}
+	r.hs = &http.Server{Addr: cfg.Listen, Handler: handler}
+	return r, nil
+}
+
+// isLoopback returns true if the host of addr is a loopback address
+func isLoopback(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// requireToken rejects the requests without the bearer token from tokens
+func requireToken(tokens [][]byte, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		auth := req.Header.Get("Authorization")
+		const prefix = "bearer "
+		if len(auth) > len(prefix) && strings.EqualFold(auth[:len(prefix)], prefix) {
+			token := []byte(auth[len(prefix):])
+			for _, t := range tokens {
+				if subtle.ConstantTimeCompare(t, token) == 1 {
+					next.ServeHTTP(w, req)
+					return
+				}
+			}
+		}
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, "invalid auth token", http.StatusUnauthorized)
+	})
+}
@@ -0,0 +1,55 @@
+// +build unit
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/block-explorer/configuration"
+)
+
+func TestNewAdminRouter_Listen(t *testing.T) {
+	for _, listen := range []string{"127.0.0.1:8001", "localhost:8001", "[::1]:8001"} {
+		_, err := NewAdminRouter(configuration.Admin{Listen: listen})
+		require.NoError(t, err, listen)
+	}
+	for _, listen := range []string{":8001", "0.0.0.0:8001", "10.0.0.1:8001"} {
+		_, err := NewAdminRouter(configuration.Admin{Listen: listen})
+		require.Error(t, err, "%s requires tokens", listen)
+		_, err = NewAdminRouter(configuration.Admin{Listen: listen, Tokens: []string{"secret"}})
+		require.NoError(t, err, listen)
+	}
+}
+
+func TestNewAdminRouter_Tokens(t *testing.T) {
+	router, err := NewAdminRouter(configuration.Admin{Listen: ":8001", Tokens: []string{"", "first", "second"}})
+	require.NoError(t, err)
+	router.Handle("/admin/test", http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		auth string
+		code int
+	}{
+		{"", http.StatusUnauthorized},
+		{"Bearer ", http.StatusUnauthorized},
+		{"Bearer third", http.StatusUnauthorized},
+		{"Basic first", http.StatusUnauthorized},
+		{"Bearer first", http.StatusOK},
+		{"bearer second", http.StatusOK},
+	}
+	for _, test := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/admin/test", nil)
+		if test.auth != "" {
+			req.Header.Set("Authorization", test.auth)
+		}
+		rec := httptest.NewRecorder()
+		router.hs.Handler.ServeHTTP(rec, req)
+		require.Equal(t, test.code, rec.Code, test.auth)
+	}
+}
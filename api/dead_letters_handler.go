@@ -0,0 +1,93 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/jinzhu/gorm"
+
+	"github.com/insolar/block-explorer/etl/interfaces"
+	"github.com/insolar/block-explorer/instrumentation/belogger"
+)
+
+// deadLettersDefaultLimit is the page size of the dead letters list if the limit parameter is omitted
+const deadLettersDefaultLimit = 20
+
+// NewDeadLettersHandler returns the handler of jet drops which failed to persist after all retries.
+// GET request returns the page of dead letters selected by limit and offset query parameters,
+// or the dead letter with its jet drop if the id query parameter is provided.
+// POST request with the id query parameter retries the dead letter, DELETE request discards it.
+// The retry is bound to the provided context, not to the request one.
+func NewDeadLettersHandler(ctx context.Context, queue interfaces.DeadLetterQueue) http.HandlerFunc {
+	logger := belogger.FromContext(ctx)
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet, http.MethodPost, http.MethodDelete:
+		default:
+			w.Header().Set("Allow", "GET, POST, DELETE")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		var id int64
+		if r.Method != http.MethodGet || r.URL.Query().Get("id") != "" {
+			var err error
+			id, err = strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("invalid id: %s", err), http.StatusBadRequest)
+				return
+			}
+		}
+
+		var response interface{}
+		var err error
+		switch r.Method {
+		case http.MethodGet:
+			if id != 0 {
+				response, err = queue.DeadLetter(id)
+				break
+			}
+			limit, offset := deadLettersDefaultLimit, 0
+			if l := r.URL.Query().Get("limit"); l != "" {
+				limit, err = strconv.Atoi(l)
+				if err != nil || limit < 1 {
+					http.Error(w, fmt.Sprintf("invalid limit: %s", l), http.StatusBadRequest)
+					return
+				}
+			}
+			if o := r.URL.Query().Get("offset"); o != "" {
+				offset, err = strconv.Atoi(o)
+				if err != nil || offset < 0 {
+					http.Error(w, fmt.Sprintf("invalid offset: %s", o), http.StatusBadRequest)
+					return
+				}
+			}
+			response, err = queue.DeadLetters(limit, offset)
+		case http.MethodPost:
+			logger.Infof("Retry of dead letter %d requested", id)
+			err = queue.RetryDeadLetter(ctx, id)
+		case http.MethodDelete:
+			logger.Infof("Discard of dead letter %d requested", id)
+			err = queue.DiscardDeadLetter(id)
+		}
+
+		if err != nil {
+			if gorm.IsRecordNotFoundError(err) {
+				http.Error(w, fmt.Sprintf("dead letter %d not found", id), http.StatusNotFound)
+				return
+			}
+			logger.Error(err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if response == nil {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(response)
+	}
+}
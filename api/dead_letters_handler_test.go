@@ -0,0 +1,83 @@
+// +build unit
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/block-explorer/etl/interfaces/mock"
+	"github.com/insolar/block-explorer/etl/types"
+)
+
+func TestDeadLettersHandler(t *testing.T) {
+	ctx := context.Background()
+	deadLetter := types.DeadLetter{ID: 1, PulseNumber: 1000, JetID: "0", Error: "test error", Attempts: 3, Timestamp: 100}
+	queue := mock.NewDeadLetterQueueMock(t)
+	queue.DeadLettersMock.Expect(deadLettersDefaultLimit, 0).Return([]types.DeadLetter{deadLetter}, nil)
+	queue.DeadLetterMock.Expect(1).Return(deadLetter, nil)
+	queue.RetryDeadLetterMock.Expect(ctx, 1).Return(nil)
+	queue.DiscardDeadLetterMock.Expect(1).Return(nil)
+	handler := NewDeadLettersHandler(ctx, queue)
+
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/admin/dead-letters", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var list []types.DeadLetter
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	require.Equal(t, []types.DeadLetter{deadLetter}, list)
+
+	rec = httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/admin/dead-letters?id=1", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var received types.DeadLetter
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &received))
+	require.Equal(t, deadLetter, received)
+
+	rec = httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodPost, "/admin/dead-letters?id=1", nil))
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodDelete, "/admin/dead-letters?id=1", nil))
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	require.Equal(t, uint64(1), queue.RetryDeadLetterAfterCounter())
+	require.Equal(t, uint64(1), queue.DiscardDeadLetterAfterCounter())
+}
+
+func TestDeadLettersHandler_Errors(t *testing.T) {
+	ctx := context.Background()
+	queue := mock.NewDeadLetterQueueMock(t)
+	queue.DeadLetterMock.Return(types.DeadLetter{}, gorm.ErrRecordNotFound)
+	queue.RetryDeadLetterMock.Return(errors.New("cannot process jet drop"))
+	queue.DiscardDeadLetterMock.Return(gorm.ErrRecordNotFound)
+	handler := NewDeadLettersHandler(ctx, queue)
+
+	tests := []struct {
+		method string
+		target string
+		code   int
+	}{
+		{http.MethodGet, "/admin/dead-letters?limit=0", http.StatusBadRequest},
+		{http.MethodGet, "/admin/dead-letters?offset=-1", http.StatusBadRequest},
+		{http.MethodGet, "/admin/dead-letters?id=a", http.StatusBadRequest},
+		{http.MethodGet, "/admin/dead-letters?id=1", http.StatusNotFound},
+		{http.MethodPost, "/admin/dead-letters", http.StatusBadRequest},
+		{http.MethodPost, "/admin/dead-letters?id=1", http.StatusInternalServerError},
+		{http.MethodDelete, "/admin/dead-letters?id=1", http.StatusNotFound},
+		{http.MethodPut, "/admin/dead-letters?id=1", http.StatusMethodNotAllowed},
+	}
+	for _, test := range tests {
+		rec := httptest.NewRecorder()
+		handler(rec, httptest.NewRequest(test.method, test.target, nil))
+		require.Equal(t, test.code, rec.Code, test.method+" "+test.target)
+	}
+}
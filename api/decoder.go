@@ -0,0 +1,224 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+
+	"github.com/insolar/insolar/insolar"
+	ins_record "github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/insolar/spec-insolar-block-explorer-api/v1/server"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/block-explorer/etl/models"
+	"github.com/insolar/block-explorer/instrumentation"
+)
+
+// DecodedPayload is the payload of the record decoded with the platform serialization.
+// Undecodable data is returned in Raw as base64 with the reason in DecodeError.
+type DecodedPayload struct {
+	Method      *string         `json:"method,omitempty"`
+	Arguments   json.RawMessage `json:"arguments,omitempty"`
+	Memory      json.RawMessage `json:"memory,omitempty"`
+	Result      json.RawMessage `json:"result,omitempty"`
+	Raw         *string         `json:"raw,omitempty"`
+	DecodeError *string         `json:"decode_error,omitempty"`
+}
+
+// ExtendedRecord is the record with the fields which are not described in the API specification:
+// the exact subtype, the fields of requests, the decoded payload and the extensions
+type ExtendedRecord struct {
+	server.Record
+	Subtype        *string            `json:"subtype,omitempty"`
+	Caller         *string            `json:"caller,omitempty"`
+	Method         *string            `json:"method,omitempty"`
+	Immutable      *bool              `json:"immutable,omitempty"`
+	APIRequestID   *string            `json:"api_request_id,omitempty"`
+	DecodedPayload *DecodedPayload    `json:"decoded_payload,omitempty"`
+	Extensions     *[]RecordExtension `json:"extensions,omitempty"`
+}
+
+// RecordExtension is the copy of the record code or large memory from the additional section, the data is encoded as base64
+type RecordExtension struct {
+	Kind *string `json:"kind,omitempty"`
+	Data *string `json:"data,omitempty"`
+}
+
+// ExtendedRecords is the list of the extended records
+type ExtendedRecords struct {
+	Result *[]ExtendedRecord `json:"result,omitempty"`
+	Total  *int64            `json:"total,omitempty"`
+}
+
+// RecordToExtendedAPI maps the record to the extended record, the payload is decoded if decode is true
+func RecordToExtendedAPI(record models.Record, decode bool) ExtendedRecord {
+	response := ExtendedRecord{Record: RecordToAPI(record)}
+	if record.Subtype != "" {
+		response.Subtype = NullableString(string(record.Subtype))
+	}
+	if record.Type == models.Request {
+		if !instrumentation.IsEmpty(record.Caller) {
+			caller := insolar.NewIDFromBytes(record.Caller)
+			if caller != nil {
+				response.Caller = NullableString(insolar.NewReference(*caller).String())
+			}
+		}
+		response.Method = NullableString(record.Method)
+		response.Immutable = &record.Immutable
+		response.APIRequestID = NullableString(record.APIRequestID)
+	}
+	if decode {
+		response.DecodedPayload = DecodePayload(record)
+	}
+	return response
+}
+
+// DecodePayload decodes the object memory of state records, the method and arguments of requests
+// and the payload of results. It returns nil if there is nothing to decode.
+func DecodePayload(record models.Record) *DecodedPayload {
+	var raw exporter.Record
+	if err := raw.Unmarshal(record.RawData); err != nil {
+		return undecodedPayload(record.Payload, errors.Wrap(err, "failed to unmarshal raw data"))
+	}
+
+	decoded := &DecodedPayload{}
+	var err error
+	switch v := raw.Record.Virtual.Union.(type) {
+	case *ins_record.Virtual_Activate:
+		decoded.Memory, err = deserialize(v.Activate.Memory)
+		if err != nil {
+			return undecodedPayload(v.Activate.Memory, err)
+		}
+	case *ins_record.Virtual_Amend:
+		decoded.Memory, err = deserialize(v.Amend.Memory)
+		if err != nil {
+			return undecodedPayload(v.Amend.Memory, err)
+		}
+	case *ins_record.Virtual_IncomingRequest:
+		decoded.Method = NullableString(v.IncomingRequest.Method)
+		decoded.Arguments, err = deserialize(v.IncomingRequest.Arguments)
+		if err != nil {
+			decoded.setUndecoded(v.IncomingRequest.Arguments, err)
+		}
+	case *ins_record.Virtual_OutgoingRequest:
+		decoded.Method = NullableString(v.OutgoingRequest.Method)
+		decoded.Arguments, err = deserialize(v.OutgoingRequest.Arguments)
+		if err != nil {
+			decoded.setUndecoded(v.OutgoingRequest.Arguments, err)
+		}
+	case *ins_record.Virtual_Result:
+		decoded.Result, err = deserialize(v.Result.Payload)
+		if err != nil {
+			return undecodedPayload(v.Result.Payload, err)
+		}
+	default:
+		return nil
+	}
+	return decoded
+}
+
+// deserialize decodes data with the platform serialization and encodes it to json
+func deserialize(data []byte) (json.RawMessage, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var value interface{}
+	if err := insolar.Deserialize(data, &value); err != nil {
+		return nil, errors.Wrap(err, "failed to deserialize")
+	}
+	res, err := json.Marshal(value)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode to json")
+	}
+	return res, nil
+}
+
+func undecodedPayload(data []byte, err error) *DecodedPayload {
+	decoded := &DecodedPayload{}
+	decoded.setUndecoded(data, err)
+	return decoded
+}
+
+func (d *DecodedPayload) setUndecoded(data []byte, err error) {
+	d.Raw = NullableString(base64.StdEncoding.EncodeToString(data))
+	d.DecodeError = NullableString(err.Error())
+}
+
+// checkDecode returns value of the optional decode query parameter
+func checkDecode(ctx echo.Context) (bool, []server.CodeValidationFailures) {
+	return checkBoolQueryParam(ctx, "decode")
+}
+
+// checkExtensions returns value of the optional extensions query parameter
+func checkExtensions(ctx echo.Context) (bool, []server.CodeValidationFailures) {
+	return checkBoolQueryParam(ctx, "extensions")
+}
+
+func checkBoolQueryParam(ctx echo.Context, name string) (bool, []server.CodeValidationFailures) {
+	param := ctx.QueryParam(name)
+	if param == "" {
+		return false, nil
+	}
+	value, err := strconv.ParseBool(param)
+	if err != nil {
+		return false, []server.CodeValidationFailures{{
+			FailureReason: NullableString("should be 'true' or 'false'"),
+			Property:      NullableString(name),
+		}}
+	}
+	return value, nil
+}
+
+// recordsResponse returns extended records, payloads are decoded if decode is true
+// and extensions are selected from db if extensions is true
+func (s *Server) recordsResponse(records []models.Record, count int, decode, extensions bool) (ExtendedRecords, error) {
+	var extensionsByRecord map[string][]models.RecordExtension
+	if extensions {
+		var err error
+		extensionsByRecord, err = s.getRecordExtensions(records)
+		if err != nil {
+			return ExtendedRecords{}, err
+		}
+	}
+
+	cnt := int64(count)
+	result := []ExtendedRecord{}
+	for _, r := range records {
+		record := RecordToExtendedAPI(r, decode)
+		if extensions {
+			record.Extensions = RecordExtensionsToAPI(extensionsByRecord[string(r.Reference)])
+		}
+		result = append(result, record)
+	}
+	return ExtendedRecords{Total: &cnt, Result: &result}, nil
+}
+
+// getRecordExtensions returns extensions of provided records grouped by record reference
+func (s *Server) getRecordExtensions(records []models.Record) (map[string][]models.RecordExtension, error) {
+	refs := make([]models.Reference, 0, len(records))
+	for _, r := range records {
+		refs = append(refs, r.Reference)
+	}
+	extensions, err := s.storage.GetRecordExtensions(refs)
+	if err != nil {
+		return nil, err
+	}
+	res := map[string][]models.RecordExtension{}
+	for _, e := range extensions {
+		res[string(e.RecordReference)] = append(res[string(e.RecordReference)], e)
+	}
+	return res, nil
+}
+
+func RecordExtensionsToAPI(extensions []models.RecordExtension) *[]RecordExtension {
+	res := []RecordExtension{}
+	for _, e := range extensions {
+		res = append(res, RecordExtension{
+			Kind: NullableString(string(e.Kind)),
+			Data: NullableString(base64.StdEncoding.EncodeToString(e.Data)),
+		})
+	}
+	return &res
+}
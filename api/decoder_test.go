@@ -0,0 +1,88 @@
+// +build unit
+
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	ins_record "github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/block-explorer/etl/models"
+)
+
+func recordWithVirtual(t *testing.T, virtual ins_record.Virtual) models.Record {
+	raw := exporter.Record{Record: ins_record.Material{ID: gen.ID(), Virtual: virtual}}
+	rawData, err := raw.Marshal()
+	require.NoError(t, err)
+	return models.Record{Reference: raw.Record.ID.Bytes(), RawData: rawData}
+}
+
+func TestDecodePayload_Memory(t *testing.T) {
+	memory := insolar.MustSerialize(struct {
+		Balance string
+		Owner   string
+	}{"100", "owner"})
+	record := recordWithVirtual(t, ins_record.Wrap(&ins_record.Amend{Memory: memory}))
+
+	decoded := DecodePayload(record)
+	require.NotNil(t, decoded)
+	require.Nil(t, decoded.DecodeError)
+	require.JSONEq(t, `{"Balance":"100","Owner":"owner"}`, string(decoded.Memory))
+}
+
+func TestDecodePayload_Request(t *testing.T) {
+	arguments := insolar.MustSerialize([]interface{}{"first", uint64(2)})
+	record := recordWithVirtual(t, ins_record.Wrap(&ins_record.IncomingRequest{Method: "Transfer", Arguments: arguments}))
+
+	decoded := DecodePayload(record)
+	require.NotNil(t, decoded)
+	require.Nil(t, decoded.DecodeError)
+	require.Equal(t, "Transfer", *decoded.Method)
+	require.JSONEq(t, `["first",2]`, string(decoded.Arguments))
+}
+
+func TestDecodePayload_Result(t *testing.T) {
+	payload := insolar.MustSerialize(struct {
+		Error   interface{}
+		Returns []interface{}
+	}{nil, []interface{}{"done", nil}})
+	record := recordWithVirtual(t, ins_record.Wrap(&ins_record.Result{Payload: payload}))
+
+	decoded := DecodePayload(record)
+	require.NotNil(t, decoded)
+	require.Nil(t, decoded.DecodeError)
+	var result map[string]interface{}
+	require.NoError(t, json.Unmarshal(decoded.Result, &result))
+	require.Equal(t, []interface{}{"done", nil}, result["Returns"])
+}
+
+func TestDecodePayload_Undecodable(t *testing.T) {
+	memory := []byte{0xff, 0x01}
+	record := recordWithVirtual(t, ins_record.Wrap(&ins_record.Activate{Memory: memory}))
+
+	decoded := DecodePayload(record)
+	require.NotNil(t, decoded)
+	require.NotNil(t, decoded.DecodeError)
+	require.Equal(t, base64.StdEncoding.EncodeToString(memory), *decoded.Raw)
+	require.Nil(t, decoded.Memory)
+}
+
+func TestDecodePayload_InvalidRawData(t *testing.T) {
+	record := models.Record{RawData: []byte{0xff, 0xff}, Payload: []byte{1, 2, 3}}
+
+	decoded := DecodePayload(record)
+	require.NotNil(t, decoded)
+	require.NotNil(t, decoded.DecodeError)
+	require.Equal(t, base64.StdEncoding.EncodeToString(record.Payload), *decoded.Raw)
+}
+
+func TestDecodePayload_NothingToDecode(t *testing.T) {
+	record := recordWithVirtual(t, ins_record.Wrap(&ins_record.Deactivate{}))
+	require.Nil(t, DecodePayload(record))
+}
@@ -107,14 +107,20 @@ func (s *Server) JetDropRecords(ctx echo.Context, jetDropID server.JetDropIdPath
 	}
 	if params.Type != nil {
 		str := string(*params.Type)
-		if str != "request" && str != "result" && str != "state" {
+		if !isRecordType(models.RecordType(str)) {
 			failures = append(failures, server.CodeValidationFailures{
-				FailureReason: NullableString("should be 'request', 'state' or 'result'"),
+				FailureReason: NullableString(fmt.Sprintf("should be one of %s", recordTypesString())),
 				Property:      NullableString("type"),
 			})
 		}
 		recordType = &str
 	}
+	decode, decodeFailures := checkDecode(ctx)
+	failures = append(failures, decodeFailures...)
+	extensions, extensionsFailures := checkExtensions(ctx)
+	failures = append(failures, extensionsFailures...)
+	filter, filterFailures := checkRecordFilter(ctx)
+	failures = append(failures, filterFailures...)
 
 	if failures != nil {
 		apiErr := server.CodeValidationError{
@@ -130,21 +136,19 @@ func (s *Server) JetDropRecords(ctx echo.Context, jetDropID server.JetDropIdPath
 		fromIndexString,
 		recordType,
 		limit, offset,
+		filter,
 	)
 	if err != nil {
 		s.logger.Error(err)
 		return ctx.JSON(http.StatusInternalServerError, struct{}{})
 	}
 
-	result := []server.Record{}
-	for _, r := range records {
-		result = append(result, RecordToAPI(r))
+	response, err := s.recordsResponse(records, count, decode, extensions)
+	if err != nil {
+		s.logger.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
 	}
-	cnt := int64(count)
-	return ctx.JSON(http.StatusOK, server.RecordsResponse{
-		Total:  &cnt,
-		Result: &result,
-	})
+	return ctx.JSON(http.StatusOK, response)
 }
 
 func (s *Server) JetDropsByJetID(ctx echo.Context, jetID server.JetIdPath, params server.JetDropsByJetIDParams) error {
@@ -602,6 +606,12 @@ func (s *Server) ObjectLifeline(ctx echo.Context, objectReference server.ObjectR
 	if params.PulseNumberLt != nil {
 		pulseNumberLt, failures = getPulseNumberValue(int(*params.PulseNumberLt), "pulse_number_lt", failures)
 	}
+	decode, decodeFailures := checkDecode(ctx)
+	failures = append(failures, decodeFailures...)
+	extensions, extensionsFailures := checkExtensions(ctx)
+	failures = append(failures, extensionsFailures...)
+	filter, filterFailures := checkRecordFilter(ctx)
+	failures = append(failures, filterFailures...)
 
 	if failures != nil {
 		apiErr := server.CodeValidationError{
@@ -628,21 +638,19 @@ func (s *Server) ObjectLifeline(ctx echo.Context, objectReference server.ObjectR
 		timestampLte, timestampGte,
 		limit, offset,
 		sortByIndexAsc,
+		filter,
 	)
 	if err != nil {
 		s.logger.Error(err)
 		return ctx.JSON(http.StatusInternalServerError, struct{}{})
 	}
 
-	result := []server.Record{}
-	for _, r := range records {
-		result = append(result, RecordToAPI(r))
+	response, err := s.recordsResponse(records, count, decode, extensions)
+	if err != nil {
+		s.logger.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
 	}
-	cnt := int64(count)
-	return ctx.JSON(http.StatusOK, server.RecordsResponse{
-		Total:  &cnt,
-		Result: &result,
-	})
+	return ctx.JSON(http.StatusOK, response)
 }
 
 func (s *Server) findEdgePNInJetDrops(jetDrops []models.JetDrop, sortByAsc bool) (int64, int64) {
@@ -54,6 +54,7 @@ func TestMain(t *testing.M) {
 	blockExplorerAPI := NewServer(context.Background(), s, configuration.API{})
 
 	server.RegisterHandlers(e, blockExplorerAPI)
+	RegisterAdditionalHandlers(e, blockExplorerAPI)
 	stopped := make(chan struct{})
 	go func() {
 		err := e.Start(apihost)
@@ -2627,9 +2628,290 @@ func TestJetDropRecords_Several_Errors(t *testing.T) {
 			FailureReason: NullableString("invalid"),
 			Property:      NullableString("from_index"),
 		}, {
-			FailureReason: NullableString("should be 'request', 'state' or 'result'"),
+			FailureReason: NullableString("should be one of 'state', 'request', 'result', 'code', 'pending_filament', 'genesis'"),
 			Property:      NullableString("type"),
 		}},
 	}
 	require.Equal(t, expected, received)
 }
+
+func TestRecordVerification(t *testing.T) {
+	defer testutils.TruncateTables(t, testDB, []interface{}{models.Record{}, models.JetDrop{}, models.Pulse{}})
+
+	pulse, err := testutils.InitPulseDB()
+	require.NoError(t, err)
+	err = testutils.CreatePulse(testDB, pulse)
+	require.NoError(t, err)
+	jetDrop := testutils.InitJetDropDB(pulse)
+	err = testutils.CreateJetDrop(testDB, jetDrop)
+	require.NoError(t, err)
+
+	genRecord := testutils.GenerateRecordWithHashedID(insolar.PulseNumber(pulse.PulseNumber), gen.ID())
+	rawData, err := genRecord.Marshal()
+	require.NoError(t, err)
+	validRecord := testutils.InitRecordDB(jetDrop)
+	validRecord.Reference = genRecord.Record.ID.Bytes()
+	validRecord.Hash = genRecord.Record.ID.Hash()
+	validRecord.RawData = rawData
+	err = testutils.CreateRecord(testDB, validRecord)
+	require.NoError(t, err)
+	invalidRecord := testutils.InitRecordDB(jetDrop)
+	err = testutils.CreateRecord(testDB, invalidRecord)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		record models.Record
+		valid  bool
+	}{
+		{"valid", validRecord, true},
+		{"invalid", invalidRecord, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ref := insolar.NewReference(*insolar.NewIDFromBytes(test.record.Reference))
+			resp, err := http.Get("http://" + apihost + "/api/v1/records/" + url.PathEscape(ref.String()) + "/verification")
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			bodyBytes, err := ioutil.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			var received RecordVerification
+			err = json.Unmarshal(bodyBytes, &received)
+			require.NoError(t, err)
+			require.Equal(t, test.valid, received.Valid)
+			require.Equal(t, base64.StdEncoding.EncodeToString(test.record.Hash), *received.Hash)
+			require.Equal(t, test.valid, received.Error == nil)
+		})
+	}
+
+	resp, err := http.Get("http://" + apihost + "/api/v1/records/" + url.PathEscape(gen.Reference().String()) + "/verification")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, err = http.Get("http://" + apihost + "/api/v1/records/not_valid_reference/verification")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestRecord_Decode(t *testing.T) {
+	defer testutils.TruncateTables(t, testDB, []interface{}{models.Record{}, models.JetDrop{}, models.Pulse{}})
+
+	pulse, err := testutils.InitPulseDB()
+	require.NoError(t, err)
+	err = testutils.CreatePulse(testDB, pulse)
+	require.NoError(t, err)
+	jetDrop := testutils.InitJetDropDB(pulse)
+	err = testutils.CreateJetDrop(testDB, jetDrop)
+	require.NoError(t, err)
+
+	genRecord := testutils.GenerateRequestRecord(insolar.PulseNumber(pulse.PulseNumber), gen.ID())
+	genRecord.Record.Virtual.GetIncomingRequest().Arguments = insolar.MustSerialize([]interface{}{"argument"})
+	rawData, err := genRecord.Marshal()
+	require.NoError(t, err)
+	record := testutils.InitRecordDB(jetDrop)
+	record.Reference = genRecord.Record.ID.Bytes()
+	record.RawData = rawData
+	err = testutils.CreateRecord(testDB, record)
+	require.NoError(t, err)
+	ref := insolar.NewReference(genRecord.Record.ID).String()
+
+	resp, err := http.Get("http://" + apihost + "/api/v1/records/" + url.PathEscape(ref) + "?decode=true")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	var received ExtendedRecord
+	err = json.Unmarshal(bodyBytes, &received)
+	require.NoError(t, err)
+	require.Equal(t, insolar.NewIDFromBytes(record.Reference).String(), *received.Reference)
+	require.NotNil(t, received.DecodedPayload)
+	require.Equal(t, genRecord.Record.Virtual.GetIncomingRequest().Method, *received.DecodedPayload.Method)
+	require.JSONEq(t, `["argument"]`, string(received.DecodedPayload.Arguments))
+
+	resp, err = http.Get("http://" + apihost + "/api/v1/records/" + url.PathEscape(ref))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	bodyBytes, err = ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	received = ExtendedRecord{}
+	err = json.Unmarshal(bodyBytes, &received)
+	require.NoError(t, err)
+	require.Nil(t, received.DecodedPayload)
+
+	resp, err = http.Get("http://" + apihost + "/api/v1/jet-drops/" + models.NewJetDropID(jetDrop.JetID, jetDrop.PulseNumber).ToString() + "/records?decode=true")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	bodyBytes, err = ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	var receivedRecords ExtendedRecords
+	err = json.Unmarshal(bodyBytes, &receivedRecords)
+	require.NoError(t, err)
+	require.Len(t, *receivedRecords.Result, 1)
+	require.NotNil(t, (*receivedRecords.Result)[0].DecodedPayload)
+
+	resp, err = http.Get("http://" + apihost + "/api/v1/records/" + url.PathEscape(ref) + "?decode=yes")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestCallTree(t *testing.T) {
+	defer testutils.TruncateTables(t, testDB, []interface{}{models.Record{}, models.JetDrop{}, models.Pulse{}})
+
+	pulse, err := testutils.InitPulseDB()
+	require.NoError(t, err)
+	err = testutils.CreatePulse(testDB, pulse)
+	require.NoError(t, err)
+	jetDrop := testutils.InitJetDropDB(pulse)
+	err = testutils.CreateJetDrop(testDB, jetDrop)
+	require.NoError(t, err)
+
+	request := testutils.InitRecordDB(jetDrop)
+	request.Type = models.Request
+	err = testutils.CreateRecord(testDB, request)
+	require.NoError(t, err)
+	result := testutils.InitRecordDB(jetDrop)
+	result.Type = models.Result
+	result.Order = 2
+	result.RequestReference = request.Reference
+	err = testutils.CreateRecord(testDB, result)
+	require.NoError(t, err)
+	nested := testutils.InitRecordDB(jetDrop)
+	nested.Type = models.Request
+	nested.Order = 3
+	nested.ReasonReference = request.Reference
+	err = testutils.CreateRecord(testDB, nested)
+	require.NoError(t, err)
+
+	ref := insolar.NewReference(*insolar.NewIDFromBytes(request.Reference))
+	resp, err := http.Get("http://" + apihost + "/api/v1/requests/" + url.PathEscape(ref.String()) + "/call-tree")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	var received CallTree
+	err = json.Unmarshal(bodyBytes, &received)
+	require.NoError(t, err)
+	require.Equal(t, ref.String(), *received.Request.Reference)
+	require.NotNil(t, received.Result)
+	require.Equal(t, insolar.NewReference(*insolar.NewIDFromBytes(result.Reference)).String(), *received.Result.Reference)
+	require.Len(t, received.Nested, 1)
+	require.Equal(t, insolar.NewReference(*insolar.NewIDFromBytes(nested.Reference)).String(), *received.Nested[0].Request.Reference)
+	require.Nil(t, received.Nested[0].Result)
+
+	resultRef := insolar.NewReference(*insolar.NewIDFromBytes(result.Reference))
+	resp, err = http.Get("http://" + apihost + "/api/v1/requests/" + url.PathEscape(resultRef.String()) + "/call-tree")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, err = http.Get("http://" + apihost + "/api/v1/requests/not_valid_ref/call-tree")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestRecord_Extensions(t *testing.T) {
+	defer testutils.TruncateTables(t, testDB, []interface{}{models.RecordExtension{}, models.Record{}, models.JetDrop{}, models.Pulse{}})
+
+	pulse, err := testutils.InitPulseDB()
+	require.NoError(t, err)
+	err = testutils.CreatePulse(testDB, pulse)
+	require.NoError(t, err)
+	jetDrop := testutils.InitJetDropDB(pulse)
+	err = testutils.CreateJetDrop(testDB, jetDrop)
+	require.NoError(t, err)
+	record := testutils.InitRecordDB(jetDrop)
+	record.Type = models.Code
+	record.Payload = []byte{}
+	err = testutils.CreateRecord(testDB, record)
+	require.NoError(t, err)
+	extension := models.RecordExtension{RecordReference: record.Reference, Kind: models.CodeExtension, Data: testutils.GenerateRandBytes()}
+	err = testDB.Create(&extension).Error
+	require.NoError(t, err)
+	ref := insolar.NewReference(*insolar.NewIDFromBytes(record.Reference)).String()
+	expected := []RecordExtension{{
+		Kind: NullableString("code"),
+		Data: NullableString(base64.StdEncoding.EncodeToString(extension.Data)),
+	}}
+
+	resp, err := http.Get("http://" + apihost + "/api/v1/records/" + url.PathEscape(ref) + "?extensions=true")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	var received ExtendedRecord
+	err = json.Unmarshal(bodyBytes, &received)
+	require.NoError(t, err)
+	require.NotNil(t, received.Extensions)
+	require.Equal(t, expected, *received.Extensions)
+
+	resp, err = http.Get("http://" + apihost + "/api/v1/records/" + url.PathEscape(ref))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	bodyBytes, err = ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	received = ExtendedRecord{}
+	err = json.Unmarshal(bodyBytes, &received)
+	require.NoError(t, err)
+	require.Nil(t, received.Extensions)
+
+	resp, err = http.Get("http://" + apihost + "/api/v1/jet-drops/" + models.NewJetDropID(jetDrop.JetID, jetDrop.PulseNumber).ToString() + "/records?extensions=true")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	bodyBytes, err = ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	var receivedRecords ExtendedRecords
+	err = json.Unmarshal(bodyBytes, &receivedRecords)
+	require.NoError(t, err)
+	require.Len(t, *receivedRecords.Result, 1)
+	require.Equal(t, expected, *(*receivedRecords.Result)[0].Extensions)
+
+	resp, err = http.Get("http://" + apihost + "/api/v1/records/" + url.PathEscape(ref) + "?extensions=maybe")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestPulseJets(t *testing.T) {
+	defer testutils.TruncateTables(t, testDB, []interface{}{models.PulseJet{}, models.Pulse{}})
+	s := storage.NewStorage(testDB)
+
+	prevPulse, err := testutils.InitPulseDB()
+	require.NoError(t, err)
+	err = testutils.CreatePulse(testDB, prevPulse)
+	require.NoError(t, err)
+	pulse, err := testutils.InitNextPulseDB(prevPulse.PulseNumber)
+	require.NoError(t, err)
+	err = testutils.CreatePulse(testDB, pulse)
+	require.NoError(t, err)
+
+	err = s.SavePulseJets(prevPulse.PulseNumber, []string{"00", "01", "1"})
+	require.NoError(t, err)
+	err = s.SavePulseJets(pulse.PulseNumber, []string{"0", "10", "11"})
+	require.NoError(t, err)
+
+	resp, err := http.Get("http://" + apihost + "/api/v1/pulses/" + strconv.FormatInt(pulse.PulseNumber, 10) + "/jets")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	var received PulseJetTree
+	err = json.Unmarshal(bodyBytes, &received)
+	require.NoError(t, err)
+	require.Equal(t, PulseJetTree{
+		PulseNumber: pulse.PulseNumber,
+		Jets: []PulseJet{
+			{JetID: "0", Event: JetMerge},
+			{JetID: "10", Event: JetSplit},
+			{JetID: "11", Event: JetSplit},
+		},
+	}, received)
+
+	resp, err = http.Get("http://" + apihost + "/api/v1/pulses/" + strconv.FormatInt(pulse.NextPulseNumber, 10) + "/jets")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, err = http.Get("http://" + apihost + "/api/v1/pulses/not_valid_pulse/jets")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
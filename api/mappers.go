@@ -92,3 +92,85 @@ func JetDropToAPI(jetDrop models.JetDrop, prevJetDrops, nextJetDrops []server.Ne
 	}
 	return result
 }
+
+// CallTree is the request with its result and requests caused by it
+type CallTree struct {
+	Request server.Record  `json:"request"`
+	Result  *server.Record `json:"result,omitempty"`
+	Nested  []CallTree     `json:"nested,omitempty"`
+}
+
+// RecordsToCallTree builds the call tree from the records, the first record has to be the root request
+func RecordsToCallTree(records []models.Record) CallTree {
+	results := map[string]models.Record{}
+	nested := map[string][]models.Record{}
+	for _, r := range records[1:] {
+		switch r.Type {
+		case models.Result:
+			results[string(r.RequestReference)] = r
+		case models.Request:
+			nested[string(r.ReasonReference)] = append(nested[string(r.ReasonReference)], r)
+		}
+	}
+
+	var build func(request models.Record) CallTree
+	build = func(request models.Record) CallTree {
+		tree := CallTree{Request: RecordToAPI(request)}
+		if result, ok := results[string(request.Reference)]; ok {
+			apiResult := RecordToAPI(result)
+			tree.Result = &apiResult
+		}
+		for _, r := range nested[string(request.Reference)] {
+			tree.Nested = append(tree.Nested, build(r))
+		}
+		return tree
+	}
+	return build(records[0])
+}
+
+// Jet tree changes relative to the previous pulse
+const (
+	JetSplit = "split"
+	JetMerge = "merge"
+)
+
+// PulseJet is the jet of the pulse jet tree, Event shows whether the jet appeared by split or merge
+type PulseJet struct {
+	JetID string `json:"jet_id"`
+	Event string `json:"event,omitempty"`
+}
+
+// PulseJetTree is the jet tree of the pulse
+type PulseJetTree struct {
+	PulseNumber int64      `json:"pulse_number"`
+	Jets        []PulseJet `json:"jets"`
+}
+
+// PulseJetsToAPI builds the jet tree of the pulse, events are detected by the jet tree of the previous pulse,
+// jet is split if its parent was in the previous tree and merged if both its children were.
+// The root jet is rendered as "*" like in the other responses
+func PulseJetsToAPI(pulseNumber int64, jets []models.PulseJet, prevJets []models.PulseJet) PulseJetTree {
+	prev := make(map[string]struct{}, len(prevJets))
+	for _, j := range prevJets {
+		prev[j.JetID] = struct{}{}
+	}
+	tree := PulseJetTree{PulseNumber: pulseNumber, Jets: make([]PulseJet, 0, len(jets))}
+	for _, j := range jets {
+		jet := PulseJet{JetID: models.NewJetDropID(j.JetID, pulseNumber).JetIDToString()}
+		if len(prev) > 0 {
+			if _, ok := prev[j.JetID]; !ok {
+				_, left := prev[j.JetID+"0"]
+				_, right := prev[j.JetID+"1"]
+				if left && right {
+					jet.Event = JetMerge
+				} else if j.JetID != "" {
+					if _, ok := prev[j.JetID[:len(j.JetID)-1]]; ok {
+						jet.Event = JetSplit
+					}
+				}
+			}
+		}
+		tree.Jets = append(tree.Jets, jet)
+	}
+	return tree
+}
@@ -0,0 +1,82 @@
+// +build unit
+
+package api
+
+import (
+	"testing"
+
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/block-explorer/etl/models"
+)
+
+func TestRecordsToCallTree(t *testing.T) {
+	record := func(recordType models.RecordType, reason, request models.Reference) models.Record {
+		return models.Record{
+			Reference:        gen.ID().Bytes(),
+			Type:             recordType,
+			ReasonReference:  reason,
+			RequestReference: request,
+			JetID:            "0",
+			PulseNumber:      int64(gen.PulseNumber()),
+		}
+	}
+	root := record(models.Request, nil, nil)
+	rootResult := record(models.Result, nil, root.Reference)
+	first := record(models.Request, root.Reference, nil)
+	second := record(models.Request, root.Reference, nil)
+	secondResult := record(models.Result, nil, second.Reference)
+	deep := record(models.Request, first.Reference, nil)
+
+	tree := RecordsToCallTree([]models.Record{root, rootResult, first, second, secondResult, deep})
+
+	require.Equal(t, RecordToAPI(root), tree.Request)
+	require.NotNil(t, tree.Result)
+	require.Equal(t, RecordToAPI(rootResult), *tree.Result)
+	require.Len(t, tree.Nested, 2)
+
+	require.Equal(t, RecordToAPI(first), tree.Nested[0].Request)
+	require.Nil(t, tree.Nested[0].Result)
+	require.Len(t, tree.Nested[0].Nested, 1)
+	require.Equal(t, RecordToAPI(deep), tree.Nested[0].Nested[0].Request)
+	require.Empty(t, tree.Nested[0].Nested[0].Nested)
+
+	require.Equal(t, RecordToAPI(second), tree.Nested[1].Request)
+	require.NotNil(t, tree.Nested[1].Result)
+	require.Equal(t, RecordToAPI(secondResult), *tree.Nested[1].Result)
+	require.Empty(t, tree.Nested[1].Nested)
+}
+
+func TestPulseJetsToAPI(t *testing.T) {
+	jets := func(ids ...string) []models.PulseJet {
+		result := make([]models.PulseJet, 0, len(ids))
+		for _, id := range ids {
+			result = append(result, models.PulseJet{JetID: id})
+		}
+		return result
+	}
+
+	tree := PulseJetsToAPI(1000, jets("0", "10", "11"), jets("00", "01", "1"))
+	require.Equal(t, PulseJetTree{
+		PulseNumber: 1000,
+		Jets: []PulseJet{
+			{JetID: "0", Event: JetMerge},
+			{JetID: "10", Event: JetSplit},
+			{JetID: "11", Event: JetSplit},
+		},
+	}, tree)
+
+	tree = PulseJetsToAPI(1000, jets("0", "1"), jets(""))
+	require.Equal(t, []PulseJet{{JetID: "0", Event: JetSplit}, {JetID: "1", Event: JetSplit}}, tree.Jets)
+
+	tree = PulseJetsToAPI(1000, jets(""), jets("0", "1"))
+	require.Equal(t, []PulseJet{{JetID: "*", Event: JetMerge}}, tree.Jets)
+
+	tree = PulseJetsToAPI(1000, jets("0", "1"), jets("0", "1"))
+	require.Equal(t, []PulseJet{{JetID: "0"}, {JetID: "1"}}, tree.Jets)
+
+	// events are unknown without the previous jet tree
+	tree = PulseJetsToAPI(1000, jets("0", "1"), nil)
+	require.Equal(t, []PulseJet{{JetID: "0"}, {JetID: "1"}}, tree.Jets)
+}
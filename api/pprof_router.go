@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/pprof"
+	"sync/atomic"
 
 	"github.com/insolar/assured-ledger/ledger-core/v2/log"
 	"github.com/insolar/block-explorer/instrumentation/belogger"
@@ -13,12 +14,9 @@ import (
 
 func NewRouter() *Router {
 	mux := http.NewServeMux()
+	r := &Router{mux: mux}
 
-	mux.HandleFunc("/healthcheck", func(w http.ResponseWriter, _ *http.Request) {
-		w.Header().Set("Content-Type", "text/plain")
-		w.WriteHeader(http.StatusOK)
-		_, _ = fmt.Fprint(w, "OK")
-	})
+	mux.HandleFunc("/healthcheck", r.healthcheck)
 
 	mux.HandleFunc("/debug/pprof/", pprof.Index)
 	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
@@ -26,11 +24,7 @@ func NewRouter() *Router {
 	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
 	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
 
-	hs := &http.Server{Addr: ":8000", Handler: mux}
-
-	r := &Router{
-		hs: hs,
-	}
+	r.hs = &http.Server{Addr: ":8000", Handler: mux}
 
 	return r
 }
@@ -44,7 +38,29 @@ func (o PromHTTPLoggerAdapter) Println(v ...interface{}) {
 }
 
 type Router struct {
-	hs *http.Server
+	hs   *http.Server
+	mux  *http.ServeMux
+	role atomic.Value
+}
+
+// SetRole makes the healthcheck report the role of the instance returned by role
+func (r *Router) SetRole(role func() string) {
+	r.role.Store(role)
+}
+
+func (r *Router) healthcheck(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	if role, ok := r.role.Load().(func() string); ok {
+		_, _ = fmt.Fprintf(w, "OK\nrole: %s", role())
+		return
+	}
+	_, _ = fmt.Fprint(w, "OK")
+}
+
+// Handle registers the handler for the given pattern, it can be called after the router is started
+func (r *Router) Handle(pattern string, handler http.Handler) {
+	r.mux.Handle(pattern, handler)
 }
 
 func (r *Router) Start(ctx context.Context) error {
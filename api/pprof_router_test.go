@@ -0,0 +1,31 @@
+// +build unit
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_Healthcheck(t *testing.T) {
+	router := NewRouter()
+	rec := httptest.NewRecorder()
+	router.mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthcheck", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "OK", rec.Body.String())
+
+	role := "standby"
+	router.SetRole(func() string { return role })
+	rec = httptest.NewRecorder()
+	router.mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthcheck", nil))
+	require.Equal(t, http.StatusOK, rec.Code, "standby instance is healthy")
+	require.Equal(t, "OK\nrole: standby", rec.Body.String())
+
+	role = "leader"
+	rec = httptest.NewRecorder()
+	router.mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthcheck", nil))
+	require.Equal(t, "OK\nrole: leader", rec.Body.String())
+}
@@ -0,0 +1,92 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/insolar/spec-insolar-block-explorer-api/v1/server"
+	"github.com/labstack/echo/v4"
+
+	"github.com/insolar/block-explorer/etl/models"
+)
+
+// checkRecordFilter returns the filter built from the optional query parameters
+// subtype, caller, method, immutable and api_request_id
+func checkRecordFilter(ctx echo.Context) (models.RecordFilter, []server.CodeValidationFailures) {
+	var filter models.RecordFilter
+	var failures []server.CodeValidationFailures
+
+	if param := ctx.QueryParam("subtype"); param != "" {
+		subtype := models.RecordSubtype(param)
+		if !isRecordSubtype(subtype) {
+			failures = append(failures, server.CodeValidationFailures{
+				FailureReason: NullableString(fmt.Sprintf("should be one of %s", recordSubtypesString())),
+				Property:      NullableString("subtype"),
+			})
+		}
+		filter.Subtype = &subtype
+	}
+	if param := ctx.QueryParam("caller"); param != "" {
+		ref, err := checkReference(param)
+		if err != nil {
+			failures = append(failures, server.CodeValidationFailures{
+				FailureReason: NullableString(err.Error()),
+				Property:      NullableString("caller"),
+			})
+		} else {
+			filter.Caller = ref.GetLocal().Bytes()
+		}
+	}
+	if param := ctx.QueryParam("method"); param != "" {
+		filter.Method = &param
+	}
+	if param := ctx.QueryParam("immutable"); param != "" {
+		immutable, err := strconv.ParseBool(param)
+		if err != nil {
+			failures = append(failures, server.CodeValidationFailures{
+				FailureReason: NullableString("should be 'true' or 'false'"),
+				Property:      NullableString("immutable"),
+			})
+		}
+		filter.Immutable = &immutable
+	}
+	if param := ctx.QueryParam("api_request_id"); param != "" {
+		filter.APIRequestID = &param
+	}
+	return filter, failures
+}
+
+func isRecordSubtype(subtype models.RecordSubtype) bool {
+	for _, s := range models.RecordSubtypes {
+		if s == subtype {
+			return true
+		}
+	}
+	return false
+}
+
+func recordSubtypesString() string {
+	subtypes := make([]string, 0, len(models.RecordSubtypes))
+	for _, s := range models.RecordSubtypes {
+		subtypes = append(subtypes, fmt.Sprintf("'%s'", s))
+	}
+	return strings.Join(subtypes, ", ")
+}
+
+func isRecordType(recordType models.RecordType) bool {
+	for _, t := range models.RecordTypes {
+		if t == recordType {
+			return true
+		}
+	}
+	return false
+}
+
+func recordTypesString() string {
+	recordTypes := make([]string, 0, len(models.RecordTypes))
+	for _, t := range models.RecordTypes {
+		recordTypes = append(recordTypes, fmt.Sprintf("'%s'", t))
+	}
+	return strings.Join(recordTypes, ", ")
+}
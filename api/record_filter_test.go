@@ -0,0 +1,88 @@
+// +build unit
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/block-explorer/etl/models"
+)
+
+func filterContext(query url.Values) echo.Context {
+	req := httptest.NewRequest(http.MethodGet, "/?"+query.Encode(), nil)
+	return echo.New().NewContext(req, httptest.NewRecorder())
+}
+
+func TestCheckRecordFilter(t *testing.T) {
+	caller := gen.Reference()
+	filter, failures := checkRecordFilter(filterContext(url.Values{
+		"subtype":        {"outgoing_request"},
+		"caller":         {caller.String()},
+		"method":         {"Transfer"},
+		"immutable":      {"true"},
+		"api_request_id": {"api-request"},
+	}))
+	require.Empty(t, failures)
+	require.Equal(t, models.SubtypeOutgoingRequest, *filter.Subtype)
+	require.Equal(t, models.Reference(caller.GetLocal().Bytes()), filter.Caller)
+	require.Equal(t, "Transfer", *filter.Method)
+	require.True(t, *filter.Immutable)
+	require.Equal(t, "api-request", *filter.APIRequestID)
+
+	filter, failures = checkRecordFilter(filterContext(url.Values{}))
+	require.Empty(t, failures)
+	require.Equal(t, models.RecordFilter{}, filter)
+
+	_, failures = checkRecordFilter(filterContext(url.Values{
+		"subtype":   {"state"},
+		"caller":    {"not_valid_ref"},
+		"immutable": {"maybe"},
+	}))
+	require.Len(t, failures, 3)
+	require.Equal(t, "subtype", *failures[0].Property)
+	require.Equal(t, "caller", *failures[1].Property)
+	require.Equal(t, "immutable", *failures[2].Property)
+}
+
+func TestRecordToExtendedAPI(t *testing.T) {
+	caller := gen.Reference()
+	request := models.Record{
+		Reference:    gen.ID().Bytes(),
+		Type:         models.Request,
+		Subtype:      models.SubtypeIncomingRequest,
+		Caller:       caller.GetLocal().Bytes(),
+		Method:       "Transfer",
+		APIRequestID: "api-request",
+		JetID:        "0",
+		PulseNumber:  int64(gen.PulseNumber()),
+	}
+	received := RecordToExtendedAPI(request, false)
+	require.Equal(t, RecordToAPI(request), received.Record)
+	require.Equal(t, "incoming_request", *received.Subtype)
+	require.Equal(t, caller.String(), *received.Caller)
+	require.Equal(t, "Transfer", *received.Method)
+	require.False(t, *received.Immutable)
+	require.Equal(t, "api-request", *received.APIRequestID)
+	require.Nil(t, received.DecodedPayload)
+
+	state := models.Record{
+		Reference:   gen.ID().Bytes(),
+		Type:        models.State,
+		Subtype:     models.SubtypeDeactivate,
+		JetID:       "0",
+		PulseNumber: int64(gen.PulseNumber()),
+	}
+	received = RecordToExtendedAPI(state, false)
+	require.Equal(t, "deactivate", *received.Subtype)
+	require.Nil(t, received.Caller)
+	require.Nil(t, received.Method)
+	require.Nil(t, received.Immutable)
+	require.Nil(t, received.APIRequestID)
+}
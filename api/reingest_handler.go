@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/block-explorer/etl/interfaces"
+	"github.com/insolar/block-explorer/instrumentation/belogger"
+)
+
+// NewReingestHandler returns the handler of the pulses re-ingestion.
+// POST request with from and to query parameters starts the re-ingestion of the pulse range,
+// GET request returns the progress of the last re-ingestion.
+// The re-ingestion is bound to the provided context, not to the request one.
+func NewReingestHandler(ctx context.Context, reingester interfaces.Reingester) http.HandlerFunc {
+	logger := belogger.FromContext(ctx)
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPost:
+			from, err := strconv.ParseInt(r.URL.Query().Get("from"), 10, 64)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("invalid from pulse: %s", err), http.StatusBadRequest)
+				return
+			}
+			to, err := strconv.ParseInt(r.URL.Query().Get("to"), 10, 64)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("invalid to pulse: %s", err), http.StatusBadRequest)
+				return
+			}
+			logger.Infof("Re-ingestion of pulses %d - %d requested", from, to)
+			if err := reingester.Reingest(ctx, from, to); err != nil {
+				logger.Error(err)
+				http.Error(w, err.Error(), reingestErrorCode(err))
+				return
+			}
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		progress, ok := reingester.ReingestProgress()
+		if !ok {
+			http.Error(w, "no re-ingestion", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(progress)
+	}
+}
+
+// reingestErrorCode returns the status code of the Reingest error
+func reingestErrorCode(err error) int {
+	switch errors.Cause(err) {
+	case interfaces.ErrReingestInvalidRange:
+		return http.StatusBadRequest
+	case interfaces.ErrReingestPulseNotFound:
+		return http.StatusNotFound
+	case interfaces.ErrReingestInProgress:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,67 @@
+// +build unit
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/block-explorer/etl/interfaces"
+	"github.com/insolar/block-explorer/etl/interfaces/mock"
+	"github.com/insolar/block-explorer/etl/types"
+)
+
+func TestReingestHandler(t *testing.T) {
+	ctx := context.Background()
+	progress := types.ReingestProgress{FromPulseNumber: 30, ToPulseNumber: 50, SequentialPulseNumber: 20}
+	reingester := mock.NewReingesterMock(t)
+	reingester.ReingestMock.Expect(ctx, 30, 50).Return(nil)
+	reingester.ReingestProgressMock.Return(progress, true)
+	handler := NewReingestHandler(ctx, reingester)
+
+	for _, method := range []string{http.MethodPost, http.MethodGet} {
+		rec := httptest.NewRecorder()
+		handler(rec, httptest.NewRequest(method, "/admin/reingest?from=30&to=50", nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+		var received types.ReingestProgress
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &received))
+		require.Equal(t, progress, received)
+	}
+	require.Equal(t, uint64(1), reingester.ReingestAfterCounter())
+}
+
+func TestReingestHandler_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		method string
+		target string
+		err    error
+		code   int
+	}{
+		{http.MethodPost, "/admin/reingest?from=30", nil, http.StatusBadRequest},
+		{http.MethodPost, "/admin/reingest?from=a&to=50", nil, http.StatusBadRequest},
+		{http.MethodPost, "/admin/reingest?from=50&to=30", errors.Wrap(interfaces.ErrReingestInvalidRange, "from pulse 50 is greater than to pulse 30"), http.StatusBadRequest},
+		{http.MethodPost, "/admin/reingest?from=30&to=50", errors.Wrap(interfaces.ErrReingestPulseNotFound, "pulse 30"), http.StatusNotFound},
+		{http.MethodPost, "/admin/reingest?from=30&to=50", errors.Wrap(interfaces.ErrReingestInProgress, "pulses 10 - 20"), http.StatusConflict},
+		{http.MethodPost, "/admin/reingest?from=30&to=50", errors.New("can't delete pulses 30 - 50"), http.StatusInternalServerError},
+		{http.MethodGet, "/admin/reingest", nil, http.StatusNotFound},
+		{http.MethodDelete, "/admin/reingest", nil, http.StatusMethodNotAllowed},
+	}
+	for _, test := range tests {
+		reingester := mock.NewReingesterMock(t)
+		reingester.ReingestMock.Return(test.err)
+		reingester.ReingestProgressMock.Return(types.ReingestProgress{}, false)
+		handler := NewReingestHandler(ctx, reingester)
+
+		rec := httptest.NewRecorder()
+		handler(rec, httptest.NewRequest(test.method, test.target, nil))
+		require.Equal(t, test.code, rec.Code, test.method+" "+test.target)
+	}
+}
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"encoding/base64"
+	"net/http"
+
+	"github.com/insolar/spec-insolar-block-explorer-api/v1/server"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/block-explorer/etl/audit"
+)
+
+// RecordVerification is the result of the record hash verification
+type RecordVerification struct {
+	Reference      *string `json:"reference,omitempty"`
+	Hash           *string `json:"hash,omitempty"`
+	CalculatedHash *string `json:"calculated_hash,omitempty"`
+	Valid          bool    `json:"valid"`
+	Error          *string `json:"error,omitempty"`
+}
+
+// RecordVerification recalculates the hash of the record from its raw data and compares it with the stored one
+func (s *Server) RecordVerification(ctx echo.Context) error {
+	ref, err := checkReference(ctx.Param("reference"))
+	if err != nil {
+		apiErr := server.CodeValidationError{
+			Code:    NullableString(http.StatusText(http.StatusBadRequest)),
+			Message: NullableString(InvalidParamsMessage),
+			ValidationFailures: &[]server.CodeValidationFailures{{
+				FailureReason: NullableString(err.Error()),
+				Property:      NullableString("reference"),
+			}},
+		}
+		return ctx.JSON(http.StatusBadRequest, apiErr)
+	}
+
+	record, err := s.storage.GetRecord(ref.GetLocal().Bytes())
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return ctx.JSON(http.StatusNotFound, struct{}{})
+		}
+		s.logger.Error(errors.Wrapf(err, "error while select record from db by reference %s", ref.String()))
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+
+	response := RecordVerification{
+		Reference: NullableString(ref.String()),
+		Hash:      NullableString(base64.StdEncoding.EncodeToString(record.Hash)),
+		Valid:     true,
+	}
+	hash, err := audit.VerifyRecord(record)
+	if hash != nil {
+		response.CalculatedHash = NullableString(base64.StdEncoding.EncodeToString(hash))
+	}
+	if err != nil {
+		response.Valid = false
+		response.Error = NullableString(err.Error())
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
@@ -79,6 +79,7 @@ func main() {
 
 	apiServer := api.NewServer(ctx, s, *cfg)
 	server.RegisterHandlers(e, apiServer)
+	api.RegisterAdditionalHandlers(e, apiServer)
 
 	srv := &http.Server{
 		Addr:         cfg.Listen,
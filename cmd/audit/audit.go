@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/insolar/insconfig"
+
+	"github.com/insolar/block-explorer/configuration"
+	"github.com/insolar/block-explorer/etl/audit"
+	"github.com/insolar/block-explorer/etl/dbconn"
+	"github.com/insolar/block-explorer/etl/storage"
+	"github.com/insolar/block-explorer/instrumentation/belogger"
+)
+
+func main() {
+	cfg := &configuration.AuditCLI{}
+	params := insconfig.Params{
+		EnvPrefix:        "audit",
+		ConfigPathGetter: &insconfig.DefaultPathGetter{},
+	}
+	insConfigurator := insconfig.New(params)
+	if err := insConfigurator.Load(cfg); err != nil {
+		panic(err)
+	}
+	fmt.Println("Starts with configuration:\n", insConfigurator.ToYaml(cfg))
+	ctx, log := belogger.InitLogger(context.Background(), cfg.Log, "audit")
+
+	db, err := dbconn.Connect(cfg.DB)
+	if err != nil {
+		log.Fatalf("Error while connecting to database: %s", err.Error())
+		return
+	}
+	defer db.Close()
+
+	auditor := audit.NewAuditor(storage.NewStorage(db), 0)
+	pulses, findings, err := auditor.AuditRange(ctx, cfg.FromPulse, cfg.ToPulse)
+	if err != nil {
+		log.Fatalf("Audit failed after %d pulses: %v", pulses, err)
+		return
+	}
+	log.Infof("audited %d pulses, found %d discrepancies", pulses, findings)
+
+	if cfg.VerifyRecords {
+		verified, invalid, err := auditor.VerifyRecordsRange(ctx, cfg.FromPulse, cfg.ToPulse)
+		if err != nil {
+			log.Fatalf("Records verification failed after %d records: %v", verified, err)
+			return
+		}
+		log.Infof("verified %d records, found %d invalid records", verified, invalid)
+	}
+}
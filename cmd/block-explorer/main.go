@@ -8,11 +8,14 @@ import (
 	"syscall"
 
 	"github.com/insolar/block-explorer/api"
+	"github.com/insolar/block-explorer/etl/audit"
 	"github.com/insolar/block-explorer/etl/connection"
 	"github.com/insolar/block-explorer/etl/controller"
 	"github.com/insolar/block-explorer/etl/dbconn"
 	"github.com/insolar/block-explorer/etl/dbconn/plugins"
 	"github.com/insolar/block-explorer/etl/extractor"
+	"github.com/insolar/block-explorer/etl/interfaces"
+	"github.com/insolar/block-explorer/etl/leader"
 	"github.com/insolar/block-explorer/etl/processor"
 	"github.com/insolar/block-explorer/etl/storage"
 	"github.com/insolar/block-explorer/etl/transformer"
@@ -20,7 +23,6 @@ import (
 	"github.com/insolar/block-explorer/instrumentation/metrics"
 	"github.com/insolar/block-explorer/instrumentation/profefe"
 	"github.com/insolar/insconfig"
-	"github.com/insolar/insolar/ledger/heavy/exporter"
 	"github.com/pkg/errors"
 
 	"github.com/insolar/block-explorer/configuration"
@@ -66,23 +68,114 @@ func main() {
 		}
 	}()
 
-	client, err := connection.NewGRPCClientConnection(ctx, cfg.Replicator)
+	adminRouter, err := api.NewAdminRouter(cfg.Admin)
 	if err != nil {
-		logger.Fatal("cannot connect to GRPC server: ", err)
-	}
-	defer client.GetGRPCConn().Close()
-	client.NotifyShutdown(ctx, stopChannel, cfg.Replicator.WaitForConnectionRecoveryTimeout)
-
-	pulseExtractor := extractor.NewPlatformPulseExtractor(exporter.NewPulseExporterClient(client.GetGRPCConn()))
-	platformExtractor := extractor.NewPlatformExtractor(
-		100,
-		cfg.Replicator.ContinuousPulseRetrievingHalfPulseSeconds,
-		int32(cfg.Replicator.ParallelConnections),
-		cfg.Replicator.QueueLen,
-		pulseExtractor,
-		exporter.NewRecordExporterClient(client.GetGRPCConn()),
-		shutdownBE,
-	)
+		logger.Fatal("cannot create admin router: ", err)
+	}
+	_ = adminRouter.Start(ctx)
+	defer func() {
+		err := adminRouter.Stop(ctx)
+		if err != nil {
+			logger.Fatal("cannot stop admin router: ", err)
+		}
+	}()
+
+	db, err := dbconn.Connect(cfg.DB)
+	if err != nil {
+		logger.Fatalf("Error while connecting to database: %s", err.Error())
+	}
+	defer func() {
+		err := db.DB().Close()
+		if err != nil {
+			logger.Error(errors.Wrap(err, "failed to close database").Error())
+		}
+	}()
+
+	db.SetLogger(belogger.NewGORMLogAdapter(logger))
+
+	r := plugins.NewDefaultShutdownPlugin(stopChannel)
+	r.Apply(db)
+
+	repository := storage.NewStorage(db)
+
+	metricConfig := metrics.Config{
+		RefreshInterval: cfg.Metrics.RefreshInterval,
+		StartServer:     cfg.Metrics.StartServer,
+		HTTPServerPort:  cfg.Metrics.HTTPServerPort,
+		MetricsCollectors: []metrics.Collector{
+			storage.NewPostgresCollector(nil, db),
+			storage.NewStatsCollector(db, nil),
+			storage.Metrics{},
+			extractor.Metrics{},
+			transformer.Metrics{},
+			controller.Metrics{},
+			processor.Metrics{},
+			audit.Metrics{},
+			connection.Metrics{},
+			leader.Metrics{},
+		},
+	}
+
+	_ = metrics.New(metricConfig).Initialize()
+
+	var platformExtractor interfaces.JetDropsExtractor
+	if cfg.Import.Enabled {
+		logger.Infof("Importing pulses from %s and records from %s", cfg.Import.PulsesFile, cfg.Import.RecordsFile)
+		pulseExtractor, err := extractor.NewFilePulseExtractor(cfg.Import.PulsesFile)
+		if err != nil {
+			logger.Fatal("cannot read pulses file: ", err)
+		}
+		platformExtractor = extractor.NewFileExtractor(cfg.Import.RecordsFile, cfg.Import.QueueLen, pulseExtractor)
+	} else {
+		replicas, err := connection.NewReplicas(ctx, cfg.Replicator)
+		if err != nil {
+			logger.Fatal("cannot connect to GRPC server: ", err)
+		}
+		defer replicas.Close()
+		err = replicas.Start(ctx)
+		if err != nil {
+			logger.Fatal("cannot start health checks of heavy replicas: ", err)
+		}
+		defer func() {
+			err := replicas.Stop(ctx)
+			if err != nil {
+				logger.Fatal("cannot stop health checks of heavy replicas: ", err)
+			}
+		}()
+		replicas.NotifyShutdown(ctx, stopChannel, cfg.Replicator.WaitForConnectionRecoveryTimeout)
+
+		pulseExtractor := extractor.NewPlatformPulseExtractor(replicas.PulseExporterClient())
+		platformExtractor = extractor.NewPlatformExtractor(
+			100,
+			cfg.Replicator.ContinuousPulseRetrievingHalfPulseSeconds,
+			int32(cfg.Replicator.ParallelConnections),
+			cfg.Replicator.QueueLen,
+			pulseExtractor,
+			replicas.RecordExporterClient(),
+			repository,
+			shutdownBE,
+		)
+	}
+
+	if cfg.LeaderElection.Enabled {
+		elector := leader.NewElector(db.DB(), cfg.LeaderElection)
+		router.SetRole(elector.Role)
+		err = elector.Start(ctx)
+		if err != nil {
+			logger.Fatal("cannot start leader election: ", err)
+		}
+		defer func() {
+			err := elector.Stop(ctx)
+			if err != nil {
+				logger.Fatal("cannot stop leader election: ", err)
+			}
+		}()
+		if !waitForLeadership(ctx, elector) {
+			return
+		}
+		elector.NotifyShutdown(ctx, stopChannel)
+	}
+
 	err = platformExtractor.Start(ctx)
 	if err != nil {
 		logger.Fatal("cannot start platformExtractor: ", err)
@@ -109,24 +202,6 @@ func main() {
 		}
 	}()
 
-	db, err := dbconn.Connect(cfg.DB)
-	if err != nil {
-		logger.Fatalf("Error while connecting to database: %s", err.Error())
-	}
-	defer func() {
-		err := db.DB().Close()
-		if err != nil {
-			logger.Error(errors.Wrap(err, "failed to close database").Error())
-		}
-	}()
-
-	db.SetLogger(belogger.NewGORMLogAdapter(logger))
-
-	r := plugins.NewDefaultShutdownPlugin(stopChannel)
-	r.Apply(db)
-
-	repository := storage.NewStorage(db)
-
 	gbeController, err := controller.NewController(cfg.Controller, platformExtractor, repository, cfg.Replicator.PlatformVersion)
 	if err != nil {
 		logger.Fatal("cannot initialize gbeController: ", err)
@@ -142,7 +217,9 @@ func main() {
 		}
 	}()
 
-	proc := processor.NewProcessor(mainNetTransformer, repository, gbeController, cfg.Processor.Workers)
+	adminRouter.Handle("/admin/reingest", api.NewReingestHandler(ctx, gbeController))
+
+	proc := processor.NewProcessor(mainNetTransformer, repository, repository, repository, gbeController, cfg.Processor)
 	err = proc.Start(ctx)
 	if err != nil {
 		logger.Fatal("cannot start processor: ", err)
@@ -154,21 +231,21 @@ func main() {
 		}
 	}()
 
-	metricConfig := metrics.Config{
-		RefreshInterval: cfg.Metrics.RefreshInterval,
-		StartServer:     cfg.Metrics.StartServer,
-		HTTPServerPort:  cfg.Metrics.HTTPServerPort,
-		MetricsCollectors: []metrics.Collector{
-			storage.NewPostgresCollector(nil, db),
-			storage.NewStatsCollector(db, nil),
-			storage.Metrics{},
-			extractor.Metrics{},
-			transformer.Metrics{},
-			controller.Metrics{},
-		},
-	}
+	adminRouter.Handle("/admin/dead-letters", api.NewDeadLettersHandler(ctx, proc))
 
-	_ = metrics.New(metricConfig).Initialize()
+	if cfg.Audit.Enabled {
+		auditor := audit.NewAuditor(repository, cfg.Audit.Period)
+		err = auditor.Start(ctx)
+		if err != nil {
+			logger.Fatal("cannot start auditor: ", err)
+		}
+		defer func() {
+			err := auditor.Stop(ctx)
+			if err != nil {
+				logger.Fatal("cannot stop auditor: ", err)
+			}
+		}()
+	}
 
 	graceful(ctx)
 }
@@ -184,6 +261,24 @@ func graceful(ctx context.Context) {
 	}
 }
 
+// waitForLeadership blocks the standby instance until it's elected as the leader,
+// false is returned if the instance is stopped before
+func waitForLeadership(ctx context.Context, elector *leader.Elector) bool {
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	logger := belogger.FromContext(ctx)
+	logger.Info("Waiting for the leadership, the instance is standby")
+	select {
+	case <-elector.Elected():
+		logger.Info("The instance is the leader, starting ETL")
+		return true
+	case <-stopChannel:
+		logger.Info("gracefully stopping standby by channel")
+	case <-stop:
+		logger.Info("gracefully stopping standby by signal")
+	}
+	return false
+}
+
 func shutdownBE() {
 	stopChannel <- struct{}{}
 }
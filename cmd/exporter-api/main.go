@@ -59,7 +59,11 @@ func main() {
 		RefreshInterval:   cfg.Metrics.RefreshInterval,
 		StartServer:       cfg.Metrics.StartServer,
 		HTTPServerPort:    cfg.Metrics.HTTPServerPort,
-		MetricsCollectors: []metrics.Collector{},
+		MetricsCollectors: []metrics.Collector{
+			storage.Metrics{},
+			exporter.Metrics{},
+			connection.Metrics{},
+		},
 	}
 
 	_ = metrics.New(metricConfig).Initialize()
@@ -69,8 +73,20 @@ func main() {
 		pulseExporter  *exporter.PulseServer
 	)
 
-	recordExporter = exporter.NewRecordServer()
-	pulseExporter = exporter.NewPulseServer(s, cfg.PulsePeriod, &logger)
+	pulseNotifier := exporter.NewPulseNotifier(s, cfg.PulsePeriod, &logger)
+	err = pulseNotifier.Start(ctx)
+	if err != nil {
+		logger.Fatal(err)
+	}
+	defer func() {
+		err := pulseNotifier.Stop(ctx)
+		if err != nil {
+			logger.Error(err)
+		}
+	}()
+
+	recordExporter = exporter.NewRecordServer(s, pulseNotifier, cfg.Retry, &logger)
+	pulseExporter = exporter.NewPulseServer(s, pulseNotifier, cfg.Retry, &logger)
 
 	grpcMetrics := grpc_prometheus.NewServerMetrics()
 	grpcMetrics.EnableHandlingTimeHistogram()
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/insolar/insconfig"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"google.golang.org/grpc"
+
+	"github.com/insolar/block-explorer/configuration"
+	"github.com/insolar/block-explorer/etl/connection"
+	"github.com/insolar/block-explorer/instrumentation/belogger"
+	"github.com/insolar/block-explorer/test/heavymock"
+)
+
+var stop = make(chan os.Signal, 1)
+
+// heavy-recorder sits between the block explorer and the heavy node and captures the exporter traffic,
+// the capture is replayed by the heavymock in the integration tests
+func main() {
+	cfg := &configuration.HeavyRecorder{}
+	params := insconfig.Params{
+		EnvPrefix:        "heavy_recorder",
+		ConfigPathGetter: &insconfig.DefaultPathGetter{},
+	}
+	insConfigurator := insconfig.New(params)
+	if err := insConfigurator.Load(cfg); err != nil {
+		panic(err)
+	}
+	fmt.Println("Starts with configuration:\n", insConfigurator.ToYaml(cfg))
+	ctx, logger := belogger.InitLogger(context.Background(), cfg.Log, "heavy_recorder")
+
+	capture, err := heavymock.NewCaptureWriter(cfg.CaptureFile)
+	if err != nil {
+		logger.Fatal(err)
+	}
+	defer func() {
+		if err := capture.Close(); err != nil {
+			logger.Error(err)
+		}
+	}()
+
+	client, err := connection.NewGRPCClientConnection(ctx, cfg.Replicator)
+	if err != nil {
+		logger.Fatal("cannot connect to GRPC server: ", err)
+	}
+	defer client.GetGRPCConn().Close()
+
+	grpcServer := grpc.NewServer(
+		grpc.MaxRecvMsgSize(cfg.Replicator.MaxTransportMsg),
+		grpc.MaxSendMsgSize(cfg.Replicator.MaxTransportMsg),
+	)
+	exporter.RegisterPulseExporterServer(grpcServer, heavymock.NewRecordingPulseExporter(
+		exporter.NewPulseExporterClient(client.GetGRPCConn()), capture))
+	exporter.RegisterRecordExporterServer(grpcServer, heavymock.NewRecordingRecordExporter(
+		exporter.NewRecordExporterClient(client.GetGRPCConn()), capture))
+
+	listener, err := net.Listen("tcp", cfg.Listen)
+	if err != nil {
+		logger.Fatal("failed to listen: ", err)
+	}
+	go func() {
+		if err := grpcServer.Serve(listener); err != nil {
+			logger.Error("GRPC server stopped: ", err)
+		}
+	}()
+	logger.Infof("Recording the traffic of %s on %s to %s", cfg.Replicator.Addr, cfg.Listen, cfg.CaptureFile)
+
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	<-stop
+	logger.Info("gracefully stopping by signal")
+	grpcServer.GracefulStop()
+}
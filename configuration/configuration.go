@@ -16,8 +16,13 @@ func init() {
 // Exporter is grpc-base service
 type Exporter struct {
 	// Listen specifies address where exporter server starts
-	Listen      string        `insconfig:":0| exporter-api gRPC server starts on this address"`
-	PulsePeriod time.Duration `insconfig:"10s| Seconds between pulse completion tries"`
+	Listen string `insconfig:":0| exporter-api gRPC server starts on this address"`
+	// PulsePeriod is shared by all streams, so db load doesn't grow with the amount of clients
+	PulsePeriod time.Duration `insconfig:"1s| Interval between checks for new sequential pulses"`
+	Retry       Retry
+	TLS         ServerTLS
+	Auth        ServerAuth
+	Limits      ServerLimits
 	DB          DB
 	Log         Log
 	Metrics     Metrics
@@ -25,14 +30,36 @@ type Exporter struct {
 }
 
 type BlockExplorer struct {
-	Log         Log
-	DB          DB
+	Log            Log
+	DB             DB
+	Replicator     Replicator
+	Import         Import
+	Controller     Controller
+	Processor      Processor
+	Transformer    Transformer
+	Audit          Audit
+	LeaderElection LeaderElection
+	Admin          Admin
+	Metrics        Metrics
+	Profefe        Profefe
+}
+
+// AuditCLI holds configuration of the command auditing the stored jet drops hash chains
+type AuditCLI struct {
+	FromPulse int64 `insconfig:"0| The first pulse to audit, 0 means the first sequential pulse"`
+	ToPulse   int64 `insconfig:"0| The last pulse to audit, 0 means the last sequential pulse"`
+	// VerifyRecords recalculates hashes of all records, so it takes much longer than the hash chains audit
+	VerifyRecords bool `insconfig:"false| If true, hashes of the records are verified against their raw data too"`
+	DB            DB
+	Log           Log
+}
+
+// HeavyRecorder holds configuration of the proxy capturing the heavy node exporter traffic for the heavymock replay
+type HeavyRecorder struct {
+	Listen      string `insconfig:"127.0.0.1:5679| The block explorer connects to the recorder on this address instead of the heavy node"`
+	CaptureFile string `insconfig:"heavy-capture.jsonl| Captured requests and responses are appended to this file"`
 	Replicator  Replicator
-	Controller  Controller
-	Processor   Processor
-	Transformer Transformer
-	Metrics     Metrics
-	Profefe     Profefe
+	Log         Log
 }
 
 type API struct {
@@ -64,6 +91,9 @@ type TestDB struct {
 type Replicator struct {
 	PlatformVersion                           int           `insconfig:"1| Platform version, can be 1 or 2"`
 	Addr                                      string        `insconfig:"127.0.0.1:5678| The gRPC server address"`
+	Addrs                                     []string      `insconfig:"| The gRPC server addresses of the heavy replicas, Addr is used if it's empty"`
+	HealthCheckPeriod                         time.Duration `insconfig:"5s| Period of the health checks of the heavy replicas"`
+	HealthCheckTimeout                        time.Duration `insconfig:"3s| Timeout of the health check of a heavy replica"`
 	MaxTransportMsg                           int           `insconfig:"1073741824| Maximum message size the client can send"`
 	WaitForConnectionRecoveryTimeout          time.Duration `insconfig:"30s| Connection recovery timeout"`
 	ContinuousPulseRetrievingHalfPulseSeconds uint32        `insconfig:"5| Half pulse in seconds"`
@@ -72,6 +102,56 @@ type Replicator struct {
 	Auth                                      Auth
 }
 
+// Import represents the offline import of heavy node exports from files, it replaces the replication from the heavy node
+type Import struct {
+	Enabled     bool   `insconfig:"false| If true, data is imported from the files instead of the heavy node"`
+	PulsesFile  string `insconfig:"| Path to the file with length-delimited exporter.FullPulse messages, it can be gzip compressed"`
+	RecordsFile string `insconfig:"| Path to the file with length-delimited exporter.Record messages ordered by pulses, it can be gzip compressed, the compressed file is decompressed to the temporary directory on start"`
+	QueueLen    uint32 `insconfig:"500| Max elements in extractor queue"`
+}
+
+// Retry represents a policy of retries after failures
+type Retry struct {
+	MinInterval time.Duration `insconfig:"100ms| Interval before the first retry, it is doubled after every failed attempt, at least 10ms"`
+	MaxInterval time.Duration `insconfig:"10s| Maximum interval between retries"`
+	MaxAttempts int           `insconfig:"10| Number of consecutive failed attempts before giving up, 0 means infinite"`
+}
+
+// MinRetryInterval is the least interval before the first retry, a zero interval would retry in a tight loop
+const MinRetryInterval = 10 * time.Millisecond
+
+// Clamped returns the policy with the interval before the first retry not less than MinRetryInterval
+func (r Retry) Clamped() Retry {
+	if r.MinInterval < MinRetryInterval {
+		r.MinInterval = MinRetryInterval
+	}
+	return r
+}
+
+// ServerTLS represents a transport security of the gRPC server
+type ServerTLS struct {
+	Enabled  bool   `insconfig:"false| If true, the gRPC server accepts only TLS connections"`
+	CertFile string `insconfig:"| Path to the PEM encoded server certificate"`
+	KeyFile  string `insconfig:"| Path to the PEM encoded server private key"`
+}
+
+// ServerAuth represents an authentication of the gRPC server clients by bearer tokens
+type ServerAuth struct {
+	Required bool     `insconfig:"false| If true, clients have to provide a valid bearer token"`
+	Tokens   []string `insconfig:"| Static tokens allowed to access the server"`
+	// JWT tokens are verified by HMAC secret or RSA/ECDSA public key in PEM format
+	JWTKeyFile string `insconfig:"| Path to the key for JWT verification"`
+}
+
+// ServerLimits represents limits of the gRPC server clients.
+// A client is identified by its bearer token if the server validated it, otherwise by its address
+type ServerLimits struct {
+	MaxStreams       int     `insconfig:"0| Maximum number of concurrent streams of all clients, 0 means unlimited"`
+	MaxClientStreams int     `insconfig:"0| Maximum number of concurrent streams of a client, 0 means unlimited"`
+	ClientRate       float64 `insconfig:"0| Maximum number of calls per second of a client, 0 means unlimited"`
+	ClientBurst      int     `insconfig:"10| Maximum number of calls of a client at once above ClientRate"`
+}
+
 // Metrics represents a configuration for expose metrics
 type Metrics struct {
 	HTTPServerPort  uint32        `insconfig:"8081| http server port"`
@@ -124,6 +204,10 @@ type Controller struct {
 // Processor represents for processing layer
 type Processor struct {
 	Workers int `insconfig:"200| The count of workers for processing transformed data"`
+	// Retry is the policy of retries of jet drops failed to persist, after MaxAttempts the jet drop is sent to the dead letters
+	Retry       Retry
+	BatchSize   int           `insconfig:"100| Max count of jet drops of one pulse saved in one transaction"`
+	BatchPeriod time.Duration `insconfig:"100ms| Max time of collecting jet drops of one pulse to one transaction"`
 }
 
 // Transformer transforms raw platform data to canonical GBE data types
@@ -131,6 +215,28 @@ type Transformer struct {
 	QueueLen uint32 `insconfig:"500| Max elements in transformer queue"`
 }
 
+// Audit represents a background audit of the jet drops hash chains of new sequential pulses
+type Audit struct {
+	Enabled bool          `insconfig:"false| If true, new sequential pulses are audited in the background"`
+	Period  time.Duration `insconfig:"10s| Interval between checks for new sequential pulses to audit"`
+}
+
+// LeaderElection represents the election of the instance running the ETL among block-explorer instances sharing the database
+type LeaderElection struct {
+	Enabled bool          `insconfig:"false| If true, only the elected leader runs the ETL, other instances are standby"`
+	LockID  int64         `insconfig:"4242| The key of the Postgres advisory lock held by the leader"`
+	Period  time.Duration `insconfig:"1s| Interval between attempts to take the leadership and between checks of the held lock"`
+	// the database detects a dead leader host or a broken link by TCP keepalives of the lock session
+	SessionTimeout time.Duration `insconfig:"10s| The database releases the lock of the leader that doesn't respond for this time, it must be greater than Period"`
+}
+
+// Admin represents the HTTP server of the admin endpoints changing the stored data: re-ingestion and dead letters.
+// Without tokens the server may listen only on the loopback interface
+type Admin struct {
+	Listen string   `insconfig:"127.0.0.1:8001| Admin server starts on this address"`
+	Tokens []string `insconfig:"| Static bearer tokens allowed to call the admin endpoints, required if Listen isn't a loopback address"`
+}
+
 type Profefe struct {
 	StartAgent bool   `insconfig:"true| if true, start the profefe agent"`
 	Address    string `insconfig:"http://127.0.0.1:10100| Profefe collector public address to send profiling data"`
@@ -17,6 +17,8 @@ func main() {
 		".artifacts/migrate.yaml":         configuration.DB{},
 		".artifacts/api.yaml":             configuration.API{},
 		".artifacts/exporter-api.yaml":    configuration.Exporter{},
+		".artifacts/audit.yaml":           configuration.AuditCLI{},
+		".artifacts/heavy-recorder.yaml":  configuration.HeavyRecorder{},
 		"./load/migrate_cfg/migrate.yaml": configuration.TestDB{},
 	}
 
@@ -0,0 +1,251 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/block-explorer/etl/interfaces"
+	"github.com/insolar/block-explorer/etl/models"
+	"github.com/insolar/block-explorer/instrumentation/belogger"
+)
+
+// Auditor verifies that prev hashes of the stored jet drops match hashes of their ancestors in the previous pulse.
+// Discrepancies are saved to db as audit findings.
+type Auditor struct {
+	storage interfaces.AuditStorage
+	period  time.Duration
+
+	cancel context.CancelFunc
+	done   chan struct{}
+	mu     sync.Mutex
+}
+
+func NewAuditor(storage interfaces.AuditStorage, period time.Duration) *Auditor {
+	return &Auditor{
+		storage: storage,
+		period:  period,
+	}
+}
+
+// Start audits new sequential pulses in the background
+func (a *Auditor) Start(ctx context.Context) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.cancel != nil {
+		return nil
+	}
+
+	pulse, err := a.storage.GetSequentialPulse()
+	if err != nil {
+		return errors.Wrap(err, "failed to get sequential pulse")
+	}
+
+	ctx, a.cancel = context.WithCancel(ctx)
+	a.done = make(chan struct{})
+	go a.run(ctx, pulse.PulseNumber)
+	return nil
+}
+
+// Stop stops the background audit
+func (a *Auditor) Stop(ctx context.Context) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.cancel == nil {
+		return nil
+	}
+
+	a.cancel()
+	<-a.done
+	a.cancel = nil
+	return nil
+}
+
+func (a *Auditor) run(ctx context.Context, lastPulse int64) {
+	defer close(a.done)
+	log := belogger.FromContext(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		pulse, err := a.storage.GetNextSequentialPulse(lastPulse)
+		if err == nil && pulse.PulseNumber != 0 {
+			var findings []models.AuditFinding
+			findings, err = a.AuditPulse(pulse)
+			if err == nil {
+				for _, f := range findings {
+					log.Warnf("audit finding in jet drop %s:%d: %s, %s", f.JetID, f.PulseNumber, f.Kind, f.Details)
+				}
+				lastPulse = pulse.PulseNumber
+				continue
+			}
+		}
+		if err != nil {
+			Errors.Inc()
+			log.Error(errors.Wrapf(err, "failed to audit pulse after %d", lastPulse))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(a.period):
+		}
+	}
+}
+
+// AuditRange audits sequential pulses between fromPulseNumber and toPulseNumber inclusive,
+// toPulseNumber equal to 0 means the last sequential pulse.
+// It returns the amount of audited pulses and found discrepancies.
+func (a *Auditor) AuditRange(ctx context.Context, fromPulseNumber, toPulseNumber int64) (int, int, error) {
+	var pulses, findings int
+	lastPulse := fromPulseNumber - 1
+	for {
+		select {
+		case <-ctx.Done():
+			return pulses, findings, ctx.Err()
+		default:
+		}
+
+		pulse, err := a.storage.GetNextSequentialPulse(lastPulse)
+		if err != nil {
+			return pulses, findings, errors.Wrapf(err, "failed to get sequential pulse after %d", lastPulse)
+		}
+		if pulse.PulseNumber == 0 || (toPulseNumber != 0 && pulse.PulseNumber > toPulseNumber) {
+			return pulses, findings, nil
+		}
+
+		found, err := a.AuditPulse(pulse)
+		if err != nil {
+			return pulses, findings, err
+		}
+		pulses++
+		findings += len(found)
+		lastPulse = pulse.PulseNumber
+	}
+}
+
+// AuditPulse checks jet drops of the pulse against jet drops of the previous pulse and saves found discrepancies.
+// Pulses without jet drops in the previous pulse can't be checked, so they are skipped.
+func (a *Auditor) AuditPulse(pulse models.Pulse) ([]models.AuditFinding, error) {
+	jetDrops, err := a.storage.GetJetDrops(pulse)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get jet drops of pulse %d", pulse.PulseNumber)
+	}
+	prevJetDrops, err := a.storage.GetJetDrops(models.Pulse{PulseNumber: pulse.PrevPulseNumber})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get jet drops of pulse %d", pulse.PrevPulseNumber)
+	}
+
+	var findings []models.AuditFinding
+	if len(prevJetDrops) > 0 {
+		prev := make(map[string]models.JetDrop, len(prevJetDrops))
+		for _, jd := range prevJetDrops {
+			prev[jd.JetID] = jd
+		}
+		now := time.Now().Unix()
+		for _, jd := range jetDrops {
+			if f := CheckJetDrop(jd, prev); f != nil {
+				f.Timestamp = now
+				findings = append(findings, *f)
+			}
+		}
+	}
+
+	err = a.storage.SaveAuditFindings(pulse.PulseNumber, findings)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to save audit findings of pulse %d", pulse.PulseNumber)
+	}
+
+	AuditedPulses.Inc()
+	LastAuditedPulse.Set(float64(pulse.PulseNumber))
+	for _, f := range findings {
+		Findings.WithLabelValues(string(f.Kind)).Inc()
+	}
+	return findings, nil
+}
+
+// CheckJetDrop compares prev hashes of the jet drop with hashes of its ancestors from the previous pulse.
+// The ancestor is the same jet, or the parent jet after a split, or both child jets after a merge.
+func CheckJetDrop(jetDrop models.JetDrop, prev map[string]models.JetDrop) *models.AuditFinding {
+	siblings := jetDrop.Siblings()
+	same, hasSame := prev[siblings[0]]
+	left, hasLeft := prev[siblings[1]]
+	right, hasRight := prev[siblings[2]]
+	var parent models.JetDrop
+	hasParent := false
+	if len(siblings) > 3 {
+		parent, hasParent = prev[siblings[3]]
+	}
+
+	var expected [][]byte
+	switch {
+	case hasSame:
+		expected = [][]byte{same.Hash}
+	case hasLeft && hasRight:
+		expected = [][]byte{left.Hash, right.Hash}
+	case hasParent:
+		expected = [][]byte{parent.Hash}
+	default:
+		return &models.AuditFinding{
+			PulseNumber: jetDrop.PulseNumber,
+			JetID:       jetDrop.JetID,
+			Kind:        models.MissingParent,
+			Details:     fmt.Sprintf("none of jets %s found in the previous pulse", strings.Join(siblings, ", ")),
+		}
+	}
+
+	var actual [][]byte
+	for _, h := range [][]byte{jetDrop.FirstPrevHash, jetDrop.SecondPrevHash} {
+		if len(h) > 0 {
+			actual = append(actual, h)
+		}
+	}
+	if hashesEqual(expected, actual) {
+		return nil
+	}
+	return &models.AuditFinding{
+		PulseNumber: jetDrop.PulseNumber,
+		JetID:       jetDrop.JetID,
+		Kind:        models.PrevHashMismatch,
+		Details:     fmt.Sprintf("expected prev hashes [%s], got [%s]", hashesString(expected), hashesString(actual)),
+	}
+}
+
+// hashesEqual compares hashes regardless of their order
+func hashesEqual(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a, b = sortedHashes(a), sortedHashes(b)
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedHashes(hashes [][]byte) [][]byte {
+	sorted := append([][]byte(nil), hashes...)
+	sort.Slice(sorted, func(i, j int) bool { return bytes.Compare(sorted[i], sorted[j]) < 0 })
+	return sorted
+}
+
+func hashesString(hashes [][]byte) string {
+	s := make([]string, len(hashes))
+	for i, h := range hashes {
+		s[i] = hex.EncodeToString(h)
+	}
+	return strings.Join(s, ", ")
+}
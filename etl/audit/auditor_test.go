@@ -0,0 +1,131 @@
+// +build unit
+
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/block-explorer/etl/interfaces/mock"
+	"github.com/insolar/block-explorer/etl/models"
+)
+
+func TestCheckJetDrop(t *testing.T) {
+	prev := map[string]models.JetDrop{
+		"0":   {JetID: "0", Hash: []byte{1}},
+		"10":  {JetID: "10", Hash: []byte{2}},
+		"110": {JetID: "110", Hash: []byte{3}},
+		"111": {JetID: "111", Hash: []byte{4}},
+	}
+
+	tests := []struct {
+		name     string
+		jetDrop  models.JetDrop
+		expected models.AuditFindingKind
+	}{
+		{"same jet", models.JetDrop{JetID: "0", FirstPrevHash: []byte{1}}, ""},
+		{"same jet mismatch", models.JetDrop{JetID: "0", FirstPrevHash: []byte{2}}, models.PrevHashMismatch},
+		{"split", models.JetDrop{JetID: "101", FirstPrevHash: []byte{2}}, ""},
+		{"split mismatch", models.JetDrop{JetID: "100", FirstPrevHash: []byte{1}}, models.PrevHashMismatch},
+		{"merge", models.JetDrop{JetID: "11", FirstPrevHash: []byte{4}, SecondPrevHash: []byte{3}}, ""},
+		{"merge with one hash", models.JetDrop{JetID: "11", FirstPrevHash: []byte{3}}, models.PrevHashMismatch},
+		{"missing parent", models.JetDrop{JetID: "1", FirstPrevHash: []byte{1}}, models.MissingParent},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			finding := CheckJetDrop(test.jetDrop, prev)
+			if test.expected == "" {
+				require.Nil(t, finding)
+				return
+			}
+			require.NotNil(t, finding)
+			require.Equal(t, test.expected, finding.Kind)
+			require.Equal(t, test.jetDrop.JetID, finding.JetID)
+		})
+	}
+}
+
+func TestAuditor_AuditPulse(t *testing.T) {
+	pulse := models.Pulse{PulseNumber: 20, PrevPulseNumber: 10}
+	sm := mock.NewAuditStorageMock(t)
+	sm.GetJetDropsMock.Set(func(p models.Pulse) ([]models.JetDrop, error) {
+		if p.PulseNumber == pulse.PulseNumber {
+			return []models.JetDrop{
+				{JetID: "0", PulseNumber: 20, FirstPrevHash: []byte{1}},
+				{JetID: "1", PulseNumber: 20, FirstPrevHash: []byte{1}},
+			}, nil
+		}
+		return []models.JetDrop{
+			{JetID: "0", PulseNumber: 10, Hash: []byte{1}},
+			{JetID: "1", PulseNumber: 10, Hash: []byte{2}},
+		}, nil
+	})
+	sm.SaveAuditFindingsMock.Set(func(pulseNumber int64, findings []models.AuditFinding) error {
+		require.Equal(t, pulse.PulseNumber, pulseNumber)
+		require.Len(t, findings, 1)
+		require.Equal(t, "1", findings[0].JetID)
+		require.Equal(t, models.PrevHashMismatch, findings[0].Kind)
+		return nil
+	})
+
+	findings, err := NewAuditor(sm, time.Millisecond).AuditPulse(pulse)
+	require.NoError(t, err)
+	require.Len(t, findings, 1)
+}
+
+func TestAuditor_AuditPulse_NoPrevJetDrops(t *testing.T) {
+	sm := mock.NewAuditStorageMock(t)
+	sm.GetJetDropsMock.Set(func(p models.Pulse) ([]models.JetDrop, error) {
+		if p.PulseNumber == 20 {
+			return []models.JetDrop{{JetID: "0", PulseNumber: 20}}, nil
+		}
+		return nil, nil
+	})
+	sm.SaveAuditFindingsMock.Expect(20, nil).Return(nil)
+
+	findings, err := NewAuditor(sm, time.Millisecond).AuditPulse(models.Pulse{PulseNumber: 20, PrevPulseNumber: 10})
+	require.NoError(t, err)
+	require.Empty(t, findings)
+}
+
+func TestAuditor_AuditRange(t *testing.T) {
+	sm := mock.NewAuditStorageMock(t)
+	sm.GetNextSequentialPulseMock.Set(func(fromPulseNumber int64) (models.Pulse, error) {
+		next := (fromPulseNumber/10 + 1) * 10
+		return models.Pulse{PulseNumber: next, PrevPulseNumber: next - 10}, nil
+	})
+	sm.GetJetDropsMock.Return([]models.JetDrop{{JetID: "0", Hash: []byte{1}, FirstPrevHash: []byte{1}}}, nil)
+	sm.SaveAuditFindingsMock.Return(nil)
+
+	pulses, findings, err := NewAuditor(sm, time.Millisecond).AuditRange(context.Background(), 10, 40)
+	require.NoError(t, err)
+	require.Equal(t, 4, pulses)
+	require.Equal(t, 0, findings)
+	require.Equal(t, uint64(4), sm.SaveAuditFindingsAfterCounter())
+}
+
+func TestAuditor_StartStop(t *testing.T) {
+	saved := make(chan int64, 10)
+	sm := mock.NewAuditStorageMock(t)
+	sm.GetSequentialPulseMock.Return(models.Pulse{PulseNumber: 10}, nil)
+	sm.GetNextSequentialPulseMock.Set(func(fromPulseNumber int64) (models.Pulse, error) {
+		if fromPulseNumber >= 30 {
+			return models.Pulse{}, nil
+		}
+		return models.Pulse{PulseNumber: fromPulseNumber + 10, PrevPulseNumber: fromPulseNumber}, nil
+	})
+	sm.GetJetDropsMock.Return(nil, nil)
+	sm.SaveAuditFindingsMock.Set(func(pulseNumber int64, findings []models.AuditFinding) error {
+		saved <- pulseNumber
+		return nil
+	})
+
+	auditor := NewAuditor(sm, time.Millisecond)
+	require.NoError(t, auditor.Start(context.Background()))
+	require.Equal(t, int64(20), <-saved)
+	require.Equal(t, int64(30), <-saved)
+	require.NoError(t, auditor.Stop(context.Background()))
+}
@@ -0,0 +1,53 @@
+package audit
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/insolar/block-explorer/instrumentation/metrics"
+)
+
+var (
+	AuditedPulses = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "gbe_audit_audited_pulses",
+		Help: "The number of audited pulses",
+	})
+	LastAuditedPulse = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "gbe_audit_last_audited_pulse",
+		Help: "The last audited pulse number",
+	})
+	Findings = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "gbe_audit_findings",
+		Help: "The number of discrepancies found in the jet drops hash chain",
+	},
+		[]string{"kind"},
+	)
+	VerifiedRecords = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "gbe_audit_verified_records",
+		Help: "The number of records with verified hashes",
+	})
+	InvalidRecords = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "gbe_audit_invalid_records",
+		Help: "The number of records with hashes not matching their raw data",
+	})
+	Errors = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "gbe_audit_errors",
+		Help: "The number of errors occurred during the audit",
+	})
+)
+
+type Metrics struct{}
+
+func (s Metrics) Refresh() {
+	// nothing to refresh
+}
+
+func (s Metrics) Metrics(p *metrics.Prometheus) []prometheus.Collector {
+	return []prometheus.Collector{
+		AuditedPulses,
+		LastAuditedPulse,
+		Findings,
+		VerifiedRecords,
+		InvalidRecords,
+		Errors,
+	}
+}
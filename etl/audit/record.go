@@ -0,0 +1,91 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/insolar/insolar/insolar"
+	ins_record "github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/block-explorer/etl/models"
+	"github.com/insolar/block-explorer/instrumentation/belogger"
+)
+
+// recordsBatchSize is the amount of records fetched from db at once during the records verification
+const recordsBatchSize = 1000
+
+var pcs = platformpolicy.NewPlatformCryptographyScheme()
+
+// VerifyRecord calculates the hash of the virtual record from the raw data the same way the platform does
+// and compares it with the stored hash and reference of the record.
+// It returns the calculated hash and an error describing the discrepancy if the record is not valid.
+func VerifyRecord(record models.Record) ([]byte, error) {
+	var raw exporter.Record
+	if err := raw.Unmarshal(record.RawData); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal raw data")
+	}
+
+	hash := ins_record.HashVirtual(pcs.ReferenceHasher(), raw.Record.Virtual)
+	id := insolar.NewID(raw.Record.ID.Pulse(), hash)
+	switch {
+	case !bytes.Equal(hash, record.Hash):
+		return hash, fmt.Errorf("calculated hash doesn't match the stored hash")
+	case raw.Record.ID != *id:
+		return hash, fmt.Errorf("calculated id %s doesn't match the id %s in the raw data", id.DebugString(), raw.Record.ID.DebugString())
+	case !bytes.Equal(id.Bytes(), record.Reference):
+		return hash, fmt.Errorf("calculated id %s doesn't match the stored reference", id.DebugString())
+	}
+	return hash, nil
+}
+
+// VerifyRecordsRange verifies hashes of the records of sequential pulses between fromPulseNumber and toPulseNumber inclusive,
+// toPulseNumber equal to 0 means the last sequential pulse.
+// Invalid records are logged. It returns the amount of verified and invalid records.
+func (a *Auditor) VerifyRecordsRange(ctx context.Context, fromPulseNumber, toPulseNumber int64) (int, int, error) {
+	log := belogger.FromContext(ctx)
+	var verified, invalid int
+	lastPulse := fromPulseNumber - 1
+	for {
+		pulse, err := a.storage.GetNextSequentialPulse(lastPulse)
+		if err != nil {
+			return verified, invalid, errors.Wrapf(err, "failed to get sequential pulse after %d", lastPulse)
+		}
+		if pulse.PulseNumber == 0 || (toPulseNumber != 0 && pulse.PulseNumber > toPulseNumber) {
+			return verified, invalid, nil
+		}
+
+		fromJetID, fromOrder := "", -1
+		for {
+			select {
+			case <-ctx.Done():
+				return verified, invalid, ctx.Err()
+			default:
+			}
+
+			records, err := a.storage.GetRecordsByPulse(pulse.PulseNumber, fromJetID, fromOrder, nil, nil, nil, recordsBatchSize)
+			if err != nil {
+				return verified, invalid, errors.Wrapf(err, "failed to get records of pulse %d", pulse.PulseNumber)
+			}
+			for _, r := range records {
+				verified++
+				VerifiedRecords.Inc()
+				if _, err := VerifyRecord(r); err != nil {
+					invalid++
+					InvalidRecords.Inc()
+					log.Warnf("invalid record %d:%d in jet drop %s: %s",
+						r.PulseNumber, r.Order, models.NewJetDropID(r.JetID, r.PulseNumber).ToString(), err)
+				}
+			}
+			if len(records) < recordsBatchSize {
+				break
+			}
+			last := records[len(records)-1]
+			fromJetID, fromOrder = last.JetID, last.Order
+		}
+		lastPulse = pulse.PulseNumber
+	}
+}
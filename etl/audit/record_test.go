@@ -0,0 +1,95 @@
+// +build unit
+
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/pulse"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/block-explorer/etl/interfaces/mock"
+	"github.com/insolar/block-explorer/etl/models"
+	"github.com/insolar/block-explorer/testutils"
+)
+
+func generateRecord(t *testing.T) models.Record {
+	r := testutils.GenerateRecordWithHashedID(pulse.MinTimePulse+10, gen.ID())
+	rawData, err := r.Marshal()
+	require.NoError(t, err)
+	return models.Record{
+		Reference:   r.Record.ID.Bytes(),
+		Hash:        r.Record.ID.Hash(),
+		RawData:     rawData,
+		PulseNumber: int64(r.Record.ID.Pulse()),
+	}
+}
+
+func TestVerifyRecord(t *testing.T) {
+	record := generateRecord(t)
+	hash, err := VerifyRecord(record)
+	require.NoError(t, err)
+	require.Equal(t, record.Hash, hash)
+}
+
+func TestVerifyRecord_HashMismatch(t *testing.T) {
+	record := generateRecord(t)
+	record.Hash = testutils.GenerateRandBytes()
+	hash, err := VerifyRecord(record)
+	require.Error(t, err)
+	require.NotNil(t, hash)
+}
+
+func TestVerifyRecord_ReferenceMismatch(t *testing.T) {
+	record := generateRecord(t)
+	record.Reference = gen.ID().Bytes()
+	_, err := VerifyRecord(record)
+	require.Error(t, err)
+}
+
+func TestVerifyRecord_ChangedRawData(t *testing.T) {
+	r := testutils.GenerateRecordWithHashedID(pulse.MinTimePulse+10, gen.ID())
+	record := models.Record{Reference: r.Record.ID.Bytes(), Hash: r.Record.ID.Hash()}
+	r.Record.Virtual.GetIncomingRequest().Method = "changed"
+	rawData, err := r.Marshal()
+	require.NoError(t, err)
+	record.RawData = rawData
+
+	_, err = VerifyRecord(record)
+	require.Error(t, err)
+}
+
+func TestVerifyRecord_InvalidRawData(t *testing.T) {
+	record := generateRecord(t)
+	record.RawData = []byte{0xff, 0xff}
+	hash, err := VerifyRecord(record)
+	require.Error(t, err)
+	require.Nil(t, hash)
+}
+
+func TestAuditor_VerifyRecordsRange(t *testing.T) {
+	invalidRecord := generateRecord(t)
+	invalidRecord.Hash = testutils.GenerateRandBytes()
+	records := []models.Record{generateRecord(t), invalidRecord, generateRecord(t)}
+
+	sm := mock.NewAuditStorageMock(t)
+	sm.GetNextSequentialPulseMock.Set(func(fromPulseNumber int64) (models.Pulse, error) {
+		if fromPulseNumber >= 20 {
+			return models.Pulse{}, nil
+		}
+		return models.Pulse{PulseNumber: 20}, nil
+	})
+	sm.GetRecordsByPulseMock.Set(func(pulseNumber int64, fromJetID string, fromOrder int, prototypes, objectReferences [][]byte, recordTypes []string, limit int) ([]models.Record, error) {
+		require.Equal(t, int64(20), pulseNumber)
+		require.Equal(t, -1, fromOrder)
+		return records, nil
+	})
+
+	verified, invalid, err := NewAuditor(sm, time.Millisecond).VerifyRecordsRange(context.Background(), 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 3, verified)
+	require.Equal(t, 1, invalid)
+}
@@ -13,7 +13,7 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/insolar/block-explorer/configuration"
-	"github.com/insolar/block-explorer/etl/extractor"
+	"github.com/insolar/block-explorer/etl/platform"
 	"github.com/insolar/block-explorer/testutils"
 )
 
@@ -109,7 +109,7 @@ func TestClient_GetGRPCConn_Version_Error(t *testing.T) {
 	require.Error(t, err, "No err listening stream")
 	require.Contains(t, err.Error(), "unknown heavy-version")
 
-	ctx = metadata.AppendToOutgoingContext(ctx, pb.KeyClientVersionHeavy, extractor.PlatformAPIVersion)
+	ctx = metadata.AppendToOutgoingContext(ctx, pb.KeyClientVersionHeavy, platform.APIVersion)
 
 	stream, err = greeterClient.Export(ctx, request)
 	require.NoError(t, err, "Error when sending client request")
@@ -153,7 +153,7 @@ func TestClient_GetGRPCConn_WrongVersion_Error(t *testing.T) {
 	// send record to stream
 	request := &pb.GetRecords{}
 	ctx := metadata.AppendToOutgoingContext(context.Background(), pb.KeyClientType, pb.ValidateHeavyVersion.String())
-	ctx = metadata.AppendToOutgoingContext(ctx, pb.KeyClientVersionHeavy, extractor.PlatformAPIVersion)
+	ctx = metadata.AppendToOutgoingContext(ctx, pb.KeyClientVersionHeavy, platform.APIVersion)
 	stream, err := greeterClient.Export(ctx, request)
 	require.NoError(t, err, "Error when sending client request")
 
@@ -2,14 +2,40 @@ package connection
 
 import (
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
-	"github.com/insolar/block-explorer/configuration"
+	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/insolar/block-explorer/configuration"
 )
 
-// NewGRPCServer configures the gRPC server with metrics
+// NewGRPCServer configures the gRPC server with metrics, optional TLS, bearer token authentication and client limits
 func NewGRPCServer(cfg configuration.Exporter, grpcMetrics *grpc_prometheus.ServerMetrics) (*grpc.Server, error) {
-	return grpc.NewServer(
-		grpc.UnaryInterceptor(grpcMetrics.UnaryServerInterceptor()),
-		grpc.StreamInterceptor(grpcMetrics.StreamServerInterceptor()),
-	), nil
+	unary := []grpc.UnaryServerInterceptor{grpcMetrics.UnaryServerInterceptor()}
+	stream := []grpc.StreamServerInterceptor{grpcMetrics.StreamServerInterceptor()}
+	if cfg.Auth.Required {
+		validator, err := newTokenValidator(cfg.Auth)
+		if err != nil {
+			return nil, err
+		}
+		unary = append(unary, validator.unaryInterceptor())
+		stream = append(stream, validator.streamInterceptor())
+	}
+	if limiter := newClientLimiter(cfg.Limits); limiter.enabled() {
+		unary = append(unary, limiter.unaryInterceptor())
+		stream = append(stream, limiter.streamInterceptor())
+	}
+
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	}
+	if cfg.TLS.Enabled {
+		creds, err := credentials.NewServerTLSFromFile(cfg.TLS.CertFile, cfg.TLS.KeyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load TLS certificate")
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+	return grpc.NewServer(opts...), nil
 }
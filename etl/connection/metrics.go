@@ -0,0 +1,88 @@
+package connection
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/insolar/block-explorer/instrumentation/metrics"
+)
+
+const (
+	LabelReason   = "reason"
+	LabelClient   = "client"
+	LabelEndpoint = "endpoint"
+
+	RejectReasonNoToken      = "no_token"
+	RejectReasonInvalidToken = "invalid_token"
+
+	LimitReasonStreams       = "streams"
+	LimitReasonClientStreams = "client_streams"
+	LimitReasonRate          = "rate"
+)
+
+var (
+	RejectedCalls = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "gbe_grpc_server_rejected_calls",
+		Help: "The number of gRPC calls rejected by authentication",
+	},
+		[]string{LabelReason},
+	)
+	ClientStreams = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gbe_grpc_server_client_streams",
+		Help: "The number of active streams of a client",
+	},
+		[]string{LabelClient},
+	)
+	ClientCalls = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "gbe_grpc_server_client_calls",
+		Help: "The number of calls accepted from a client",
+	},
+		[]string{LabelClient},
+	)
+	LimitedCalls = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "gbe_grpc_server_limited_calls",
+		Help: "The number of calls rejected because of exceeded limits",
+	},
+		[]string{LabelClient, LabelReason},
+	)
+	ReplicaHealthy = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gbe_replicator_endpoint_healthy",
+		Help: "1 if the heavy replica answered the last call, 0 otherwise",
+	},
+		[]string{LabelEndpoint},
+	)
+	ReplicaCompatible = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gbe_replicator_endpoint_compatible",
+		Help: "1 if the heavy replica accepts the client version, 0 otherwise",
+	},
+		[]string{LabelEndpoint},
+	)
+	ReplicaServing = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gbe_replicator_endpoint_serving",
+		Help: "1 if the heavy replica serves the exporter calls, 0 otherwise",
+	},
+		[]string{LabelEndpoint},
+	)
+	ReplicaFailovers = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "gbe_replicator_failovers",
+		Help: "The number of switches of the serving heavy replica",
+	})
+)
+
+type Metrics struct{}
+
+func (s Metrics) Refresh() {
+	// nothing to refresh
+}
+
+func (s Metrics) Metrics(p *metrics.Prometheus) []prometheus.Collector {
+	return []prometheus.Collector{
+		RejectedCalls,
+		ClientStreams,
+		ClientCalls,
+		LimitedCalls,
+		ReplicaHealthy,
+		ReplicaCompatible,
+		ReplicaServing,
+		ReplicaFailovers,
+	}
+}
@@ -0,0 +1,350 @@
+package connection
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/insolar/block-explorer/configuration"
+	"github.com/insolar/block-explorer/etl/platform"
+	"github.com/insolar/block-explorer/instrumentation/belogger"
+)
+
+// replica is the connection to one heavy replica and its state after the last health check or call
+type replica struct {
+	addr       string
+	conn       *GRPCClientConnection
+	pulses     exporter.PulseExporterClient
+	records    exporter.RecordExporterClient
+	healthy    bool
+	compatible bool
+}
+
+func (r *replica) available() bool {
+	return r.healthy && r.compatible
+}
+
+// Replicas keeps connections to the heavy replicas and serves the exporter calls by one of them.
+// The serving replica is kept while it's available, when it fails or rejects the client version
+// the calls are switched to the next available replica.
+type Replicas struct {
+	cfg configuration.Replicator
+
+	lock     sync.RWMutex
+	replicas []*replica
+	serving  int
+	// switched is closed when the serving replica is switched
+	switched chan struct{}
+	// availableAt is the last time when at least one replica was available
+	availableAt time.Time
+
+	cancel context.CancelFunc
+}
+
+// NewReplicas connects to the heavy replicas from cfg.Addrs or to cfg.Addr if the list is empty
+func NewReplicas(ctx context.Context, cfg configuration.Replicator) (*Replicas, error) {
+	addrs := cfg.Addrs
+	if len(addrs) == 0 {
+		addrs = []string{cfg.Addr}
+	}
+	r := &Replicas{
+		cfg:         cfg,
+		availableAt: time.Now(),
+		switched:    make(chan struct{}),
+	}
+	for _, addr := range addrs {
+		replicaCfg := cfg
+		replicaCfg.Addr = addr
+		conn, err := NewGRPCClientConnection(ctx, replicaCfg)
+		if err != nil {
+			r.Close()
+			return nil, errors.Wrapf(err, "failed to connect to heavy replica %s", addr)
+		}
+		r.replicas = append(r.replicas, &replica{
+			addr:       addr,
+			conn:       conn,
+			pulses:     exporter.NewPulseExporterClient(conn.GetGRPCConn()),
+			records:    exporter.NewRecordExporterClient(conn.GetGRPCConn()),
+			healthy:    true,
+			compatible: true,
+		})
+		ReplicaHealthy.With(map[string]string{LabelEndpoint: addr}).Set(1)
+		ReplicaCompatible.With(map[string]string{LabelEndpoint: addr}).Set(1)
+	}
+	ReplicaServing.With(map[string]string{LabelEndpoint: r.replicas[0].addr}).Set(1)
+	return r, nil
+}
+
+// Start runs the health checks of the replicas
+func (r *Replicas) Start(ctx context.Context) error {
+	ctx, r.cancel = context.WithCancel(ctx)
+	go func() {
+		for {
+			r.checkHealth(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(r.cfg.HealthCheckPeriod):
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops the health checks of the replicas
+func (r *Replicas) Stop(ctx context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	return nil
+}
+
+// Close closes connections to the replicas
+func (r *Replicas) Close() {
+	for _, replica := range r.replicas {
+		replica.conn.GetGRPCConn().Close()
+	}
+}
+
+// PulseExporterClient returns the pulse exporter client calling the serving replica
+func (r *Replicas) PulseExporterClient() exporter.PulseExporterClient {
+	return &replicasPulseClient{replicas: r}
+}
+
+// RecordExporterClient returns the record exporter client calling the serving replica
+func (r *Replicas) RecordExporterClient() exporter.RecordExporterClient {
+	return &replicasRecordClient{replicas: r}
+}
+
+// NotifyShutdown sends a notification to the channel when no replica is available longer than waitForStateChange
+func (r *Replicas) NotifyShutdown(ctx context.Context, stopChannel chan<- struct{}, waitForStateChange time.Duration) {
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(r.cfg.HealthCheckPeriod):
+			}
+			r.lock.RLock()
+			unavailable := time.Since(r.availableAt)
+			r.lock.RUnlock()
+			if unavailable > waitForStateChange {
+				belogger.FromContext(ctx).Errorf("No heavy replica is available for %s", unavailable)
+				stopChannel <- struct{}{}
+				return
+			}
+		}
+	}()
+}
+
+// checkHealth asks every replica for its top sync pulse with the client version
+func (r *Replicas) checkHealth(ctx context.Context) {
+	log := belogger.FromContext(ctx)
+	ctx = platform.AppendVersionToContext(ctx)
+	for i := range r.replicas {
+		replica := r.replicas[i]
+		err := func() error {
+			ctx, cancel := context.WithTimeout(ctx, r.cfg.HealthCheckTimeout)
+			defer cancel()
+			_, err := replica.pulses.TopSyncPulse(ctx, &exporter.GetTopSyncPulse{})
+			return err
+		}()
+		if err != nil {
+			log.Warnf("Health check of heavy replica %s failed: %s", replica.addr, err)
+		}
+		r.lock.Lock()
+		r.setState(replica, err)
+		r.lock.Unlock()
+	}
+}
+
+// setState updates the state of the replica after the call with err and switches the serving replica if it's not available,
+// it has to be called under the lock
+func (r *Replicas) setState(replica *replica, err error) {
+	switch {
+	case err == nil:
+		replica.healthy, replica.compatible = true, true
+	case platform.IsVersionError(err):
+		replica.healthy, replica.compatible = true, false
+	default:
+		replica.healthy = false
+	}
+	ReplicaHealthy.With(map[string]string{LabelEndpoint: replica.addr}).Set(boolToFloat(replica.healthy))
+	ReplicaCompatible.With(map[string]string{LabelEndpoint: replica.addr}).Set(boolToFloat(replica.compatible))
+
+	for _, replica := range r.replicas {
+		if replica.available() {
+			r.availableAt = time.Now()
+			break
+		}
+	}
+	if r.replicas[r.serving].available() {
+		return
+	}
+	for i := 1; i < len(r.replicas); i++ {
+		next := (r.serving + i) % len(r.replicas)
+		if r.replicas[next].available() {
+			ReplicaServing.With(map[string]string{LabelEndpoint: r.replicas[r.serving].addr}).Set(0)
+			ReplicaServing.With(map[string]string{LabelEndpoint: r.replicas[next].addr}).Set(1)
+			ReplicaFailovers.Inc()
+			r.serving = next
+			close(r.switched)
+			r.switched = make(chan struct{})
+			return
+		}
+	}
+}
+
+// call returns the serving replica and the context of the call cancelled when the serving replica is switched,
+// so the calls waiting for the failed replica are retried by the caller
+func (r *Replicas) call(ctx context.Context) (*replica, context.Context, context.CancelFunc) {
+	r.lock.RLock()
+	replica, switched := r.replicas[r.serving], r.switched
+	r.lock.RUnlock()
+
+	callCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		select {
+		case <-switched:
+			cancel()
+		case <-callCtx.Done():
+		}
+	}()
+	return replica, callCtx, cancel
+}
+
+// failed marks the replica after a failed call and returns the error for the caller.
+// If the call was cancelled by the switch or the replica rejected the client version while another replica is available,
+// the error is replaced with Unavailable one, so the caller retries the call instead of stopping.
+func (r *Replicas) failed(ctx, callCtx context.Context, replica *replica, err error) error {
+	if ctx.Err() == nil && callCtx.Err() != nil {
+		return status.Errorf(codes.Unavailable, "heavy replica %s was switched to another one: %s", replica.addr, err)
+	}
+	code := status.Code(err)
+	versionError := platform.IsVersionError(err)
+	if code != codes.Unavailable && !versionError {
+		return err
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.setState(replica, err)
+	serving := r.replicas[r.serving]
+	if serving != replica {
+		belogger.FromContext(ctx).Warnf("Heavy replica %s failed, switched to %s: %s", replica.addr, serving.addr, err)
+		if versionError {
+			return status.Errorf(codes.Unavailable, "heavy replica %s rejected the client version", replica.addr)
+		}
+	}
+	return err
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+type replicasPulseClient struct {
+	replicas *Replicas
+}
+
+func (c *replicasPulseClient) Export(ctx context.Context, in *exporter.GetPulses, opts ...grpc.CallOption) (exporter.PulseExporter_ExportClient, error) {
+	replica, callCtx, cancel := c.replicas.call(ctx)
+	stream, err := replica.pulses.Export(callCtx, in, opts...)
+	if err != nil {
+		cancel()
+		return nil, c.replicas.failed(ctx, callCtx, replica, err)
+	}
+	return &replicaPulseStream{
+		PulseExporter_ExportClient: stream,
+		replicaCall:                replicaCall{ctx: ctx, callCtx: callCtx, cancel: cancel, replicas: c.replicas, replica: replica},
+	}, nil
+}
+
+func (c *replicasPulseClient) TopSyncPulse(ctx context.Context, in *exporter.GetTopSyncPulse, opts ...grpc.CallOption) (*exporter.TopSyncPulseResponse, error) {
+	replica, callCtx, cancel := c.replicas.call(ctx)
+	defer cancel()
+	resp, err := replica.pulses.TopSyncPulse(callCtx, in, opts...)
+	if err != nil {
+		return nil, c.replicas.failed(ctx, callCtx, replica, err)
+	}
+	return resp, nil
+}
+
+func (c *replicasPulseClient) NextFinalizedPulse(ctx context.Context, in *exporter.GetNextFinalizedPulse, opts ...grpc.CallOption) (*exporter.FullPulse, error) {
+	replica, callCtx, cancel := c.replicas.call(ctx)
+	defer cancel()
+	resp, err := replica.pulses.NextFinalizedPulse(callCtx, in, opts...)
+	if err != nil {
+		return nil, c.replicas.failed(ctx, callCtx, replica, err)
+	}
+	return resp, nil
+}
+
+// replicaCall is the stream opened by the replica, the call context is cancelled when the stream ends
+type replicaCall struct {
+	ctx      context.Context
+	callCtx  context.Context
+	cancel   context.CancelFunc
+	replicas *Replicas
+	replica  *replica
+}
+
+func (c *replicaCall) recvError(err error) error {
+	c.cancel()
+	if err == io.EOF {
+		return err
+	}
+	return c.replicas.failed(c.ctx, c.callCtx, c.replica, err)
+}
+
+type replicaPulseStream struct {
+	exporter.PulseExporter_ExportClient
+	replicaCall
+}
+
+func (s *replicaPulseStream) Recv() (*exporter.Pulse, error) {
+	resp, err := s.PulseExporter_ExportClient.Recv()
+	if err != nil {
+		return nil, s.recvError(err)
+	}
+	return resp, nil
+}
+
+type replicasRecordClient struct {
+	replicas *Replicas
+}
+
+func (c *replicasRecordClient) Export(ctx context.Context, in *exporter.GetRecords, opts ...grpc.CallOption) (exporter.RecordExporter_ExportClient, error) {
+	replica, callCtx, cancel := c.replicas.call(ctx)
+	stream, err := replica.records.Export(callCtx, in, opts...)
+	if err != nil {
+		cancel()
+		return nil, c.replicas.failed(ctx, callCtx, replica, err)
+	}
+	return &replicaRecordStream{
+		RecordExporter_ExportClient: stream,
+		replicaCall:                 replicaCall{ctx: ctx, callCtx: callCtx, cancel: cancel, replicas: c.replicas, replica: replica},
+	}, nil
+}
+
+type replicaRecordStream struct {
+	exporter.RecordExporter_ExportClient
+	replicaCall
+}
+
+func (s *replicaRecordStream) Recv() (*exporter.Record, error) {
+	resp, err := s.RecordExporter_ExportClient.Recv()
+	if err != nil {
+		return nil, s.recvError(err)
+	}
+	return resp, nil
+}
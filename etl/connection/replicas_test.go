@@ -0,0 +1,135 @@
+// +build unit
+
+package connection
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/insolar/block-explorer/configuration"
+	"github.com/insolar/block-explorer/etl/platform"
+	"github.com/insolar/block-explorer/instrumentation/belogger"
+	"github.com/insolar/block-explorer/testutils"
+)
+
+// replicaPulses answers with the same pulse, so the serving replica is seen by the pulse number
+type replicaPulses struct {
+	exporter.PulseExporterServer
+	pulseNumber insolar.PulseNumber
+}
+
+func (p *replicaPulses) TopSyncPulse(context.Context, *exporter.GetTopSyncPulse) (*exporter.TopSyncPulseResponse, error) {
+	return &exporter.TopSyncPulseResponse{PulseNumber: uint32(p.pulseNumber)}, nil
+}
+
+func (p *replicaPulses) NextFinalizedPulse(context.Context, *exporter.GetNextFinalizedPulse) (*exporter.FullPulse, error) {
+	return &exporter.FullPulse{PulseNumber: p.pulseNumber}, nil
+}
+
+func startReplica(t *testing.T, pulseNumber insolar.PulseNumber, heavyVersion int) *testutils.TestGRPCServer {
+	server := testutils.CreateTestGRPCServer(t, &testutils.TestGRPCServerConfig{VersionChecker: true, HeavyVersion: &heavyVersion})
+	exporter.RegisterPulseExporterServer(server.Server, &replicaPulses{pulseNumber: pulseNumber})
+	server.Serve(t)
+	t.Cleanup(server.Server.Stop)
+	return server
+}
+
+func replicasConfig(addrs ...string) configuration.Replicator {
+	return configuration.Replicator{
+		Addrs:              addrs,
+		MaxTransportMsg:    1073741824,
+		HealthCheckPeriod:  10 * time.Millisecond,
+		HealthCheckTimeout: 100 * time.Millisecond,
+	}
+}
+
+func versionContext() context.Context {
+	return platform.AppendVersionToContext(context.Background())
+}
+
+func TestReplicas_Failover(t *testing.T) {
+	first := startReplica(t, 1000, exporter.AllowedOnHeavyVersion)
+	second := startReplica(t, 2000, exporter.AllowedOnHeavyVersion)
+	replicas, err := NewReplicas(belogger.TestContext(t), replicasConfig(first.Address, second.Address))
+	require.NoError(t, err)
+	defer replicas.Close()
+	ctx := belogger.TestContext(t)
+	require.NoError(t, replicas.Start(ctx))
+	defer replicas.Stop(ctx)
+	client := replicas.PulseExporterClient()
+
+	pulse, err := client.NextFinalizedPulse(versionContext(), &exporter.GetNextFinalizedPulse{})
+	require.NoError(t, err)
+	require.Equal(t, insolar.PulseNumber(1000), pulse.PulseNumber)
+	failovers := testutil.ToFloat64(ReplicaFailovers)
+
+	first.Server.Stop()
+	require.Eventually(t, func() bool {
+		pulse, err := client.NextFinalizedPulse(versionContext(), &exporter.GetNextFinalizedPulse{})
+		return err == nil && pulse.PulseNumber == 2000
+	}, 10*time.Second, 10*time.Millisecond, "calls have to be switched to the second replica")
+	require.Equal(t, failovers+1, testutil.ToFloat64(ReplicaFailovers))
+	require.Equal(t, float64(0), testutil.ToFloat64(ReplicaServing.WithLabelValues(first.Address)))
+	require.Equal(t, float64(1), testutil.ToFloat64(ReplicaServing.WithLabelValues(second.Address)))
+	require.Equal(t, float64(0), testutil.ToFloat64(ReplicaHealthy.WithLabelValues(first.Address)))
+}
+
+func TestReplicas_IncompatibleVersion(t *testing.T) {
+	outdated := startReplica(t, 1000, exporter.AllowedOnHeavyVersion+1)
+	actual := startReplica(t, 2000, exporter.AllowedOnHeavyVersion)
+	replicas, err := NewReplicas(belogger.TestContext(t), replicasConfig(outdated.Address, actual.Address))
+	require.NoError(t, err)
+	defer replicas.Close()
+	client := replicas.PulseExporterClient()
+
+	_, err = client.NextFinalizedPulse(versionContext(), &exporter.GetNextFinalizedPulse{})
+	require.Equal(t, codes.Unavailable, status.Code(err), "the version error is retried by another replica")
+	require.False(t, platform.IsVersionError(err))
+	require.Equal(t, float64(0), testutil.ToFloat64(ReplicaCompatible.WithLabelValues(outdated.Address)))
+
+	pulse, err := client.NextFinalizedPulse(versionContext(), &exporter.GetNextFinalizedPulse{})
+	require.NoError(t, err)
+	require.Equal(t, insolar.PulseNumber(2000), pulse.PulseNumber)
+}
+
+func TestReplicas_IncompatibleVersion_Single(t *testing.T) {
+	outdated := startReplica(t, 1000, exporter.AllowedOnHeavyVersion+1)
+	replicas, err := NewReplicas(belogger.TestContext(t), replicasConfig(outdated.Address))
+	require.NoError(t, err)
+	defer replicas.Close()
+
+	_, err = replicas.PulseExporterClient().NextFinalizedPulse(versionContext(), &exporter.GetNextFinalizedPulse{})
+	require.True(t, platform.IsVersionError(err), "there is no other replica to retry")
+}
+
+func TestReplicas_NotifyShutdown(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	replicas, err := NewReplicas(belogger.TestContext(t), replicasConfig(addr))
+	require.NoError(t, err)
+	defer replicas.Close()
+	ctx, cancel := context.WithCancel(belogger.TestContext(t))
+	defer cancel()
+	require.NoError(t, replicas.Start(ctx))
+	defer replicas.Stop(ctx)
+
+	stopChannel := make(chan struct{}, 1)
+	replicas.NotifyShutdown(ctx, stopChannel, 50*time.Millisecond)
+	select {
+	case <-stopChannel:
+	case <-time.After(10 * time.Second):
+		t.Fatal("shutdown isn't notified")
+	}
+}
@@ -0,0 +1,126 @@
+package connection
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/pem"
+	"io/ioutil"
+
+	"github.com/golang-jwt/jwt"
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/insolar/block-explorer/configuration"
+)
+
+// authenticatedTokenKey is the context key of the bearer token validated by tokenValidator
+type authenticatedTokenKey struct{}
+
+// authenticatedToken returns the bearer token of the call if it was validated
+func authenticatedToken(ctx context.Context) (string, bool) {
+	token, ok := ctx.Value(authenticatedTokenKey{}).(string)
+	return token, ok
+}
+
+// tokenValidator validates bearer tokens of the incoming calls.
+// A token is valid if it is one of the static tokens or it is JWT signed by the configured key.
+type tokenValidator struct {
+	tokens map[string]struct{}
+	jwtKey interface{}
+}
+
+func newTokenValidator(cfg configuration.ServerAuth) (*tokenValidator, error) {
+	v := &tokenValidator{tokens: make(map[string]struct{}, len(cfg.Tokens))}
+	for _, t := range cfg.Tokens {
+		if t != "" {
+			v.tokens[t] = struct{}{}
+		}
+	}
+	if cfg.JWTKeyFile != "" {
+		key, err := loadJWTKey(cfg.JWTKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		v.jwtKey = key
+	}
+	if len(v.tokens) == 0 && v.jwtKey == nil {
+		return nil, errors.New("authentication is required, but neither tokens nor JWT key are configured")
+	}
+	return v, nil
+}
+
+// loadJWTKey reads RSA or ECDSA public key in PEM format, otherwise the file content is used as HMAC secret
+func loadJWTKey(path string) (interface{}, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read JWT key")
+	}
+	if block, _ := pem.Decode(data); block == nil {
+		secret := bytes.TrimSpace(data)
+		if len(secret) == 0 {
+			return nil, errors.Errorf("JWT key %s is empty", path)
+		}
+		return secret, nil
+	}
+	if key, err := jwt.ParseRSAPublicKeyFromPEM(data); err == nil {
+		return key, nil
+	}
+	key, err := jwt.ParseECPublicKeyFromPEM(data)
+	if err != nil {
+		return nil, errors.Errorf("JWT key %s is neither RSA nor ECDSA public key", path)
+	}
+	return key, nil
+}
+
+// authenticate checks the bearer token from the call metadata and keeps the valid token in the context,
+// it implements grpc_auth.AuthFunc
+func (v *tokenValidator) authenticate(ctx context.Context) (context.Context, error) {
+	token, err := grpc_auth.AuthFromMD(ctx, "bearer")
+	if err != nil {
+		RejectedCalls.WithLabelValues(RejectReasonNoToken).Inc()
+		return nil, err
+	}
+	if _, ok := v.tokens[token]; ok {
+		return context.WithValue(ctx, authenticatedTokenKey{}, token), nil
+	}
+	if v.jwtKey != nil {
+		if _, err := jwt.Parse(token, v.jwtKeyFunc); err == nil {
+			return context.WithValue(ctx, authenticatedTokenKey{}, token), nil
+		}
+	}
+	RejectedCalls.WithLabelValues(RejectReasonInvalidToken).Inc()
+	return nil, status.Error(codes.Unauthenticated, "invalid auth token")
+}
+
+// jwtKeyFunc returns the configured key if it fits the token signing method
+func (v *tokenValidator) jwtKeyFunc(token *jwt.Token) (interface{}, error) {
+	var ok bool
+	switch v.jwtKey.(type) {
+	case []byte:
+		_, ok = token.Method.(*jwt.SigningMethodHMAC)
+	case *rsa.PublicKey:
+		switch token.Method.(type) {
+		case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS:
+			ok = true
+		}
+	case *ecdsa.PublicKey:
+		_, ok = token.Method.(*jwt.SigningMethodECDSA)
+	}
+	if !ok {
+		return nil, errors.Errorf("unexpected signing method %s", token.Header["alg"])
+	}
+	return v.jwtKey, nil
+}
+
+func (v *tokenValidator) unaryInterceptor() grpc.UnaryServerInterceptor {
+	return grpc_auth.UnaryServerInterceptor(v.authenticate)
+}
+
+func (v *tokenValidator) streamInterceptor() grpc.StreamServerInterceptor {
+	return grpc_auth.StreamServerInterceptor(v.authenticate)
+}
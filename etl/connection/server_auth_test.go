@@ -0,0 +1,112 @@
+// +build unit
+
+package connection
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/insolar/block-explorer/configuration"
+)
+
+func writeFile(t *testing.T, data []byte) string {
+	f, err := ioutil.TempFile("", "key")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.Remove(f.Name()) })
+	_, err = f.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	return f.Name()
+}
+
+func bearerContext(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func signToken(t *testing.T, method jwt.SigningMethod, key interface{}, expiresAt time.Time) string {
+	token, err := jwt.NewWithClaims(method, jwt.StandardClaims{ExpiresAt: expiresAt.Unix()}).SignedString(key)
+	require.NoError(t, err)
+	return token
+}
+
+func TestTokenValidator_StaticTokens(t *testing.T) {
+	v, err := newTokenValidator(configuration.ServerAuth{Required: true, Tokens: []string{"first", "second"}})
+	require.NoError(t, err)
+
+	_, err = v.authenticate(bearerContext("second"))
+	require.NoError(t, err)
+
+	rejected := testutil.ToFloat64(RejectedCalls.WithLabelValues(RejectReasonInvalidToken))
+	_, err = v.authenticate(bearerContext("third"))
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.Equal(t, rejected+1, testutil.ToFloat64(RejectedCalls.WithLabelValues(RejectReasonInvalidToken)))
+
+	rejected = testutil.ToFloat64(RejectedCalls.WithLabelValues(RejectReasonNoToken))
+	_, err = v.authenticate(context.Background())
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.Equal(t, rejected+1, testutil.ToFloat64(RejectedCalls.WithLabelValues(RejectReasonNoToken)))
+}
+
+func TestTokenValidator_JWTHMAC(t *testing.T) {
+	secret := []byte("secret")
+	v, err := newTokenValidator(configuration.ServerAuth{Required: true, JWTKeyFile: writeFile(t, secret)})
+	require.NoError(t, err)
+
+	_, err = v.authenticate(bearerContext(signToken(t, jwt.SigningMethodHS256, secret, time.Now().Add(time.Hour))))
+	require.NoError(t, err)
+
+	_, err = v.authenticate(bearerContext(signToken(t, jwt.SigningMethodHS256, secret, time.Now().Add(-time.Hour))))
+	require.Equal(t, codes.Unauthenticated, status.Code(err), "expired token must be rejected")
+
+	_, err = v.authenticate(bearerContext(signToken(t, jwt.SigningMethodHS256, []byte("other"), time.Now().Add(time.Hour))))
+	require.Equal(t, codes.Unauthenticated, status.Code(err), "token signed by another key must be rejected")
+}
+
+func TestTokenValidator_JWTRSA(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	publicKey, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})
+	v, err := newTokenValidator(configuration.ServerAuth{Required: true, JWTKeyFile: writeFile(t, publicPEM)})
+	require.NoError(t, err)
+
+	_, err = v.authenticate(bearerContext(signToken(t, jwt.SigningMethodRS256, privateKey, time.Now().Add(time.Hour))))
+	require.NoError(t, err)
+
+	// the public key mustn't be accepted as HMAC secret
+	_, err = v.authenticate(bearerContext(signToken(t, jwt.SigningMethodHS256, publicPEM, time.Now().Add(time.Hour))))
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestNewGRPCServer_InvalidConfig(t *testing.T) {
+	grpcMetrics := grpc_prometheus.NewServerMetrics()
+
+	_, err := NewGRPCServer(configuration.Exporter{Auth: configuration.ServerAuth{Required: true}}, grpcMetrics)
+	require.Error(t, err, "auth without tokens and key")
+
+	_, err = NewGRPCServer(configuration.Exporter{Auth: configuration.ServerAuth{Required: true, JWTKeyFile: "/not/existing/key"}}, grpcMetrics)
+	require.Error(t, err, "not existing JWT key")
+
+	_, err = NewGRPCServer(configuration.Exporter{TLS: configuration.ServerTLS{Enabled: true, CertFile: "/not/existing/cert", KeyFile: "/not/existing/key"}}, grpcMetrics)
+	require.Error(t, err, "not existing TLS certificate")
+
+	server, err := NewGRPCServer(configuration.Exporter{Auth: configuration.ServerAuth{Required: true, Tokens: []string{"token"}}}, grpcMetrics)
+	require.NoError(t, err)
+	require.NotNil(t, server)
+}
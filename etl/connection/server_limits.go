@@ -0,0 +1,194 @@
+package connection
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/insolar/block-explorer/configuration"
+)
+
+// idleClientTimeout is the time after which the usage of a client without streams is forgotten
+const idleClientTimeout = time.Minute
+
+// clientUsage holds the number of active streams and the rate limit bucket of a client
+type clientUsage struct {
+	streams   int
+	tokens    float64
+	updatedAt time.Time
+}
+
+// clientLimiter limits concurrent streams and call rate of the gRPC server clients.
+// Exceeded limits are reported with ResourceExhausted code and heavy's rate limit message,
+// so the clients treat them as rate limit errors.
+type clientLimiter struct {
+	cfg configuration.ServerLimits
+	now func() time.Time
+
+	mu        sync.Mutex
+	streams   int
+	clients   map[string]*clientUsage
+	lastSweep time.Time
+}
+
+func newClientLimiter(cfg configuration.ServerLimits) *clientLimiter {
+	return &clientLimiter{
+		cfg:     cfg,
+		now:     time.Now,
+		clients: make(map[string]*clientUsage),
+	}
+}
+
+// enabled returns true if at least one limit is configured
+func (l *clientLimiter) enabled() bool {
+	return l.cfg.MaxStreams > 0 || l.cfg.MaxClientStreams > 0 || l.cfg.ClientRate > 0
+}
+
+// clientID returns the hash of the client's bearer token validated by the server or the client's host otherwise.
+// Tokens aren't validated if the authentication is disabled, so they aren't used to identify the client,
+// otherwise a client would get a fresh rate limit bucket with every random token.
+func clientID(ctx context.Context) string {
+	if token, ok := authenticatedToken(ctx); ok {
+		hash := sha256.Sum256([]byte(token))
+		return "token:" + hex.EncodeToString(hash[:4])
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			return p.Addr.String()
+		}
+		return host
+	}
+	return "unknown"
+}
+
+// allowCall takes a call from the client's rate limit bucket
+func (l *clientLimiter) allowCall(client string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	usage := l.usage(client)
+	if l.cfg.ClientRate > 0 {
+		if usage.tokens < 1 {
+			return l.reject(client, LimitReasonRate)
+		}
+		usage.tokens--
+	}
+	ClientCalls.WithLabelValues(client).Inc()
+	return nil
+}
+
+// acquireStream takes a call from the client's rate limit bucket and reserves a stream for the client
+func (l *clientLimiter) acquireStream(client string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	usage := l.usage(client)
+	if l.cfg.MaxStreams > 0 && l.streams >= l.cfg.MaxStreams {
+		return l.reject(client, LimitReasonStreams)
+	}
+	if l.cfg.MaxClientStreams > 0 && usage.streams >= l.cfg.MaxClientStreams {
+		return l.reject(client, LimitReasonClientStreams)
+	}
+	if l.cfg.ClientRate > 0 {
+		if usage.tokens < 1 {
+			return l.reject(client, LimitReasonRate)
+		}
+		usage.tokens--
+	}
+
+	l.streams++
+	usage.streams++
+	ClientCalls.WithLabelValues(client).Inc()
+	ClientStreams.WithLabelValues(client).Inc()
+	return nil
+}
+
+// releaseStream frees the stream reserved by acquireStream
+func (l *clientLimiter) releaseStream(client string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.streams--
+	if usage, ok := l.clients[client]; ok {
+		usage.streams--
+	}
+	ClientStreams.WithLabelValues(client).Dec()
+}
+
+func (l *clientLimiter) reject(client, reason string) error {
+	LimitedCalls.WithLabelValues(client, reason).Inc()
+	return status.Errorf(codes.ResourceExhausted, "%s: %s limit exceeded", exporter.RateLimitExceededMsg, reason)
+}
+
+// usage returns the client's usage with refilled rate limit bucket, it has to be called under the lock
+func (l *clientLimiter) usage(client string) *clientUsage {
+	now := l.now()
+	l.sweep(now)
+
+	burst := float64(l.cfg.ClientBurst)
+	if burst < 1 {
+		burst = 1
+	}
+	usage, ok := l.clients[client]
+	if !ok {
+		usage = &clientUsage{tokens: burst, updatedAt: now}
+		l.clients[client] = usage
+		return usage
+	}
+
+	usage.tokens += now.Sub(usage.updatedAt).Seconds() * l.cfg.ClientRate
+	if usage.tokens > burst {
+		usage.tokens = burst
+	}
+	usage.updatedAt = now
+	return usage
+}
+
+// sweep forgets clients without streams which haven't called for a long time with their metrics,
+// so the number of the metrics label sets is bounded by the clients active within idleClientTimeout
+func (l *clientLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < idleClientTimeout {
+		return
+	}
+	l.lastSweep = now
+	for client, usage := range l.clients {
+		if usage.streams == 0 && now.Sub(usage.updatedAt) >= idleClientTimeout {
+			delete(l.clients, client)
+			ClientStreams.DeleteLabelValues(client)
+			ClientCalls.DeleteLabelValues(client)
+			for _, reason := range []string{LimitReasonStreams, LimitReasonClientStreams, LimitReasonRate} {
+				LimitedCalls.DeleteLabelValues(client, reason)
+			}
+		}
+	}
+}
+
+func (l *clientLimiter) unaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := l.allowCall(clientID(ctx)); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (l *clientLimiter) streamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		client := clientID(stream.Context())
+		if err := l.acquireStream(client); err != nil {
+			return err
+		}
+		defer l.releaseStream(client)
+		return handler(srv, stream)
+	}
+}
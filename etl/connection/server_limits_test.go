@@ -0,0 +1,95 @@
+// +build unit
+
+package connection
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/insolar/block-explorer/configuration"
+)
+
+func TestClientLimiter_Streams(t *testing.T) {
+	l := newClientLimiter(configuration.ServerLimits{MaxStreams: 3, MaxClientStreams: 2})
+
+	require.NoError(t, l.acquireStream("first"))
+	require.NoError(t, l.acquireStream("first"))
+	err := l.acquireStream("first")
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Contains(t, err.Error(), exporter.RateLimitExceededMsg)
+	require.Equal(t, float64(2), testutil.ToFloat64(ClientStreams.WithLabelValues("first")))
+
+	require.NoError(t, l.acquireStream("second"))
+	err = l.acquireStream("third")
+	require.Equal(t, codes.ResourceExhausted, status.Code(err), "global limit must be exceeded")
+
+	l.releaseStream("first")
+	require.NoError(t, l.acquireStream("third"))
+	require.Equal(t, float64(1), testutil.ToFloat64(ClientStreams.WithLabelValues("first")))
+}
+
+func TestClientLimiter_Rate(t *testing.T) {
+	now := time.Now()
+	l := newClientLimiter(configuration.ServerLimits{ClientRate: 2, ClientBurst: 2})
+	l.now = func() time.Time { return now }
+
+	limited := testutil.ToFloat64(LimitedCalls.WithLabelValues("rate_client", LimitReasonRate))
+	require.NoError(t, l.allowCall("rate_client"))
+	require.NoError(t, l.acquireStream("rate_client"))
+	err := l.allowCall("rate_client")
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Equal(t, limited+1, testutil.ToFloat64(LimitedCalls.WithLabelValues("rate_client", LimitReasonRate)))
+
+	// other clients have their own limits
+	require.NoError(t, l.allowCall("other_client"))
+
+	now = now.Add(500 * time.Millisecond)
+	require.NoError(t, l.allowCall("rate_client"))
+	err = l.allowCall("rate_client")
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestClientLimiter_Sweep(t *testing.T) {
+	now := time.Now()
+	l := newClientLimiter(configuration.ServerLimits{MaxClientStreams: 1})
+	l.now = func() time.Time { return now }
+
+	require.NoError(t, l.allowCall("idle"))
+	require.NoError(t, l.acquireStream("streaming"))
+
+	now = now.Add(idleClientTimeout)
+	require.NoError(t, l.allowCall("other"))
+	require.NotContains(t, l.clients, "idle")
+	require.Contains(t, l.clients, "streaming")
+	require.Zero(t, testutil.ToFloat64(ClientCalls.WithLabelValues("idle")), "metrics of the idle client are deleted")
+	require.Equal(t, float64(1), testutil.ToFloat64(ClientCalls.WithLabelValues("streaming")))
+}
+
+func TestClientID(t *testing.T) {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 1234}})
+	require.Equal(t, "10.0.0.1", clientID(ctx))
+
+	require.Equal(t, "unknown", clientID(bearerContext("first")), "not validated token isn't used")
+
+	validator, err := newTokenValidator(configuration.ServerAuth{Required: true, Tokens: []string{"first", "second"}})
+	require.NoError(t, err)
+	authenticated := func(token string) context.Context {
+		ctx, err := validator.authenticate(peer.NewContext(bearerContext(token), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1)}}))
+		require.NoError(t, err)
+		return ctx
+	}
+	first := clientID(authenticated("first"))
+	require.NotContains(t, first, "first", "token mustn't be exposed")
+	require.NotEqual(t, "10.0.0.1", first)
+	require.NotEqual(t, first, clientID(authenticated("second")))
+	require.Equal(t, first, clientID(authenticated("first")))
+}
@@ -33,6 +33,8 @@ type Controller struct {
 	// sequentialPulse is greatest complete pulse after which all pulses complete too
 	sequentialPulse     models.Pulse
 	sequentialPulseLock sync.RWMutex
+	// reingestion is the last re-ingestion of a pulse range, it's guarded by sequentialPulseLock
+	reingestion *types.ReingestProgress
 
 	// incompletePulseCounter for penv-615
 	incompletePulseCounter int
@@ -96,6 +98,21 @@ func (c *Controller) setSeqPulse(ctx context.Context) error {
 	return nil
 }
 
+// getReloadRanges returns pulse ranges which were reloading before restart,
+// ranges which became sequential are deleted from storage
+func (c *Controller) getReloadRanges(ctx context.Context) ([]models.ReloadRange, error) {
+	err := c.storage.DeleteReloadRanges(c.sequentialPulse.PulseNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't delete reloaded ranges from storage")
+	}
+	reloadRanges, err := c.storage.GetReloadRanges()
+	if err != nil {
+		return nil, errors.Wrap(err, "can't get reload ranges from storage")
+	}
+	belogger.FromContext(ctx).Debugf("Found %d reload ranges in db", len(reloadRanges))
+	return reloadRanges, nil
+}
+
 // Start implements interfaces.Starter
 func (c *Controller) Start(ctx context.Context) error {
 	err := c.setIncompletePulses(ctx)
@@ -106,8 +123,16 @@ func (c *Controller) Start(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	reloadRanges, err := c.getReloadRanges(ctx)
+	if err != nil {
+		return err
+	}
 	ctx, c.cancelFunc = context.WithCancel(ctx)
 	c.missedDataManager.Start()
+	// ranges are ordered so the wider ones are resumed first and the ranges inside them aren't loaded twice
+	for _, r := range reloadRanges {
+		c.reloadData(ctx, r.FromPulseNumber, r.ToPulseNumber)
+	}
 	go c.pulseMaintainer(ctx)
 	go c.pulseSequence(ctx)
 	return nil
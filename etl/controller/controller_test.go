@@ -6,6 +6,7 @@ import (
 	"context"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/insolar/block-explorer/configuration"
 
@@ -26,6 +27,9 @@ func TestNewController_NoPulses(t *testing.T) {
 	sm := mock.NewStorageMock(t)
 	sm.GetIncompletePulsesMock.Return(nil, nil)
 	sm.GetSequentialPulseMock.Return(models.Pulse{}, nil)
+	sm.DeleteReloadRangesMock.Return(nil)
+	sm.GetReloadRangesMock.Return(nil, nil)
+	sm.SaveReloadAttemptMock.Return(models.ReloadRange{Attempts: 1}, nil)
 	sm.GetPulseByPrevMock.Return(models.Pulse{}, nil)
 	sm.GetNextSavedPulseMock.Return(models.Pulse{}, nil)
 	extractor.LoadJetDropsMock.Return(nil)
@@ -53,6 +57,9 @@ func TestNewController_OneNotCompletePulse(t *testing.T) {
 	sm.GetIncompletePulsesMock.Return([]models.Pulse{{PulseNumber: pulseNumber}}, nil)
 	sm.GetJetDropsMock.Return([]models.JetDrop{{JetID: firstJetID}, {JetID: secondJetID}}, nil)
 	sm.GetSequentialPulseMock.Return(models.Pulse{}, nil)
+	sm.DeleteReloadRangesMock.Return(nil)
+	sm.GetReloadRangesMock.Return(nil, nil)
+	sm.SaveReloadAttemptMock.Return(models.ReloadRange{Attempts: 1}, nil)
 	sm.GetPulseByPrevMock.Return(models.Pulse{}, nil)
 	sm.GetNextSavedPulseMock.Return(models.Pulse{}, nil)
 	extractor.LoadJetDropsMock.Return(nil)
@@ -81,6 +88,9 @@ func TestNewController_OneNotCompletePulse_NoJets(t *testing.T) {
 	sm.GetIncompletePulsesMock.Return([]models.Pulse{{PulseNumber: pulseNumber}}, nil)
 	sm.GetJetDropsMock.Return([]models.JetDrop{}, nil)
 	sm.GetSequentialPulseMock.Return(models.Pulse{}, nil)
+	sm.DeleteReloadRangesMock.Return(nil)
+	sm.GetReloadRangesMock.Return(nil, nil)
+	sm.SaveReloadAttemptMock.Return(models.ReloadRange{Attempts: 1}, nil)
 	sm.GetPulseByPrevMock.Return(models.Pulse{}, nil)
 	sm.GetNextSavedPulseMock.Return(models.Pulse{}, nil)
 	extractor.LoadJetDropsMock.Return(nil)
@@ -125,6 +135,9 @@ func TestNewController_SeveralNotCompletePulses(t *testing.T) {
 	sm.GetIncompletePulsesMock.Return([]models.Pulse{{PulseNumber: firstPulseNumber}, {PulseNumber: secondPulseNumber}}, nil)
 	sm.GetJetDropsMock.Set(getJetDrops)
 	sm.GetSequentialPulseMock.Return(models.Pulse{}, nil)
+	sm.DeleteReloadRangesMock.Return(nil)
+	sm.GetReloadRangesMock.Return(nil, nil)
+	sm.SaveReloadAttemptMock.Return(models.ReloadRange{Attempts: 1}, nil)
 	sm.GetPulseByPrevMock.Return(models.Pulse{}, nil)
 	sm.GetNextSavedPulseMock.Return(models.Pulse{}, nil)
 	extractor.LoadJetDropsMock.Return(nil)
@@ -184,6 +197,50 @@ func TestNewController_ErrorGetJetDrops(t *testing.T) {
 	require.Equal(t, uint64(1), sm.GetJetDropsAfterCounter())
 }
 
+func TestNewController_ResumeReloadRanges(t *testing.T) {
+	extractor := mock.NewJetDropsExtractorMock(t)
+
+	sequentialPulse := models.Pulse{PulseNumber: 1000000}
+	sm := mock.NewStorageMock(t)
+	sm.GetIncompletePulsesMock.Return(nil, nil)
+	sm.GetSequentialPulseMock.Return(sequentialPulse, nil)
+	sm.DeleteReloadRangesMock.Expect(sequentialPulse.PulseNumber).Return(nil)
+	sm.GetReloadRangesMock.Return([]models.ReloadRange{
+		{FromPulseNumber: 1000000, ToPulseNumber: 1000050, Attempts: 2},
+		{FromPulseNumber: 1000010, ToPulseNumber: 1000030, Attempts: 1},
+		{FromPulseNumber: 1000060, ToPulseNumber: 1000080, Attempts: 1},
+	}, nil)
+	sm.SaveReloadAttemptMock.Set(func(fromPulseNumber int64, toPulseNumber int64) (r1 models.ReloadRange, err error) {
+		return models.ReloadRange{FromPulseNumber: fromPulseNumber, ToPulseNumber: toPulseNumber, Attempts: 3}, nil
+	})
+	sm.GetPulseByPrevMock.Return(models.Pulse{}, nil)
+	sm.GetNextSavedPulseMock.Return(models.Pulse{PrevPulseNumber: 1000040, PulseNumber: 1000050}, nil)
+
+	var loaded [][2]int64
+	var loadedLock sync.Mutex
+	extractor.LoadJetDropsMock.Set(func(ctx context.Context, fromPulseNumber int64, toPulseNumber int64) (err error) {
+		loadedLock.Lock()
+		defer loadedLock.Unlock()
+		loaded = append(loaded, [2]int64{fromPulseNumber, toPulseNumber})
+		return nil
+	})
+
+	c, err := NewController(cfg, extractor, sm, platformVersion)
+	require.NoError(t, err)
+	err = c.Start(context.Background())
+	require.NoError(t, err)
+	// the sequence checker requests the range inside the resumed one
+	for sm.GetNextSavedPulseAfterCounter() < 2 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.NoError(t, c.Stop(context.Background()))
+
+	loadedLock.Lock()
+	defer loadedLock.Unlock()
+	require.Equal(t, [][2]int64{{1000000, 1000050}, {1000060, 1000080}}, loaded, "ranges inside resumed ones aren't loaded twice")
+	require.Equal(t, uint64(2), sm.SaveReloadAttemptAfterCounter())
+}
+
 func TestController_SetJetDropData(t *testing.T) {
 	c := Controller{
 		jetDropRegister: make(map[types.Pulse]map[string]struct{}),
@@ -15,6 +15,10 @@ var (
 		Name: "gbe_controller_current_seq_pulse",
 		Help: "Current sequentual pulse rerequested from platform",
 	})
+	ReingestInProgress = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "gbe_controller_reingest_in_progress",
+		Help: "1 if a pulse range is being re-ingested, 0 otherwise",
+	})
 	PulseCompleteCounter = prometheus.NewCounter(prometheus.CounterOpts{
 		Name: "gbe_controller_pulse_complete_counter",
 		Help: "How many pulses is completed by 'pulseIsComplete' check",
@@ -35,6 +39,7 @@ func (s Metrics) Metrics(p *metrics.Prometheus) []prometheus.Collector {
 	return []prometheus.Collector{
 		IncompletePulsesQueue,
 		CurrentSeqPulse,
+		ReingestInProgress,
 		PulseNotCompleteCounter,
 		PulseCompleteCounter,
 	}
@@ -43,7 +43,12 @@ func eraseJetDropRegister(ctx context.Context, c *Controller, log log.Logger) {
 	}()
 
 	for p, d := range jetDropRegisterCopy {
-		if pulseIsComplete(p, d) {
+		complete, err := c.isPulseComplete(p, d)
+		if err != nil {
+			log.Errorf("During checking pulse %d completeness: %s", p.PulseNo, err.Error())
+			continue
+		}
+		if complete {
 			PulseCompleteCounter.Inc()
 			log.Infof("Pulse %d completed, update it in db", p.PulseNo)
 			if func() bool {
@@ -116,6 +121,10 @@ func (c *Controller) pulseSequence(ctx context.Context) {
 				}
 				c.sequentialPulse = nextSequential
 				log.Infof("Pulse %d sequenced", nextSequential.PulseNumber)
+				if err := c.storage.DeleteReloadRanges(nextSequential.PulseNumber); err != nil {
+					log.Errorf("During deleting reloaded ranges: %s", err.Error())
+				}
+				c.updateReingestion(ctx)
 				waitTime = time.Duration(0)
 				return
 			}
@@ -142,6 +151,24 @@ func (c *Controller) pulseSequence(ctx context.Context) {
 	}
 }
 
+// isPulseComplete checks that every jet of the saved pulse jet tree has a jet drop,
+// the completeness is guessed from jet drops if the jet tree wasn't saved
+func (c *Controller) isPulseComplete(p types.Pulse, d map[string]struct{}) (bool, error) {
+	jets, err := c.storage.GetPulseJets(p.PulseNo)
+	if err != nil {
+		return false, err
+	}
+	if len(jets) == 0 {
+		return pulseIsComplete(p, d), nil
+	}
+	for _, jet := range jets {
+		if _, ok := d[jet.JetID]; !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 func pulseIsComplete(p types.Pulse, d map[string]struct{}) bool { // nolint
 	if len(d) == 0 {
 		return false
@@ -217,7 +244,8 @@ func (c *Controller) reloadData(ctx context.Context, fromPulseNumber int64, toPu
 		fromPulseNumber = pulse.MinTimePulse - 1
 	}
 	if c.missedDataManager.Add(ctx, fromPulseNumber, toPulseNumber) {
-		log.Infof("Reload data from %d to %d", fromPulseNumber, toPulseNumber)
+		attempts := c.saveReloadAttempt(ctx, fromPulseNumber, toPulseNumber)
+		log.Infof("Reload data from %d to %d, attempt %d", fromPulseNumber, toPulseNumber, attempts)
 		err := c.extractor.LoadJetDrops(ctx, fromPulseNumber, toPulseNumber)
 		if err != nil {
 			log.Errorf("During loading missing data from extractor: %s", err.Error())
@@ -226,6 +254,17 @@ func (c *Controller) reloadData(ctx context.Context, fromPulseNumber int64, toPu
 	}
 }
 
+// saveReloadAttempt checkpoints the reloaded range, so it's resumed after restart until it becomes sequential,
+// it returns the number of the reloads of the range or zero if the checkpoint wasn't saved
+func (c *Controller) saveReloadAttempt(ctx context.Context, fromPulseNumber int64, toPulseNumber int64) int {
+	reloadRange, err := c.storage.SaveReloadAttempt(fromPulseNumber, toPulseNumber)
+	if err != nil {
+		belogger.FromContext(ctx).Errorf("During saving reload of pulses %d - %d: %s", fromPulseNumber, toPulseNumber, err.Error())
+		return 0
+	}
+	return reloadRange.Attempts
+}
+
 func (c *Controller) cleanJetDropRegister(ctx context.Context) {
 	log := belogger.FromContext(ctx)
 	jetDropRegisterCopy := map[types.Pulse]map[string]struct{}{}
@@ -29,6 +29,9 @@ func TestController_pulseMaintainer(t *testing.T) {
 	sm := mock.NewStorageMock(t)
 	sm.GetIncompletePulsesMock.Return(nil, nil)
 	sm.GetSequentialPulseMock.Return(models.Pulse{}, nil)
+	sm.DeleteReloadRangesMock.Return(nil)
+	sm.GetReloadRangesMock.Return(nil, nil)
+	sm.SaveReloadAttemptMock.Return(models.ReloadRange{Attempts: 1}, nil)
 	sm.GetPulseByPrevMock.Return(models.Pulse{}, errors.New("test error"))
 
 	defer leaktest.Check(t)()
@@ -53,6 +56,9 @@ func TestController_pulseSequence_StartFromNothing(t *testing.T) {
 	sm := mock.NewStorageMock(t)
 	sm.GetIncompletePulsesMock.Return(nil, nil)
 	sm.GetSequentialPulseMock.Return(models.Pulse{}, nil)
+	sm.DeleteReloadRangesMock.Return(nil)
+	sm.GetReloadRangesMock.Return(nil, nil)
+	sm.SaveReloadAttemptMock.Return(models.ReloadRange{Attempts: 1}, nil)
 	wg := sync.WaitGroup{}
 	wg.Add(5)
 	sm.GetPulseByPrevMock.Set(func(prevPulse models.Pulse) (p1 models.Pulse, err error) {
@@ -126,6 +132,9 @@ func TestController_pulseSequence_StartFromSomething(t *testing.T) {
 	sm := mock.NewStorageMock(t)
 	sm.GetIncompletePulsesMock.Return(nil, nil)
 	sm.GetSequentialPulseMock.Return(models.Pulse{PulseNumber: 1000000}, nil)
+	sm.DeleteReloadRangesMock.Return(nil)
+	sm.GetReloadRangesMock.Return(nil, nil)
+	sm.SaveReloadAttemptMock.Return(models.ReloadRange{Attempts: 1}, nil)
 	wg := sync.WaitGroup{}
 	wg.Add(5)
 	sm.GetPulseByPrevMock.Set(func(prevPulse models.Pulse) (p1 models.Pulse, err error) {
@@ -196,6 +205,9 @@ func TestController_pulseSequence_Start_NoMissedData(t *testing.T) {
 	sm := mock.NewStorageMock(t)
 	sm.GetIncompletePulsesMock.Return(nil, nil)
 	sm.GetSequentialPulseMock.Return(models.Pulse{PulseNumber: 1000000}, nil)
+	sm.DeleteReloadRangesMock.Return(nil)
+	sm.GetReloadRangesMock.Return(nil, nil)
+	sm.SaveReloadAttemptMock.Return(models.ReloadRange{Attempts: 1}, nil)
 	wg := sync.WaitGroup{}
 	wg.Add(3)
 	sm.GetPulseByPrevMock.Set(func(prevPulse models.Pulse) (p1 models.Pulse, err error) {
@@ -244,6 +256,9 @@ func TestController_pulseMaintainer_Start_PulsesCompleteAndNot(t *testing.T) {
 
 	sm := mock.NewStorageMock(t)
 	sm.GetSequentialPulseMock.Return(models.Pulse{PulseNumber: 0}, nil)
+	sm.DeleteReloadRangesMock.Return(nil)
+	sm.GetReloadRangesMock.Return(nil, nil)
+	sm.SaveReloadAttemptMock.Return(models.ReloadRange{Attempts: 1}, nil)
 	sm.GetPulseByPrevMock.Set(func(prevPulse models.Pulse) (p1 models.Pulse, err error) {
 		return models.Pulse{}, errors.New("some test error")
 	})
@@ -256,6 +271,7 @@ func TestController_pulseMaintainer_Start_PulsesCompleteAndNot(t *testing.T) {
 	}, nil)
 	sm.GetJetDropsMock.When(notCompletePulse).Then([]models.JetDrop{{JetID: "1000"}}, nil)
 	sm.GetJetDropsMock.When(completePulse).Then([]models.JetDrop{{JetID: ""}}, nil)
+	sm.GetPulseJetsMock.Return(nil, nil)
 
 	wg := sync.WaitGroup{}
 	wg.Add(2)
@@ -299,6 +315,9 @@ func TestController_pulseSequence_ReloadPeriodExpired(t *testing.T) {
 	sm := mock.NewStorageMock(t)
 	sm.GetIncompletePulsesMock.Return(nil, nil)
 	sm.GetSequentialPulseMock.Return(models.Pulse{PulseNumber: 1000000}, nil)
+	sm.DeleteReloadRangesMock.Return(nil)
+	sm.GetReloadRangesMock.Return(nil, nil)
+	sm.SaveReloadAttemptMock.Return(models.ReloadRange{Attempts: 1}, nil)
 	wg := sync.WaitGroup{}
 	wg.Add(2)
 	sm.GetPulseByPrevMock.Set(func(prevPulse models.Pulse) (p1 models.Pulse, err error) {
@@ -329,6 +348,44 @@ func TestController_pulseSequence_ReloadPeriodExpired(t *testing.T) {
 	time.Sleep(time.Millisecond)
 }
 
+func TestController_isPulseComplete_SavedJetTree(t *testing.T) {
+	sm := mock.NewStorageMock(t)
+	sm.GetPulseJetsMock.Return([]models.PulseJet{
+		{PulseNumber: 1000, JetID: "0"},
+		{PulseNumber: 1000, JetID: "10"},
+		{PulseNumber: 1000, JetID: "11"},
+	}, nil)
+	c := &Controller{storage: sm}
+	p := types.Pulse{PulseNo: 1000}
+
+	complete, err := c.isPulseComplete(p, map[string]struct{}{"0": {}, "10": {}})
+	require.NoError(t, err)
+	require.False(t, complete)
+
+	complete, err = c.isPulseComplete(p, map[string]struct{}{"0": {}, "10": {}, "11": {}})
+	require.NoError(t, err)
+	require.True(t, complete)
+}
+
+func TestController_isPulseComplete_NoSavedJetTree(t *testing.T) {
+	sm := mock.NewStorageMock(t)
+	sm.GetPulseJetsMock.Return(nil, nil)
+	c := &Controller{storage: sm}
+
+	complete, err := c.isPulseComplete(types.Pulse{PulseNo: 1000}, map[string]struct{}{"": {}})
+	require.NoError(t, err)
+	require.True(t, complete)
+}
+
+func TestController_isPulseComplete_Error(t *testing.T) {
+	sm := mock.NewStorageMock(t)
+	sm.GetPulseJetsMock.Return(nil, errors.New("test error"))
+	c := &Controller{storage: sm}
+
+	_, err := c.isPulseComplete(types.Pulse{PulseNo: 1000}, map[string]struct{}{"": {}})
+	require.Error(t, err)
+}
+
 func Test_pulseIsComplete(t *testing.T) {
 	type args struct {
 		p types.Pulse
@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/block-explorer/etl/interfaces"
+	"github.com/insolar/block-explorer/etl/types"
+	"github.com/insolar/block-explorer/instrumentation/belogger"
+)
+
+// Reingest deletes pulses between fromPulseNumber and toPulseNumber inclusive with their jet drops and records,
+// rolls back the sequential pulse and loads the range from the platform again.
+// The re-ingestion is done when the range becomes sequential again, see ReingestProgress.
+func (c *Controller) Reingest(ctx context.Context, fromPulseNumber, toPulseNumber int64) error {
+	if fromPulseNumber > toPulseNumber {
+		return errors.Wrapf(interfaces.ErrReingestInvalidRange, "from pulse %d is greater than to pulse %d", fromPulseNumber, toPulseNumber)
+	}
+	fromPulse, err := c.storage.GetPulse(fromPulseNumber)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return errors.Wrapf(interfaces.ErrReingestPulseNotFound, "pulse %d", fromPulseNumber)
+		}
+		return errors.Wrapf(err, "can't get pulse %d from storage", fromPulseNumber)
+	}
+
+	err = func() error {
+		c.sequentialPulseLock.Lock()
+		defer c.sequentialPulseLock.Unlock()
+		if c.reingestion != nil && !c.reingestion.Done {
+			return errors.Wrapf(interfaces.ErrReingestInProgress, "pulses %d - %d", c.reingestion.FromPulseNumber, c.reingestion.ToPulseNumber)
+		}
+
+		if err := c.storage.DeletePulses(fromPulseNumber, toPulseNumber); err != nil {
+			return errors.Wrapf(err, "can't delete pulses %d - %d", fromPulseNumber, toPulseNumber)
+		}
+		sequentialPulse, err := c.storage.GetSequentialPulse()
+		if err != nil {
+			return errors.Wrap(err, "can't get sequential pulse from storage")
+		}
+		c.sequentialPulse = sequentialPulse
+		CurrentSeqPulse.Set(float64(c.sequentialPulse.PulseNumber))
+
+		c.jetDropRegisterLock.Lock()
+		defer c.jetDropRegisterLock.Unlock()
+		for p := range c.jetDropRegister {
+			if p.PulseNo >= fromPulseNumber && p.PulseNo <= toPulseNumber {
+				delete(c.jetDropRegister, p)
+			}
+		}
+		IncompletePulsesQueue.Set(float64(len(c.jetDropRegister)))
+
+		c.reingestion = &types.ReingestProgress{FromPulseNumber: fromPulseNumber, ToPulseNumber: toPulseNumber}
+		ReingestInProgress.Set(1)
+		return nil
+	}()
+	if err != nil {
+		return err
+	}
+
+	belogger.FromContext(ctx).Infof("Pulses %d - %d deleted, reloading them", fromPulseNumber, toPulseNumber)
+	// the range is registered as reloaded, so the sequence checker doesn't request it once more
+	c.missedDataManager.Add(ctx, fromPulse.PrevPulseNumber, toPulseNumber)
+	c.saveReloadAttempt(ctx, fromPulse.PrevPulseNumber, toPulseNumber)
+	err = c.extractor.LoadJetDrops(ctx, fromPulse.PrevPulseNumber, toPulseNumber)
+	if err != nil {
+		return errors.Wrapf(err, "can't load pulses %d - %d", fromPulseNumber, toPulseNumber)
+	}
+	return nil
+}
+
+// ReingestProgress returns the state of the last re-ingestion, false if there was no re-ingestion
+func (c *Controller) ReingestProgress() (types.ReingestProgress, bool) {
+	c.sequentialPulseLock.RLock()
+	defer c.sequentialPulseLock.RUnlock()
+	if c.reingestion == nil {
+		return types.ReingestProgress{}, false
+	}
+	progress := *c.reingestion
+	if !progress.Done {
+		progress.SequentialPulseNumber = c.sequentialPulse.PulseNumber
+	}
+	return progress, true
+}
+
+// updateReingestion marks the re-ingestion as done when the sequential pulse reaches the end of the range,
+// it has to be called under the sequentialPulseLock
+func (c *Controller) updateReingestion(ctx context.Context) {
+	if c.reingestion == nil || c.reingestion.Done {
+		return
+	}
+	if c.sequentialPulse.PulseNumber < c.reingestion.ToPulseNumber {
+		return
+	}
+	c.reingestion.SequentialPulseNumber = c.sequentialPulse.PulseNumber
+	c.reingestion.Done = true
+	ReingestInProgress.Set(0)
+	belogger.FromContext(ctx).Infof("Re-ingestion of pulses %d - %d completed", c.reingestion.FromPulseNumber, c.reingestion.ToPulseNumber)
+}
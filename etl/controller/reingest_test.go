@@ -0,0 +1,89 @@
+// +build unit
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/block-explorer/etl/interfaces"
+	"github.com/insolar/block-explorer/etl/interfaces/mock"
+	"github.com/insolar/block-explorer/etl/models"
+	"github.com/insolar/block-explorer/etl/types"
+)
+
+func TestController_Reingest(t *testing.T) {
+	ctx := context.Background()
+	fromPulse := models.Pulse{PulseNumber: 30, PrevPulseNumber: 20}
+	toPulseNumber := int64(50)
+
+	sm := mock.NewStorageMock(t)
+	sm.GetPulseMock.Expect(fromPulse.PulseNumber).Return(fromPulse, nil)
+	sm.DeletePulsesMock.Expect(fromPulse.PulseNumber, toPulseNumber).Return(nil)
+	sm.GetSequentialPulseMock.Return(models.Pulse{PulseNumber: 20, IsSequential: true}, nil)
+	sm.SaveReloadAttemptMock.Expect(fromPulse.PrevPulseNumber, toPulseNumber).Return(models.ReloadRange{Attempts: 1}, nil)
+	extractor := mock.NewJetDropsExtractorMock(t)
+	extractor.LoadJetDropsMock.Expect(ctx, fromPulse.PrevPulseNumber, toPulseNumber).Return(nil)
+
+	c, err := NewController(cfg, extractor, sm, platformVersion)
+	require.NoError(t, err)
+	c.sequentialPulse = models.Pulse{PulseNumber: 60}
+	c.jetDropRegister = map[types.Pulse]map[string]struct{}{
+		{PulseNo: 40}: {"0": {}},
+		{PulseNo: 70}: {"0": {}},
+	}
+
+	_, ok := c.ReingestProgress()
+	require.False(t, ok)
+
+	err = c.Reingest(ctx, fromPulse.PulseNumber, toPulseNumber)
+	require.NoError(t, err)
+	require.Equal(t, int64(20), c.sequentialPulse.PulseNumber)
+	require.Equal(t, map[types.Pulse]map[string]struct{}{{PulseNo: 70}: {"0": {}}}, c.jetDropRegister)
+
+	progress, ok := c.ReingestProgress()
+	require.True(t, ok)
+	require.Equal(t, types.ReingestProgress{FromPulseNumber: 30, ToPulseNumber: 50, SequentialPulseNumber: 20}, progress)
+
+	err = c.Reingest(ctx, fromPulse.PulseNumber, toPulseNumber)
+	require.Error(t, err)
+	require.Equal(t, interfaces.ErrReingestInProgress, errors.Cause(err))
+
+	c.sequentialPulse = models.Pulse{PulseNumber: 40}
+	c.updateReingestion(ctx)
+	progress, _ = c.ReingestProgress()
+	require.False(t, progress.Done)
+	require.Equal(t, int64(40), progress.SequentialPulseNumber)
+
+	c.sequentialPulse = models.Pulse{PulseNumber: 60}
+	c.updateReingestion(ctx)
+	c.sequentialPulse = models.Pulse{PulseNumber: 70}
+	progress, _ = c.ReingestProgress()
+	require.Equal(t, types.ReingestProgress{FromPulseNumber: 30, ToPulseNumber: 50, SequentialPulseNumber: 60, Done: true}, progress)
+}
+
+func TestController_Reingest_WrongRange(t *testing.T) {
+	c, err := NewController(cfg, mock.NewJetDropsExtractorMock(t), mock.NewStorageMock(t), platformVersion)
+	require.NoError(t, err)
+
+	err = c.Reingest(context.Background(), 50, 30)
+	require.Equal(t, interfaces.ErrReingestInvalidRange, errors.Cause(err))
+	_, ok := c.ReingestProgress()
+	require.False(t, ok)
+}
+
+func TestController_Reingest_PulseNotFound(t *testing.T) {
+	sm := mock.NewStorageMock(t)
+	sm.GetPulseMock.Return(models.Pulse{}, gorm.ErrRecordNotFound)
+	c, err := NewController(cfg, mock.NewJetDropsExtractorMock(t), sm, platformVersion)
+	require.NoError(t, err)
+
+	err = c.Reingest(context.Background(), 30, 50)
+	require.Equal(t, interfaces.ErrReingestPulseNotFound, errors.Cause(err))
+	_, ok := c.ReingestProgress()
+	require.False(t, ok)
+}
@@ -0,0 +1,63 @@
+package exporter
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/status"
+
+	"github.com/insolar/block-explorer/configuration"
+)
+
+// backoff calculates exponentially growing intervals between retries
+type backoff struct {
+	cfg     configuration.Retry
+	attempt int
+}
+
+func newBackoff(cfg configuration.Retry) *backoff {
+	return &backoff{cfg: cfg.Clamped()}
+}
+
+// Next returns the interval before the next retry
+func (b *backoff) Next() time.Duration {
+	interval := b.cfg.MinInterval
+	for i := 0; i < b.attempt && interval < b.cfg.MaxInterval; i++ {
+		interval *= 2
+	}
+	if b.cfg.MaxInterval > 0 && interval > b.cfg.MaxInterval {
+		interval = b.cfg.MaxInterval
+	}
+	b.attempt++
+	return interval
+}
+
+// Exhausted returns true if the number of failed attempts has reached the limit
+func (b *backoff) Exhausted() bool {
+	return b.cfg.MaxAttempts > 0 && b.attempt >= b.cfg.MaxAttempts
+}
+
+// Reset resets the number of failed attempts
+func (b *backoff) Reset() {
+	b.attempt = 0
+}
+
+// wait blocks for the provided duration or until the context is done
+func wait(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	case <-time.After(d):
+		return nil
+	}
+}
+
+// waitNotification blocks until the notification channel is closed or the context is done
+func waitNotification(ctx context.Context, notification <-chan struct{}) error {
+	select {
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	case <-notification:
+		return nil
+	}
+}
@@ -0,0 +1,47 @@
+// +build unit
+
+package exporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/block-explorer/configuration"
+)
+
+func TestBackoff(t *testing.T) {
+	b := newBackoff(configuration.Retry{MinInterval: 100 * time.Millisecond, MaxInterval: time.Second, MaxAttempts: 6})
+
+	var intervals []time.Duration
+	for !b.Exhausted() {
+		intervals = append(intervals, b.Next())
+	}
+	require.Equal(t, []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+		time.Second,
+	}, intervals)
+
+	b.Reset()
+	require.False(t, b.Exhausted())
+	require.Equal(t, 100*time.Millisecond, b.Next())
+}
+
+func TestBackoff_Infinite(t *testing.T) {
+	b := newBackoff(configuration.Retry{MinInterval: time.Millisecond, MaxInterval: 10 * time.Millisecond})
+	for i := 0; i < 100; i++ {
+		require.False(t, b.Exhausted())
+		require.LessOrEqual(t, int64(b.Next()), int64(10*time.Millisecond))
+	}
+}
+
+func TestBackoff_ZeroInterval(t *testing.T) {
+	b := newBackoff(configuration.Retry{MaxInterval: time.Second})
+	require.Equal(t, configuration.MinRetryInterval, b.Next())
+	require.Equal(t, 2*configuration.MinRetryInterval, b.Next())
+}
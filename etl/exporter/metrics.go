@@ -0,0 +1,62 @@
+package exporter
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/insolar/block-explorer/instrumentation/metrics"
+)
+
+const (
+	LabelMethod = "method"
+	LabelType   = "type"
+
+	MethodGetNextPulse = "GetNextPulse"
+	MethodGetRecords   = "GetRecords"
+	MethodNotifier     = "PulseNotifier"
+
+	ErrorTypeStorage = "storage"
+	ErrorTypeSend    = "send"
+)
+
+var (
+	ActiveStreams = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gbe_exporter_active_streams",
+		Help: "The number of active exporter streams",
+	},
+		[]string{LabelMethod},
+	)
+	SentPulses = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "gbe_exporter_sent_pulses",
+		Help: "The number of pulses sent to the clients",
+	})
+	SentRecords = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "gbe_exporter_sent_records",
+		Help: "The number of records sent to the clients",
+	})
+	PulseNotifications = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "gbe_exporter_pulse_notifications",
+		Help: "The number of new sequential pulse notifications sent to the streams",
+	})
+	Errors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "gbe_exporter_errors",
+		Help: "The number of errors occurred during streaming",
+	},
+		[]string{LabelMethod, LabelType},
+	)
+)
+
+type Metrics struct{}
+
+func (s Metrics) Refresh() {
+	// nothing to refresh
+}
+
+func (s Metrics) Metrics(p *metrics.Prometheus) []prometheus.Collector {
+	return []prometheus.Collector{
+		ActiveStreams,
+		SentPulses,
+		SentRecords,
+		PulseNotifications,
+		Errors,
+	}
+}
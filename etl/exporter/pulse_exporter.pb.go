@@ -30,9 +30,15 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GetNextPulseResponse struct {
-	PulseNumber     int64 `protobuf:"varint,1,opt,name=PulseNumber,proto3" json:"PulseNumber,omitempty"`
-	PrevPulseNumber int64 `protobuf:"varint,2,opt,name=PrevPulseNumber,proto3" json:"PrevPulseNumber,omitempty"`
-	RecordAmount    int64 `protobuf:"varint,3,opt,name=RecordAmount,proto3" json:"RecordAmount,omitempty"`
+	PulseNumber       int64                    `protobuf:"varint,1,opt,name=PulseNumber,proto3" json:"PulseNumber,omitempty"`
+	PrevPulseNumber   int64                    `protobuf:"varint,2,opt,name=PrevPulseNumber,proto3" json:"PrevPulseNumber,omitempty"`
+	RecordAmount      int64                    `protobuf:"varint,3,opt,name=RecordAmount,proto3" json:"RecordAmount,omitempty"`
+	NextPulseNumber   int64                    `protobuf:"varint,4,opt,name=NextPulseNumber,proto3" json:"NextPulseNumber,omitempty"`
+	Timestamp         int64                    `protobuf:"varint,5,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	JetDropAmount     int64                    `protobuf:"varint,6,opt,name=JetDropAmount,proto3" json:"JetDropAmount,omitempty"`
+	PulseRecordAmount int64                    `protobuf:"varint,7,opt,name=PulseRecordAmount,proto3" json:"PulseRecordAmount,omitempty"`
+	JetDropIDs        []string                 `protobuf:"bytes,8,rep,name=JetDropIDs,proto3" json:"JetDropIDs,omitempty"`
+	Prototypes        []*PrototypeRecordAmount `protobuf:"bytes,9,rep,name=Prototypes,proto3" json:"Prototypes,omitempty"`
 }
 
 func (m *GetNextPulseResponse) Reset()      { *m = GetNextPulseResponse{} }
@@ -88,15 +94,110 @@ func (m *GetNextPulseResponse) GetRecordAmount() int64 {
 	return 0
 }
 
+func (m *GetNextPulseResponse) GetNextPulseNumber() int64 {
+	if m != nil {
+		return m.NextPulseNumber
+	}
+	return 0
+}
+
+func (m *GetNextPulseResponse) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *GetNextPulseResponse) GetJetDropAmount() int64 {
+	if m != nil {
+		return m.JetDropAmount
+	}
+	return 0
+}
+
+func (m *GetNextPulseResponse) GetPulseRecordAmount() int64 {
+	if m != nil {
+		return m.PulseRecordAmount
+	}
+	return 0
+}
+
+func (m *GetNextPulseResponse) GetJetDropIDs() []string {
+	if m != nil {
+		return m.JetDropIDs
+	}
+	return nil
+}
+
+func (m *GetNextPulseResponse) GetPrototypes() []*PrototypeRecordAmount {
+	if m != nil {
+		return m.Prototypes
+	}
+	return nil
+}
+
+type PrototypeRecordAmount struct {
+	PrototypeReference []byte `protobuf:"bytes,1,opt,name=PrototypeReference,proto3" json:"PrototypeReference,omitempty"`
+	RecordAmount       int64  `protobuf:"varint,2,opt,name=RecordAmount,proto3" json:"RecordAmount,omitempty"`
+}
+
+func (m *PrototypeRecordAmount) Reset()      { *m = PrototypeRecordAmount{} }
+func (*PrototypeRecordAmount) ProtoMessage() {}
+func (*PrototypeRecordAmount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a6d0e77f1c7203d, []int{1}
+}
+func (m *PrototypeRecordAmount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrototypeRecordAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrototypeRecordAmount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrototypeRecordAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrototypeRecordAmount.Merge(m, src)
+}
+func (m *PrototypeRecordAmount) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrototypeRecordAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrototypeRecordAmount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrototypeRecordAmount proto.InternalMessageInfo
+
+func (m *PrototypeRecordAmount) GetPrototypeReference() []byte {
+	if m != nil {
+		return m.PrototypeReference
+	}
+	return nil
+}
+
+func (m *PrototypeRecordAmount) GetRecordAmount() int64 {
+	if m != nil {
+		return m.RecordAmount
+	}
+	return 0
+}
+
 type GetNextPulseRequest struct {
-	PulseNumberFrom int64    `protobuf:"varint,1,opt,name=PulseNumberFrom,proto3" json:"PulseNumberFrom,omitempty"`
-	Prototypes      [][]byte `protobuf:"bytes,2,rep,name=Prototypes,proto3" json:"Prototypes,omitempty"`
+	PulseNumberFrom  int64    `protobuf:"varint,1,opt,name=PulseNumberFrom,proto3" json:"PulseNumberFrom,omitempty"`
+	Prototypes       [][]byte `protobuf:"bytes,2,rep,name=Prototypes,proto3" json:"Prototypes,omitempty"`
+	ObjectReferences [][]byte `protobuf:"bytes,3,rep,name=ObjectReferences,proto3" json:"ObjectReferences,omitempty"`
+	RecordTypes      []string `protobuf:"bytes,4,rep,name=RecordTypes,proto3" json:"RecordTypes,omitempty"`
 }
 
 func (m *GetNextPulseRequest) Reset()      { *m = GetNextPulseRequest{} }
 func (*GetNextPulseRequest) ProtoMessage() {}
 func (*GetNextPulseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1a6d0e77f1c7203d, []int{1}
+	return fileDescriptor_1a6d0e77f1c7203d, []int{2}
 }
 func (m *GetNextPulseRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -139,33 +240,57 @@ func (m *GetNextPulseRequest) GetPrototypes() [][]byte {
 	return nil
 }
 
+func (m *GetNextPulseRequest) GetObjectReferences() [][]byte {
+	if m != nil {
+		return m.ObjectReferences
+	}
+	return nil
+}
+
+func (m *GetNextPulseRequest) GetRecordTypes() []string {
+	if m != nil {
+		return m.RecordTypes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetNextPulseResponse)(nil), "exporter.GetNextPulseResponse")
+	proto.RegisterType((*PrototypeRecordAmount)(nil), "exporter.PrototypeRecordAmount")
 	proto.RegisterType((*GetNextPulseRequest)(nil), "exporter.GetNextPulseRequest")
 }
 
 func init() { proto.RegisterFile("etl/exporter/pulse_exporter.proto", fileDescriptor_1a6d0e77f1c7203d) }
 
 var fileDescriptor_1a6d0e77f1c7203d = []byte{
-	// 277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0x7d, 0x8d, 0x84, 0xd0, 0x11, 0x84, 0x64, 0x18, 0x22, 0x24, 0x4e, 0x21, 0x53, 0xa6,
-	0x16, 0x01, 0x2f, 0x00, 0x12, 0xb0, 0x55, 0x21, 0x2f, 0x50, 0x29, 0x70, 0x5b, 0x53, 0x07, 0xc7,
-	0x41, 0x65, 0x63, 0x62, 0xe6, 0x31, 0x78, 0x14, 0xc6, 0x8c, 0x1d, 0x89, 0xb3, 0x30, 0xf6, 0x11,
-	0x50, 0x4d, 0x0b, 0x69, 0x05, 0xe3, 0x7d, 0xfe, 0xec, 0xfb, 0xef, 0x8c, 0xc7, 0x6c, 0xc6, 0x03,
-	0x9e, 0x16, 0x4a, 0x1b, 0xd6, 0x83, 0xa2, 0x1a, 0x97, 0x3c, 0x5a, 0x95, 0xfd, 0x42, 0x2b, 0xa3,
-	0xe4, 0xf6, 0xaa, 0x8e, 0x5e, 0x00, 0x0f, 0x6e, 0xd8, 0x0c, 0x79, 0x6a, 0x92, 0x85, 0x99, 0x72,
-	0x59, 0xa8, 0x49, 0xc9, 0x32, 0xc4, 0x1d, 0x07, 0x86, 0x55, 0x9e, 0xb1, 0x0e, 0x20, 0x84, 0xd8,
-	0x4b, 0xbb, 0x48, 0xc6, 0xb8, 0x97, 0x68, 0x7e, 0xec, 0x5a, 0x3d, 0x67, 0x6d, 0x62, 0x19, 0xa1,
-	0x9f, 0xf2, 0x9d, 0xd2, 0xf7, 0x17, 0xb9, 0xaa, 0x26, 0x26, 0xf0, 0x9c, 0xb6, 0xc6, 0xa2, 0x11,
-	0xee, 0xaf, 0xe7, 0x78, 0xa8, 0xb8, 0x34, 0xae, 0xc9, 0xef, 0x4b, 0xd7, 0x5a, 0xe5, 0xcb, 0x28,
-	0x9b, 0x58, 0x12, 0x62, 0xb2, 0x18, 0xce, 0x3c, 0x15, 0x5c, 0x06, 0xbd, 0xd0, 0x8b, 0xfd, 0xb4,
-	0x43, 0x4e, 0x33, 0xdc, 0x75, 0x57, 0xae, 0x96, 0xa3, 0xcb, 0x5b, 0xf4, 0xbb, 0x1d, 0xe5, 0x51,
-	0xff, 0x67, 0x4b, 0x7f, 0x24, 0x39, 0xa4, 0xff, 0x8e, 0xbf, 0x17, 0x16, 0x89, 0x13, 0xb8, 0x3c,
-	0xaf, 0x1b, 0x12, 0xb3, 0x86, 0xc4, 0xbc, 0x21, 0x78, 0xb6, 0x04, 0x6f, 0x96, 0xe0, 0xdd, 0x12,
-	0xd4, 0x96, 0xe0, 0xc3, 0x12, 0x7c, 0x5a, 0x12, 0x73, 0x4b, 0xf0, 0xda, 0x92, 0xa8, 0x5b, 0x12,
-	0xb3, 0x96, 0x44, 0xb6, 0xe5, 0x3e, 0xe5, 0xec, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xd5, 0xec, 0xa2,
-	0xe1, 0xb9, 0x01, 0x00, 0x00,
+	// 426 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xc1, 0xae, 0xd2, 0x40,
+	0x14, 0x86, 0x3b, 0x14, 0x11, 0x0e, 0x25, 0xea, 0xa8, 0x49, 0x63, 0x74, 0xac, 0x8d, 0x8b, 0xc6,
+	0x18, 0x30, 0xe8, 0xde, 0x68, 0x50, 0xa3, 0x0b, 0xc4, 0x86, 0xbd, 0xb1, 0x78, 0x4c, 0x54, 0xca,
+	0xd4, 0x99, 0xa9, 0xc1, 0x9d, 0x89, 0x2f, 0xe0, 0x63, 0xb8, 0xf1, 0x3d, 0x5c, 0xb2, 0x64, 0x29,
+	0x65, 0x73, 0x97, 0x3c, 0xc2, 0x4d, 0x87, 0x52, 0x06, 0xca, 0x5d, 0xce, 0x77, 0xfe, 0x39, 0xfd,
+	0xcf, 0x7f, 0xa6, 0x70, 0x0f, 0xd5, 0xb4, 0x87, 0xf3, 0x84, 0x0b, 0x85, 0xa2, 0x97, 0xa4, 0x53,
+	0x89, 0xef, 0x77, 0xc7, 0x6e, 0x22, 0xb8, 0xe2, 0xb4, 0xb9, 0x3b, 0xfb, 0xbf, 0x6c, 0xb8, 0xf1,
+	0x0a, 0xd5, 0x10, 0xe7, 0x6a, 0x94, 0x2b, 0x43, 0x94, 0x09, 0x9f, 0x49, 0xa4, 0x1e, 0xb4, 0x35,
+	0x18, 0xa6, 0x71, 0x84, 0xc2, 0x25, 0x1e, 0x09, 0xec, 0xd0, 0x44, 0x34, 0x80, 0x2b, 0x23, 0x81,
+	0xdf, 0x4d, 0x55, 0x4d, 0xab, 0x8e, 0x31, 0xf5, 0xc1, 0x09, 0x71, 0xc2, 0xc5, 0xc7, 0x67, 0x31,
+	0x4f, 0x67, 0xca, 0xb5, 0xb5, 0xec, 0x80, 0xe5, 0xdd, 0x4a, 0x13, 0x45, 0xb7, 0xfa, 0xb6, 0xdb,
+	0x11, 0xa6, 0xb7, 0xa1, 0x35, 0xfe, 0x1c, 0xa3, 0x54, 0x1f, 0xe2, 0xc4, 0xbd, 0xa4, 0x35, 0x7b,
+	0x40, 0xef, 0x43, 0xe7, 0x0d, 0xaa, 0x81, 0xe0, 0x49, 0xf1, 0xb1, 0x86, 0x56, 0x1c, 0x42, 0xfa,
+	0x10, 0xae, 0x15, 0xe3, 0x1a, 0xb6, 0x2e, 0x6b, 0x65, 0xb5, 0x40, 0x19, 0x40, 0x71, 0xfd, 0xf5,
+	0x40, 0xba, 0x4d, 0xcf, 0x0e, 0x5a, 0xa1, 0x41, 0xe8, 0x53, 0x80, 0x51, 0x9e, 0xab, 0xfa, 0x91,
+	0xa0, 0x74, 0x5b, 0x9e, 0x1d, 0xb4, 0xfb, 0x77, 0xbb, 0x65, 0xe6, 0x65, 0xcd, 0x6c, 0x1a, 0x1a,
+	0x57, 0xfc, 0xaf, 0x70, 0xf3, 0xa4, 0x88, 0x76, 0x81, 0x1a, 0x85, 0x4f, 0x28, 0x70, 0x36, 0x41,
+	0xbd, 0x0c, 0x27, 0x3c, 0x51, 0xa9, 0x24, 0x5d, 0xab, 0x26, 0xed, 0xff, 0x25, 0x70, 0xfd, 0x70,
+	0xe5, 0xdf, 0x52, 0x94, 0x7a, 0x03, 0x46, 0xcc, 0x2f, 0x05, 0x8f, 0x8b, 0xad, 0x1f, 0xe3, 0x3c,
+	0x0f, 0x63, 0xde, 0x9a, 0x67, 0x07, 0x8e, 0x39, 0x0e, 0x7d, 0x00, 0x57, 0xdf, 0x46, 0x5f, 0x70,
+	0xa2, 0x4a, 0x63, 0xd2, 0xb5, 0xb5, 0xaa, 0xc2, 0xf3, 0x77, 0xb6, 0x75, 0x37, 0xd6, 0xcd, 0xea,
+	0x3a, 0x5c, 0x13, 0xf5, 0x23, 0xe8, 0x68, 0x03, 0x2f, 0x8a, 0x3c, 0xe9, 0x3b, 0x70, 0x4c, 0xff,
+	0xf4, 0xce, 0x3e, 0xea, 0x13, 0x73, 0xdd, 0x62, 0x17, 0x95, 0xb7, 0x2f, 0xdd, 0xb7, 0x1e, 0x91,
+	0xe7, 0x4f, 0x16, 0x2b, 0x66, 0x2d, 0x57, 0xcc, 0xda, 0xac, 0x18, 0xf9, 0x99, 0x31, 0xf2, 0x27,
+	0x63, 0xe4, 0x5f, 0xc6, 0xc8, 0x22, 0x63, 0xe4, 0x7f, 0xc6, 0xc8, 0x59, 0xc6, 0xac, 0x4d, 0xc6,
+	0xc8, 0xef, 0x35, 0xb3, 0x16, 0x6b, 0x66, 0x2d, 0xd7, 0xcc, 0x8a, 0x1a, 0xfa, 0x6f, 0x7a, 0x7c,
+	0x3e, 0x00, 0x0b, 0xf4, 0x76, 0x68, 0x72, 0x03, 0x00, 0x00,
 }
 
 func (this *GetNextPulseResponse) Equal(that interface{}) bool {
@@ -196,6 +321,61 @@ func (this *GetNextPulseResponse) Equal(that interface{}) bool {
 	if this.RecordAmount != that1.RecordAmount {
 		return false
 	}
+	if this.NextPulseNumber != that1.NextPulseNumber {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if this.JetDropAmount != that1.JetDropAmount {
+		return false
+	}
+	if this.PulseRecordAmount != that1.PulseRecordAmount {
+		return false
+	}
+	if len(this.JetDropIDs) != len(that1.JetDropIDs) {
+		return false
+	}
+	for i := range this.JetDropIDs {
+		if this.JetDropIDs[i] != that1.JetDropIDs[i] {
+			return false
+		}
+	}
+	if len(this.Prototypes) != len(that1.Prototypes) {
+		return false
+	}
+	for i := range this.Prototypes {
+		if !this.Prototypes[i].Equal(that1.Prototypes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PrototypeRecordAmount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PrototypeRecordAmount)
+	if !ok {
+		that2, ok := that.(PrototypeRecordAmount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.PrototypeReference, that1.PrototypeReference) {
+		return false
+	}
+	if this.RecordAmount != that1.RecordAmount {
+		return false
+	}
 	return true
 }
 func (this *GetNextPulseRequest) Equal(that interface{}) bool {
@@ -228,28 +408,65 @@ func (this *GetNextPulseRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.ObjectReferences) != len(that1.ObjectReferences) {
+		return false
+	}
+	for i := range this.ObjectReferences {
+		if !bytes.Equal(this.ObjectReferences[i], that1.ObjectReferences[i]) {
+			return false
+		}
+	}
+	if len(this.RecordTypes) != len(that1.RecordTypes) {
+		return false
+	}
+	for i := range this.RecordTypes {
+		if this.RecordTypes[i] != that1.RecordTypes[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *GetNextPulseResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 13)
 	s = append(s, "&exporter.GetNextPulseResponse{")
 	s = append(s, "PulseNumber: "+fmt.Sprintf("%#v", this.PulseNumber)+",\n")
 	s = append(s, "PrevPulseNumber: "+fmt.Sprintf("%#v", this.PrevPulseNumber)+",\n")
 	s = append(s, "RecordAmount: "+fmt.Sprintf("%#v", this.RecordAmount)+",\n")
+	s = append(s, "NextPulseNumber: "+fmt.Sprintf("%#v", this.NextPulseNumber)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "JetDropAmount: "+fmt.Sprintf("%#v", this.JetDropAmount)+",\n")
+	s = append(s, "PulseRecordAmount: "+fmt.Sprintf("%#v", this.PulseRecordAmount)+",\n")
+	s = append(s, "JetDropIDs: "+fmt.Sprintf("%#v", this.JetDropIDs)+",\n")
+	if this.Prototypes != nil {
+		s = append(s, "Prototypes: "+fmt.Sprintf("%#v", this.Prototypes)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *GetNextPulseRequest) GoString() string {
+func (this *PrototypeRecordAmount) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 6)
+	s = append(s, "&exporter.PrototypeRecordAmount{")
+	s = append(s, "PrototypeReference: "+fmt.Sprintf("%#v", this.PrototypeReference)+",\n")
+	s = append(s, "RecordAmount: "+fmt.Sprintf("%#v", this.RecordAmount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetNextPulseRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
 	s = append(s, "&exporter.GetNextPulseRequest{")
 	s = append(s, "PulseNumberFrom: "+fmt.Sprintf("%#v", this.PulseNumberFrom)+",\n")
 	s = append(s, "Prototypes: "+fmt.Sprintf("%#v", this.Prototypes)+",\n")
+	s = append(s, "ObjectReferences: "+fmt.Sprintf("%#v", this.ObjectReferences)+",\n")
+	s = append(s, "RecordTypes: "+fmt.Sprintf("%#v", this.RecordTypes)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -389,6 +606,49 @@ func (m *GetNextPulseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Prototypes) > 0 {
+		for iNdEx := len(m.Prototypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prototypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPulseExporter(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.JetDropIDs) > 0 {
+		for iNdEx := len(m.JetDropIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JetDropIDs[iNdEx])
+			copy(dAtA[i:], m.JetDropIDs[iNdEx])
+			i = encodeVarintPulseExporter(dAtA, i, uint64(len(m.JetDropIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.PulseRecordAmount != 0 {
+		i = encodeVarintPulseExporter(dAtA, i, uint64(m.PulseRecordAmount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.JetDropAmount != 0 {
+		i = encodeVarintPulseExporter(dAtA, i, uint64(m.JetDropAmount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintPulseExporter(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NextPulseNumber != 0 {
+		i = encodeVarintPulseExporter(dAtA, i, uint64(m.NextPulseNumber))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.RecordAmount != 0 {
 		i = encodeVarintPulseExporter(dAtA, i, uint64(m.RecordAmount))
 		i--
@@ -407,6 +667,41 @@ func (m *GetNextPulseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PrototypeRecordAmount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrototypeRecordAmount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrototypeRecordAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordAmount != 0 {
+		i = encodeVarintPulseExporter(dAtA, i, uint64(m.RecordAmount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PrototypeReference) > 0 {
+		i -= len(m.PrototypeReference)
+		copy(dAtA[i:], m.PrototypeReference)
+		i = encodeVarintPulseExporter(dAtA, i, uint64(len(m.PrototypeReference)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GetNextPulseRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -427,6 +722,24 @@ func (m *GetNextPulseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RecordTypes) > 0 {
+		for iNdEx := len(m.RecordTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RecordTypes[iNdEx])
+			copy(dAtA[i:], m.RecordTypes[iNdEx])
+			i = encodeVarintPulseExporter(dAtA, i, uint64(len(m.RecordTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ObjectReferences) > 0 {
+		for iNdEx := len(m.ObjectReferences) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ObjectReferences[iNdEx])
+			copy(dAtA[i:], m.ObjectReferences[iNdEx])
+			i = encodeVarintPulseExporter(dAtA, i, uint64(len(m.ObjectReferences[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Prototypes) > 0 {
 		for iNdEx := len(m.Prototypes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Prototypes[iNdEx])
@@ -470,6 +783,46 @@ func (m *GetNextPulseResponse) Size() (n int) {
 	if m.RecordAmount != 0 {
 		n += 1 + sovPulseExporter(uint64(m.RecordAmount))
 	}
+	if m.NextPulseNumber != 0 {
+		n += 1 + sovPulseExporter(uint64(m.NextPulseNumber))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovPulseExporter(uint64(m.Timestamp))
+	}
+	if m.JetDropAmount != 0 {
+		n += 1 + sovPulseExporter(uint64(m.JetDropAmount))
+	}
+	if m.PulseRecordAmount != 0 {
+		n += 1 + sovPulseExporter(uint64(m.PulseRecordAmount))
+	}
+	if len(m.JetDropIDs) > 0 {
+		for _, s := range m.JetDropIDs {
+			l = len(s)
+			n += 1 + l + sovPulseExporter(uint64(l))
+		}
+	}
+	if len(m.Prototypes) > 0 {
+		for _, e := range m.Prototypes {
+			l = e.Size()
+			n += 1 + l + sovPulseExporter(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PrototypeRecordAmount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrototypeReference)
+	if l > 0 {
+		n += 1 + l + sovPulseExporter(uint64(l))
+	}
+	if m.RecordAmount != 0 {
+		n += 1 + sovPulseExporter(uint64(m.RecordAmount))
+	}
 	return n
 }
 
@@ -488,6 +841,18 @@ func (m *GetNextPulseRequest) Size() (n int) {
 			n += 1 + l + sovPulseExporter(uint64(l))
 		}
 	}
+	if len(m.ObjectReferences) > 0 {
+		for _, b := range m.ObjectReferences {
+			l = len(b)
+			n += 1 + l + sovPulseExporter(uint64(l))
+		}
+	}
+	if len(m.RecordTypes) > 0 {
+		for _, s := range m.RecordTypes {
+			l = len(s)
+			n += 1 + l + sovPulseExporter(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -501,10 +866,32 @@ func (this *GetNextPulseResponse) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForPrototypes := "[]*PrototypeRecordAmount{"
+	for _, f := range this.Prototypes {
+		repeatedStringForPrototypes += strings.Replace(f.String(), "PrototypeRecordAmount", "PrototypeRecordAmount", 1) + ","
+	}
+	repeatedStringForPrototypes += "}"
 	s := strings.Join([]string{`&GetNextPulseResponse{`,
 		`PulseNumber:` + fmt.Sprintf("%v", this.PulseNumber) + `,`,
 		`PrevPulseNumber:` + fmt.Sprintf("%v", this.PrevPulseNumber) + `,`,
 		`RecordAmount:` + fmt.Sprintf("%v", this.RecordAmount) + `,`,
+		`NextPulseNumber:` + fmt.Sprintf("%v", this.NextPulseNumber) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`JetDropAmount:` + fmt.Sprintf("%v", this.JetDropAmount) + `,`,
+		`PulseRecordAmount:` + fmt.Sprintf("%v", this.PulseRecordAmount) + `,`,
+		`JetDropIDs:` + fmt.Sprintf("%v", this.JetDropIDs) + `,`,
+		`Prototypes:` + repeatedStringForPrototypes + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PrototypeRecordAmount) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PrototypeRecordAmount{`,
+		`PrototypeReference:` + fmt.Sprintf("%v", this.PrototypeReference) + `,`,
+		`RecordAmount:` + fmt.Sprintf("%v", this.RecordAmount) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -516,6 +903,8 @@ func (this *GetNextPulseRequest) String() string {
 	s := strings.Join([]string{`&GetNextPulseRequest{`,
 		`PulseNumberFrom:` + fmt.Sprintf("%v", this.PulseNumberFrom) + `,`,
 		`Prototypes:` + fmt.Sprintf("%v", this.Prototypes) + `,`,
+		`ObjectReferences:` + fmt.Sprintf("%v", this.ObjectReferences) + `,`,
+		`RecordTypes:` + fmt.Sprintf("%v", this.RecordTypes) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -614,6 +1003,254 @@ func (m *GetNextPulseResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPulseNumber", wireType)
+			}
+			m.NextPulseNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulseExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPulseNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulseExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JetDropAmount", wireType)
+			}
+			m.JetDropAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulseExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JetDropAmount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PulseRecordAmount", wireType)
+			}
+			m.PulseRecordAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulseExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PulseRecordAmount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JetDropIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulseExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPulseExporter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPulseExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JetDropIDs = append(m.JetDropIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prototypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulseExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPulseExporter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPulseExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prototypes = append(m.Prototypes, &PrototypeRecordAmount{})
+			if err := m.Prototypes[len(m.Prototypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPulseExporter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPulseExporter
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPulseExporter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrototypeRecordAmount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPulseExporter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrototypeRecordAmount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrototypeRecordAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrototypeReference", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulseExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPulseExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPulseExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrototypeReference = append(m.PrototypeReference[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrototypeReference == nil {
+				m.PrototypeReference = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAmount", wireType)
+			}
+			m.RecordAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulseExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordAmount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPulseExporter(dAtA[iNdEx:])
@@ -718,6 +1355,70 @@ func (m *GetNextPulseRequest) Unmarshal(dAtA []byte) error {
 			m.Prototypes = append(m.Prototypes, make([]byte, postIndex-iNdEx))
 			copy(m.Prototypes[len(m.Prototypes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectReferences", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulseExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPulseExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPulseExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectReferences = append(m.ObjectReferences, make([]byte, postIndex-iNdEx))
+			copy(m.ObjectReferences[len(m.ObjectReferences)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulseExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPulseExporter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPulseExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordTypes = append(m.RecordTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPulseExporter(dAtA[iNdEx:])
@@ -1,51 +1,136 @@
 package exporter
 
 import (
-	"time"
+	"bytes"
+	"sort"
 
 	"github.com/insolar/assured-ledger/ledger-core/v2/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/insolar/block-explorer/configuration"
 	"github.com/insolar/block-explorer/etl/interfaces"
+	"github.com/insolar/block-explorer/etl/models"
 )
 
 type PulseServer struct {
-	repository  interfaces.Storage
-	pulsePeriod time.Duration
-	logger      *log.Logger
+	repository interfaces.Storage
+	notifier   *PulseNotifier
+	retry      configuration.Retry
+	logger     *log.Logger
 }
 
-func NewPulseServer(repo interfaces.Storage, pulsePeriod time.Duration, logger *log.Logger) *PulseServer {
-	return &PulseServer{repo, pulsePeriod, logger}
+func NewPulseServer(repo interfaces.Storage, notifier *PulseNotifier, retry configuration.Retry, logger *log.Logger) *PulseServer {
+	return &PulseServer{repo, notifier, retry, logger}
 }
 
+// GetNextPulse streams complete pulses one by one until the client cancels the stream or the server stops.
+// Only pulses containing records matched by the request filters are sent.
+// Storage errors are retried with exponential backoff, the stream fails with Unavailable code when retries are exhausted.
 func (s *PulseServer) GetNextPulse(req *GetNextPulseRequest, stream PulseExporter_GetNextPulseServer) error {
+	if err := validateRecordTypes(req.GetRecordTypes()); err != nil {
+		return err
+	}
+
+	ActiveStreams.WithLabelValues(MethodGetNextPulse).Inc()
+	defer ActiveStreams.WithLabelValues(MethodGetNextPulse).Dec()
+
+	ctx := stream.Context()
 	currentPN := req.GetPulseNumberFrom()
-	protos := req.GetPrototypes()
+	retry := newBackoff(s.retry)
 
 	for {
-		receivedPulse, err := s.repository.GetNextCompletePulseFilterByPrototypeReference(currentPN, protos)
-		// try again while error occurred
+		// subscribe before querying db, so a pulse completed in between isn't missed
+		notification := s.notifier.Subscribe()
+		response, err := s.getNextPulse(req, currentPN)
 		if err != nil {
+			Errors.WithLabelValues(MethodGetNextPulse, ErrorTypeStorage).Inc()
+			if s.logger != nil {
+				s.logger.Error(err)
+			}
+			if retry.Exhausted() {
+				return status.Errorf(codes.Unavailable, "failed to get next pulse after %d: %s", currentPN, err)
+			}
+			if err := wait(ctx, retry.Next()); err != nil {
+				return err
+			}
 			continue
 		}
+		retry.Reset()
 
-		// if we have received current pulse_number we need to wait a bit
-		if currentPN >= receivedPulse.PulseNumber {
-			time.Sleep(s.pulsePeriod)
+		// if there is no pulse after the current one we need to wait for the next one
+		if response == nil {
+			if err := waitNotification(ctx, notification); err != nil {
+				return err
+			}
 			continue
 		}
 
-		err = stream.Send(&GetNextPulseResponse{
-			PulseNumber:     receivedPulse.PulseNumber,
-			PrevPulseNumber: receivedPulse.PrevPulseNumber,
-			RecordAmount:    receivedPulse.RecordAmount,
-		})
+		err = stream.Send(response)
 		if err != nil {
+			Errors.WithLabelValues(MethodGetNextPulse, ErrorTypeSend).Inc()
 			if s.logger != nil {
 				s.logger.Error(err)
 			}
 			return err
 		}
+		SentPulses.Inc()
+
+		currentPN = response.PulseNumber
+	}
+}
 
-		currentPN = receivedPulse.PulseNumber
+// getNextPulse returns the response for the next sequential pulse with records matched by the request filters.
+// It returns nil if there is no such pulse after currentPN yet.
+func (s *PulseServer) getNextPulse(req *GetNextPulseRequest, currentPN int64) (*GetNextPulseResponse, error) {
+	receivedPulse, err := s.repository.GetNextSequentialPulseFilterByRecords(
+		currentPN, req.GetPrototypes(), req.GetObjectReferences(), req.GetRecordTypes(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if receivedPulse.PulseNumber == 0 {
+		return nil, nil
+	}
+
+	amounts, err := s.repository.GetRecordAmountsByPulse(
+		receivedPulse.PulseNumber, req.GetPrototypes(), req.GetObjectReferences(), req.GetRecordTypes(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return PulseToResponse(receivedPulse, amounts), nil
+}
+
+// PulseToResponse converts pulse and amounts of its matched records from db to the exporter response
+func PulseToResponse(pulse models.Pulse, amounts []models.JetDropRecordAmount) *GetNextPulseResponse {
+	response := &GetNextPulseResponse{
+		PulseNumber:       pulse.PulseNumber,
+		PrevPulseNumber:   pulse.PrevPulseNumber,
+		NextPulseNumber:   pulse.NextPulseNumber,
+		Timestamp:         pulse.Timestamp,
+		JetDropAmount:     pulse.JetDropAmount,
+		PulseRecordAmount: pulse.RecordAmount,
+	}
+
+	jetDrops := make(map[string]struct{})
+	for _, amount := range amounts {
+		response.RecordAmount += amount.RecordAmount
+
+		jetDropID := models.NewJetDropID(amount.JetID, pulse.PulseNumber).ToString()
+		if _, ok := jetDrops[jetDropID]; !ok {
+			jetDrops[jetDropID] = struct{}{}
+			response.JetDropIDs = append(response.JetDropIDs, jetDropID)
+		}
+
+		// amounts are ordered by prototype reference, so the same prototypes go one by one
+		last := len(response.Prototypes) - 1
+		if last < 0 || !bytes.Equal(response.Prototypes[last].PrototypeReference, amount.PrototypeReference) {
+			response.Prototypes = append(response.Prototypes, &PrototypeRecordAmount{PrototypeReference: amount.PrototypeReference})
+			last++
+		}
+		response.Prototypes[last].RecordAmount += amount.RecordAmount
 	}
+	sort.Strings(response.JetDropIDs)
+	return response
 }
@@ -9,14 +9,18 @@ import (
 	"testing"
 	"time"
 
+	"github.com/insolar/block-explorer/configuration"
 	"github.com/insolar/block-explorer/etl/interfaces/mock"
 	"github.com/insolar/block-explorer/etl/models"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 type pulseExporterTestServer struct {
 	sender func(*GetNextPulseResponse) error
+	ctx    context.Context
 }
 
 func (p *pulseExporterTestServer) Send(response *GetNextPulseResponse) error {
@@ -36,6 +40,9 @@ func (p *pulseExporterTestServer) SetTrailer(md metadata.MD) {
 }
 
 func (p *pulseExporterTestServer) Context() context.Context {
+	if p.ctx != nil {
+		return p.ctx
+	}
 	return context.TODO()
 }
 
@@ -49,8 +56,9 @@ func (p *pulseExporterTestServer) RecvMsg(m interface{}) error {
 
 func TestExporter_Pulse_Export_Fail(t *testing.T) {
 	sm := mock.NewStorageMock(t)
-	sm.GetNextCompletePulseFilterByPrototypeReferenceMock.Return(models.Pulse{PulseNumber: 1}, nil)
-	pulseServer := NewPulseServer(sm, time.Nanosecond, nil)
+	sm.GetNextSequentialPulseFilterByRecordsMock.Return(models.Pulse{PulseNumber: 1}, nil)
+	sm.GetRecordAmountsByPulseMock.Return(nil, nil)
+	pulseServer := NewPulseServer(sm, startNotifier(t, sm), configuration.Retry{}, nil)
 
 	EOF := io.EOF
 	iterations := 0
@@ -59,7 +67,7 @@ func TestExporter_Pulse_Export_Fail(t *testing.T) {
 		// exit point from sender
 		return EOF
 	}
-	err := pulseServer.GetNextPulse(&GetNextPulseRequest{0, nil}, &pulseExporterTestServer{sender})
+	err := pulseServer.GetNextPulse(&GetNextPulseRequest{}, &pulseExporterTestServer{sender: sender})
 
 	require.Equal(t, 1, iterations, "iterations must be called one times because of error")
 	require.Equal(t, err, EOF)
@@ -68,11 +76,12 @@ func TestExporter_Pulse_Export_Fail(t *testing.T) {
 func TestExporter_Pulse_Export_Success(t *testing.T) {
 	sm := mock.NewStorageMock(t)
 	pulseForSend := models.Pulse{PulseNumber: 1}
-	sm.GetNextCompletePulseFilterByPrototypeReferenceMock.
-		Set(func(prevPulse int64, prototypes [][]byte) (models.Pulse, error) {
+	sm.GetNextSequentialPulseFilterByRecordsMock.
+		Set(func(fromPulseNumber int64, prototypes, objectReferences [][]byte, recordTypes []string) (models.Pulse, error) {
 			return pulseForSend, nil
 		})
-	pulseServer := NewPulseServer(sm, time.Nanosecond, nil)
+	sm.GetRecordAmountsByPulseMock.Return(nil, nil)
+	pulseServer := NewPulseServer(sm, startNotifier(t, sm), configuration.Retry{}, nil)
 
 	EOF := io.EOF
 	iterations := 0
@@ -86,10 +95,10 @@ func TestExporter_Pulse_Export_Success(t *testing.T) {
 		}
 		return nil
 	}
-	err := pulseServer.GetNextPulse(&GetNextPulseRequest{0, nil}, &pulseExporterTestServer{sender})
+	err := pulseServer.GetNextPulse(&GetNextPulseRequest{}, &pulseExporterTestServer{sender: sender})
 
 	require.Equal(t, totalIterations, iterations, "sender must have been called defined times")
-	require.Equal(t, totalIterations, int(sm.GetNextCompletePulseFilterByPrototypeReferenceBeforeCounter()),
+	require.Equal(t, totalIterations, int(sm.GetNextSequentialPulseFilterByRecordsBeforeCounter()),
 		"storage mustn't be called more than totalIterations")
 	require.Equal(t, err, EOF, "error should be io.EOF")
 }
@@ -98,21 +107,22 @@ func TestExporter_Pulse_Export_All_Situations(t *testing.T) {
 	sm := mock.NewStorageMock(t)
 	pulseForSend := models.Pulse{PulseNumber: 0}
 	iterations := 0
-	sm.GetNextCompletePulseFilterByPrototypeReferenceMock.
-		Set(func(prevPulse int64, prototypes [][]byte) (models.Pulse, error) {
+	sm.GetNextSequentialPulseFilterByRecordsMock.
+		Set(func(fromPulseNumber int64, prototypes, objectReferences [][]byte, recordTypes []string) (models.Pulse, error) {
 			// if it's the first calling time
-			if sm.GetNextCompletePulseFilterByPrototypeReferenceBeforeCounter() == 1 {
+			if sm.GetNextSequentialPulseFilterByRecordsBeforeCounter() == 1 {
 				// simulate the error from storage
 				return models.Pulse{}, errors.New("some error")
 			}
 
-			if sm.GetNextCompletePulseFilterByPrototypeReferenceBeforeCounter() == 2 {
+			if sm.GetNextSequentialPulseFilterByRecordsBeforeCounter() == 2 {
 				return pulseForSend, nil
 			}
 			pulseForSend.PulseNumber += 1
 			return pulseForSend, nil
 		})
-	pulseServer := NewPulseServer(sm, time.Nanosecond, nil)
+	sm.GetRecordAmountsByPulseMock.Return(nil, nil)
+	pulseServer := NewPulseServer(sm, startNotifier(t, sm), configuration.Retry{}, nil)
 
 	EOF := io.EOF
 
@@ -125,12 +135,127 @@ func TestExporter_Pulse_Export_All_Situations(t *testing.T) {
 		}
 		return nil
 	}
-	err := pulseServer.GetNextPulse(&GetNextPulseRequest{0, nil}, &pulseExporterTestServer{sender})
+	err := pulseServer.GetNextPulse(&GetNextPulseRequest{}, &pulseExporterTestServer{sender: sender})
 
 	require.Equal(t, totalIterations, iterations, "sender must have been called defined times")
-	// we are calling GetNextCompletePulseFilterByPrototypeReference 2 times more
-	// because of if statements
-	require.Equal(t, totalIterations+2, int(sm.GetNextCompletePulseFilterByPrototypeReferenceBeforeCounter()),
+	// we are calling GetNextSequentialPulseFilterByRecords 2 times more
+	// because of the storage error and the absence of the next pulse
+	require.Equal(t, totalIterations+2, int(sm.GetNextSequentialPulseFilterByRecordsBeforeCounter()),
 		"storage must be called at 2 times more than totalIterations")
 	require.Equal(t, err, EOF, "error should be io.EOF")
 }
+
+func TestExporter_Pulse_Export_ClientCanceled(t *testing.T) {
+	sm := mock.NewStorageMock(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	sm.GetNextSequentialPulseFilterByRecordsMock.
+		Set(func(fromPulseNumber int64, prototypes, objectReferences [][]byte, recordTypes []string) (models.Pulse, error) {
+			if sm.GetNextSequentialPulseFilterByRecordsBeforeCounter() == 3 {
+				cancel()
+			}
+			// there are no new pulses
+			return models.Pulse{}, nil
+		})
+	pulseServer := NewPulseServer(sm, startNotifier(t, sm), configuration.Retry{}, nil)
+
+	sender := func(*GetNextPulseResponse) error {
+		t.Fatal("sender mustn't be called")
+		return nil
+	}
+	err := pulseServer.GetNextPulse(&GetNextPulseRequest{PulseNumberFrom: 10}, &pulseExporterTestServer{sender: sender, ctx: ctx})
+
+	require.Equal(t, codes.Canceled, status.Code(err))
+	require.Equal(t, 3, int(sm.GetNextSequentialPulseFilterByRecordsBeforeCounter()))
+}
+
+func TestExporter_Pulse_Export_StorageErrors(t *testing.T) {
+	sm := mock.NewStorageMock(t)
+	sm.GetNextSequentialPulseFilterByRecordsMock.Return(models.Pulse{}, errors.New("some error"))
+	retry := configuration.Retry{MinInterval: time.Millisecond, MaxInterval: 2 * time.Millisecond, MaxAttempts: 3}
+	pulseServer := NewPulseServer(sm, startNotifier(t, sm), retry, nil)
+
+	sender := func(*GetNextPulseResponse) error {
+		t.Fatal("sender mustn't be called")
+		return nil
+	}
+	err := pulseServer.GetNextPulse(&GetNextPulseRequest{PulseNumberFrom: 10}, &pulseExporterTestServer{sender: sender})
+
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.Equal(t, retry.MaxAttempts+1, int(sm.GetNextSequentialPulseFilterByRecordsBeforeCounter()),
+		"storage must be called once and then retried MaxAttempts times")
+}
+
+func TestExporter_Pulse_Export_Response(t *testing.T) {
+	sm := mock.NewStorageMock(t)
+	req := &GetNextPulseRequest{
+		PulseNumberFrom:  10,
+		Prototypes:       [][]byte{{1}, {2}},
+		ObjectReferences: [][]byte{{3}},
+		RecordTypes:      []string{string(models.State)},
+	}
+	sm.GetNextSequentialPulseFilterByRecordsMock.
+		Expect(10, req.Prototypes, req.ObjectReferences, req.RecordTypes).
+		Return(models.Pulse{
+			PulseNumber:     20,
+			PrevPulseNumber: 10,
+			NextPulseNumber: 30,
+			Timestamp:       1000,
+			JetDropAmount:   3,
+			RecordAmount:    9,
+		}, nil)
+	sm.GetRecordAmountsByPulseMock.
+		Expect(20, req.Prototypes, req.ObjectReferences, req.RecordTypes).
+		Return([]models.JetDropRecordAmount{
+			{JetID: "1", PrototypeReference: []byte{1}, RecordAmount: 1},
+			{JetID: "", PrototypeReference: []byte{2}, RecordAmount: 2},
+			{JetID: "1", PrototypeReference: []byte{2}, RecordAmount: 1},
+		}, nil)
+	pulseServer := NewPulseServer(sm, startNotifier(t, sm), configuration.Retry{}, nil)
+
+	var received *GetNextPulseResponse
+	sender := func(resp *GetNextPulseResponse) error {
+		received = resp
+		return io.EOF
+	}
+	err := pulseServer.GetNextPulse(req, &pulseExporterTestServer{sender: sender})
+
+	require.Equal(t, io.EOF, err)
+	require.Equal(t, &GetNextPulseResponse{
+		PulseNumber:       20,
+		PrevPulseNumber:   10,
+		RecordAmount:      4,
+		NextPulseNumber:   30,
+		Timestamp:         1000,
+		JetDropAmount:     3,
+		PulseRecordAmount: 9,
+		JetDropIDs:        []string{"*:20", "1:20"},
+		Prototypes: []*PrototypeRecordAmount{
+			{PrototypeReference: []byte{1}, RecordAmount: 1},
+			{PrototypeReference: []byte{2}, RecordAmount: 3},
+		},
+	}, received)
+}
+
+func TestExporter_Pulse_Export_InvalidRequest(t *testing.T) {
+	pulseServer := NewPulseServer(mock.NewStorageMock(t), nil, configuration.Retry{}, nil)
+
+	err := pulseServer.GetNextPulse(&GetNextPulseRequest{PulseNumberFrom: 10, RecordTypes: []string{"unknown"}}, &pulseExporterTestServer{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestExporter_Pulse_Export_RecordAmountsError(t *testing.T) {
+	sm := mock.NewStorageMock(t)
+	sm.GetNextSequentialPulseFilterByRecordsMock.Return(models.Pulse{PulseNumber: 20}, nil)
+	sm.GetRecordAmountsByPulseMock.Return(nil, errors.New("some error"))
+	retry := configuration.Retry{MinInterval: time.Millisecond, MaxInterval: 2 * time.Millisecond, MaxAttempts: 2}
+	pulseServer := NewPulseServer(sm, startNotifier(t, sm), retry, nil)
+
+	sender := func(*GetNextPulseResponse) error {
+		t.Fatal("sender mustn't be called")
+		return nil
+	}
+	err := pulseServer.GetNextPulse(&GetNextPulseRequest{PulseNumberFrom: 10}, &pulseExporterTestServer{sender: sender})
+
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.Equal(t, retry.MaxAttempts+1, int(sm.GetRecordAmountsByPulseBeforeCounter()))
+}
@@ -0,0 +1,109 @@
+package exporter
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/insolar/assured-ledger/ledger-core/v2/log"
+
+	"github.com/insolar/block-explorer/etl/interfaces"
+)
+
+// PulseNotifier polls db for the last sequential pulse and notifies all subscribed streams when it changes.
+// The amount of db queries doesn't depend on the amount of streams.
+type PulseNotifier struct {
+	repository interfaces.StorageFetcher
+	period     time.Duration
+	logger     *log.Logger
+
+	mu        sync.Mutex
+	notify    chan struct{}
+	lastPulse int64
+
+	cancel         context.CancelFunc
+	done           chan struct{}
+	startStopMutex sync.Mutex
+}
+
+func NewPulseNotifier(repo interfaces.StorageFetcher, period time.Duration, logger *log.Logger) *PulseNotifier {
+	return &PulseNotifier{
+		repository: repo,
+		period:     period,
+		logger:     logger,
+		notify:     make(chan struct{}),
+	}
+}
+
+// Start starts polling db in a background goroutine
+func (n *PulseNotifier) Start(ctx context.Context) error {
+	n.startStopMutex.Lock()
+	defer n.startStopMutex.Unlock()
+	if n.cancel != nil {
+		return nil
+	}
+
+	ctx, n.cancel = context.WithCancel(ctx)
+	n.done = make(chan struct{})
+	go n.run(ctx)
+	return nil
+}
+
+// Stop stops polling and waits for the background goroutine to exit
+func (n *PulseNotifier) Stop(ctx context.Context) error {
+	n.startStopMutex.Lock()
+	defer n.startStopMutex.Unlock()
+	if n.cancel == nil {
+		return nil
+	}
+
+	n.cancel()
+	<-n.done
+	n.cancel = nil
+	return nil
+}
+
+// Subscribe returns a channel which is closed when a new sequential pulse appears in db.
+// Subscribe has to be called before checking db for new data, so that no notification is missed.
+func (n *PulseNotifier) Subscribe() <-chan struct{} {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.notify
+}
+
+func (n *PulseNotifier) run(ctx context.Context) {
+	defer close(n.done)
+
+	ticker := time.NewTicker(n.period)
+	defer ticker.Stop()
+
+	for {
+		n.check()
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (n *PulseNotifier) check() {
+	pulse, err := n.repository.GetSequentialPulse()
+	if err != nil {
+		Errors.WithLabelValues(MethodNotifier, ErrorTypeStorage).Inc()
+		if n.logger != nil {
+			n.logger.Error(err)
+		}
+		return
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if pulse.PulseNumber <= n.lastPulse {
+		return
+	}
+	n.lastPulse = pulse.PulseNumber
+	close(n.notify)
+	n.notify = make(chan struct{})
+	PulseNotifications.Inc()
+}
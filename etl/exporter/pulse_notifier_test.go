@@ -0,0 +1,88 @@
+// +build unit
+
+package exporter
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/block-explorer/etl/interfaces/mock"
+	"github.com/insolar/block-explorer/etl/models"
+)
+
+// startNotifier starts the notifier which finds a new sequential pulse on every check
+func startNotifier(t *testing.T, sm *mock.StorageMock) *PulseNotifier {
+	var pn int64
+	sm.GetSequentialPulseMock.Set(func() (models.Pulse, error) {
+		return models.Pulse{PulseNumber: atomic.AddInt64(&pn, 1)}, nil
+	})
+	notifier := NewPulseNotifier(sm, time.Millisecond, nil)
+	require.NoError(t, notifier.Start(context.Background()))
+	t.Cleanup(func() {
+		require.NoError(t, notifier.Stop(context.Background()))
+	})
+	return notifier
+}
+
+func TestPulseNotifier_NotifiesAllSubscribers(t *testing.T) {
+	sm := mock.NewStorageMock(t)
+	pulses := make(chan models.Pulse, 1)
+	sm.GetSequentialPulseMock.Set(func() (models.Pulse, error) {
+		return <-pulses, nil
+	})
+	notifier := NewPulseNotifier(sm, time.Millisecond, nil)
+
+	subscriptions := []<-chan struct{}{notifier.Subscribe(), notifier.Subscribe(), notifier.Subscribe()}
+	pulses <- models.Pulse{PulseNumber: 10}
+	require.NoError(t, notifier.Start(context.Background()))
+	defer notifier.Stop(context.Background())
+
+	for _, s := range subscriptions {
+		select {
+		case <-s:
+		case <-time.After(time.Second):
+			t.Fatal("subscriber wasn't notified")
+		}
+	}
+
+	// the same pulse doesn't lead to a notification
+	s := notifier.Subscribe()
+	pulses <- models.Pulse{PulseNumber: 10}
+	pulses <- models.Pulse{PulseNumber: 10}
+	select {
+	case <-s:
+		t.Fatal("subscriber mustn't be notified without a new pulse")
+	default:
+	}
+
+	pulses <- models.Pulse{PulseNumber: 11}
+	select {
+	case <-s:
+	case <-time.After(time.Second):
+		t.Fatal("subscriber wasn't notified")
+	}
+	close(pulses)
+}
+
+func TestPulseNotifier_StorageError(t *testing.T) {
+	sm := mock.NewStorageMock(t)
+	sm.GetSequentialPulseMock.Return(models.Pulse{}, errors.New("some error"))
+	notifier := NewPulseNotifier(sm, time.Millisecond, nil)
+
+	s := notifier.Subscribe()
+	require.NoError(t, notifier.Start(context.Background()))
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, notifier.Stop(context.Background()))
+
+	select {
+	case <-s:
+		t.Fatal("subscriber mustn't be notified on error")
+	default:
+	}
+	require.NotZero(t, sm.GetSequentialPulseAfterCounter())
+}
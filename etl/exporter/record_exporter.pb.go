@@ -4,9 +4,9 @@
 package exporter
 
 import (
+	bytes "bytes"
 	context "context"
 	fmt "fmt"
-	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -29,14 +29,78 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type RecordCursor struct {
+	PulseNumber int64  `protobuf:"varint,1,opt,name=PulseNumber,proto3" json:"PulseNumber,omitempty"`
+	JetID       string `protobuf:"bytes,2,opt,name=JetID,proto3" json:"JetID,omitempty"`
+	Order       int64  `protobuf:"varint,3,opt,name=Order,proto3" json:"Order,omitempty"`
+}
+
+func (m *RecordCursor) Reset()      { *m = RecordCursor{} }
+func (*RecordCursor) ProtoMessage() {}
+func (*RecordCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90e5b7e8e6ba6921, []int{0}
+}
+func (m *RecordCursor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordCursor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordCursor.Merge(m, src)
+}
+func (m *RecordCursor) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordCursor proto.InternalMessageInfo
+
+func (m *RecordCursor) GetPulseNumber() int64 {
+	if m != nil {
+		return m.PulseNumber
+	}
+	return 0
+}
+
+func (m *RecordCursor) GetJetID() string {
+	if m != nil {
+		return m.JetID
+	}
+	return ""
+}
+
+func (m *RecordCursor) GetOrder() int64 {
+	if m != nil {
+		return m.Order
+	}
+	return 0
+}
+
 type GetRecordsRequest struct {
-	Polymorph uint32 `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	PulseNumberFrom  int64         `protobuf:"varint,1,opt,name=PulseNumberFrom,proto3" json:"PulseNumberFrom,omitempty"`
+	PulseNumberTo    int64         `protobuf:"varint,2,opt,name=PulseNumberTo,proto3" json:"PulseNumberTo,omitempty"`
+	Prototypes       [][]byte      `protobuf:"bytes,3,rep,name=Prototypes,proto3" json:"Prototypes,omitempty"`
+	ObjectReferences [][]byte      `protobuf:"bytes,4,rep,name=ObjectReferences,proto3" json:"ObjectReferences,omitempty"`
+	RecordTypes      []string      `protobuf:"bytes,5,rep,name=RecordTypes,proto3" json:"RecordTypes,omitempty"`
+	Cursor           *RecordCursor `protobuf:"bytes,6,opt,name=Cursor,proto3" json:"Cursor,omitempty"`
 }
 
 func (m *GetRecordsRequest) Reset()      { *m = GetRecordsRequest{} }
 func (*GetRecordsRequest) ProtoMessage() {}
 func (*GetRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90e5b7e8e6ba6921, []int{0}
+	return fileDescriptor_90e5b7e8e6ba6921, []int{1}
 }
 func (m *GetRecordsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -65,21 +129,67 @@ func (m *GetRecordsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetRecordsRequest proto.InternalMessageInfo
 
-func (m *GetRecordsRequest) GetPolymorph() uint32 {
+func (m *GetRecordsRequest) GetPulseNumberFrom() int64 {
+	if m != nil {
+		return m.PulseNumberFrom
+	}
+	return 0
+}
+
+func (m *GetRecordsRequest) GetPulseNumberTo() int64 {
 	if m != nil {
-		return m.Polymorph
+		return m.PulseNumberTo
 	}
 	return 0
 }
 
+func (m *GetRecordsRequest) GetPrototypes() [][]byte {
+	if m != nil {
+		return m.Prototypes
+	}
+	return nil
+}
+
+func (m *GetRecordsRequest) GetObjectReferences() [][]byte {
+	if m != nil {
+		return m.ObjectReferences
+	}
+	return nil
+}
+
+func (m *GetRecordsRequest) GetRecordTypes() []string {
+	if m != nil {
+		return m.RecordTypes
+	}
+	return nil
+}
+
+func (m *GetRecordsRequest) GetCursor() *RecordCursor {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
 type GetRecordsResponse struct {
-	Polymorph uint32 `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	Reference           []byte `protobuf:"bytes,1,opt,name=Reference,proto3" json:"Reference,omitempty"`
+	Type                string `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	ObjectReference     []byte `protobuf:"bytes,3,opt,name=ObjectReference,proto3" json:"ObjectReference,omitempty"`
+	PrototypeReference  []byte `protobuf:"bytes,4,opt,name=PrototypeReference,proto3" json:"PrototypeReference,omitempty"`
+	Payload             []byte `protobuf:"bytes,5,opt,name=Payload,proto3" json:"Payload,omitempty"`
+	PrevRecordReference []byte `protobuf:"bytes,6,opt,name=PrevRecordReference,proto3" json:"PrevRecordReference,omitempty"`
+	Hash                []byte `protobuf:"bytes,7,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	RawData             []byte `protobuf:"bytes,8,opt,name=RawData,proto3" json:"RawData,omitempty"`
+	JetID               string `protobuf:"bytes,9,opt,name=JetID,proto3" json:"JetID,omitempty"`
+	PulseNumber         int64  `protobuf:"varint,10,opt,name=PulseNumber,proto3" json:"PulseNumber,omitempty"`
+	Order               int64  `protobuf:"varint,11,opt,name=Order,proto3" json:"Order,omitempty"`
+	Timestamp           int64  `protobuf:"varint,12,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
 }
 
 func (m *GetRecordsResponse) Reset()      { *m = GetRecordsResponse{} }
 func (*GetRecordsResponse) ProtoMessage() {}
 func (*GetRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90e5b7e8e6ba6921, []int{1}
+	return fileDescriptor_90e5b7e8e6ba6921, []int{2}
 }
 func (m *GetRecordsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -108,14 +218,92 @@ func (m *GetRecordsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetRecordsResponse proto.InternalMessageInfo
 
-func (m *GetRecordsResponse) GetPolymorph() uint32 {
+func (m *GetRecordsResponse) GetReference() []byte {
 	if m != nil {
-		return m.Polymorph
+		return m.Reference
+	}
+	return nil
+}
+
+func (m *GetRecordsResponse) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *GetRecordsResponse) GetObjectReference() []byte {
+	if m != nil {
+		return m.ObjectReference
+	}
+	return nil
+}
+
+func (m *GetRecordsResponse) GetPrototypeReference() []byte {
+	if m != nil {
+		return m.PrototypeReference
+	}
+	return nil
+}
+
+func (m *GetRecordsResponse) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *GetRecordsResponse) GetPrevRecordReference() []byte {
+	if m != nil {
+		return m.PrevRecordReference
+	}
+	return nil
+}
+
+func (m *GetRecordsResponse) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *GetRecordsResponse) GetRawData() []byte {
+	if m != nil {
+		return m.RawData
+	}
+	return nil
+}
+
+func (m *GetRecordsResponse) GetJetID() string {
+	if m != nil {
+		return m.JetID
+	}
+	return ""
+}
+
+func (m *GetRecordsResponse) GetPulseNumber() int64 {
+	if m != nil {
+		return m.PulseNumber
+	}
+	return 0
+}
+
+func (m *GetRecordsResponse) GetOrder() int64 {
+	if m != nil {
+		return m.Order
+	}
+	return 0
+}
+
+func (m *GetRecordsResponse) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
 	}
 	return 0
 }
 
 func init() {
+	proto.RegisterType((*RecordCursor)(nil), "exporter.RecordCursor")
 	proto.RegisterType((*GetRecordsRequest)(nil), "exporter.GetRecordsRequest")
 	proto.RegisterType((*GetRecordsResponse)(nil), "exporter.GetRecordsResponse")
 }
@@ -125,24 +313,69 @@ func init() {
 }
 
 var fileDescriptor_90e5b7e8e6ba6921 = []byte{
-	// 238 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0x2d, 0xc9, 0xd1,
-	0x4f, 0xad, 0x28, 0xc8, 0x2f, 0x2a, 0x49, 0x2d, 0xd2, 0x2f, 0x4a, 0x4d, 0xce, 0x2f, 0x4a, 0x89,
-	0x87, 0xf1, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x38, 0x60, 0x7c, 0x29, 0xdd, 0xf4, 0xcc,
-	0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xf4, 0xfc, 0xf4, 0x7c, 0x7d, 0xb0, 0x82,
-	0xa4, 0xd2, 0x34, 0x30, 0x0f, 0xcc, 0x01, 0xb3, 0x20, 0x1a, 0x95, 0x0c, 0xb9, 0x04, 0xdd, 0x53,
-	0x4b, 0x82, 0xc0, 0x86, 0x16, 0x07, 0xa5, 0x16, 0x96, 0xa6, 0x16, 0x97, 0x08, 0xc9, 0x70, 0x71,
-	0x06, 0xe4, 0xe7, 0x54, 0xe6, 0xe6, 0x17, 0x15, 0x64, 0x48, 0x08, 0x28, 0x30, 0x6a, 0xf0, 0x06,
-	0x21, 0x04, 0x94, 0x8c, 0xb8, 0x84, 0x90, 0xb5, 0x14, 0x17, 0xe4, 0xe7, 0x15, 0xa7, 0xe2, 0xd7,
-	0x63, 0x14, 0xcb, 0xc5, 0x07, 0xd1, 0xe0, 0x0a, 0x75, 0xa7, 0x90, 0x37, 0x17, 0x17, 0xc2, 0x14,
-	0x21, 0x69, 0x3d, 0xb8, 0x87, 0x30, 0x9c, 0x23, 0x25, 0x83, 0x5d, 0x12, 0x62, 0xb1, 0x12, 0x83,
-	0x01, 0xa3, 0x93, 0xc9, 0x85, 0x87, 0x72, 0x0c, 0x37, 0x1e, 0xca, 0x31, 0x7c, 0x78, 0x28, 0xc7,
-	0xd8, 0xf0, 0x48, 0x8e, 0x71, 0xc5, 0x23, 0x39, 0xc6, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92,
-	0x63, 0x7c, 0xf0, 0x48, 0x8e, 0xf1, 0xc5, 0x23, 0x39, 0x86, 0x0f, 0x8f, 0xe4, 0x18, 0x27, 0x3c,
-	0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x24, 0x36, 0x70, 0x10, 0x18,
-	0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xfe, 0x7e, 0xfb, 0x37, 0x61, 0x01, 0x00, 0x00,
+	// 478 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xf6, 0xd6, 0x49, 0xda, 0x4c, 0xcc, 0xdf, 0x80, 0xd0, 0x0a, 0xaa, 0x95, 0x65, 0x71, 0xb0,
+	0x38, 0xa4, 0x55, 0xe1, 0x09, 0xa0, 0xfc, 0x4b, 0x34, 0x5a, 0xe5, 0x08, 0x42, 0x4e, 0x32, 0x08,
+	0x50, 0x52, 0x9b, 0xdd, 0x0d, 0xd0, 0x1b, 0x5c, 0x38, 0xf3, 0x18, 0x3c, 0x0a, 0xc7, 0x1c, 0x7b,
+	0x24, 0xce, 0x85, 0x63, 0x1f, 0x01, 0x79, 0xd7, 0x89, 0xdd, 0x24, 0xb7, 0x9d, 0x6f, 0x3e, 0xcf,
+	0xcf, 0x37, 0x9f, 0x21, 0x22, 0x33, 0x3e, 0xa0, 0x6f, 0x59, 0xaa, 0x0c, 0xa9, 0x03, 0x45, 0xc3,
+	0x54, 0x8d, 0xde, 0x2d, 0xe3, 0x6e, 0xa6, 0x52, 0x93, 0xe2, 0xde, 0x32, 0x8e, 0xde, 0x40, 0x20,
+	0x2d, 0xe5, 0xf1, 0x54, 0xe9, 0x54, 0x61, 0x08, 0x9d, 0xde, 0x74, 0xac, 0xe9, 0xf5, 0x74, 0x32,
+	0x20, 0xc5, 0x59, 0xc8, 0x62, 0x5f, 0xd6, 0x21, 0xbc, 0x05, 0xcd, 0x97, 0x64, 0x5e, 0x1c, 0xf3,
+	0x9d, 0x90, 0xc5, 0x6d, 0xe9, 0x82, 0x02, 0x3d, 0x51, 0x23, 0x52, 0xdc, 0xb7, 0x5f, 0xb8, 0x20,
+	0xfa, 0xb1, 0x03, 0x37, 0x9e, 0x91, 0x71, 0x1d, 0xb4, 0xa4, 0xcf, 0x53, 0xd2, 0x06, 0x63, 0xb8,
+	0x56, 0x2b, 0xf8, 0x54, 0xa5, 0x93, 0xb2, 0xcf, 0x3a, 0x8c, 0xf7, 0xe0, 0x4a, 0x0d, 0xea, 0xa7,
+	0xb6, 0xa7, 0x2f, 0x2f, 0x83, 0x28, 0x00, 0x7a, 0xc5, 0x5a, 0xe6, 0x2c, 0x23, 0xcd, 0xfd, 0xd0,
+	0x8f, 0x03, 0x59, 0x43, 0xf0, 0x3e, 0x5c, 0x3f, 0x19, 0x7c, 0xa2, 0xa1, 0x91, 0xf4, 0x9e, 0x14,
+	0x9d, 0x0e, 0x49, 0xf3, 0x86, 0x65, 0x6d, 0xe0, 0xc5, 0xfe, 0x6e, 0xda, 0xbe, 0x2d, 0xd6, 0x0c,
+	0xfd, 0xb8, 0x2d, 0xeb, 0x10, 0x76, 0xa1, 0xe5, 0xb4, 0xe2, 0xad, 0x90, 0xc5, 0x9d, 0xa3, 0xdb,
+	0xdd, 0x95, 0xb8, 0x75, 0x25, 0x65, 0xc9, 0x8a, 0x7e, 0xfa, 0x80, 0x75, 0x0d, 0x74, 0x96, 0x9e,
+	0x6a, 0xc2, 0x7d, 0x68, 0xaf, 0xda, 0xda, 0xf5, 0x03, 0x59, 0x01, 0x88, 0xd0, 0x28, 0xba, 0x95,
+	0x1a, 0xdb, 0x77, 0x21, 0xdb, 0xda, 0xb8, 0x56, 0xec, 0x40, 0xae, 0xc3, 0xd8, 0x05, 0x5c, 0xad,
+	0x5f, 0x91, 0x1b, 0x96, 0xbc, 0x25, 0x83, 0x1c, 0x76, 0x7b, 0xc9, 0xd9, 0x38, 0x4d, 0x46, 0xbc,
+	0x69, 0x49, 0xcb, 0x10, 0x0f, 0xe1, 0x66, 0x4f, 0xd1, 0x17, 0x37, 0x7c, 0x55, 0xaa, 0x65, 0x59,
+	0xdb, 0x52, 0xc5, 0xe4, 0xcf, 0x13, 0xfd, 0x81, 0xef, 0x5a, 0x8a, 0x7d, 0x17, 0xf5, 0x65, 0xf2,
+	0xf5, 0x38, 0x31, 0x09, 0xdf, 0x73, 0xf5, 0xcb, 0xb0, 0x32, 0x53, 0xbb, 0x6e, 0xa6, 0x35, 0x13,
+	0xc2, 0x56, 0x13, 0x3a, 0xbb, 0x75, 0x6a, 0x76, 0x2b, 0x34, 0xed, 0x7f, 0x9c, 0x90, 0x36, 0xc9,
+	0x24, 0xe3, 0x81, 0xcd, 0x54, 0xc0, 0xd1, 0x5b, 0xb8, 0xea, 0x86, 0x7d, 0x52, 0xde, 0x0b, 0x5f,
+	0x01, 0x54, 0x97, 0xc1, 0xbb, 0xd5, 0x21, 0x37, 0x3c, 0x7b, 0x67, 0x7f, 0x7b, 0xd2, 0x1d, 0x33,
+	0xf2, 0x0e, 0xd9, 0xa3, 0x87, 0xb3, 0xb9, 0xf0, 0xce, 0xe7, 0xc2, 0xbb, 0x98, 0x0b, 0xf6, 0x3d,
+	0x17, 0xec, 0x77, 0x2e, 0xd8, 0x9f, 0x5c, 0xb0, 0x59, 0x2e, 0xd8, 0xdf, 0x5c, 0xb0, 0x7f, 0xb9,
+	0xf0, 0x2e, 0x72, 0xc1, 0x7e, 0x2d, 0x84, 0x37, 0x5b, 0x08, 0xef, 0x7c, 0x21, 0xbc, 0x41, 0xcb,
+	0xfe, 0x90, 0x0f, 0xfe, 0x0f, 0x00, 0x85, 0x00, 0xe7, 0x61, 0xb6, 0x03, 0x00, 0x00,
 }
 
+func (this *RecordCursor) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RecordCursor)
+	if !ok {
+		that2, ok := that.(RecordCursor)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PulseNumber != that1.PulseNumber {
+		return false
+	}
+	if this.JetID != that1.JetID {
+		return false
+	}
+	if this.Order != that1.Order {
+		return false
+	}
+	return true
+}
 func (this *GetRecordsRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -162,7 +395,37 @@ func (this *GetRecordsRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Polymorph != that1.Polymorph {
+	if this.PulseNumberFrom != that1.PulseNumberFrom {
+		return false
+	}
+	if this.PulseNumberTo != that1.PulseNumberTo {
+		return false
+	}
+	if len(this.Prototypes) != len(that1.Prototypes) {
+		return false
+	}
+	for i := range this.Prototypes {
+		if !bytes.Equal(this.Prototypes[i], that1.Prototypes[i]) {
+			return false
+		}
+	}
+	if len(this.ObjectReferences) != len(that1.ObjectReferences) {
+		return false
+	}
+	for i := range this.ObjectReferences {
+		if !bytes.Equal(this.ObjectReferences[i], that1.ObjectReferences[i]) {
+			return false
+		}
+	}
+	if len(this.RecordTypes) != len(that1.RecordTypes) {
+		return false
+	}
+	for i := range this.RecordTypes {
+		if this.RecordTypes[i] != that1.RecordTypes[i] {
+			return false
+		}
+	}
+	if !this.Cursor.Equal(that1.Cursor) {
 		return false
 	}
 	return true
@@ -186,18 +449,70 @@ func (this *GetRecordsResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Polymorph != that1.Polymorph {
+	if !bytes.Equal(this.Reference, that1.Reference) {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !bytes.Equal(this.ObjectReference, that1.ObjectReference) {
+		return false
+	}
+	if !bytes.Equal(this.PrototypeReference, that1.PrototypeReference) {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	if !bytes.Equal(this.PrevRecordReference, that1.PrevRecordReference) {
+		return false
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
+	}
+	if !bytes.Equal(this.RawData, that1.RawData) {
+		return false
+	}
+	if this.JetID != that1.JetID {
+		return false
+	}
+	if this.PulseNumber != that1.PulseNumber {
+		return false
+	}
+	if this.Order != that1.Order {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
 		return false
 	}
 	return true
 }
+func (this *RecordCursor) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&exporter.RecordCursor{")
+	s = append(s, "PulseNumber: "+fmt.Sprintf("%#v", this.PulseNumber)+",\n")
+	s = append(s, "JetID: "+fmt.Sprintf("%#v", this.JetID)+",\n")
+	s = append(s, "Order: "+fmt.Sprintf("%#v", this.Order)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *GetRecordsRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 10)
 	s = append(s, "&exporter.GetRecordsRequest{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "PulseNumberFrom: "+fmt.Sprintf("%#v", this.PulseNumberFrom)+",\n")
+	s = append(s, "PulseNumberTo: "+fmt.Sprintf("%#v", this.PulseNumberTo)+",\n")
+	s = append(s, "Prototypes: "+fmt.Sprintf("%#v", this.Prototypes)+",\n")
+	s = append(s, "ObjectReferences: "+fmt.Sprintf("%#v", this.ObjectReferences)+",\n")
+	s = append(s, "RecordTypes: "+fmt.Sprintf("%#v", this.RecordTypes)+",\n")
+	if this.Cursor != nil {
+		s = append(s, "Cursor: "+fmt.Sprintf("%#v", this.Cursor)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -205,9 +520,20 @@ func (this *GetRecordsResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 16)
 	s = append(s, "&exporter.GetRecordsResponse{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "Reference: "+fmt.Sprintf("%#v", this.Reference)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "ObjectReference: "+fmt.Sprintf("%#v", this.ObjectReference)+",\n")
+	s = append(s, "PrototypeReference: "+fmt.Sprintf("%#v", this.PrototypeReference)+",\n")
+	s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
+	s = append(s, "PrevRecordReference: "+fmt.Sprintf("%#v", this.PrevRecordReference)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "RawData: "+fmt.Sprintf("%#v", this.RawData)+",\n")
+	s = append(s, "JetID: "+fmt.Sprintf("%#v", this.JetID)+",\n")
+	s = append(s, "PulseNumber: "+fmt.Sprintf("%#v", this.PulseNumber)+",\n")
+	s = append(s, "Order: "+fmt.Sprintf("%#v", this.Order)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -327,6 +653,46 @@ var _RecordExporter_serviceDesc = grpc.ServiceDesc{
 	Metadata: "etl/exporter/record_exporter.proto",
 }
 
+func (m *RecordCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordCursor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordCursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Order != 0 {
+		i = encodeVarintRecordExporter(dAtA, i, uint64(m.Order))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.JetID) > 0 {
+		i -= len(m.JetID)
+		copy(dAtA[i:], m.JetID)
+		i = encodeVarintRecordExporter(dAtA, i, uint64(len(m.JetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PulseNumber != 0 {
+		i = encodeVarintRecordExporter(dAtA, i, uint64(m.PulseNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GetRecordsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -347,12 +713,54 @@ func (m *GetRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Polymorph != 0 {
-		i = encodeVarintRecordExporter(dAtA, i, uint64(m.Polymorph))
+	if m.Cursor != nil {
+		{
+			size, err := m.Cursor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecordExporter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RecordTypes) > 0 {
+		for iNdEx := len(m.RecordTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RecordTypes[iNdEx])
+			copy(dAtA[i:], m.RecordTypes[iNdEx])
+			i = encodeVarintRecordExporter(dAtA, i, uint64(len(m.RecordTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ObjectReferences) > 0 {
+		for iNdEx := len(m.ObjectReferences) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ObjectReferences[iNdEx])
+			copy(dAtA[i:], m.ObjectReferences[iNdEx])
+			i = encodeVarintRecordExporter(dAtA, i, uint64(len(m.ObjectReferences[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Prototypes) > 0 {
+		for iNdEx := len(m.Prototypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Prototypes[iNdEx])
+			copy(dAtA[i:], m.Prototypes[iNdEx])
+			i = encodeVarintRecordExporter(dAtA, i, uint64(len(m.Prototypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PulseNumberTo != 0 {
+		i = encodeVarintRecordExporter(dAtA, i, uint64(m.PulseNumberTo))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x10
+	}
+	if m.PulseNumberFrom != 0 {
+		i = encodeVarintRecordExporter(dAtA, i, uint64(m.PulseNumberFrom))
 		i--
-		dAtA[i] = 0x80
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
@@ -377,12 +785,83 @@ func (m *GetRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Polymorph != 0 {
-		i = encodeVarintRecordExporter(dAtA, i, uint64(m.Polymorph))
+	if m.Timestamp != 0 {
+		i = encodeVarintRecordExporter(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Order != 0 {
+		i = encodeVarintRecordExporter(dAtA, i, uint64(m.Order))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.PulseNumber != 0 {
+		i = encodeVarintRecordExporter(dAtA, i, uint64(m.PulseNumber))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.JetID) > 0 {
+		i -= len(m.JetID)
+		copy(dAtA[i:], m.JetID)
+		i = encodeVarintRecordExporter(dAtA, i, uint64(len(m.JetID)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.RawData) > 0 {
+		i -= len(m.RawData)
+		copy(dAtA[i:], m.RawData)
+		i = encodeVarintRecordExporter(dAtA, i, uint64(len(m.RawData)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintRecordExporter(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PrevRecordReference) > 0 {
+		i -= len(m.PrevRecordReference)
+		copy(dAtA[i:], m.PrevRecordReference)
+		i = encodeVarintRecordExporter(dAtA, i, uint64(len(m.PrevRecordReference)))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x32
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintRecordExporter(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PrototypeReference) > 0 {
+		i -= len(m.PrototypeReference)
+		copy(dAtA[i:], m.PrototypeReference)
+		i = encodeVarintRecordExporter(dAtA, i, uint64(len(m.PrototypeReference)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ObjectReference) > 0 {
+		i -= len(m.ObjectReference)
+		copy(dAtA[i:], m.ObjectReference)
+		i = encodeVarintRecordExporter(dAtA, i, uint64(len(m.ObjectReference)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintRecordExporter(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintRecordExporter(dAtA, i, uint64(len(m.Reference)))
 		i--
-		dAtA[i] = 0x80
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
@@ -398,29 +877,115 @@ func encodeVarintRecordExporter(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
-func (m *GetRecordsRequest) Size() (n int) {
+func (m *RecordCursor) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Polymorph != 0 {
-		n += 2 + sovRecordExporter(uint64(m.Polymorph))
+	if m.PulseNumber != 0 {
+		n += 1 + sovRecordExporter(uint64(m.PulseNumber))
+	}
+	l = len(m.JetID)
+	if l > 0 {
+		n += 1 + l + sovRecordExporter(uint64(l))
+	}
+	if m.Order != 0 {
+		n += 1 + sovRecordExporter(uint64(m.Order))
 	}
 	return n
 }
 
-func (m *GetRecordsResponse) Size() (n int) {
+func (m *GetRecordsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Polymorph != 0 {
-		n += 2 + sovRecordExporter(uint64(m.Polymorph))
+	if m.PulseNumberFrom != 0 {
+		n += 1 + sovRecordExporter(uint64(m.PulseNumberFrom))
 	}
-	return n
-}
+	if m.PulseNumberTo != 0 {
+		n += 1 + sovRecordExporter(uint64(m.PulseNumberTo))
+	}
+	if len(m.Prototypes) > 0 {
+		for _, b := range m.Prototypes {
+			l = len(b)
+			n += 1 + l + sovRecordExporter(uint64(l))
+		}
+	}
+	if len(m.ObjectReferences) > 0 {
+		for _, b := range m.ObjectReferences {
+			l = len(b)
+			n += 1 + l + sovRecordExporter(uint64(l))
+		}
+	}
+	if len(m.RecordTypes) > 0 {
+		for _, s := range m.RecordTypes {
+			l = len(s)
+			n += 1 + l + sovRecordExporter(uint64(l))
+		}
+	}
+	if m.Cursor != nil {
+		l = m.Cursor.Size()
+		n += 1 + l + sovRecordExporter(uint64(l))
+	}
+	return n
+}
+
+func (m *GetRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovRecordExporter(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovRecordExporter(uint64(l))
+	}
+	l = len(m.ObjectReference)
+	if l > 0 {
+		n += 1 + l + sovRecordExporter(uint64(l))
+	}
+	l = len(m.PrototypeReference)
+	if l > 0 {
+		n += 1 + l + sovRecordExporter(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovRecordExporter(uint64(l))
+	}
+	l = len(m.PrevRecordReference)
+	if l > 0 {
+		n += 1 + l + sovRecordExporter(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovRecordExporter(uint64(l))
+	}
+	l = len(m.RawData)
+	if l > 0 {
+		n += 1 + l + sovRecordExporter(uint64(l))
+	}
+	l = len(m.JetID)
+	if l > 0 {
+		n += 1 + l + sovRecordExporter(uint64(l))
+	}
+	if m.PulseNumber != 0 {
+		n += 1 + sovRecordExporter(uint64(m.PulseNumber))
+	}
+	if m.Order != 0 {
+		n += 1 + sovRecordExporter(uint64(m.Order))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovRecordExporter(uint64(m.Timestamp))
+	}
+	return n
+}
 
 func sovRecordExporter(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -428,12 +993,29 @@ func sovRecordExporter(x uint64) (n int) {
 func sozRecordExporter(x uint64) (n int) {
 	return sovRecordExporter(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (this *RecordCursor) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RecordCursor{`,
+		`PulseNumber:` + fmt.Sprintf("%v", this.PulseNumber) + `,`,
+		`JetID:` + fmt.Sprintf("%v", this.JetID) + `,`,
+		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GetRecordsRequest) String() string {
 	if this == nil {
 		return "nil"
 	}
 	s := strings.Join([]string{`&GetRecordsRequest{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`PulseNumberFrom:` + fmt.Sprintf("%v", this.PulseNumberFrom) + `,`,
+		`PulseNumberTo:` + fmt.Sprintf("%v", this.PulseNumberTo) + `,`,
+		`Prototypes:` + fmt.Sprintf("%v", this.Prototypes) + `,`,
+		`ObjectReferences:` + fmt.Sprintf("%v", this.ObjectReferences) + `,`,
+		`RecordTypes:` + fmt.Sprintf("%v", this.RecordTypes) + `,`,
+		`Cursor:` + strings.Replace(this.Cursor.String(), "RecordCursor", "RecordCursor", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -443,7 +1025,18 @@ func (this *GetRecordsResponse) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&GetRecordsResponse{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Reference:` + fmt.Sprintf("%v", this.Reference) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ObjectReference:` + fmt.Sprintf("%v", this.ObjectReference) + `,`,
+		`PrototypeReference:` + fmt.Sprintf("%v", this.PrototypeReference) + `,`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`PrevRecordReference:` + fmt.Sprintf("%v", this.PrevRecordReference) + `,`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`RawData:` + fmt.Sprintf("%v", this.RawData) + `,`,
+		`JetID:` + fmt.Sprintf("%v", this.JetID) + `,`,
+		`PulseNumber:` + fmt.Sprintf("%v", this.PulseNumber) + `,`,
+		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -456,6 +1049,129 @@ func valueToStringRecordExporter(v interface{}) string {
 	pv := reflect.Indirect(rv).Interface()
 	return fmt.Sprintf("*%v", pv)
 }
+func (m *RecordCursor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecordExporter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordCursor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordCursor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PulseNumber", wireType)
+			}
+			m.PulseNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PulseNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			m.Order = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Order |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecordExporter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GetRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -485,11 +1201,145 @@ func (m *GetRecordsRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: GetRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 16:
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PulseNumberFrom", wireType)
+			}
+			m.PulseNumberFrom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PulseNumberFrom |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PulseNumberTo", wireType)
+			}
+			m.PulseNumberTo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PulseNumberTo |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prototypes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prototypes = append(m.Prototypes, make([]byte, postIndex-iNdEx))
+			copy(m.Prototypes[len(m.Prototypes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectReferences", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectReferences = append(m.ObjectReferences, make([]byte, postIndex-iNdEx))
+			copy(m.ObjectReferences[len(m.ObjectReferences)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordTypes = append(m.RecordTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
-			m.Polymorph = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRecordExporter
@@ -499,11 +1349,28 @@ func (m *GetRecordsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Polymorph |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cursor == nil {
+				m.Cursor = &RecordCursor{}
+			}
+			if err := m.Cursor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecordExporter(dAtA[iNdEx:])
@@ -557,11 +1424,351 @@ func (m *GetRecordsResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: GetRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 16:
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = append(m.Reference[:0], dAtA[iNdEx:postIndex]...)
+			if m.Reference == nil {
+				m.Reference = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectReference", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectReference = append(m.ObjectReference[:0], dAtA[iNdEx:postIndex]...)
+			if m.ObjectReference == nil {
+				m.ObjectReference = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrototypeReference", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrototypeReference = append(m.PrototypeReference[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrototypeReference == nil {
+				m.PrototypeReference = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevRecordReference", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevRecordReference = append(m.PrevRecordReference[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrevRecordReference == nil {
+				m.PrevRecordReference = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawData = append(m.RawData[:0], dAtA[iNdEx:postIndex]...)
+			if m.RawData == nil {
+				m.RawData = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PulseNumber", wireType)
+			}
+			m.PulseNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PulseNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			m.Order = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Order |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.Polymorph = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRecordExporter
@@ -571,7 +1778,7 @@ func (m *GetRecordsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Polymorph |= uint32(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
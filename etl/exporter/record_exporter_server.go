@@ -1,12 +1,163 @@
 package exporter
 
+import (
+	"github.com/insolar/assured-ledger/ledger-core/v2/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/insolar/block-explorer/configuration"
+	"github.com/insolar/block-explorer/etl/interfaces"
+	"github.com/insolar/block-explorer/etl/models"
+)
+
+// recordsBatchSize is the amount of records fetched from db at once
+const recordsBatchSize = 1000
+
 type RecordServer struct {
+	repository interfaces.Storage
+	notifier   *PulseNotifier
+	retry      configuration.Retry
+	logger     *log.Logger
+}
+
+func NewRecordServer(repo interfaces.Storage, notifier *PulseNotifier, retry configuration.Retry, logger *log.Logger) *RecordServer {
+	return &RecordServer{repo, notifier, retry, logger}
+}
+
+// GetRecords streams records of sequential pulses in order of pulse number, jet id and order.
+// If PulseNumberTo isn't set, the stream waits for new pulses after the last one is sent.
+func (s *RecordServer) GetRecords(req *GetRecordsRequest, stream RecordExporter_GetRecordsServer) error {
+	if err := validateGetRecordsRequest(req); err != nil {
+		return err
+	}
+
+	ActiveStreams.WithLabelValues(MethodGetRecords).Inc()
+	defer ActiveStreams.WithLabelValues(MethodGetRecords).Dec()
+
+	ctx := stream.Context()
+	retry := newBackoff(s.retry)
+
+	// currentPN is the last pulse which records have been sent
+	currentPN := req.GetPulseNumberFrom() - 1
+	fromJetID, fromOrder := "", -1
+	if cursor := req.GetCursor(); cursor != nil && cursor.GetPulseNumber() >= req.GetPulseNumberFrom() {
+		currentPN = cursor.GetPulseNumber() - 1
+		fromJetID, fromOrder = cursor.GetJetID(), int(cursor.GetOrder())
+	}
+
+	for {
+		if req.GetPulseNumberTo() != 0 && currentPN >= req.GetPulseNumberTo() {
+			return nil
+		}
+
+		// subscribe before querying db, so a pulse sequenced in between isn't missed
+		notification := s.notifier.Subscribe()
+		receivedPulse, err := s.repository.GetNextSequentialPulse(currentPN)
+		if err != nil {
+			Errors.WithLabelValues(MethodGetRecords, ErrorTypeStorage).Inc()
+			if s.logger != nil {
+				s.logger.Error(err)
+			}
+			if retry.Exhausted() {
+				return status.Errorf(codes.Unavailable, "failed to get next pulse after %d: %s", currentPN, err)
+			}
+			if err := wait(ctx, retry.Next()); err != nil {
+				return err
+			}
+			continue
+		}
+		retry.Reset()
+
+		// if there is no next sequential pulse yet we need to wait for it
+		if receivedPulse.PulseNumber == 0 {
+			if err := waitNotification(ctx, notification); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if req.GetPulseNumberTo() != 0 && receivedPulse.PulseNumber > req.GetPulseNumberTo() {
+			return nil
+		}
+
+		err = s.sendPulseRecords(req, receivedPulse.PulseNumber, fromJetID, fromOrder, stream)
+		if err != nil {
+			if s.logger != nil {
+				s.logger.Error(err)
+			}
+			return err
+		}
+
+		currentPN = receivedPulse.PulseNumber
+		fromJetID, fromOrder = "", -1
+	}
+}
+
+func (s *RecordServer) sendPulseRecords(req *GetRecordsRequest, pulseNumber int64, fromJetID string, fromOrder int, stream RecordExporter_GetRecordsServer) error {
+	for {
+		records, err := s.repository.GetRecordsByPulse(
+			pulseNumber, fromJetID, fromOrder, req.GetPrototypes(), req.GetObjectReferences(), req.GetRecordTypes(), recordsBatchSize,
+		)
+		if err != nil {
+			Errors.WithLabelValues(MethodGetRecords, ErrorTypeStorage).Inc()
+			return status.Errorf(codes.Unavailable, "failed to get records of pulse %d: %s", pulseNumber, err)
+		}
+
+		for _, record := range records {
+			if err := stream.Send(RecordToResponse(record)); err != nil {
+				Errors.WithLabelValues(MethodGetRecords, ErrorTypeSend).Inc()
+				return err
+			}
+			SentRecords.Inc()
+		}
+
+		if len(records) < recordsBatchSize {
+			return nil
+		}
+		last := records[len(records)-1]
+		fromJetID, fromOrder = last.JetID, last.Order
+	}
 }
 
-func NewRecordServer() *RecordServer {
-	return &RecordServer{}
+func validateGetRecordsRequest(req *GetRecordsRequest) error {
+	if req.GetPulseNumberTo() != 0 && req.GetPulseNumberTo() < req.GetPulseNumberFrom() {
+		return status.Error(codes.InvalidArgument, "PulseNumberTo should be greater or equal to PulseNumberFrom")
+	}
+	return validateRecordTypes(req.GetRecordTypes())
 }
 
-func (s *RecordServer) GetRecords(*GetRecordsRequest, RecordExporter_GetRecordsServer) error {
+func validateRecordTypes(recordTypes []string) error {
+	for _, t := range recordTypes {
+		if !isRecordType(models.RecordType(t)) {
+			return status.Errorf(codes.InvalidArgument, "unknown record type %q", t)
+		}
+	}
 	return nil
 }
+
+func isRecordType(recordType models.RecordType) bool {
+	for _, t := range models.RecordTypes {
+		if t == recordType {
+			return true
+		}
+	}
+	return false
+}
+
+// RecordToResponse converts record from db to the exporter response
+func RecordToResponse(record models.Record) *GetRecordsResponse {
+	return &GetRecordsResponse{
+		Reference:           record.Reference,
+		Type:                string(record.Type),
+		ObjectReference:     record.ObjectReference,
+		PrototypeReference:  record.PrototypeReference,
+		Payload:             record.Payload,
+		PrevRecordReference: record.PrevRecordReference,
+		Hash:                record.Hash,
+		RawData:             record.RawData,
+		JetID:               record.JetID,
+		PulseNumber:         record.PulseNumber,
+		Order:               int64(record.Order),
+		Timestamp:           record.Timestamp,
+	}
+}
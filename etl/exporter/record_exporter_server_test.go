@@ -0,0 +1,198 @@
+// +build unit
+
+package exporter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/insolar/block-explorer/configuration"
+	"github.com/insolar/block-explorer/etl/interfaces/mock"
+	"github.com/insolar/block-explorer/etl/models"
+)
+
+type recordExporterTestServer struct {
+	ctx    context.Context
+	sender func(*GetRecordsResponse) error
+}
+
+func (r *recordExporterTestServer) Send(response *GetRecordsResponse) error {
+	return r.sender(response)
+}
+
+func (r *recordExporterTestServer) SetHeader(md metadata.MD) error {
+	panic("implement me")
+}
+
+func (r *recordExporterTestServer) SendHeader(md metadata.MD) error {
+	panic("implement me")
+}
+
+func (r *recordExporterTestServer) SetTrailer(md metadata.MD) {
+	panic("implement me")
+}
+
+func (r *recordExporterTestServer) Context() context.Context {
+	if r.ctx != nil {
+		return r.ctx
+	}
+	return context.TODO()
+}
+
+func (r *recordExporterTestServer) SendMsg(m interface{}) error {
+	panic("implement me")
+}
+
+func (r *recordExporterTestServer) RecvMsg(m interface{}) error {
+	panic("implement me")
+}
+
+func TestExporter_Records_Export_PulseRange(t *testing.T) {
+	sm := mock.NewStorageMock(t)
+	sm.GetNextSequentialPulseMock.Set(func(fromPulseNumber int64) (models.Pulse, error) {
+		return models.Pulse{PulseNumber: fromPulseNumber + 10}, nil
+	})
+	sm.GetRecordsByPulseMock.Set(func(pulseNumber int64, fromJetID string, fromOrder int, prototypes, objectReferences [][]byte, recordTypes []string, limit int) ([]models.Record, error) {
+		require.Equal(t, "", fromJetID)
+		require.Equal(t, -1, fromOrder)
+		return []models.Record{
+			{Reference: []byte{1}, PulseNumber: pulseNumber, JetID: "0", Order: 1},
+			{Reference: []byte{2}, PulseNumber: pulseNumber, JetID: "1", Order: 1},
+		}, nil
+	})
+	recordServer := NewRecordServer(sm, startNotifier(t, sm), configuration.Retry{}, nil)
+
+	var received []*GetRecordsResponse
+	sender := func(resp *GetRecordsResponse) error {
+		received = append(received, resp)
+		return nil
+	}
+	err := recordServer.GetRecords(&GetRecordsRequest{PulseNumberFrom: 11, PulseNumberTo: 30}, &recordExporterTestServer{sender: sender})
+
+	require.NoError(t, err)
+	require.Len(t, received, 4)
+	require.Equal(t, int64(20), received[0].PulseNumber)
+	require.Equal(t, "0", received[0].JetID)
+	require.Equal(t, int64(20), received[1].PulseNumber)
+	require.Equal(t, "1", received[1].JetID)
+	require.Equal(t, int64(30), received[2].PulseNumber)
+	require.Equal(t, int64(30), received[3].PulseNumber)
+}
+
+func TestExporter_Records_Export_Cursor(t *testing.T) {
+	sm := mock.NewStorageMock(t)
+	sm.GetNextSequentialPulseMock.Set(func(fromPulseNumber int64) (models.Pulse, error) {
+		return models.Pulse{PulseNumber: fromPulseNumber + 1}, nil
+	})
+	sm.GetRecordsByPulseMock.Set(func(pulseNumber int64, fromJetID string, fromOrder int, prototypes, objectReferences [][]byte, recordTypes []string, limit int) ([]models.Record, error) {
+		if pulseNumber == 15 {
+			require.Equal(t, "01", fromJetID)
+			require.Equal(t, 5, fromOrder)
+		} else {
+			require.Equal(t, "", fromJetID)
+			require.Equal(t, -1, fromOrder)
+		}
+		return []models.Record{{PulseNumber: pulseNumber}}, nil
+	})
+	recordServer := NewRecordServer(sm, startNotifier(t, sm), configuration.Retry{}, nil)
+
+	var pulses []int64
+	sender := func(resp *GetRecordsResponse) error {
+		pulses = append(pulses, resp.PulseNumber)
+		return nil
+	}
+	req := &GetRecordsRequest{
+		PulseNumberFrom: 10,
+		PulseNumberTo:   16,
+		Cursor:          &RecordCursor{PulseNumber: 15, JetID: "01", Order: 5},
+	}
+	err := recordServer.GetRecords(req, &recordExporterTestServer{sender: sender})
+
+	require.NoError(t, err)
+	require.Equal(t, []int64{15, 16}, pulses)
+}
+
+func TestExporter_Records_Export_Batches(t *testing.T) {
+	sm := mock.NewStorageMock(t)
+	sm.GetNextSequentialPulseMock.Return(models.Pulse{PulseNumber: 10}, nil)
+	sm.GetRecordsByPulseMock.Set(func(pulseNumber int64, fromJetID string, fromOrder int, prototypes, objectReferences [][]byte, recordTypes []string, limit int) ([]models.Record, error) {
+		if fromOrder >= 0 {
+			require.Equal(t, limit-1, fromOrder)
+			return []models.Record{{PulseNumber: pulseNumber, Order: limit}}, nil
+		}
+		records := make([]models.Record, limit)
+		for i := range records {
+			records[i] = models.Record{PulseNumber: pulseNumber, Order: i}
+		}
+		return records, nil
+	})
+	recordServer := NewRecordServer(sm, startNotifier(t, sm), configuration.Retry{}, nil)
+
+	iterations := 0
+	sender := func(*GetRecordsResponse) error {
+		iterations++
+		return nil
+	}
+	err := recordServer.GetRecords(&GetRecordsRequest{PulseNumberFrom: 10, PulseNumberTo: 10}, &recordExporterTestServer{sender: sender})
+
+	require.NoError(t, err)
+	require.Equal(t, recordsBatchSize+1, iterations)
+	require.Equal(t, uint64(2), sm.GetRecordsByPulseBeforeCounter())
+}
+
+func TestExporter_Records_Export_WaitAndCancel(t *testing.T) {
+	sm := mock.NewStorageMock(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	sm.GetNextSequentialPulseMock.Set(func(fromPulseNumber int64) (models.Pulse, error) {
+		if sm.GetNextSequentialPulseBeforeCounter() == 1 {
+			return models.Pulse{}, errors.New("some error")
+		}
+		if sm.GetNextSequentialPulseBeforeCounter() == 3 {
+			cancel()
+		}
+		return models.Pulse{}, nil
+	})
+	recordServer := NewRecordServer(sm, startNotifier(t, sm), configuration.Retry{}, nil)
+
+	sender := func(*GetRecordsResponse) error {
+		return nil
+	}
+	err := recordServer.GetRecords(&GetRecordsRequest{PulseNumberFrom: 10}, &recordExporterTestServer{ctx: ctx, sender: sender})
+
+	require.Equal(t, codes.Canceled, status.Code(err))
+	require.Equal(t, uint64(3), sm.GetNextSequentialPulseBeforeCounter())
+}
+
+func TestExporter_Records_Export_Fail(t *testing.T) {
+	sm := mock.NewStorageMock(t)
+	sm.GetNextSequentialPulseMock.Return(models.Pulse{PulseNumber: 10}, nil)
+	sm.GetRecordsByPulseMock.Return([]models.Record{{PulseNumber: 10}, {PulseNumber: 10}}, nil)
+	recordServer := NewRecordServer(sm, startNotifier(t, sm), configuration.Retry{}, nil)
+
+	iterations := 0
+	sender := func(*GetRecordsResponse) error {
+		iterations++
+		return io.EOF
+	}
+	err := recordServer.GetRecords(&GetRecordsRequest{PulseNumberFrom: 10}, &recordExporterTestServer{sender: sender})
+
+	require.Equal(t, io.EOF, err)
+	require.Equal(t, 1, iterations, "iterations must be called one times because of error")
+}
+
+func TestExporter_Records_Export_InvalidRequest(t *testing.T) {
+	recordServer := NewRecordServer(mock.NewStorageMock(t), nil, configuration.Retry{}, nil)
+
+	err := recordServer.GetRecords(&GetRecordsRequest{PulseNumberFrom: 10, PulseNumberTo: 5}, &recordExporterTestServer{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	err = recordServer.GetRecords(&GetRecordsRequest{PulseNumberFrom: 10, RecordTypes: []string{"unknown"}}, &recordExporterTestServer{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -48,6 +48,7 @@ func (s *Server) Start(ctx context.Context) error {
 
 	ctx, cancel := context.WithCancel(ctx)
 	s.cancel = cancel
+	s.hasStarted = true
 
 	go s.run(ctx, l)
 	belogger.FromContext(ctx).
@@ -60,15 +61,17 @@ func (s *Server) Stop(ctx context.Context) error {
 	s.startStopMutex.Lock()
 	defer s.startStopMutex.Unlock()
 	if !s.hasStarted {
+		belogger.FromContext(ctx).
+			Warn("stop called for not started introspection server")
 		return nil
 	}
 
 	if s.cancel != nil {
 		s.cancel()
 	}
-
-	belogger.FromContext(ctx).
-		Warn("stop called for not started introspection server")
+	// closes all connections, so contexts of the active streams are canceled
+	s.grpcServer.Stop()
+	s.hasStarted = false
 	return nil
 }
 
@@ -0,0 +1,100 @@
+package extractor
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// maxDelimitedMessageSize limits the size of one message of the file, so a broken length doesn't exhaust the memory
+const maxDelimitedMessageSize = 1 << 30
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// unmarshaler is the protobuf message
+type unmarshaler interface {
+	Unmarshal(data []byte) error
+}
+
+// delimitedReader reads protobuf messages prefixed by their varint encoded length from the file,
+// gzip compressed files are decompressed transparently
+type delimitedReader struct {
+	file *os.File
+	gzip *gzip.Reader
+	r    *bufio.Reader
+	// offset is the position of the next message in the decompressed data
+	offset int64
+}
+
+func openDelimited(path string) (*delimitedReader, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot open file %s", path)
+	}
+	reader := &delimitedReader{file: file, r: bufio.NewReader(file)}
+	magic, err := reader.r.Peek(len(gzipMagic))
+	if err == nil && bytes.Equal(magic, gzipMagic) {
+		reader.gzip, err = gzip.NewReader(reader.r)
+		if err != nil {
+			file.Close()
+			return nil, errors.Wrapf(err, "cannot decompress file %s", path)
+		}
+		reader.r = bufio.NewReader(reader.gzip)
+	}
+	return reader, nil
+}
+
+// sectionDelimited reads messages of the uncompressed file from offset to offset+size,
+// the reader doesn't own the file, so it isn't closed
+func sectionDelimited(file *os.File, offset, size int64) *delimitedReader {
+	return &delimitedReader{r: bufio.NewReader(io.NewSectionReader(file, offset, size)), offset: offset}
+}
+
+// Compressed returns true if the file is gzip compressed
+func (r *delimitedReader) Compressed() bool {
+	return r.gzip != nil
+}
+
+// Offset returns the position of the next message in the decompressed data
+func (r *delimitedReader) Offset() int64 {
+	return r.offset
+}
+
+// Next reads the next message of the file to msg, io.EOF is returned at the end of the file
+func (r *delimitedReader) Next(msg unmarshaler) error {
+	size, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		if err == io.EOF {
+			return io.EOF
+		}
+		return errors.Wrap(err, "cannot read message size")
+	}
+	if size > maxDelimitedMessageSize {
+		return errors.Errorf("message size %d exceeds the limit %d", size, maxDelimitedMessageSize)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r.r, data); err != nil {
+		return errors.Wrap(err, "cannot read message")
+	}
+	var sizeBuf [binary.MaxVarintLen64]byte
+	r.offset += int64(binary.PutUvarint(sizeBuf[:], size)) + int64(size)
+	return errors.Wrap(msg.Unmarshal(data), "cannot unmarshal message")
+}
+
+func (r *delimitedReader) Close() error {
+	if r.file == nil {
+		return nil
+	}
+	if r.gzip != nil {
+		if err := r.gzip.Close(); err != nil {
+			r.file.Close()
+			return err
+		}
+	}
+	return r.file.Close()
+}
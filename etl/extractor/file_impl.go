@@ -0,0 +1,263 @@
+package extractor
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/block-explorer/etl/interfaces"
+	"github.com/insolar/block-explorer/etl/types"
+	"github.com/insolar/block-explorer/instrumentation/belogger"
+)
+
+// FileExtractor imports pulses and records from the heavy node export files instead of the heavy node.
+// The records file contains length-delimited exporter.Record messages ordered by pulses, it can be gzip compressed.
+// The records file is indexed by pulses on start, so every load reads only the records of the loaded pulses.
+// The compressed file can't be read from the middle, so it's decompressed to a temporary file for the index.
+type FileExtractor struct {
+	hasStarted     bool
+	startStopMutex *sync.Mutex
+	ctx            context.Context
+	cancel         context.CancelFunc
+
+	pulseExtractor    interfaces.PulseExtractor
+	recordsFile       string
+	index             *recordsIndex
+	mainPulseDataChan chan *types.PlatformPulseData
+}
+
+// recordsIndex keeps the positions of the pulses records in the uncompressed records file
+type recordsIndex struct {
+	// file is the records file or its temporary uncompressed copy
+	file     string
+	sections []pulseSection
+}
+
+// pulseSection is the part of the uncompressed records file with the records of one pulse
+type pulseSection struct {
+	pulseNumber insolar.PulseNumber
+	offset      int64
+	size        int64
+}
+
+func NewFileExtractor(recordsFile string, queueLen uint32, pulseExtractor interfaces.PulseExtractor) *FileExtractor {
+	return &FileExtractor{
+		startStopMutex:    &sync.Mutex{},
+		pulseExtractor:    pulseExtractor,
+		recordsFile:       recordsFile,
+		mainPulseDataChan: make(chan *types.PlatformPulseData, queueLen),
+	}
+}
+
+func (e *FileExtractor) GetJetDrops(ctx context.Context) <-chan *types.PlatformPulseData {
+	return e.mainPulseDataChan
+}
+
+// LoadJetDrops imports the pulses in the background until the extractor is stopped
+func (e *FileExtractor) LoadJetDrops(ctx context.Context, fromPulseNumber int64, toPulseNumber int64) error {
+	e.startStopMutex.Lock()
+	defer e.startStopMutex.Unlock()
+	if !e.hasStarted {
+		return errors.New("file extractor isn't started")
+	}
+	go e.importPulses(e.ctx, e.index, fromPulseNumber, toPulseNumber)
+	return nil
+}
+
+func (e *FileExtractor) Stop(ctx context.Context) error {
+	e.startStopMutex.Lock()
+	defer e.startStopMutex.Unlock()
+	if e.hasStarted {
+		e.cancel()
+		belogger.FromContext(ctx).Info("Stopping file extractor...")
+		e.hasStarted = false
+		if e.index.file != e.recordsFile {
+			// the importing goroutines keep the removed file open until they stop
+			if err := os.Remove(e.index.file); err != nil {
+				belogger.FromContext(ctx).Warnf("Cannot remove uncompressed records file: %s", err)
+			}
+		}
+	}
+	return nil
+}
+
+func (e *FileExtractor) Start(ctx context.Context) error {
+	e.startStopMutex.Lock()
+	defer e.startStopMutex.Unlock()
+	if !e.hasStarted {
+		belogger.FromContext(ctx).Info("Starting file extractor main thread...")
+		index, err := indexRecords(ctx, e.recordsFile)
+		if err != nil {
+			return err
+		}
+		belogger.FromContext(ctx).Infof("Records of %d pulses are indexed", len(index.sections))
+		e.index = index
+		e.hasStarted = true
+		e.ctx, e.cancel = context.WithCancel(ctx)
+		go e.importPulses(e.ctx, e.index, 0, 0)
+	}
+	return nil
+}
+
+// indexRecords finds the records of every pulse in the records file,
+// the compressed file is decompressed to a temporary file
+func indexRecords(ctx context.Context, recordsFile string) (*recordsIndex, error) {
+	reader, err := openDelimited(recordsFile)
+	if err != nil {
+		return nil, err
+	}
+	index := &recordsIndex{file: recordsFile}
+	if reader.Compressed() {
+		index.file, err = decompress(reader)
+		reader.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot decompress file %s", recordsFile)
+		}
+		reader, err = openDelimited(index.file)
+		if err != nil {
+			os.Remove(index.file)
+			return nil, err
+		}
+	}
+	defer reader.Close()
+
+	log := belogger.FromContext(ctx)
+	for {
+		offset := reader.Offset()
+		rec := &exporter.Record{}
+		err := reader.Next(rec)
+		if err == io.EOF {
+			return index, nil
+		}
+		if err != nil {
+			if index.file != recordsFile {
+				os.Remove(index.file)
+			}
+			return nil, errors.Wrapf(err, "cannot index file %s", recordsFile)
+		}
+		if rec.ShouldIterateFrom != nil {
+			continue
+		}
+		rpn := rec.Record.ID.Pulse()
+		last := len(index.sections) - 1
+		switch {
+		case last >= 0 && index.sections[last].pulseNumber == rpn:
+			index.sections[last].size = reader.Offset() - index.sections[last].offset
+		case last < 0 || index.sections[last].pulseNumber < rpn:
+			index.sections = append(index.sections, pulseSection{pulseNumber: rpn, offset: offset, size: reader.Offset() - offset})
+		default:
+			log.Warnf("indexRecords(): record of pulse %d is skipped, it's placed after records of pulse %d",
+				rpn, index.sections[last].pulseNumber)
+		}
+	}
+}
+
+// decompress copies the decompressed data of the reader to a temporary file
+func decompress(reader *delimitedReader) (string, error) {
+	file, err := ioutil.TempFile("", "records")
+	if err != nil {
+		return "", err
+	}
+	_, err = io.Copy(file, reader.r)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return "", err
+	}
+	return file.Name(), nil
+}
+
+// importPulses sends pulses between not including from and including until with their records to the main channel,
+// zero until means all pulses after from
+func (e *FileExtractor) importPulses(ctx context.Context, index *recordsIndex, from, until int64) {
+	RetrievePulsesCount.Inc()
+	defer RetrievePulsesCount.Dec()
+
+	log := belogger.FromContext(ctx).WithField("from", from).WithField("until", until)
+	file, err := os.Open(index.file)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	defer file.Close()
+
+	pn := from
+	for {
+		pu, err := e.pulseExtractor.GetNextFinalizedPulse(ctx, pn)
+		if err != nil {
+			if strings.Contains(err.Error(), pulse.ErrNotFound.Error()) {
+				log.Infof("importPulses(): all pulses after %d are imported", from)
+				return
+			}
+			log.Errorf("importPulses(): after=%d err=%s", pn, err)
+			return
+		}
+		if until > 0 && int64(pu.PulseNumber) > until {
+			return
+		}
+
+		records, err := index.readRecords(ctx, file, insolar.PulseNumber(pn), pu.PulseNumber)
+		if err != nil {
+			log.Errorf("importPulses(): cannot read records of pulse %d: %s", pu.PulseNumber, err)
+			return
+		}
+		pulseData := &types.PlatformPulseData{Pulse: pu, Records: records}
+
+		select {
+		case <-ctx.Done():
+			return
+		case e.mainPulseDataChan <- pulseData:
+		}
+		FromExtractorDataQueue.Set(float64(len(e.mainPulseDataChan)))
+		ReceivedPulses.Inc()
+		LastPulseFetched.Set(float64(pu.PulseNumber))
+		log.Debugf("importPulses(): pulse %d, recs: %d", pu.PulseNumber, len(pulseData.Records))
+		pn = int64(pu.PulseNumber)
+	}
+}
+
+// readRecords reads the records of the pulse from the uncompressed records file,
+// records of the pulses between prev and the pulse aren't in the pulses file, so they're skipped
+func (index *recordsIndex) readRecords(ctx context.Context, file *os.File, prev, pn insolar.PulseNumber) ([]*exporter.Record, error) {
+	i := sort.Search(len(index.sections), func(i int) bool {
+		return index.sections[i].pulseNumber > prev
+	})
+	for ; i < len(index.sections) && index.sections[i].pulseNumber < pn; i++ {
+		belogger.FromContext(ctx).Warnf("importPulses(): records of pulse %d are skipped, the pulse isn't in the pulses file",
+			index.sections[i].pulseNumber)
+	}
+	if i == len(index.sections) || index.sections[i].pulseNumber != pn {
+		return nil, nil
+	}
+
+	section := index.sections[i]
+	reader := sectionDelimited(file, section.offset, section.size)
+	var records []*exporter.Record
+	for {
+		rec := &exporter.Record{}
+		err := reader.Next(rec)
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		// the section may contain the skipped records of other pulses
+		if rec.ShouldIterateFrom != nil || rec.Record.ID.Pulse() != pn {
+			continue
+		}
+		records = append(records, rec)
+		ReceivedRecords.Inc()
+	}
+}
@@ -0,0 +1,64 @@
+package extractor
+
+import (
+	"context"
+	"io"
+	"sort"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/pkg/errors"
+)
+
+// FilePulseExtractor returns pulses of the heavy node export file instead of the heavy node
+type FilePulseExtractor struct {
+	pulses []*exporter.FullPulse
+}
+
+// NewFilePulseExtractor reads all pulses of the file with length-delimited exporter.FullPulse messages,
+// the file can be gzip compressed
+func NewFilePulseExtractor(pulsesFile string) (*FilePulseExtractor, error) {
+	reader, err := openDelimited(pulsesFile)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	var pulses []*exporter.FullPulse
+	for {
+		pu := &exporter.FullPulse{}
+		err := reader.Next(pu)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot read pulse %d of file %s", len(pulses), pulsesFile)
+		}
+		pulses = append(pulses, pu)
+	}
+	sort.Slice(pulses, func(i, j int) bool {
+		return pulses[i].PulseNumber < pulses[j].PulseNumber
+	})
+	return &FilePulseExtractor{pulses: pulses}, nil
+}
+
+// GetCurrentPulse returns the last pulse of the file
+func (fpe *FilePulseExtractor) GetCurrentPulse(ctx context.Context) (uint32, error) {
+	if len(fpe.pulses) == 0 {
+		return 0, pulse.ErrNotFound
+	}
+	return uint32(fpe.pulses[len(fpe.pulses)-1].PulseNumber), nil
+}
+
+// GetNextFinalizedPulse returns the first pulse of the file after p, pulse.ErrNotFound is returned after the last pulse
+func (fpe *FilePulseExtractor) GetNextFinalizedPulse(ctx context.Context, p int64) (*exporter.FullPulse, error) {
+	i := sort.Search(len(fpe.pulses), func(i int) bool {
+		return fpe.pulses[i].PulseNumber > insolar.PulseNumber(p)
+	})
+	if i == len(fpe.pulses) {
+		return nil, pulse.ErrNotFound
+	}
+	pu := *fpe.pulses[i]
+	return &pu, nil
+}
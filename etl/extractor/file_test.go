@@ -0,0 +1,263 @@
+// +build unit
+
+package extractor
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	insolarPulse "github.com/insolar/insolar/pulse"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/block-explorer/etl/types"
+)
+
+type marshaler interface {
+	Marshal() ([]byte, error)
+}
+
+func writeDelimited(t *testing.T, path string, compress bool, msgs ...marshaler) {
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	defer file.Close()
+	var w io.Writer = file
+	if compress {
+		gz := gzip.NewWriter(file)
+		defer gz.Close()
+		w = gz
+	}
+	for _, msg := range msgs {
+		data, err := msg.Marshal()
+		require.NoError(t, err)
+		size := make([]byte, binary.MaxVarintLen64)
+		_, err = w.Write(size[:binary.PutUvarint(size, uint64(len(data)))])
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+	}
+}
+
+func testFileRecord(pn insolar.PulseNumber, number uint32) *exporter.Record {
+	return &exporter.Record{
+		RecordNumber: number,
+		Record:       record.Material{ID: *insolar.NewID(pn, nil)},
+	}
+}
+
+func testFiles(t *testing.T, compress bool) (string, string) {
+	dir, err := ioutil.TempDir("", "extractor")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	pulsesFile := filepath.Join(dir, "pulses")
+	writeDelimited(t, pulsesFile, compress,
+		&exporter.FullPulse{PulseNumber: insolarPulse.MinTimePulse + 20, PrevPulseNumber: insolarPulse.MinTimePulse + 10},
+		&exporter.FullPulse{PulseNumber: insolarPulse.MinTimePulse + 10, PrevPulseNumber: insolarPulse.MinTimePulse},
+		&exporter.FullPulse{PulseNumber: insolarPulse.MinTimePulse + 30, PrevPulseNumber: insolarPulse.MinTimePulse + 20},
+	)
+	recordsFile := filepath.Join(dir, "records")
+	writeDelimited(t, recordsFile, compress,
+		testFileRecord(insolarPulse.MinTimePulse, 1),
+		testFileRecord(insolarPulse.MinTimePulse+10, 2),
+		testFileRecord(insolarPulse.MinTimePulse+10, 3),
+		&exporter.Record{ShouldIterateFrom: pulseNumberPtr(insolarPulse.MinTimePulse + 20)},
+		testFileRecord(insolarPulse.MinTimePulse+20, 4),
+		testFileRecord(insolarPulse.MinTimePulse+30, 5),
+		testFileRecord(insolarPulse.MinTimePulse+30, 6),
+	)
+	return pulsesFile, recordsFile
+}
+
+func pulseNumberPtr(pn insolar.PulseNumber) *insolar.PulseNumber {
+	return &pn
+}
+
+func receivePulses(t *testing.T, ch <-chan *types.PlatformPulseData, count int) []*types.PlatformPulseData {
+	var result []*types.PlatformPulseData
+	for i := 0; i < count; i++ {
+		select {
+		case pd := <-ch:
+			result = append(result, pd)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("only %d of %d pulses are received", len(result), count)
+		}
+	}
+	return result
+}
+
+func TestFilePulseExtractor(t *testing.T) {
+	ctx := context.Background()
+	pulsesFile, _ := testFiles(t, false)
+
+	pe, err := NewFilePulseExtractor(pulsesFile)
+	require.NoError(t, err)
+
+	current, err := pe.GetCurrentPulse(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, insolarPulse.MinTimePulse+30, current)
+
+	pu, err := pe.GetNextFinalizedPulse(ctx, 0)
+	require.NoError(t, err)
+	require.EqualValues(t, insolarPulse.MinTimePulse+10, pu.PulseNumber)
+
+	pu, err = pe.GetNextFinalizedPulse(ctx, insolarPulse.MinTimePulse+15)
+	require.NoError(t, err)
+	require.EqualValues(t, insolarPulse.MinTimePulse+20, pu.PulseNumber)
+
+	_, err = pe.GetNextFinalizedPulse(ctx, insolarPulse.MinTimePulse+30)
+	require.Equal(t, pulse.ErrNotFound, err)
+}
+
+func TestFilePulseExtractor_Errors(t *testing.T) {
+	_, err := NewFilePulseExtractor("not_existing_file")
+	require.Error(t, err)
+
+	dir, err := ioutil.TempDir("", "extractor")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	brokenFile := filepath.Join(dir, "broken")
+	require.NoError(t, ioutil.WriteFile(brokenFile, []byte{10, 1, 2}, 0600))
+	_, err = NewFilePulseExtractor(brokenFile)
+	require.Error(t, err)
+
+	emptyFile := filepath.Join(dir, "empty")
+	require.NoError(t, ioutil.WriteFile(emptyFile, nil, 0600))
+	pe, err := NewFilePulseExtractor(emptyFile)
+	require.NoError(t, err)
+	_, err = pe.GetCurrentPulse(context.Background())
+	require.Equal(t, pulse.ErrNotFound, err)
+}
+
+func TestFileExtractor_Start(t *testing.T) {
+	for _, compress := range []bool{false, true} {
+		t.Run(map[bool]string{false: "plain", true: "gzip"}[compress], func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			pulsesFile, recordsFile := testFiles(t, compress)
+			pe, err := NewFilePulseExtractor(pulsesFile)
+			require.NoError(t, err)
+
+			fe := NewFileExtractor(recordsFile, 10, pe)
+			require.NoError(t, fe.Start(ctx))
+			defer fe.Stop(ctx)
+
+			received := receivePulses(t, fe.GetJetDrops(ctx), 3)
+			expected := []struct {
+				pn      insolar.PulseNumber
+				records []uint32
+			}{
+				{insolarPulse.MinTimePulse + 10, []uint32{2, 3}},
+				{insolarPulse.MinTimePulse + 20, []uint32{4}},
+				{insolarPulse.MinTimePulse + 30, []uint32{5, 6}},
+			}
+			for i, e := range expected {
+				require.Equal(t, e.pn, received[i].Pulse.PulseNumber)
+				var numbers []uint32
+				for _, r := range received[i].Records {
+					require.Equal(t, e.pn, r.Record.ID.Pulse())
+					numbers = append(numbers, r.RecordNumber)
+				}
+				require.Equal(t, e.records, numbers)
+			}
+		})
+	}
+}
+
+func TestFileExtractor_LoadJetDrops(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pulsesFile, recordsFile := testFiles(t, true)
+	pe, err := NewFilePulseExtractor(pulsesFile)
+	require.NoError(t, err)
+
+	fe := NewFileExtractor(recordsFile, 10, pe)
+	require.Error(t, fe.LoadJetDrops(ctx, insolarPulse.MinTimePulse+10, insolarPulse.MinTimePulse+20), "extractor isn't started")
+	require.NoError(t, fe.Start(ctx))
+	defer fe.Stop(ctx)
+	receivePulses(t, fe.GetJetDrops(ctx), 3)
+
+	require.NoError(t, fe.LoadJetDrops(ctx, insolarPulse.MinTimePulse+10, insolarPulse.MinTimePulse+20))
+
+	received := receivePulses(t, fe.GetJetDrops(ctx), 1)
+	require.EqualValues(t, insolarPulse.MinTimePulse+20, received[0].Pulse.PulseNumber)
+	require.Len(t, received[0].Records, 1)
+	require.EqualValues(t, 4, received[0].Records[0].RecordNumber)
+
+	select {
+	case pd := <-fe.GetJetDrops(ctx):
+		t.Fatalf("unexpected pulse %d is loaded", pd.Pulse.PulseNumber)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestIndexRecords(t *testing.T) {
+	ctx := context.Background()
+	for _, compress := range []bool{false, true} {
+		t.Run(map[bool]string{false: "plain", true: "gzip"}[compress], func(t *testing.T) {
+			_, recordsFile := testFiles(t, compress)
+
+			index, err := indexRecords(ctx, recordsFile)
+			require.NoError(t, err)
+			if compress {
+				require.NotEqual(t, recordsFile, index.file, "compressed file is decompressed")
+				defer os.Remove(index.file)
+			} else {
+				require.Equal(t, recordsFile, index.file)
+			}
+
+			var pulses []insolar.PulseNumber
+			for _, section := range index.sections {
+				pulses = append(pulses, section.pulseNumber)
+			}
+			require.Equal(t, []insolar.PulseNumber{
+				insolarPulse.MinTimePulse, insolarPulse.MinTimePulse + 10, insolarPulse.MinTimePulse + 20, insolarPulse.MinTimePulse + 30,
+			}, pulses)
+
+			file, err := os.Open(index.file)
+			require.NoError(t, err)
+			defer file.Close()
+			records, err := index.readRecords(ctx, file, insolarPulse.MinTimePulse+10, insolarPulse.MinTimePulse+30)
+			require.NoError(t, err)
+			require.Len(t, records, 2)
+			require.EqualValues(t, 5, records[0].RecordNumber)
+			require.EqualValues(t, 6, records[1].RecordNumber)
+		})
+	}
+}
+
+func TestFileExtractor_Stop(t *testing.T) {
+	ctx := context.Background()
+	pulsesFile, recordsFile := testFiles(t, true)
+	pe, err := NewFilePulseExtractor(pulsesFile)
+	require.NoError(t, err)
+
+	// the queue is full after the first pulse, so the importing goroutines wait until they're stopped
+	fe := NewFileExtractor(recordsFile, 1, pe)
+	running := testutil.ToFloat64(RetrievePulsesCount)
+	require.NoError(t, fe.Start(ctx))
+	require.NoError(t, fe.LoadJetDrops(ctx, 0, insolarPulse.MinTimePulse+30))
+	indexedFile := fe.index.file
+	require.Eventually(t, func() bool {
+		return testutil.ToFloat64(RetrievePulsesCount) >= running+2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, fe.Stop(ctx))
+
+	require.Eventually(t, func() bool {
+		return testutil.ToFloat64(RetrievePulsesCount) <= running
+	}, 5*time.Second, 10*time.Millisecond, "reload is stopped with the extractor")
+	_, err = os.Stat(indexedFile)
+	require.True(t, os.IsNotExist(err), "uncompressed file is removed")
+	require.Error(t, fe.LoadJetDrops(ctx, 0, insolarPulse.MinTimePulse+30))
+}
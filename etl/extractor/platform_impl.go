@@ -13,15 +13,16 @@ import (
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/pulse"
 	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/jinzhu/gorm"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/insolar/block-explorer/etl/interfaces"
+	"github.com/insolar/block-explorer/etl/models"
+	"github.com/insolar/block-explorer/etl/platform"
 	"github.com/insolar/block-explorer/etl/types"
 	"github.com/insolar/block-explorer/instrumentation/belogger"
 )
 
-const PlatformAPIVersion = "2"
-
 type PlatformExtractor struct {
 	hasStarted     bool
 	startStopMutex *sync.Mutex
@@ -29,6 +30,8 @@ type PlatformExtractor struct {
 	maxWorkers     int32
 
 	pulseExtractor interfaces.PulseExtractor
+	// checkpoints keeps the last pulse saved by the processor, the main thread resumes from it after restart
+	checkpoints interfaces.CheckpointStorage
 
 	client            exporter.RecordExporterClient
 	request           *exporter.GetRecords
@@ -48,6 +51,7 @@ func NewPlatformExtractor(
 	queueLen uint32,
 	pulseExtractor interfaces.PulseExtractor,
 	exporterClient exporter.RecordExporterClient,
+	checkpoints interfaces.CheckpointStorage,
 	shutdownBE func(),
 ) *PlatformExtractor {
 	request := &exporter.GetRecords{Count: batchSize}
@@ -58,6 +62,7 @@ func NewPlatformExtractor(
 		mainPulseDataChan: make(chan *types.PlatformPulseData, queueLen),
 
 		pulseExtractor: pulseExtractor,
+		checkpoints:    checkpoints,
 		batchSize:      batchSize,
 		continuousPulseRetrievingHalfPulseSeconds: continuousPulseRetrievingHalfPulseSeconds,
 		maxWorkers: maxWorkers,
@@ -92,11 +97,29 @@ func (e *PlatformExtractor) Start(ctx context.Context) error {
 		belogger.FromContext(ctx).Info("Starting platform extractor main thread...")
 		e.hasStarted = true
 		ctx, e.cancel = context.WithCancel(ctx)
-		go e.retrievePulses(ctx, 0, 0)
+		go e.retrievePulses(ctx, e.lastSavedPulse(ctx), 0)
 	}
 	return nil
 }
 
+// lastSavedPulse returns the checkpoint of the processor, zero if there is no checkpoint.
+// The pulses before the checkpoint which weren't saved completely are reloaded by the controller
+func (e *PlatformExtractor) lastSavedPulse(ctx context.Context) int64 {
+	if e.checkpoints == nil {
+		return 0
+	}
+	logger := belogger.FromContext(ctx)
+	checkpoint, err := e.checkpoints.GetCheckpoint(models.LastSavedPulseCheckpoint)
+	if err != nil {
+		if !gorm.IsRecordNotFoundError(err) {
+			logger.Errorf("Can't get the last saved pulse, starting from the first pulse: %s", err)
+		}
+		return 0
+	}
+	logger.Infof("Resuming the main thread from the last saved pulse %d", checkpoint.PulseNumber)
+	return checkpoint.PulseNumber
+}
+
 func closeStream(ctx context.Context, stream exporter.RecordExporter_ExportClient) {
 	if stream != nil {
 		streamError := stream.CloseSend()
@@ -122,7 +145,7 @@ func (e *PlatformExtractor) retrievePulses(ctx context.Context, from, until int6
 		logger = logger.WithField("from", from).WithField("until", until)
 	}
 
-	ctx = appendPlatformVersionToCtx(ctx)
+	ctx = platform.AppendVersionToContext(ctx)
 	halfPulse := time.Duration(e.continuousPulseRetrievingHalfPulseSeconds) * time.Second
 	var nextNotEmptyPulseNumber *insolar.PulseNumber
 
@@ -150,7 +173,7 @@ func (e *PlatformExtractor) retrievePulses(ctx context.Context, from, until int6
 		pu, err = e.pulseExtractor.GetNextFinalizedPulse(ctx, int64(before.PulseNumber))
 		if err != nil { // network error ?
 			pu = &before
-			if isVersionError(err) {
+			if platform.IsVersionError(err) {
 				log.Errorf("retrievePulses(): version error occurred, debug: %s", debugVersionError(ctx))
 				e.shutdownBE()
 				break
@@ -258,7 +281,7 @@ func (e *PlatformExtractor) retrieveRecords(ctx context.Context, pu exporter.Ful
 		)
 		if err != nil {
 			log.Error("retrieveRecords() on rpc call: ", err.Error())
-			if isVersionError(err) {
+			if platform.IsVersionError(err) {
 				e.shutdownBE()
 				return nil
 			}
@@ -346,19 +369,6 @@ func debugVersionError(ctx context.Context) string {
 	return fmt.Sprintf("Client Type: %s, Client version: %s", mtd.Get(exporter.KeyClientType), mtd.Get(exporter.KeyClientVersionHeavy))
 }
 
-func appendPlatformVersionToCtx(ctx context.Context) context.Context {
-	ctx = metadata.AppendToOutgoingContext(ctx, exporter.KeyClientType, exporter.ValidateHeavyVersion.String())
-	return metadata.AppendToOutgoingContext(ctx, exporter.KeyClientVersionHeavy, PlatformAPIVersion)
-}
-
-func isVersionError(err error) bool {
-	return strings.Contains(err.Error(), exporter.ErrDeprecatedClientVersion.Error()) ||
-		strings.Contains(err.Error(), "unknown heavy-version") ||
-		strings.Contains(err.Error(), "unknown type client") ||
-		strings.Contains(err.Error(), "incorrect format of the heavy-version")
-
-}
-
 func isRateLimitError(err error) bool {
 	return strings.Contains(err.Error(), exporter.RateLimitExceededMsg)
 }
@@ -20,6 +20,8 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/insolar/block-explorer/etl/interfaces/mock"
+	"github.com/insolar/block-explorer/etl/models"
+	"github.com/insolar/block-explorer/etl/platform"
 	"github.com/insolar/block-explorer/testutils"
 	"github.com/insolar/block-explorer/testutils/clients"
 )
@@ -43,14 +45,14 @@ func TestGetJetDrops(t *testing.T) {
 			mtd, ok := metadata.FromOutgoingContext(ctx)
 			require.True(t, ok)
 			require.Equal(t, exporter.ValidateHeavyVersion.String(), mtd.Get(exporter.KeyClientType)[0])
-			require.Equal(t, PlatformAPIVersion, mtd.Get(exporter.KeyClientVersionHeavy)[0])
+			require.Equal(t, platform.APIVersion, mtd.Get(exporter.KeyClientVersionHeavy)[0])
 
 			return stream, nil
 		})
 
 	pulseClient := clients.GetTestPulseClient(65537, nil)
 	pulseExtractor := NewPlatformPulseExtractor(pulseClient)
-	extractor := NewPlatformExtractor(uint32(pulseCount), 0, 100, 100, pulseExtractor, recordClient, func() {})
+	extractor := NewPlatformExtractor(uint32(pulseCount), 0, 100, 100, pulseExtractor, recordClient, nil, func() {})
 	err = extractor.Start(ctx)
 	require.NoError(t, err)
 	defer extractor.Stop(ctx)
@@ -93,7 +95,7 @@ func TestGetJetDrops_WrongVersionOnPulseError(t *testing.T) {
 	pulseExtractor.GetNextFinalizedPulseMock.Set(func(ctx context.Context, p int64) (fp1 *exporter.FullPulse, err error) {
 		return nil, errors.New("unknown heavy-version")
 	})
-	extractor := NewPlatformExtractor(uint32(1), 0, 100, 100, pulseExtractor, recordClient, shutdownBETestFunc)
+	extractor := NewPlatformExtractor(uint32(1), 0, 100, 100, pulseExtractor, recordClient, nil, shutdownBETestFunc)
 	err := extractor.Start(ctx)
 	defer extractor.Stop(ctx)
 	require.NoError(mc, err)
@@ -121,7 +123,7 @@ func TestGetJetDrops_WrongVersionOnRecordError(t *testing.T) {
 			mtd, ok := metadata.FromOutgoingContext(ctx)
 			require.True(t, ok)
 			require.Equal(t, exporter.ValidateHeavyVersion.String(), mtd.Get(exporter.KeyClientType)[0])
-			require.Equal(t, PlatformAPIVersion, mtd.Get(exporter.KeyClientVersionHeavy)[0])
+			require.Equal(t, platform.APIVersion, mtd.Get(exporter.KeyClientVersionHeavy)[0])
 			return recordStream{}, exporter.ErrDeprecatedClientVersion
 		})
 	var called int32 = 0
@@ -130,7 +132,7 @@ func TestGetJetDrops_WrongVersionOnRecordError(t *testing.T) {
 	}
 	pulseClient := clients.GetTestPulseClient(65537, nil)
 	pulseExtractor := NewPlatformPulseExtractor(pulseClient)
-	extractor := NewPlatformExtractor(uint32(1), 0, 100, 100, pulseExtractor, recordClient, shutdownBETestFunc)
+	extractor := NewPlatformExtractor(uint32(1), 0, 100, 100, pulseExtractor, recordClient, nil, shutdownBETestFunc)
 	err := extractor.Start(ctx)
 	defer extractor.Stop(ctx)
 	require.NoError(mc, err)
@@ -224,7 +226,7 @@ func TestLoadJetDrops_returnsRecordByPulses(t *testing.T) {
 					return pp, err
 				})
 
-			extractor := NewPlatformExtractor(77, 0, 100, 100, pulseExtractor, recordClient, func() {})
+			extractor := NewPlatformExtractor(77, 0, 100, 100, pulseExtractor, recordClient, nil, func() {})
 			err := extractor.LoadJetDrops(ctx, int64(startPulseNumber-10), int64(startPulseNumber+10*(test.differentPulseCount-1)))
 			require.NoError(t, err)
 			for i := 0; i < test.differentPulseCount; i++ {
@@ -239,3 +241,38 @@ func TestLoadJetDrops_returnsRecordByPulses(t *testing.T) {
 		})
 	}
 }
+
+func TestGetJetDrops_ResumeFromCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	mc := minimock.NewController(t)
+	lastSaved := insolar.PulseNumber(StartPulseNumber + 10)
+	nextPulse := lastSaved + 10
+
+	// the checkpoint is saved by the processor, the extractor only reads it
+	checkpoints := mock.NewCheckpointStorageMock(mc)
+	checkpoints.GetCheckpointMock.Expect(models.LastSavedPulseCheckpoint).
+		Return(models.Checkpoint{Name: models.LastSavedPulseCheckpoint, PulseNumber: int64(lastSaved)}, nil)
+
+	pulseExtractor := mock.NewPulseExtractorMock(mc)
+	pulseExtractor.GetNextFinalizedPulseMock.Set(func(ctx context.Context, p int64) (fp1 *exporter.FullPulse, err error) {
+		require.Equal(t, int64(lastSaved), p, "main thread has to resume from the checkpoint")
+		return clients.GetFullPulse(uint32(nextPulse), nil)
+	})
+	recordClient := mock.NewRecordExporterClientMock(mc)
+	recordClient.ExportMock.Return(recordStream{recvFunc: func() (*exporter.Record, error) {
+		iterateFrom := nextPulse + 10
+		return &exporter.Record{ShouldIterateFrom: &iterateFrom}, nil
+	}}, nil)
+
+	extractor := NewPlatformExtractor(1, 1, 100, 100, pulseExtractor, recordClient, checkpoints, func() {})
+	err := extractor.Start(ctx)
+	require.NoError(t, err)
+	defer extractor.Stop(ctx)
+
+	select {
+	case jd := <-extractor.GetJetDrops(ctx):
+		require.Equal(t, nextPulse, jd.Pulse.PulseNumber)
+	case <-time.After(time.Second * 10):
+		t.Fatal("chan receive timeout ")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/insolar/insolar/ledger/heavy/exporter"
 
 	"github.com/insolar/block-explorer/etl/types"
+	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 
 	"github.com/insolar/block-explorer/etl/models"
@@ -84,26 +85,74 @@ type Controller interface {
 	SetJetDropData(pulse types.Pulse, jetID string)
 }
 
+//go:generate minimock -i github.com/insolar/block-explorer/etl/interfaces.Reingester -o ./mock -s _mock.go -g
+// Reingester replaces stored data of pulses with data loaded from the platform again
+type Reingester interface {
+	// Reingest deletes data of pulses between fromPulseNumber and toPulseNumber inclusive and loads it again.
+	Reingest(ctx context.Context, fromPulseNumber, toPulseNumber int64) error
+	// ReingestProgress returns the state of the last re-ingestion, false if there was no re-ingestion.
+	ReingestProgress() (types.ReingestProgress, bool)
+}
+
+// Errors of Reingest, other errors are failures of the storage or the platform
+var (
+	// ErrReingestInvalidRange is returned when the from pulse is greater than the to pulse
+	ErrReingestInvalidRange = errors.New("invalid pulse range")
+	// ErrReingestPulseNotFound is returned when the from pulse isn't stored
+	ErrReingestPulseNotFound = errors.New("pulse not found")
+	// ErrReingestInProgress is returned when the previous re-ingestion isn't done yet
+	ErrReingestInProgress = errors.New("re-ingestion is in progress")
+)
+
+//go:generate minimock -i github.com/insolar/block-explorer/etl/interfaces.DeadLetterQueue -o ./mock -s _mock.go -g
+// DeadLetterQueue manages jet drops which failed to persist after all retries
+type DeadLetterQueue interface {
+	// DeadLetters returns dead letters without jet drops ordered by id.
+	DeadLetters(limit, offset int) ([]types.DeadLetter, error)
+	// DeadLetter returns dead letter with provided id and its jet drop.
+	DeadLetter(id int64) (types.DeadLetter, error)
+	// RetryDeadLetter processes the jet drop of dead letter with provided id again, the dead letter is removed on success.
+	RetryDeadLetter(ctx context.Context, id int64) error
+	// DiscardDeadLetter removes dead letter with provided id without processing.
+	DiscardDeadLetter(id int64) error
+}
+
 //go:generate minimock -i github.com/insolar/block-explorer/etl/interfaces.StorageSetter -o ./mock -s _mock.go -g
 // StorageSetter saves data to database
 type StorageSetter interface {
 	// SaveJetDropData saves provided jetDrop and records to db in one transaction.
 	// increase jet_drop_amount and record_amount
 	SaveJetDropData(jetDrop models.JetDrop, records []models.Record, pulseNumber int64) error
+	// SaveJetDropsBatch saves provided pulse with its jet tree, jet drops, records and record extensions to db in one transaction,
+	// jet_drop_amount and record_amount of the pulse are increased by new jet drops only.
+	SaveJetDropsBatch(pulse models.Pulse, pulseJetIDs []string, jetDrops []models.JetDropData) error
+	// SaveRecordExtensions saves provided extensions of the saved records to db in one transaction.
+	SaveRecordExtensions(extensions []models.RecordExtension) error
 	// SavePulse saves provided pulse to db.
 	SavePulse(pulse models.Pulse) error
+	// SavePulseJets saves provided jet tree of the pulse to db, already saved jets are skipped.
+	SavePulseJets(pulseNumber int64, jetIDs []string) error
 	// CompletePulse update pulse with provided number to completeness in db.
 	CompletePulse(pulseNumber int64) error
 	// SequencePulse update pulse with provided number to sequential in db.
 	SequencePulse(pulseNumber int64) error
+	// DeletePulses deletes pulses in range with their jet drops, records, audit findings and dead letters from db
+	// in one transaction, pulses after the range are reset to not sequential.
+	DeletePulses(fromPulseNumber, toPulseNumber int64) error
 }
 
 // StorageAPIFetcher gets data from database
 type StorageAPIFetcher interface {
 	// GetRecord returns record with provided reference from db.
 	GetRecord(ref models.Reference) (models.Record, error)
+	// GetRecordExtensions returns extensions of records with provided references from db.
+	GetRecordExtensions(refs []models.Reference) ([]models.RecordExtension, error)
+	// GetCallTree returns the request with provided reference, requests caused by it recursively and their results from db.
+	GetCallTree(requestReference models.Reference, maxDepth int) ([]models.Record, error)
 	// GetPulse returns pulse with provided pulse number from db.
 	GetPulse(pulseNumber int64) (models.Pulse, error)
+	// GetPulseJets returns the jet tree of the pulse from db, it's empty if the jet tree wasn't saved.
+	GetPulseJets(pulseNumber int64) ([]models.PulseJet, error)
 	// GetPulse returns pulses from db.
 	GetPulses(fromPulse *int64, timestampLte, timestampGte, pulseNumberLte, pulseNumberLt, pulseNumberGte, pulseNumberGt *int64, sortByAsc bool, limit, offset int) ([]models.Pulse, int, error)
 	// GetJetDropsWithParams returns jetDrops for provided pulse with limit and offset.
@@ -113,9 +162,9 @@ type StorageAPIFetcher interface {
 	// GetJetDropsByJetID returns jetDrops for provided jetID sorting and filtering by pulseNumber.
 	GetJetDropsByJetID(jetID string, pulseNumberLte, pulseNumberLt, pulseNumberGte, pulseNumberGt *int64, limit int, sortByPnAsc bool) ([]models.JetDrop, int, error)
 	// GetLifeline returns records for provided object reference, ordered by desc by pulse number and order fields.
-	GetLifeline(objRef []byte, fromIndex *string, pulseNumberLt, pulseNumberGt, timestampLte, timestampGte *int64, limit, offset int, sortByIndexAsc bool) ([]models.Record, int, error)
+	GetLifeline(objRef []byte, fromIndex *string, pulseNumberLt, pulseNumberGt, timestampLte, timestampGte *int64, limit, offset int, sortByIndexAsc bool, filter models.RecordFilter) ([]models.Record, int, error)
 	// GetRecordsByJetDrop returns records for provided jet drop, ordered by order field.
-	GetRecordsByJetDrop(jetDropID models.JetDropID, fromIndex, recordType *string, limit, offset int) ([]models.Record, int, error)
+	GetRecordsByJetDrop(jetDropID models.JetDropID, fromIndex, recordType *string, limit, offset int, filter models.RecordFilter) ([]models.Record, int, error)
 	// GetNextSavedPulse returns first pulse with pulse number bigger then fromPulseNumber from db.
 	GetNextSavedPulse(fromPulseNumber models.Pulse, completedOnly bool) (models.Pulse, error)
 	// GetJetDrops returns jetDrops for provided pulse from db.
@@ -123,6 +172,10 @@ type StorageAPIFetcher interface {
 }
 
 type StorageFetcher interface {
+	// GetPulse returns pulse with provided pulse number from db.
+	GetPulse(pulseNumber int64) (models.Pulse, error)
+	// GetPulseJets returns the jet tree of the pulse from db, it's empty if the jet tree wasn't saved.
+	GetPulseJets(pulseNumber int64) ([]models.PulseJet, error)
 	// GetIncompletePulses returns pulses that are not complete from db.
 	GetIncompletePulses() ([]models.Pulse, error)
 	// GetSequentialPulse returns max pulse that have is_sequential as true from db.
@@ -137,7 +190,62 @@ type StorageFetcher interface {
 
 // StorageExporterFetcher represents the methods for exporter-api
 type StorageExporterFetcher interface {
-	GetNextCompletePulseFilterByPrototypeReference(prevPulse int64, prototypes [][]byte) (models.Pulse, error)
+	// GetNextSequentialPulseFilterByRecords returns first sequential pulse with pulse number bigger then fromPulseNumber
+	// that contains records matched by provided prototypes, object references and types from db.
+	GetNextSequentialPulseFilterByRecords(fromPulseNumber int64, prototypes, objectReferences [][]byte, recordTypes []string) (models.Pulse, error)
+	// GetNextSequentialPulse returns first sequential pulse with pulse number bigger then fromPulseNumber from db.
+	GetNextSequentialPulse(fromPulseNumber int64) (models.Pulse, error)
+	// GetRecordsByPulse returns records of the pulse placed after provided jet id and order, ordered by jet id and order fields.
+	// Records are filtered by prototypes, object references and types if they are not empty.
+	GetRecordsByPulse(pulseNumber int64, fromJetID string, fromOrder int, prototypes, objectReferences [][]byte, recordTypes []string, limit int) ([]models.Record, error)
+	// GetRecordAmountsByPulse returns amounts of the pulse records grouped by jet id and prototype reference.
+	// Records are filtered by prototypes, object references and types if they are not empty.
+	GetRecordAmountsByPulse(pulseNumber int64, prototypes, objectReferences [][]byte, recordTypes []string) ([]models.JetDropRecordAmount, error)
+}
+
+//go:generate minimock -i github.com/insolar/block-explorer/etl/interfaces.AuditStorage -o ./mock -s _mock.go -g
+// AuditStorage represents the methods for the audit of stored jet drops
+type AuditStorage interface {
+	// GetSequentialPulse returns max pulse that have is_sequential as true from db.
+	GetSequentialPulse() (models.Pulse, error)
+	// GetNextSequentialPulse returns first sequential pulse with pulse number bigger then fromPulseNumber from db.
+	GetNextSequentialPulse(fromPulseNumber int64) (models.Pulse, error)
+	// GetJetDrops returns jetDrops for provided pulse from db.
+	GetJetDrops(pulse models.Pulse) ([]models.JetDrop, error)
+	// SaveAuditFindings replaces audit findings of the pulse with provided ones.
+	SaveAuditFindings(pulseNumber int64, findings []models.AuditFinding) error
+	// GetRecordsByPulse returns records of the pulse placed after provided jet id and order, ordered by jet id and order fields.
+	// Records are filtered by prototypes, object references and types if they are not empty.
+	GetRecordsByPulse(pulseNumber int64, fromJetID string, fromOrder int, prototypes, objectReferences [][]byte, recordTypes []string, limit int) ([]models.Record, error)
+}
+
+//go:generate minimock -i github.com/insolar/block-explorer/etl/interfaces.DeadLetterStorage -o ./mock -s _mock.go -g
+// DeadLetterStorage keeps jet drops which failed to persist after all retries
+type DeadLetterStorage interface {
+	// SaveDeadLetter saves provided dead letter to db, the dead letter of the same jet drop is updated
+	// with the new error and the attempts are added to its ones.
+	SaveDeadLetter(deadLetter models.DeadLetter) error
+	// GetDeadLetters returns dead letters without jet drops ordered by id from db.
+	GetDeadLetters(limit, offset int) ([]models.DeadLetter, error)
+	// GetDeadLetter returns dead letter with provided id from db.
+	GetDeadLetter(id int64) (models.DeadLetter, error)
+	// DeleteDeadLetter deletes dead letter with provided id from db.
+	DeleteDeadLetter(id int64) error
+}
+
+//go:generate minimock -i github.com/insolar/block-explorer/etl/interfaces.CheckpointStorage -o ./mock -s _mock.go -g
+// CheckpointStorage keeps the state of the pipeline to resume it after restart
+type CheckpointStorage interface {
+	// SaveCheckpoint saves provided checkpoint to db, the checkpoint with the same name is replaced.
+	SaveCheckpoint(checkpoint models.Checkpoint) error
+	// GetCheckpoint returns checkpoint with provided name from db.
+	GetCheckpoint(name string) (models.Checkpoint, error)
+	// SaveReloadAttempt saves the request of pulses range to db, attempts of already saved range are increased.
+	SaveReloadAttempt(fromPulseNumber, toPulseNumber int64) (models.ReloadRange, error)
+	// GetReloadRanges returns saved pulse ranges ordered by from pulse number asc and to pulse number desc.
+	GetReloadRanges() ([]models.ReloadRange, error)
+	// DeleteReloadRanges deletes pulse ranges up to provided pulse number inclusive from db.
+	DeleteReloadRanges(toPulseNumber int64) error
 }
 
 //go:generate minimock -i github.com/insolar/block-explorer/etl/interfaces.Storage -o ./mock -s _mock.go -g
@@ -146,4 +254,5 @@ type Storage interface {
 	StorageSetter
 	StorageFetcher
 	StorageExporterFetcher
+	CheckpointStorage
 }
@@ -0,0 +1,1127 @@
+package mock
+
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+import (
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/insolar/block-explorer/etl/models"
+)
+
+// AuditStorageMock implements interfaces.AuditStorage
+type AuditStorageMock struct {
+	t minimock.Tester
+
+	funcGetJetDrops          func(pulse models.Pulse) (ja1 []models.JetDrop, err error)
+	inspectFuncGetJetDrops   func(pulse models.Pulse)
+	afterGetJetDropsCounter  uint64
+	beforeGetJetDropsCounter uint64
+	GetJetDropsMock          mAuditStorageMockGetJetDrops
+
+	funcGetNextSequentialPulse          func(fromPulseNumber int64) (p1 models.Pulse, err error)
+	inspectFuncGetNextSequentialPulse   func(fromPulseNumber int64)
+	afterGetNextSequentialPulseCounter  uint64
+	beforeGetNextSequentialPulseCounter uint64
+	GetNextSequentialPulseMock          mAuditStorageMockGetNextSequentialPulse
+
+	funcGetRecordsByPulse          func(pulseNumber int64, fromJetID string, fromOrder int, prototypes [][]byte, objectReferences [][]byte, recordTypes []string, limit int) (ra1 []models.Record, err error)
+	inspectFuncGetRecordsByPulse   func(pulseNumber int64, fromJetID string, fromOrder int, prototypes [][]byte, objectReferences [][]byte, recordTypes []string, limit int)
+	afterGetRecordsByPulseCounter  uint64
+	beforeGetRecordsByPulseCounter uint64
+	GetRecordsByPulseMock          mAuditStorageMockGetRecordsByPulse
+
+	funcGetSequentialPulse          func() (p1 models.Pulse, err error)
+	inspectFuncGetSequentialPulse   func()
+	afterGetSequentialPulseCounter  uint64
+	beforeGetSequentialPulseCounter uint64
+	GetSequentialPulseMock          mAuditStorageMockGetSequentialPulse
+
+	funcSaveAuditFindings          func(pulseNumber int64, findings []models.AuditFinding) (err error)
+	inspectFuncSaveAuditFindings   func(pulseNumber int64, findings []models.AuditFinding)
+	afterSaveAuditFindingsCounter  uint64
+	beforeSaveAuditFindingsCounter uint64
+	SaveAuditFindingsMock          mAuditStorageMockSaveAuditFindings
+}
+
+// NewAuditStorageMock returns a mock for interfaces.AuditStorage
+func NewAuditStorageMock(t minimock.Tester) *AuditStorageMock {
+	m := &AuditStorageMock{t: t}
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetJetDropsMock = mAuditStorageMockGetJetDrops{mock: m}
+	m.GetJetDropsMock.callArgs = []*AuditStorageMockGetJetDropsParams{}
+
+	m.GetNextSequentialPulseMock = mAuditStorageMockGetNextSequentialPulse{mock: m}
+	m.GetNextSequentialPulseMock.callArgs = []*AuditStorageMockGetNextSequentialPulseParams{}
+
+	m.GetRecordsByPulseMock = mAuditStorageMockGetRecordsByPulse{mock: m}
+	m.GetRecordsByPulseMock.callArgs = []*AuditStorageMockGetRecordsByPulseParams{}
+
+	m.GetSequentialPulseMock = mAuditStorageMockGetSequentialPulse{mock: m}
+
+	m.SaveAuditFindingsMock = mAuditStorageMockSaveAuditFindings{mock: m}
+	m.SaveAuditFindingsMock.callArgs = []*AuditStorageMockSaveAuditFindingsParams{}
+
+	return m
+}
+
+type mAuditStorageMockGetJetDrops struct {
+	mock               *AuditStorageMock
+	defaultExpectation *AuditStorageMockGetJetDropsExpectation
+	expectations       []*AuditStorageMockGetJetDropsExpectation
+
+	callArgs []*AuditStorageMockGetJetDropsParams
+	mutex    sync.RWMutex
+}
+
+// AuditStorageMockGetJetDropsExpectation specifies expectation struct of the AuditStorage.GetJetDrops
+type AuditStorageMockGetJetDropsExpectation struct {
+	mock    *AuditStorageMock
+	params  *AuditStorageMockGetJetDropsParams
+	results *AuditStorageMockGetJetDropsResults
+	Counter uint64
+}
+
+// AuditStorageMockGetJetDropsParams contains parameters of the AuditStorage.GetJetDrops
+type AuditStorageMockGetJetDropsParams struct {
+	pulse models.Pulse
+}
+
+// AuditStorageMockGetJetDropsResults contains results of the AuditStorage.GetJetDrops
+type AuditStorageMockGetJetDropsResults struct {
+	ja1 []models.JetDrop
+	err error
+}
+
+// Expect sets up expected params for AuditStorage.GetJetDrops
+func (mmGetJetDrops *mAuditStorageMockGetJetDrops) Expect(pulse models.Pulse) *mAuditStorageMockGetJetDrops {
+	if mmGetJetDrops.mock.funcGetJetDrops != nil {
+		mmGetJetDrops.mock.t.Fatalf("AuditStorageMock.GetJetDrops mock is already set by Set")
+	}
+
+	if mmGetJetDrops.defaultExpectation == nil {
+		mmGetJetDrops.defaultExpectation = &AuditStorageMockGetJetDropsExpectation{}
+	}
+
+	mmGetJetDrops.defaultExpectation.params = &AuditStorageMockGetJetDropsParams{pulse}
+	for _, e := range mmGetJetDrops.expectations {
+		if minimock.Equal(e.params, mmGetJetDrops.defaultExpectation.params) {
+			mmGetJetDrops.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetJetDrops.defaultExpectation.params)
+		}
+	}
+
+	return mmGetJetDrops
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditStorage.GetJetDrops
+func (mmGetJetDrops *mAuditStorageMockGetJetDrops) Inspect(f func(pulse models.Pulse)) *mAuditStorageMockGetJetDrops {
+	if mmGetJetDrops.mock.inspectFuncGetJetDrops != nil {
+		mmGetJetDrops.mock.t.Fatalf("Inspect function is already set for AuditStorageMock.GetJetDrops")
+	}
+
+	mmGetJetDrops.mock.inspectFuncGetJetDrops = f
+
+	return mmGetJetDrops
+}
+
+// Return sets up results that will be returned by AuditStorage.GetJetDrops
+func (mmGetJetDrops *mAuditStorageMockGetJetDrops) Return(ja1 []models.JetDrop, err error) *AuditStorageMock {
+	if mmGetJetDrops.mock.funcGetJetDrops != nil {
+		mmGetJetDrops.mock.t.Fatalf("AuditStorageMock.GetJetDrops mock is already set by Set")
+	}
+
+	if mmGetJetDrops.defaultExpectation == nil {
+		mmGetJetDrops.defaultExpectation = &AuditStorageMockGetJetDropsExpectation{mock: mmGetJetDrops.mock}
+	}
+	mmGetJetDrops.defaultExpectation.results = &AuditStorageMockGetJetDropsResults{ja1, err}
+	return mmGetJetDrops.mock
+}
+
+//Set uses given function f to mock the AuditStorage.GetJetDrops method
+func (mmGetJetDrops *mAuditStorageMockGetJetDrops) Set(f func(pulse models.Pulse) (ja1 []models.JetDrop, err error)) *AuditStorageMock {
+	if mmGetJetDrops.defaultExpectation != nil {
+		mmGetJetDrops.mock.t.Fatalf("Default expectation is already set for the AuditStorage.GetJetDrops method")
+	}
+
+	if len(mmGetJetDrops.expectations) > 0 {
+		mmGetJetDrops.mock.t.Fatalf("Some expectations are already set for the AuditStorage.GetJetDrops method")
+	}
+
+	mmGetJetDrops.mock.funcGetJetDrops = f
+	return mmGetJetDrops.mock
+}
+
+// When sets expectation for the AuditStorage.GetJetDrops which will trigger the result defined by the following
+// Then helper
+func (mmGetJetDrops *mAuditStorageMockGetJetDrops) When(pulse models.Pulse) *AuditStorageMockGetJetDropsExpectation {
+	if mmGetJetDrops.mock.funcGetJetDrops != nil {
+		mmGetJetDrops.mock.t.Fatalf("AuditStorageMock.GetJetDrops mock is already set by Set")
+	}
+
+	expectation := &AuditStorageMockGetJetDropsExpectation{
+		mock:   mmGetJetDrops.mock,
+		params: &AuditStorageMockGetJetDropsParams{pulse},
+	}
+	mmGetJetDrops.expectations = append(mmGetJetDrops.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditStorage.GetJetDrops return parameters for the expectation previously defined by the When method
+func (e *AuditStorageMockGetJetDropsExpectation) Then(ja1 []models.JetDrop, err error) *AuditStorageMock {
+	e.results = &AuditStorageMockGetJetDropsResults{ja1, err}
+	return e.mock
+}
+
+// GetJetDrops implements interfaces.AuditStorage
+func (mmGetJetDrops *AuditStorageMock) GetJetDrops(pulse models.Pulse) (ja1 []models.JetDrop, err error) {
+	mm_atomic.AddUint64(&mmGetJetDrops.beforeGetJetDropsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetJetDrops.afterGetJetDropsCounter, 1)
+
+	if mmGetJetDrops.inspectFuncGetJetDrops != nil {
+		mmGetJetDrops.inspectFuncGetJetDrops(pulse)
+	}
+
+	mm_params := &AuditStorageMockGetJetDropsParams{pulse}
+
+	// Record call args
+	mmGetJetDrops.GetJetDropsMock.mutex.Lock()
+	mmGetJetDrops.GetJetDropsMock.callArgs = append(mmGetJetDrops.GetJetDropsMock.callArgs, mm_params)
+	mmGetJetDrops.GetJetDropsMock.mutex.Unlock()
+
+	for _, e := range mmGetJetDrops.GetJetDropsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ja1, e.results.err
+		}
+	}
+
+	if mmGetJetDrops.GetJetDropsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetJetDrops.GetJetDropsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetJetDrops.GetJetDropsMock.defaultExpectation.params
+		mm_got := AuditStorageMockGetJetDropsParams{pulse}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetJetDrops.t.Errorf("AuditStorageMock.GetJetDrops got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetJetDrops.GetJetDropsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetJetDrops.t.Fatal("No results are set for the AuditStorageMock.GetJetDrops")
+		}
+		return (*mm_results).ja1, (*mm_results).err
+	}
+	if mmGetJetDrops.funcGetJetDrops != nil {
+		return mmGetJetDrops.funcGetJetDrops(pulse)
+	}
+	mmGetJetDrops.t.Fatalf("Unexpected call to AuditStorageMock.GetJetDrops. %v", pulse)
+	return
+}
+
+// GetJetDropsAfterCounter returns a count of finished AuditStorageMock.GetJetDrops invocations
+func (mmGetJetDrops *AuditStorageMock) GetJetDropsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetJetDrops.afterGetJetDropsCounter)
+}
+
+// GetJetDropsBeforeCounter returns a count of AuditStorageMock.GetJetDrops invocations
+func (mmGetJetDrops *AuditStorageMock) GetJetDropsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetJetDrops.beforeGetJetDropsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditStorageMock.GetJetDrops.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetJetDrops *mAuditStorageMockGetJetDrops) Calls() []*AuditStorageMockGetJetDropsParams {
+	mmGetJetDrops.mutex.RLock()
+
+	argCopy := make([]*AuditStorageMockGetJetDropsParams, len(mmGetJetDrops.callArgs))
+	copy(argCopy, mmGetJetDrops.callArgs)
+
+	mmGetJetDrops.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetJetDropsDone returns true if the count of the GetJetDrops invocations corresponds
+// the number of defined expectations
+func (m *AuditStorageMock) MinimockGetJetDropsDone() bool {
+	for _, e := range m.GetJetDropsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetJetDropsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetJetDropsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetJetDrops != nil && mm_atomic.LoadUint64(&m.afterGetJetDropsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetJetDropsInspect logs each unmet expectation
+func (m *AuditStorageMock) MinimockGetJetDropsInspect() {
+	for _, e := range m.GetJetDropsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditStorageMock.GetJetDrops with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetJetDropsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetJetDropsCounter) < 1 {
+		if m.GetJetDropsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditStorageMock.GetJetDrops")
+		} else {
+			m.t.Errorf("Expected call to AuditStorageMock.GetJetDrops with params: %#v", *m.GetJetDropsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetJetDrops != nil && mm_atomic.LoadUint64(&m.afterGetJetDropsCounter) < 1 {
+		m.t.Error("Expected call to AuditStorageMock.GetJetDrops")
+	}
+}
+
+type mAuditStorageMockGetNextSequentialPulse struct {
+	mock               *AuditStorageMock
+	defaultExpectation *AuditStorageMockGetNextSequentialPulseExpectation
+	expectations       []*AuditStorageMockGetNextSequentialPulseExpectation
+
+	callArgs []*AuditStorageMockGetNextSequentialPulseParams
+	mutex    sync.RWMutex
+}
+
+// AuditStorageMockGetNextSequentialPulseExpectation specifies expectation struct of the AuditStorage.GetNextSequentialPulse
+type AuditStorageMockGetNextSequentialPulseExpectation struct {
+	mock    *AuditStorageMock
+	params  *AuditStorageMockGetNextSequentialPulseParams
+	results *AuditStorageMockGetNextSequentialPulseResults
+	Counter uint64
+}
+
+// AuditStorageMockGetNextSequentialPulseParams contains parameters of the AuditStorage.GetNextSequentialPulse
+type AuditStorageMockGetNextSequentialPulseParams struct {
+	fromPulseNumber int64
+}
+
+// AuditStorageMockGetNextSequentialPulseResults contains results of the AuditStorage.GetNextSequentialPulse
+type AuditStorageMockGetNextSequentialPulseResults struct {
+	p1  models.Pulse
+	err error
+}
+
+// Expect sets up expected params for AuditStorage.GetNextSequentialPulse
+func (mmGetNextSequentialPulse *mAuditStorageMockGetNextSequentialPulse) Expect(fromPulseNumber int64) *mAuditStorageMockGetNextSequentialPulse {
+	if mmGetNextSequentialPulse.mock.funcGetNextSequentialPulse != nil {
+		mmGetNextSequentialPulse.mock.t.Fatalf("AuditStorageMock.GetNextSequentialPulse mock is already set by Set")
+	}
+
+	if mmGetNextSequentialPulse.defaultExpectation == nil {
+		mmGetNextSequentialPulse.defaultExpectation = &AuditStorageMockGetNextSequentialPulseExpectation{}
+	}
+
+	mmGetNextSequentialPulse.defaultExpectation.params = &AuditStorageMockGetNextSequentialPulseParams{fromPulseNumber}
+	for _, e := range mmGetNextSequentialPulse.expectations {
+		if minimock.Equal(e.params, mmGetNextSequentialPulse.defaultExpectation.params) {
+			mmGetNextSequentialPulse.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetNextSequentialPulse.defaultExpectation.params)
+		}
+	}
+
+	return mmGetNextSequentialPulse
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditStorage.GetNextSequentialPulse
+func (mmGetNextSequentialPulse *mAuditStorageMockGetNextSequentialPulse) Inspect(f func(fromPulseNumber int64)) *mAuditStorageMockGetNextSequentialPulse {
+	if mmGetNextSequentialPulse.mock.inspectFuncGetNextSequentialPulse != nil {
+		mmGetNextSequentialPulse.mock.t.Fatalf("Inspect function is already set for AuditStorageMock.GetNextSequentialPulse")
+	}
+
+	mmGetNextSequentialPulse.mock.inspectFuncGetNextSequentialPulse = f
+
+	return mmGetNextSequentialPulse
+}
+
+// Return sets up results that will be returned by AuditStorage.GetNextSequentialPulse
+func (mmGetNextSequentialPulse *mAuditStorageMockGetNextSequentialPulse) Return(p1 models.Pulse, err error) *AuditStorageMock {
+	if mmGetNextSequentialPulse.mock.funcGetNextSequentialPulse != nil {
+		mmGetNextSequentialPulse.mock.t.Fatalf("AuditStorageMock.GetNextSequentialPulse mock is already set by Set")
+	}
+
+	if mmGetNextSequentialPulse.defaultExpectation == nil {
+		mmGetNextSequentialPulse.defaultExpectation = &AuditStorageMockGetNextSequentialPulseExpectation{mock: mmGetNextSequentialPulse.mock}
+	}
+	mmGetNextSequentialPulse.defaultExpectation.results = &AuditStorageMockGetNextSequentialPulseResults{p1, err}
+	return mmGetNextSequentialPulse.mock
+}
+
+//Set uses given function f to mock the AuditStorage.GetNextSequentialPulse method
+func (mmGetNextSequentialPulse *mAuditStorageMockGetNextSequentialPulse) Set(f func(fromPulseNumber int64) (p1 models.Pulse, err error)) *AuditStorageMock {
+	if mmGetNextSequentialPulse.defaultExpectation != nil {
+		mmGetNextSequentialPulse.mock.t.Fatalf("Default expectation is already set for the AuditStorage.GetNextSequentialPulse method")
+	}
+
+	if len(mmGetNextSequentialPulse.expectations) > 0 {
+		mmGetNextSequentialPulse.mock.t.Fatalf("Some expectations are already set for the AuditStorage.GetNextSequentialPulse method")
+	}
+
+	mmGetNextSequentialPulse.mock.funcGetNextSequentialPulse = f
+	return mmGetNextSequentialPulse.mock
+}
+
+// When sets expectation for the AuditStorage.GetNextSequentialPulse which will trigger the result defined by the following
+// Then helper
+func (mmGetNextSequentialPulse *mAuditStorageMockGetNextSequentialPulse) When(fromPulseNumber int64) *AuditStorageMockGetNextSequentialPulseExpectation {
+	if mmGetNextSequentialPulse.mock.funcGetNextSequentialPulse != nil {
+		mmGetNextSequentialPulse.mock.t.Fatalf("AuditStorageMock.GetNextSequentialPulse mock is already set by Set")
+	}
+
+	expectation := &AuditStorageMockGetNextSequentialPulseExpectation{
+		mock:   mmGetNextSequentialPulse.mock,
+		params: &AuditStorageMockGetNextSequentialPulseParams{fromPulseNumber},
+	}
+	mmGetNextSequentialPulse.expectations = append(mmGetNextSequentialPulse.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditStorage.GetNextSequentialPulse return parameters for the expectation previously defined by the When method
+func (e *AuditStorageMockGetNextSequentialPulseExpectation) Then(p1 models.Pulse, err error) *AuditStorageMock {
+	e.results = &AuditStorageMockGetNextSequentialPulseResults{p1, err}
+	return e.mock
+}
+
+// GetNextSequentialPulse implements interfaces.AuditStorage
+func (mmGetNextSequentialPulse *AuditStorageMock) GetNextSequentialPulse(fromPulseNumber int64) (p1 models.Pulse, err error) {
+	mm_atomic.AddUint64(&mmGetNextSequentialPulse.beforeGetNextSequentialPulseCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetNextSequentialPulse.afterGetNextSequentialPulseCounter, 1)
+
+	if mmGetNextSequentialPulse.inspectFuncGetNextSequentialPulse != nil {
+		mmGetNextSequentialPulse.inspectFuncGetNextSequentialPulse(fromPulseNumber)
+	}
+
+	mm_params := &AuditStorageMockGetNextSequentialPulseParams{fromPulseNumber}
+
+	// Record call args
+	mmGetNextSequentialPulse.GetNextSequentialPulseMock.mutex.Lock()
+	mmGetNextSequentialPulse.GetNextSequentialPulseMock.callArgs = append(mmGetNextSequentialPulse.GetNextSequentialPulseMock.callArgs, mm_params)
+	mmGetNextSequentialPulse.GetNextSequentialPulseMock.mutex.Unlock()
+
+	for _, e := range mmGetNextSequentialPulse.GetNextSequentialPulseMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmGetNextSequentialPulse.GetNextSequentialPulseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetNextSequentialPulse.GetNextSequentialPulseMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetNextSequentialPulse.GetNextSequentialPulseMock.defaultExpectation.params
+		mm_got := AuditStorageMockGetNextSequentialPulseParams{fromPulseNumber}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetNextSequentialPulse.t.Errorf("AuditStorageMock.GetNextSequentialPulse got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetNextSequentialPulse.GetNextSequentialPulseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetNextSequentialPulse.t.Fatal("No results are set for the AuditStorageMock.GetNextSequentialPulse")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmGetNextSequentialPulse.funcGetNextSequentialPulse != nil {
+		return mmGetNextSequentialPulse.funcGetNextSequentialPulse(fromPulseNumber)
+	}
+	mmGetNextSequentialPulse.t.Fatalf("Unexpected call to AuditStorageMock.GetNextSequentialPulse. %v", fromPulseNumber)
+	return
+}
+
+// GetNextSequentialPulseAfterCounter returns a count of finished AuditStorageMock.GetNextSequentialPulse invocations
+func (mmGetNextSequentialPulse *AuditStorageMock) GetNextSequentialPulseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetNextSequentialPulse.afterGetNextSequentialPulseCounter)
+}
+
+// GetNextSequentialPulseBeforeCounter returns a count of AuditStorageMock.GetNextSequentialPulse invocations
+func (mmGetNextSequentialPulse *AuditStorageMock) GetNextSequentialPulseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetNextSequentialPulse.beforeGetNextSequentialPulseCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditStorageMock.GetNextSequentialPulse.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetNextSequentialPulse *mAuditStorageMockGetNextSequentialPulse) Calls() []*AuditStorageMockGetNextSequentialPulseParams {
+	mmGetNextSequentialPulse.mutex.RLock()
+
+	argCopy := make([]*AuditStorageMockGetNextSequentialPulseParams, len(mmGetNextSequentialPulse.callArgs))
+	copy(argCopy, mmGetNextSequentialPulse.callArgs)
+
+	mmGetNextSequentialPulse.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetNextSequentialPulseDone returns true if the count of the GetNextSequentialPulse invocations corresponds
+// the number of defined expectations
+func (m *AuditStorageMock) MinimockGetNextSequentialPulseDone() bool {
+	for _, e := range m.GetNextSequentialPulseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetNextSequentialPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetNextSequentialPulseCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetNextSequentialPulse != nil && mm_atomic.LoadUint64(&m.afterGetNextSequentialPulseCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetNextSequentialPulseInspect logs each unmet expectation
+func (m *AuditStorageMock) MinimockGetNextSequentialPulseInspect() {
+	for _, e := range m.GetNextSequentialPulseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditStorageMock.GetNextSequentialPulse with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetNextSequentialPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetNextSequentialPulseCounter) < 1 {
+		if m.GetNextSequentialPulseMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditStorageMock.GetNextSequentialPulse")
+		} else {
+			m.t.Errorf("Expected call to AuditStorageMock.GetNextSequentialPulse with params: %#v", *m.GetNextSequentialPulseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetNextSequentialPulse != nil && mm_atomic.LoadUint64(&m.afterGetNextSequentialPulseCounter) < 1 {
+		m.t.Error("Expected call to AuditStorageMock.GetNextSequentialPulse")
+	}
+}
+
+type mAuditStorageMockGetRecordsByPulse struct {
+	mock               *AuditStorageMock
+	defaultExpectation *AuditStorageMockGetRecordsByPulseExpectation
+	expectations       []*AuditStorageMockGetRecordsByPulseExpectation
+
+	callArgs []*AuditStorageMockGetRecordsByPulseParams
+	mutex    sync.RWMutex
+}
+
+// AuditStorageMockGetRecordsByPulseExpectation specifies expectation struct of the AuditStorage.GetRecordsByPulse
+type AuditStorageMockGetRecordsByPulseExpectation struct {
+	mock    *AuditStorageMock
+	params  *AuditStorageMockGetRecordsByPulseParams
+	results *AuditStorageMockGetRecordsByPulseResults
+	Counter uint64
+}
+
+// AuditStorageMockGetRecordsByPulseParams contains parameters of the AuditStorage.GetRecordsByPulse
+type AuditStorageMockGetRecordsByPulseParams struct {
+	pulseNumber      int64
+	fromJetID        string
+	fromOrder        int
+	prototypes       [][]byte
+	objectReferences [][]byte
+	recordTypes      []string
+	limit            int
+}
+
+// AuditStorageMockGetRecordsByPulseResults contains results of the AuditStorage.GetRecordsByPulse
+type AuditStorageMockGetRecordsByPulseResults struct {
+	ra1 []models.Record
+	err error
+}
+
+// Expect sets up expected params for AuditStorage.GetRecordsByPulse
+func (mmGetRecordsByPulse *mAuditStorageMockGetRecordsByPulse) Expect(pulseNumber int64, fromJetID string, fromOrder int, prototypes [][]byte, objectReferences [][]byte, recordTypes []string, limit int) *mAuditStorageMockGetRecordsByPulse {
+	if mmGetRecordsByPulse.mock.funcGetRecordsByPulse != nil {
+		mmGetRecordsByPulse.mock.t.Fatalf("AuditStorageMock.GetRecordsByPulse mock is already set by Set")
+	}
+
+	if mmGetRecordsByPulse.defaultExpectation == nil {
+		mmGetRecordsByPulse.defaultExpectation = &AuditStorageMockGetRecordsByPulseExpectation{}
+	}
+
+	mmGetRecordsByPulse.defaultExpectation.params = &AuditStorageMockGetRecordsByPulseParams{pulseNumber, fromJetID, fromOrder, prototypes, objectReferences, recordTypes, limit}
+	for _, e := range mmGetRecordsByPulse.expectations {
+		if minimock.Equal(e.params, mmGetRecordsByPulse.defaultExpectation.params) {
+			mmGetRecordsByPulse.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRecordsByPulse.defaultExpectation.params)
+		}
+	}
+
+	return mmGetRecordsByPulse
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditStorage.GetRecordsByPulse
+func (mmGetRecordsByPulse *mAuditStorageMockGetRecordsByPulse) Inspect(f func(pulseNumber int64, fromJetID string, fromOrder int, prototypes [][]byte, objectReferences [][]byte, recordTypes []string, limit int)) *mAuditStorageMockGetRecordsByPulse {
+	if mmGetRecordsByPulse.mock.inspectFuncGetRecordsByPulse != nil {
+		mmGetRecordsByPulse.mock.t.Fatalf("Inspect function is already set for AuditStorageMock.GetRecordsByPulse")
+	}
+
+	mmGetRecordsByPulse.mock.inspectFuncGetRecordsByPulse = f
+
+	return mmGetRecordsByPulse
+}
+
+// Return sets up results that will be returned by AuditStorage.GetRecordsByPulse
+func (mmGetRecordsByPulse *mAuditStorageMockGetRecordsByPulse) Return(ra1 []models.Record, err error) *AuditStorageMock {
+	if mmGetRecordsByPulse.mock.funcGetRecordsByPulse != nil {
+		mmGetRecordsByPulse.mock.t.Fatalf("AuditStorageMock.GetRecordsByPulse mock is already set by Set")
+	}
+
+	if mmGetRecordsByPulse.defaultExpectation == nil {
+		mmGetRecordsByPulse.defaultExpectation = &AuditStorageMockGetRecordsByPulseExpectation{mock: mmGetRecordsByPulse.mock}
+	}
+	mmGetRecordsByPulse.defaultExpectation.results = &AuditStorageMockGetRecordsByPulseResults{ra1, err}
+	return mmGetRecordsByPulse.mock
+}
+
+//Set uses given function f to mock the AuditStorage.GetRecordsByPulse method
+func (mmGetRecordsByPulse *mAuditStorageMockGetRecordsByPulse) Set(f func(pulseNumber int64, fromJetID string, fromOrder int, prototypes [][]byte, objectReferences [][]byte, recordTypes []string, limit int) (ra1 []models.Record, err error)) *AuditStorageMock {
+	if mmGetRecordsByPulse.defaultExpectation != nil {
+		mmGetRecordsByPulse.mock.t.Fatalf("Default expectation is already set for the AuditStorage.GetRecordsByPulse method")
+	}
+
+	if len(mmGetRecordsByPulse.expectations) > 0 {
+		mmGetRecordsByPulse.mock.t.Fatalf("Some expectations are already set for the AuditStorage.GetRecordsByPulse method")
+	}
+
+	mmGetRecordsByPulse.mock.funcGetRecordsByPulse = f
+	return mmGetRecordsByPulse.mock
+}
+
+// When sets expectation for the AuditStorage.GetRecordsByPulse which will trigger the result defined by the following
+// Then helper
+func (mmGetRecordsByPulse *mAuditStorageMockGetRecordsByPulse) When(pulseNumber int64, fromJetID string, fromOrder int, prototypes [][]byte, objectReferences [][]byte, recordTypes []string, limit int) *AuditStorageMockGetRecordsByPulseExpectation {
+	if mmGetRecordsByPulse.mock.funcGetRecordsByPulse != nil {
+		mmGetRecordsByPulse.mock.t.Fatalf("AuditStorageMock.GetRecordsByPulse mock is already set by Set")
+	}
+
+	expectation := &AuditStorageMockGetRecordsByPulseExpectation{
+		mock:   mmGetRecordsByPulse.mock,
+		params: &AuditStorageMockGetRecordsByPulseParams{pulseNumber, fromJetID, fromOrder, prototypes, objectReferences, recordTypes, limit},
+	}
+	mmGetRecordsByPulse.expectations = append(mmGetRecordsByPulse.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditStorage.GetRecordsByPulse return parameters for the expectation previously defined by the When method
+func (e *AuditStorageMockGetRecordsByPulseExpectation) Then(ra1 []models.Record, err error) *AuditStorageMock {
+	e.results = &AuditStorageMockGetRecordsByPulseResults{ra1, err}
+	return e.mock
+}
+
+// GetRecordsByPulse implements interfaces.AuditStorage
+func (mmGetRecordsByPulse *AuditStorageMock) GetRecordsByPulse(pulseNumber int64, fromJetID string, fromOrder int, prototypes [][]byte, objectReferences [][]byte, recordTypes []string, limit int) (ra1 []models.Record, err error) {
+	mm_atomic.AddUint64(&mmGetRecordsByPulse.beforeGetRecordsByPulseCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRecordsByPulse.afterGetRecordsByPulseCounter, 1)
+
+	if mmGetRecordsByPulse.inspectFuncGetRecordsByPulse != nil {
+		mmGetRecordsByPulse.inspectFuncGetRecordsByPulse(pulseNumber, fromJetID, fromOrder, prototypes, objectReferences, recordTypes, limit)
+	}
+
+	mm_params := &AuditStorageMockGetRecordsByPulseParams{pulseNumber, fromJetID, fromOrder, prototypes, objectReferences, recordTypes, limit}
+
+	// Record call args
+	mmGetRecordsByPulse.GetRecordsByPulseMock.mutex.Lock()
+	mmGetRecordsByPulse.GetRecordsByPulseMock.callArgs = append(mmGetRecordsByPulse.GetRecordsByPulseMock.callArgs, mm_params)
+	mmGetRecordsByPulse.GetRecordsByPulseMock.mutex.Unlock()
+
+	for _, e := range mmGetRecordsByPulse.GetRecordsByPulseMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmGetRecordsByPulse.GetRecordsByPulseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRecordsByPulse.GetRecordsByPulseMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRecordsByPulse.GetRecordsByPulseMock.defaultExpectation.params
+		mm_got := AuditStorageMockGetRecordsByPulseParams{pulseNumber, fromJetID, fromOrder, prototypes, objectReferences, recordTypes, limit}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetRecordsByPulse.t.Errorf("AuditStorageMock.GetRecordsByPulse got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetRecordsByPulse.GetRecordsByPulseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetRecordsByPulse.t.Fatal("No results are set for the AuditStorageMock.GetRecordsByPulse")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmGetRecordsByPulse.funcGetRecordsByPulse != nil {
+		return mmGetRecordsByPulse.funcGetRecordsByPulse(pulseNumber, fromJetID, fromOrder, prototypes, objectReferences, recordTypes, limit)
+	}
+	mmGetRecordsByPulse.t.Fatalf("Unexpected call to AuditStorageMock.GetRecordsByPulse. %v %v %v %v %v %v %v", pulseNumber, fromJetID, fromOrder, prototypes, objectReferences, recordTypes, limit)
+	return
+}
+
+// GetRecordsByPulseAfterCounter returns a count of finished AuditStorageMock.GetRecordsByPulse invocations
+func (mmGetRecordsByPulse *AuditStorageMock) GetRecordsByPulseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRecordsByPulse.afterGetRecordsByPulseCounter)
+}
+
+// GetRecordsByPulseBeforeCounter returns a count of AuditStorageMock.GetRecordsByPulse invocations
+func (mmGetRecordsByPulse *AuditStorageMock) GetRecordsByPulseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRecordsByPulse.beforeGetRecordsByPulseCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditStorageMock.GetRecordsByPulse.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetRecordsByPulse *mAuditStorageMockGetRecordsByPulse) Calls() []*AuditStorageMockGetRecordsByPulseParams {
+	mmGetRecordsByPulse.mutex.RLock()
+
+	argCopy := make([]*AuditStorageMockGetRecordsByPulseParams, len(mmGetRecordsByPulse.callArgs))
+	copy(argCopy, mmGetRecordsByPulse.callArgs)
+
+	mmGetRecordsByPulse.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetRecordsByPulseDone returns true if the count of the GetRecordsByPulse invocations corresponds
+// the number of defined expectations
+func (m *AuditStorageMock) MinimockGetRecordsByPulseDone() bool {
+	for _, e := range m.GetRecordsByPulseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRecordsByPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetRecordsByPulseCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRecordsByPulse != nil && mm_atomic.LoadUint64(&m.afterGetRecordsByPulseCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetRecordsByPulseInspect logs each unmet expectation
+func (m *AuditStorageMock) MinimockGetRecordsByPulseInspect() {
+	for _, e := range m.GetRecordsByPulseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditStorageMock.GetRecordsByPulse with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRecordsByPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetRecordsByPulseCounter) < 1 {
+		if m.GetRecordsByPulseMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditStorageMock.GetRecordsByPulse")
+		} else {
+			m.t.Errorf("Expected call to AuditStorageMock.GetRecordsByPulse with params: %#v", *m.GetRecordsByPulseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRecordsByPulse != nil && mm_atomic.LoadUint64(&m.afterGetRecordsByPulseCounter) < 1 {
+		m.t.Error("Expected call to AuditStorageMock.GetRecordsByPulse")
+	}
+}
+
+type mAuditStorageMockGetSequentialPulse struct {
+	mock               *AuditStorageMock
+	defaultExpectation *AuditStorageMockGetSequentialPulseExpectation
+	expectations       []*AuditStorageMockGetSequentialPulseExpectation
+}
+
+// AuditStorageMockGetSequentialPulseExpectation specifies expectation struct of the AuditStorage.GetSequentialPulse
+type AuditStorageMockGetSequentialPulseExpectation struct {
+	mock *AuditStorageMock
+
+	results *AuditStorageMockGetSequentialPulseResults
+	Counter uint64
+}
+
+// AuditStorageMockGetSequentialPulseResults contains results of the AuditStorage.GetSequentialPulse
+type AuditStorageMockGetSequentialPulseResults struct {
+	p1  models.Pulse
+	err error
+}
+
+// Expect sets up expected params for AuditStorage.GetSequentialPulse
+func (mmGetSequentialPulse *mAuditStorageMockGetSequentialPulse) Expect() *mAuditStorageMockGetSequentialPulse {
+	if mmGetSequentialPulse.mock.funcGetSequentialPulse != nil {
+		mmGetSequentialPulse.mock.t.Fatalf("AuditStorageMock.GetSequentialPulse mock is already set by Set")
+	}
+
+	if mmGetSequentialPulse.defaultExpectation == nil {
+		mmGetSequentialPulse.defaultExpectation = &AuditStorageMockGetSequentialPulseExpectation{}
+	}
+
+	return mmGetSequentialPulse
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditStorage.GetSequentialPulse
+func (mmGetSequentialPulse *mAuditStorageMockGetSequentialPulse) Inspect(f func()) *mAuditStorageMockGetSequentialPulse {
+	if mmGetSequentialPulse.mock.inspectFuncGetSequentialPulse != nil {
+		mmGetSequentialPulse.mock.t.Fatalf("Inspect function is already set for AuditStorageMock.GetSequentialPulse")
+	}
+
+	mmGetSequentialPulse.mock.inspectFuncGetSequentialPulse = f
+
+	return mmGetSequentialPulse
+}
+
+// Return sets up results that will be returned by AuditStorage.GetSequentialPulse
+func (mmGetSequentialPulse *mAuditStorageMockGetSequentialPulse) Return(p1 models.Pulse, err error) *AuditStorageMock {
+	if mmGetSequentialPulse.mock.funcGetSequentialPulse != nil {
+		mmGetSequentialPulse.mock.t.Fatalf("AuditStorageMock.GetSequentialPulse mock is already set by Set")
+	}
+
+	if mmGetSequentialPulse.defaultExpectation == nil {
+		mmGetSequentialPulse.defaultExpectation = &AuditStorageMockGetSequentialPulseExpectation{mock: mmGetSequentialPulse.mock}
+	}
+	mmGetSequentialPulse.defaultExpectation.results = &AuditStorageMockGetSequentialPulseResults{p1, err}
+	return mmGetSequentialPulse.mock
+}
+
+//Set uses given function f to mock the AuditStorage.GetSequentialPulse method
+func (mmGetSequentialPulse *mAuditStorageMockGetSequentialPulse) Set(f func() (p1 models.Pulse, err error)) *AuditStorageMock {
+	if mmGetSequentialPulse.defaultExpectation != nil {
+		mmGetSequentialPulse.mock.t.Fatalf("Default expectation is already set for the AuditStorage.GetSequentialPulse method")
+	}
+
+	if len(mmGetSequentialPulse.expectations) > 0 {
+		mmGetSequentialPulse.mock.t.Fatalf("Some expectations are already set for the AuditStorage.GetSequentialPulse method")
+	}
+
+	mmGetSequentialPulse.mock.funcGetSequentialPulse = f
+	return mmGetSequentialPulse.mock
+}
+
+// GetSequentialPulse implements interfaces.AuditStorage
+func (mmGetSequentialPulse *AuditStorageMock) GetSequentialPulse() (p1 models.Pulse, err error) {
+	mm_atomic.AddUint64(&mmGetSequentialPulse.beforeGetSequentialPulseCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetSequentialPulse.afterGetSequentialPulseCounter, 1)
+
+	if mmGetSequentialPulse.inspectFuncGetSequentialPulse != nil {
+		mmGetSequentialPulse.inspectFuncGetSequentialPulse()
+	}
+
+	if mmGetSequentialPulse.GetSequentialPulseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetSequentialPulse.GetSequentialPulseMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmGetSequentialPulse.GetSequentialPulseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetSequentialPulse.t.Fatal("No results are set for the AuditStorageMock.GetSequentialPulse")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmGetSequentialPulse.funcGetSequentialPulse != nil {
+		return mmGetSequentialPulse.funcGetSequentialPulse()
+	}
+	mmGetSequentialPulse.t.Fatalf("Unexpected call to AuditStorageMock.GetSequentialPulse.")
+	return
+}
+
+// GetSequentialPulseAfterCounter returns a count of finished AuditStorageMock.GetSequentialPulse invocations
+func (mmGetSequentialPulse *AuditStorageMock) GetSequentialPulseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSequentialPulse.afterGetSequentialPulseCounter)
+}
+
+// GetSequentialPulseBeforeCounter returns a count of AuditStorageMock.GetSequentialPulse invocations
+func (mmGetSequentialPulse *AuditStorageMock) GetSequentialPulseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSequentialPulse.beforeGetSequentialPulseCounter)
+}
+
+// MinimockGetSequentialPulseDone returns true if the count of the GetSequentialPulse invocations corresponds
+// the number of defined expectations
+func (m *AuditStorageMock) MinimockGetSequentialPulseDone() bool {
+	for _, e := range m.GetSequentialPulseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSequentialPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetSequentialPulseCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSequentialPulse != nil && mm_atomic.LoadUint64(&m.afterGetSequentialPulseCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetSequentialPulseInspect logs each unmet expectation
+func (m *AuditStorageMock) MinimockGetSequentialPulseInspect() {
+	for _, e := range m.GetSequentialPulseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to AuditStorageMock.GetSequentialPulse")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSequentialPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetSequentialPulseCounter) < 1 {
+		m.t.Error("Expected call to AuditStorageMock.GetSequentialPulse")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSequentialPulse != nil && mm_atomic.LoadUint64(&m.afterGetSequentialPulseCounter) < 1 {
+		m.t.Error("Expected call to AuditStorageMock.GetSequentialPulse")
+	}
+}
+
+type mAuditStorageMockSaveAuditFindings struct {
+	mock               *AuditStorageMock
+	defaultExpectation *AuditStorageMockSaveAuditFindingsExpectation
+	expectations       []*AuditStorageMockSaveAuditFindingsExpectation
+
+	callArgs []*AuditStorageMockSaveAuditFindingsParams
+	mutex    sync.RWMutex
+}
+
+// AuditStorageMockSaveAuditFindingsExpectation specifies expectation struct of the AuditStorage.SaveAuditFindings
+type AuditStorageMockSaveAuditFindingsExpectation struct {
+	mock    *AuditStorageMock
+	params  *AuditStorageMockSaveAuditFindingsParams
+	results *AuditStorageMockSaveAuditFindingsResults
+	Counter uint64
+}
+
+// AuditStorageMockSaveAuditFindingsParams contains parameters of the AuditStorage.SaveAuditFindings
+type AuditStorageMockSaveAuditFindingsParams struct {
+	pulseNumber int64
+	findings    []models.AuditFinding
+}
+
+// AuditStorageMockSaveAuditFindingsResults contains results of the AuditStorage.SaveAuditFindings
+type AuditStorageMockSaveAuditFindingsResults struct {
+	err error
+}
+
+// Expect sets up expected params for AuditStorage.SaveAuditFindings
+func (mmSaveAuditFindings *mAuditStorageMockSaveAuditFindings) Expect(pulseNumber int64, findings []models.AuditFinding) *mAuditStorageMockSaveAuditFindings {
+	if mmSaveAuditFindings.mock.funcSaveAuditFindings != nil {
+		mmSaveAuditFindings.mock.t.Fatalf("AuditStorageMock.SaveAuditFindings mock is already set by Set")
+	}
+
+	if mmSaveAuditFindings.defaultExpectation == nil {
+		mmSaveAuditFindings.defaultExpectation = &AuditStorageMockSaveAuditFindingsExpectation{}
+	}
+
+	mmSaveAuditFindings.defaultExpectation.params = &AuditStorageMockSaveAuditFindingsParams{pulseNumber, findings}
+	for _, e := range mmSaveAuditFindings.expectations {
+		if minimock.Equal(e.params, mmSaveAuditFindings.defaultExpectation.params) {
+			mmSaveAuditFindings.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveAuditFindings.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveAuditFindings
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditStorage.SaveAuditFindings
+func (mmSaveAuditFindings *mAuditStorageMockSaveAuditFindings) Inspect(f func(pulseNumber int64, findings []models.AuditFinding)) *mAuditStorageMockSaveAuditFindings {
+	if mmSaveAuditFindings.mock.inspectFuncSaveAuditFindings != nil {
+		mmSaveAuditFindings.mock.t.Fatalf("Inspect function is already set for AuditStorageMock.SaveAuditFindings")
+	}
+
+	mmSaveAuditFindings.mock.inspectFuncSaveAuditFindings = f
+
+	return mmSaveAuditFindings
+}
+
+// Return sets up results that will be returned by AuditStorage.SaveAuditFindings
+func (mmSaveAuditFindings *mAuditStorageMockSaveAuditFindings) Return(err error) *AuditStorageMock {
+	if mmSaveAuditFindings.mock.funcSaveAuditFindings != nil {
+		mmSaveAuditFindings.mock.t.Fatalf("AuditStorageMock.SaveAuditFindings mock is already set by Set")
+	}
+
+	if mmSaveAuditFindings.defaultExpectation == nil {
+		mmSaveAuditFindings.defaultExpectation = &AuditStorageMockSaveAuditFindingsExpectation{mock: mmSaveAuditFindings.mock}
+	}
+	mmSaveAuditFindings.defaultExpectation.results = &AuditStorageMockSaveAuditFindingsResults{err}
+	return mmSaveAuditFindings.mock
+}
+
+//Set uses given function f to mock the AuditStorage.SaveAuditFindings method
+func (mmSaveAuditFindings *mAuditStorageMockSaveAuditFindings) Set(f func(pulseNumber int64, findings []models.AuditFinding) (err error)) *AuditStorageMock {
+	if mmSaveAuditFindings.defaultExpectation != nil {
+		mmSaveAuditFindings.mock.t.Fatalf("Default expectation is already set for the AuditStorage.SaveAuditFindings method")
+	}
+
+	if len(mmSaveAuditFindings.expectations) > 0 {
+		mmSaveAuditFindings.mock.t.Fatalf("Some expectations are already set for the AuditStorage.SaveAuditFindings method")
+	}
+
+	mmSaveAuditFindings.mock.funcSaveAuditFindings = f
+	return mmSaveAuditFindings.mock
+}
+
+// When sets expectation for the AuditStorage.SaveAuditFindings which will trigger the result defined by the following
+// Then helper
+func (mmSaveAuditFindings *mAuditStorageMockSaveAuditFindings) When(pulseNumber int64, findings []models.AuditFinding) *AuditStorageMockSaveAuditFindingsExpectation {
+	if mmSaveAuditFindings.mock.funcSaveAuditFindings != nil {
+		mmSaveAuditFindings.mock.t.Fatalf("AuditStorageMock.SaveAuditFindings mock is already set by Set")
+	}
+
+	expectation := &AuditStorageMockSaveAuditFindingsExpectation{
+		mock:   mmSaveAuditFindings.mock,
+		params: &AuditStorageMockSaveAuditFindingsParams{pulseNumber, findings},
+	}
+	mmSaveAuditFindings.expectations = append(mmSaveAuditFindings.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditStorage.SaveAuditFindings return parameters for the expectation previously defined by the When method
+func (e *AuditStorageMockSaveAuditFindingsExpectation) Then(err error) *AuditStorageMock {
+	e.results = &AuditStorageMockSaveAuditFindingsResults{err}
+	return e.mock
+}
+
+// SaveAuditFindings implements interfaces.AuditStorage
+func (mmSaveAuditFindings *AuditStorageMock) SaveAuditFindings(pulseNumber int64, findings []models.AuditFinding) (err error) {
+	mm_atomic.AddUint64(&mmSaveAuditFindings.beforeSaveAuditFindingsCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveAuditFindings.afterSaveAuditFindingsCounter, 1)
+
+	if mmSaveAuditFindings.inspectFuncSaveAuditFindings != nil {
+		mmSaveAuditFindings.inspectFuncSaveAuditFindings(pulseNumber, findings)
+	}
+
+	mm_params := &AuditStorageMockSaveAuditFindingsParams{pulseNumber, findings}
+
+	// Record call args
+	mmSaveAuditFindings.SaveAuditFindingsMock.mutex.Lock()
+	mmSaveAuditFindings.SaveAuditFindingsMock.callArgs = append(mmSaveAuditFindings.SaveAuditFindingsMock.callArgs, mm_params)
+	mmSaveAuditFindings.SaveAuditFindingsMock.mutex.Unlock()
+
+	for _, e := range mmSaveAuditFindings.SaveAuditFindingsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveAuditFindings.SaveAuditFindingsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveAuditFindings.SaveAuditFindingsMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveAuditFindings.SaveAuditFindingsMock.defaultExpectation.params
+		mm_got := AuditStorageMockSaveAuditFindingsParams{pulseNumber, findings}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveAuditFindings.t.Errorf("AuditStorageMock.SaveAuditFindings got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveAuditFindings.SaveAuditFindingsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveAuditFindings.t.Fatal("No results are set for the AuditStorageMock.SaveAuditFindings")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveAuditFindings.funcSaveAuditFindings != nil {
+		return mmSaveAuditFindings.funcSaveAuditFindings(pulseNumber, findings)
+	}
+	mmSaveAuditFindings.t.Fatalf("Unexpected call to AuditStorageMock.SaveAuditFindings. %v %v", pulseNumber, findings)
+	return
+}
+
+// SaveAuditFindingsAfterCounter returns a count of finished AuditStorageMock.SaveAuditFindings invocations
+func (mmSaveAuditFindings *AuditStorageMock) SaveAuditFindingsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveAuditFindings.afterSaveAuditFindingsCounter)
+}
+
+// SaveAuditFindingsBeforeCounter returns a count of AuditStorageMock.SaveAuditFindings invocations
+func (mmSaveAuditFindings *AuditStorageMock) SaveAuditFindingsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveAuditFindings.beforeSaveAuditFindingsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditStorageMock.SaveAuditFindings.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveAuditFindings *mAuditStorageMockSaveAuditFindings) Calls() []*AuditStorageMockSaveAuditFindingsParams {
+	mmSaveAuditFindings.mutex.RLock()
+
+	argCopy := make([]*AuditStorageMockSaveAuditFindingsParams, len(mmSaveAuditFindings.callArgs))
+	copy(argCopy, mmSaveAuditFindings.callArgs)
+
+	mmSaveAuditFindings.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveAuditFindingsDone returns true if the count of the SaveAuditFindings invocations corresponds
+// the number of defined expectations
+func (m *AuditStorageMock) MinimockSaveAuditFindingsDone() bool {
+	for _, e := range m.SaveAuditFindingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveAuditFindingsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveAuditFindingsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveAuditFindings != nil && mm_atomic.LoadUint64(&m.afterSaveAuditFindingsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSaveAuditFindingsInspect logs each unmet expectation
+func (m *AuditStorageMock) MinimockSaveAuditFindingsInspect() {
+	for _, e := range m.SaveAuditFindingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditStorageMock.SaveAuditFindings with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveAuditFindingsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveAuditFindingsCounter) < 1 {
+		if m.SaveAuditFindingsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditStorageMock.SaveAuditFindings")
+		} else {
+			m.t.Errorf("Expected call to AuditStorageMock.SaveAuditFindings with params: %#v", *m.SaveAuditFindingsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveAuditFindings != nil && mm_atomic.LoadUint64(&m.afterSaveAuditFindingsCounter) < 1 {
+		m.t.Error("Expected call to AuditStorageMock.SaveAuditFindings")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuditStorageMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockGetJetDropsInspect()
+
+		m.MinimockGetNextSequentialPulseInspect()
+
+		m.MinimockGetRecordsByPulseInspect()
+
+		m.MinimockGetSequentialPulseInspect()
+
+		m.MinimockSaveAuditFindingsInspect()
+		m.t.FailNow()
+	}
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuditStorageMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuditStorageMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetJetDropsDone() &&
+		m.MinimockGetNextSequentialPulseDone() &&
+		m.MinimockGetRecordsByPulseDone() &&
+		m.MinimockGetSequentialPulseDone() &&
+		m.MinimockSaveAuditFindingsDone()
+}
@@ -0,0 +1,1120 @@
+package mock
+
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+import (
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/insolar/block-explorer/etl/models"
+)
+
+// CheckpointStorageMock implements interfaces.CheckpointStorage
+type CheckpointStorageMock struct {
+	t minimock.Tester
+
+	funcDeleteReloadRanges          func(toPulseNumber int64) (err error)
+	inspectFuncDeleteReloadRanges   func(toPulseNumber int64)
+	afterDeleteReloadRangesCounter  uint64
+	beforeDeleteReloadRangesCounter uint64
+	DeleteReloadRangesMock          mCheckpointStorageMockDeleteReloadRanges
+
+	funcGetCheckpoint          func(name string) (c1 models.Checkpoint, err error)
+	inspectFuncGetCheckpoint   func(name string)
+	afterGetCheckpointCounter  uint64
+	beforeGetCheckpointCounter uint64
+	GetCheckpointMock          mCheckpointStorageMockGetCheckpoint
+
+	funcGetReloadRanges          func() (ra1 []models.ReloadRange, err error)
+	inspectFuncGetReloadRanges   func()
+	afterGetReloadRangesCounter  uint64
+	beforeGetReloadRangesCounter uint64
+	GetReloadRangesMock          mCheckpointStorageMockGetReloadRanges
+
+	funcSaveCheckpoint          func(checkpoint models.Checkpoint) (err error)
+	inspectFuncSaveCheckpoint   func(checkpoint models.Checkpoint)
+	afterSaveCheckpointCounter  uint64
+	beforeSaveCheckpointCounter uint64
+	SaveCheckpointMock          mCheckpointStorageMockSaveCheckpoint
+
+	funcSaveReloadAttempt          func(fromPulseNumber int64, toPulseNumber int64) (r1 models.ReloadRange, err error)
+	inspectFuncSaveReloadAttempt   func(fromPulseNumber int64, toPulseNumber int64)
+	afterSaveReloadAttemptCounter  uint64
+	beforeSaveReloadAttemptCounter uint64
+	SaveReloadAttemptMock          mCheckpointStorageMockSaveReloadAttempt
+}
+
+// NewCheckpointStorageMock returns a mock for interfaces.CheckpointStorage
+func NewCheckpointStorageMock(t minimock.Tester) *CheckpointStorageMock {
+	m := &CheckpointStorageMock{t: t}
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DeleteReloadRangesMock = mCheckpointStorageMockDeleteReloadRanges{mock: m}
+	m.DeleteReloadRangesMock.callArgs = []*CheckpointStorageMockDeleteReloadRangesParams{}
+
+	m.GetCheckpointMock = mCheckpointStorageMockGetCheckpoint{mock: m}
+	m.GetCheckpointMock.callArgs = []*CheckpointStorageMockGetCheckpointParams{}
+
+	m.GetReloadRangesMock = mCheckpointStorageMockGetReloadRanges{mock: m}
+
+	m.SaveCheckpointMock = mCheckpointStorageMockSaveCheckpoint{mock: m}
+	m.SaveCheckpointMock.callArgs = []*CheckpointStorageMockSaveCheckpointParams{}
+
+	m.SaveReloadAttemptMock = mCheckpointStorageMockSaveReloadAttempt{mock: m}
+	m.SaveReloadAttemptMock.callArgs = []*CheckpointStorageMockSaveReloadAttemptParams{}
+
+	return m
+}
+
+type mCheckpointStorageMockDeleteReloadRanges struct {
+	mock               *CheckpointStorageMock
+	defaultExpectation *CheckpointStorageMockDeleteReloadRangesExpectation
+	expectations       []*CheckpointStorageMockDeleteReloadRangesExpectation
+
+	callArgs []*CheckpointStorageMockDeleteReloadRangesParams
+	mutex    sync.RWMutex
+}
+
+// CheckpointStorageMockDeleteReloadRangesExpectation specifies expectation struct of the CheckpointStorage.DeleteReloadRanges
+type CheckpointStorageMockDeleteReloadRangesExpectation struct {
+	mock    *CheckpointStorageMock
+	params  *CheckpointStorageMockDeleteReloadRangesParams
+	results *CheckpointStorageMockDeleteReloadRangesResults
+	Counter uint64
+}
+
+// CheckpointStorageMockDeleteReloadRangesParams contains parameters of the CheckpointStorage.DeleteReloadRanges
+type CheckpointStorageMockDeleteReloadRangesParams struct {
+	toPulseNumber int64
+}
+
+// CheckpointStorageMockDeleteReloadRangesResults contains results of the CheckpointStorage.DeleteReloadRanges
+type CheckpointStorageMockDeleteReloadRangesResults struct {
+	err error
+}
+
+// Expect sets up expected params for CheckpointStorage.DeleteReloadRanges
+func (mmDeleteReloadRanges *mCheckpointStorageMockDeleteReloadRanges) Expect(toPulseNumber int64) *mCheckpointStorageMockDeleteReloadRanges {
+	if mmDeleteReloadRanges.mock.funcDeleteReloadRanges != nil {
+		mmDeleteReloadRanges.mock.t.Fatalf("CheckpointStorageMock.DeleteReloadRanges mock is already set by Set")
+	}
+
+	if mmDeleteReloadRanges.defaultExpectation == nil {
+		mmDeleteReloadRanges.defaultExpectation = &CheckpointStorageMockDeleteReloadRangesExpectation{}
+	}
+
+	mmDeleteReloadRanges.defaultExpectation.params = &CheckpointStorageMockDeleteReloadRangesParams{toPulseNumber}
+	for _, e := range mmDeleteReloadRanges.expectations {
+		if minimock.Equal(e.params, mmDeleteReloadRanges.defaultExpectation.params) {
+			mmDeleteReloadRanges.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteReloadRanges.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteReloadRanges
+}
+
+// Inspect accepts an inspector function that has same arguments as the CheckpointStorage.DeleteReloadRanges
+func (mmDeleteReloadRanges *mCheckpointStorageMockDeleteReloadRanges) Inspect(f func(toPulseNumber int64)) *mCheckpointStorageMockDeleteReloadRanges {
+	if mmDeleteReloadRanges.mock.inspectFuncDeleteReloadRanges != nil {
+		mmDeleteReloadRanges.mock.t.Fatalf("Inspect function is already set for CheckpointStorageMock.DeleteReloadRanges")
+	}
+
+	mmDeleteReloadRanges.mock.inspectFuncDeleteReloadRanges = f
+
+	return mmDeleteReloadRanges
+}
+
+// Return sets up results that will be returned by CheckpointStorage.DeleteReloadRanges
+func (mmDeleteReloadRanges *mCheckpointStorageMockDeleteReloadRanges) Return(err error) *CheckpointStorageMock {
+	if mmDeleteReloadRanges.mock.funcDeleteReloadRanges != nil {
+		mmDeleteReloadRanges.mock.t.Fatalf("CheckpointStorageMock.DeleteReloadRanges mock is already set by Set")
+	}
+
+	if mmDeleteReloadRanges.defaultExpectation == nil {
+		mmDeleteReloadRanges.defaultExpectation = &CheckpointStorageMockDeleteReloadRangesExpectation{mock: mmDeleteReloadRanges.mock}
+	}
+	mmDeleteReloadRanges.defaultExpectation.results = &CheckpointStorageMockDeleteReloadRangesResults{err}
+	return mmDeleteReloadRanges.mock
+}
+
+//Set uses given function f to mock the CheckpointStorage.DeleteReloadRanges method
+func (mmDeleteReloadRanges *mCheckpointStorageMockDeleteReloadRanges) Set(f func(toPulseNumber int64) (err error)) *CheckpointStorageMock {
+	if mmDeleteReloadRanges.defaultExpectation != nil {
+		mmDeleteReloadRanges.mock.t.Fatalf("Default expectation is already set for the CheckpointStorage.DeleteReloadRanges method")
+	}
+
+	if len(mmDeleteReloadRanges.expectations) > 0 {
+		mmDeleteReloadRanges.mock.t.Fatalf("Some expectations are already set for the CheckpointStorage.DeleteReloadRanges method")
+	}
+
+	mmDeleteReloadRanges.mock.funcDeleteReloadRanges = f
+	return mmDeleteReloadRanges.mock
+}
+
+// When sets expectation for the CheckpointStorage.DeleteReloadRanges which will trigger the result defined by the following
+// Then helper
+func (mmDeleteReloadRanges *mCheckpointStorageMockDeleteReloadRanges) When(toPulseNumber int64) *CheckpointStorageMockDeleteReloadRangesExpectation {
+	if mmDeleteReloadRanges.mock.funcDeleteReloadRanges != nil {
+		mmDeleteReloadRanges.mock.t.Fatalf("CheckpointStorageMock.DeleteReloadRanges mock is already set by Set")
+	}
+
+	expectation := &CheckpointStorageMockDeleteReloadRangesExpectation{
+		mock:   mmDeleteReloadRanges.mock,
+		params: &CheckpointStorageMockDeleteReloadRangesParams{toPulseNumber},
+	}
+	mmDeleteReloadRanges.expectations = append(mmDeleteReloadRanges.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CheckpointStorage.DeleteReloadRanges return parameters for the expectation previously defined by the When method
+func (e *CheckpointStorageMockDeleteReloadRangesExpectation) Then(err error) *CheckpointStorageMock {
+	e.results = &CheckpointStorageMockDeleteReloadRangesResults{err}
+	return e.mock
+}
+
+// DeleteReloadRanges implements interfaces.CheckpointStorage
+func (mmDeleteReloadRanges *CheckpointStorageMock) DeleteReloadRanges(toPulseNumber int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteReloadRanges.beforeDeleteReloadRangesCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteReloadRanges.afterDeleteReloadRangesCounter, 1)
+
+	if mmDeleteReloadRanges.inspectFuncDeleteReloadRanges != nil {
+		mmDeleteReloadRanges.inspectFuncDeleteReloadRanges(toPulseNumber)
+	}
+
+	mm_params := &CheckpointStorageMockDeleteReloadRangesParams{toPulseNumber}
+
+	// Record call args
+	mmDeleteReloadRanges.DeleteReloadRangesMock.mutex.Lock()
+	mmDeleteReloadRanges.DeleteReloadRangesMock.callArgs = append(mmDeleteReloadRanges.DeleteReloadRangesMock.callArgs, mm_params)
+	mmDeleteReloadRanges.DeleteReloadRangesMock.mutex.Unlock()
+
+	for _, e := range mmDeleteReloadRanges.DeleteReloadRangesMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteReloadRanges.DeleteReloadRangesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteReloadRanges.DeleteReloadRangesMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteReloadRanges.DeleteReloadRangesMock.defaultExpectation.params
+		mm_got := CheckpointStorageMockDeleteReloadRangesParams{toPulseNumber}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteReloadRanges.t.Errorf("CheckpointStorageMock.DeleteReloadRanges got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteReloadRanges.DeleteReloadRangesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteReloadRanges.t.Fatal("No results are set for the CheckpointStorageMock.DeleteReloadRanges")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteReloadRanges.funcDeleteReloadRanges != nil {
+		return mmDeleteReloadRanges.funcDeleteReloadRanges(toPulseNumber)
+	}
+	mmDeleteReloadRanges.t.Fatalf("Unexpected call to CheckpointStorageMock.DeleteReloadRanges. %v", toPulseNumber)
+	return
+}
+
+// DeleteReloadRangesAfterCounter returns a count of finished CheckpointStorageMock.DeleteReloadRanges invocations
+func (mmDeleteReloadRanges *CheckpointStorageMock) DeleteReloadRangesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteReloadRanges.afterDeleteReloadRangesCounter)
+}
+
+// DeleteReloadRangesBeforeCounter returns a count of CheckpointStorageMock.DeleteReloadRanges invocations
+func (mmDeleteReloadRanges *CheckpointStorageMock) DeleteReloadRangesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteReloadRanges.beforeDeleteReloadRangesCounter)
+}
+
+// Calls returns a list of arguments used in each call to CheckpointStorageMock.DeleteReloadRanges.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteReloadRanges *mCheckpointStorageMockDeleteReloadRanges) Calls() []*CheckpointStorageMockDeleteReloadRangesParams {
+	mmDeleteReloadRanges.mutex.RLock()
+
+	argCopy := make([]*CheckpointStorageMockDeleteReloadRangesParams, len(mmDeleteReloadRanges.callArgs))
+	copy(argCopy, mmDeleteReloadRanges.callArgs)
+
+	mmDeleteReloadRanges.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteReloadRangesDone returns true if the count of the DeleteReloadRanges invocations corresponds
+// the number of defined expectations
+func (m *CheckpointStorageMock) MinimockDeleteReloadRangesDone() bool {
+	for _, e := range m.DeleteReloadRangesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteReloadRangesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteReloadRangesCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteReloadRanges != nil && mm_atomic.LoadUint64(&m.afterDeleteReloadRangesCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDeleteReloadRangesInspect logs each unmet expectation
+func (m *CheckpointStorageMock) MinimockDeleteReloadRangesInspect() {
+	for _, e := range m.DeleteReloadRangesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CheckpointStorageMock.DeleteReloadRanges with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteReloadRangesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteReloadRangesCounter) < 1 {
+		if m.DeleteReloadRangesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to CheckpointStorageMock.DeleteReloadRanges")
+		} else {
+			m.t.Errorf("Expected call to CheckpointStorageMock.DeleteReloadRanges with params: %#v", *m.DeleteReloadRangesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteReloadRanges != nil && mm_atomic.LoadUint64(&m.afterDeleteReloadRangesCounter) < 1 {
+		m.t.Error("Expected call to CheckpointStorageMock.DeleteReloadRanges")
+	}
+}
+
+type mCheckpointStorageMockGetCheckpoint struct {
+	mock               *CheckpointStorageMock
+	defaultExpectation *CheckpointStorageMockGetCheckpointExpectation
+	expectations       []*CheckpointStorageMockGetCheckpointExpectation
+
+	callArgs []*CheckpointStorageMockGetCheckpointParams
+	mutex    sync.RWMutex
+}
+
+// CheckpointStorageMockGetCheckpointExpectation specifies expectation struct of the CheckpointStorage.GetCheckpoint
+type CheckpointStorageMockGetCheckpointExpectation struct {
+	mock    *CheckpointStorageMock
+	params  *CheckpointStorageMockGetCheckpointParams
+	results *CheckpointStorageMockGetCheckpointResults
+	Counter uint64
+}
+
+// CheckpointStorageMockGetCheckpointParams contains parameters of the CheckpointStorage.GetCheckpoint
+type CheckpointStorageMockGetCheckpointParams struct {
+	name string
+}
+
+// CheckpointStorageMockGetCheckpointResults contains results of the CheckpointStorage.GetCheckpoint
+type CheckpointStorageMockGetCheckpointResults struct {
+	c1  models.Checkpoint
+	err error
+}
+
+// Expect sets up expected params for CheckpointStorage.GetCheckpoint
+func (mmGetCheckpoint *mCheckpointStorageMockGetCheckpoint) Expect(name string) *mCheckpointStorageMockGetCheckpoint {
+	if mmGetCheckpoint.mock.funcGetCheckpoint != nil {
+		mmGetCheckpoint.mock.t.Fatalf("CheckpointStorageMock.GetCheckpoint mock is already set by Set")
+	}
+
+	if mmGetCheckpoint.defaultExpectation == nil {
+		mmGetCheckpoint.defaultExpectation = &CheckpointStorageMockGetCheckpointExpectation{}
+	}
+
+	mmGetCheckpoint.defaultExpectation.params = &CheckpointStorageMockGetCheckpointParams{name}
+	for _, e := range mmGetCheckpoint.expectations {
+		if minimock.Equal(e.params, mmGetCheckpoint.defaultExpectation.params) {
+			mmGetCheckpoint.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCheckpoint.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCheckpoint
+}
+
+// Inspect accepts an inspector function that has same arguments as the CheckpointStorage.GetCheckpoint
+func (mmGetCheckpoint *mCheckpointStorageMockGetCheckpoint) Inspect(f func(name string)) *mCheckpointStorageMockGetCheckpoint {
+	if mmGetCheckpoint.mock.inspectFuncGetCheckpoint != nil {
+		mmGetCheckpoint.mock.t.Fatalf("Inspect function is already set for CheckpointStorageMock.GetCheckpoint")
+	}
+
+	mmGetCheckpoint.mock.inspectFuncGetCheckpoint = f
+
+	return mmGetCheckpoint
+}
+
+// Return sets up results that will be returned by CheckpointStorage.GetCheckpoint
+func (mmGetCheckpoint *mCheckpointStorageMockGetCheckpoint) Return(c1 models.Checkpoint, err error) *CheckpointStorageMock {
+	if mmGetCheckpoint.mock.funcGetCheckpoint != nil {
+		mmGetCheckpoint.mock.t.Fatalf("CheckpointStorageMock.GetCheckpoint mock is already set by Set")
+	}
+
+	if mmGetCheckpoint.defaultExpectation == nil {
+		mmGetCheckpoint.defaultExpectation = &CheckpointStorageMockGetCheckpointExpectation{mock: mmGetCheckpoint.mock}
+	}
+	mmGetCheckpoint.defaultExpectation.results = &CheckpointStorageMockGetCheckpointResults{c1, err}
+	return mmGetCheckpoint.mock
+}
+
+//Set uses given function f to mock the CheckpointStorage.GetCheckpoint method
+func (mmGetCheckpoint *mCheckpointStorageMockGetCheckpoint) Set(f func(name string) (c1 models.Checkpoint, err error)) *CheckpointStorageMock {
+	if mmGetCheckpoint.defaultExpectation != nil {
+		mmGetCheckpoint.mock.t.Fatalf("Default expectation is already set for the CheckpointStorage.GetCheckpoint method")
+	}
+
+	if len(mmGetCheckpoint.expectations) > 0 {
+		mmGetCheckpoint.mock.t.Fatalf("Some expectations are already set for the CheckpointStorage.GetCheckpoint method")
+	}
+
+	mmGetCheckpoint.mock.funcGetCheckpoint = f
+	return mmGetCheckpoint.mock
+}
+
+// When sets expectation for the CheckpointStorage.GetCheckpoint which will trigger the result defined by the following
+// Then helper
+func (mmGetCheckpoint *mCheckpointStorageMockGetCheckpoint) When(name string) *CheckpointStorageMockGetCheckpointExpectation {
+	if mmGetCheckpoint.mock.funcGetCheckpoint != nil {
+		mmGetCheckpoint.mock.t.Fatalf("CheckpointStorageMock.GetCheckpoint mock is already set by Set")
+	}
+
+	expectation := &CheckpointStorageMockGetCheckpointExpectation{
+		mock:   mmGetCheckpoint.mock,
+		params: &CheckpointStorageMockGetCheckpointParams{name},
+	}
+	mmGetCheckpoint.expectations = append(mmGetCheckpoint.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CheckpointStorage.GetCheckpoint return parameters for the expectation previously defined by the When method
+func (e *CheckpointStorageMockGetCheckpointExpectation) Then(c1 models.Checkpoint, err error) *CheckpointStorageMock {
+	e.results = &CheckpointStorageMockGetCheckpointResults{c1, err}
+	return e.mock
+}
+
+// GetCheckpoint implements interfaces.CheckpointStorage
+func (mmGetCheckpoint *CheckpointStorageMock) GetCheckpoint(name string) (c1 models.Checkpoint, err error) {
+	mm_atomic.AddUint64(&mmGetCheckpoint.beforeGetCheckpointCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCheckpoint.afterGetCheckpointCounter, 1)
+
+	if mmGetCheckpoint.inspectFuncGetCheckpoint != nil {
+		mmGetCheckpoint.inspectFuncGetCheckpoint(name)
+	}
+
+	mm_params := &CheckpointStorageMockGetCheckpointParams{name}
+
+	// Record call args
+	mmGetCheckpoint.GetCheckpointMock.mutex.Lock()
+	mmGetCheckpoint.GetCheckpointMock.callArgs = append(mmGetCheckpoint.GetCheckpointMock.callArgs, mm_params)
+	mmGetCheckpoint.GetCheckpointMock.mutex.Unlock()
+
+	for _, e := range mmGetCheckpoint.GetCheckpointMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.c1, e.results.err
+		}
+	}
+
+	if mmGetCheckpoint.GetCheckpointMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCheckpoint.GetCheckpointMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCheckpoint.GetCheckpointMock.defaultExpectation.params
+		mm_got := CheckpointStorageMockGetCheckpointParams{name}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCheckpoint.t.Errorf("CheckpointStorageMock.GetCheckpoint got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCheckpoint.GetCheckpointMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCheckpoint.t.Fatal("No results are set for the CheckpointStorageMock.GetCheckpoint")
+		}
+		return (*mm_results).c1, (*mm_results).err
+	}
+	if mmGetCheckpoint.funcGetCheckpoint != nil {
+		return mmGetCheckpoint.funcGetCheckpoint(name)
+	}
+	mmGetCheckpoint.t.Fatalf("Unexpected call to CheckpointStorageMock.GetCheckpoint. %v", name)
+	return
+}
+
+// GetCheckpointAfterCounter returns a count of finished CheckpointStorageMock.GetCheckpoint invocations
+func (mmGetCheckpoint *CheckpointStorageMock) GetCheckpointAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCheckpoint.afterGetCheckpointCounter)
+}
+
+// GetCheckpointBeforeCounter returns a count of CheckpointStorageMock.GetCheckpoint invocations
+func (mmGetCheckpoint *CheckpointStorageMock) GetCheckpointBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCheckpoint.beforeGetCheckpointCounter)
+}
+
+// Calls returns a list of arguments used in each call to CheckpointStorageMock.GetCheckpoint.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCheckpoint *mCheckpointStorageMockGetCheckpoint) Calls() []*CheckpointStorageMockGetCheckpointParams {
+	mmGetCheckpoint.mutex.RLock()
+
+	argCopy := make([]*CheckpointStorageMockGetCheckpointParams, len(mmGetCheckpoint.callArgs))
+	copy(argCopy, mmGetCheckpoint.callArgs)
+
+	mmGetCheckpoint.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCheckpointDone returns true if the count of the GetCheckpoint invocations corresponds
+// the number of defined expectations
+func (m *CheckpointStorageMock) MinimockGetCheckpointDone() bool {
+	for _, e := range m.GetCheckpointMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCheckpointMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetCheckpointCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCheckpoint != nil && mm_atomic.LoadUint64(&m.afterGetCheckpointCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetCheckpointInspect logs each unmet expectation
+func (m *CheckpointStorageMock) MinimockGetCheckpointInspect() {
+	for _, e := range m.GetCheckpointMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CheckpointStorageMock.GetCheckpoint with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCheckpointMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetCheckpointCounter) < 1 {
+		if m.GetCheckpointMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to CheckpointStorageMock.GetCheckpoint")
+		} else {
+			m.t.Errorf("Expected call to CheckpointStorageMock.GetCheckpoint with params: %#v", *m.GetCheckpointMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCheckpoint != nil && mm_atomic.LoadUint64(&m.afterGetCheckpointCounter) < 1 {
+		m.t.Error("Expected call to CheckpointStorageMock.GetCheckpoint")
+	}
+}
+
+type mCheckpointStorageMockGetReloadRanges struct {
+	mock               *CheckpointStorageMock
+	defaultExpectation *CheckpointStorageMockGetReloadRangesExpectation
+	expectations       []*CheckpointStorageMockGetReloadRangesExpectation
+}
+
+// CheckpointStorageMockGetReloadRangesExpectation specifies expectation struct of the CheckpointStorage.GetReloadRanges
+type CheckpointStorageMockGetReloadRangesExpectation struct {
+	mock *CheckpointStorageMock
+
+	results *CheckpointStorageMockGetReloadRangesResults
+	Counter uint64
+}
+
+// CheckpointStorageMockGetReloadRangesResults contains results of the CheckpointStorage.GetReloadRanges
+type CheckpointStorageMockGetReloadRangesResults struct {
+	ra1 []models.ReloadRange
+	err error
+}
+
+// Expect sets up expected params for CheckpointStorage.GetReloadRanges
+func (mmGetReloadRanges *mCheckpointStorageMockGetReloadRanges) Expect() *mCheckpointStorageMockGetReloadRanges {
+	if mmGetReloadRanges.mock.funcGetReloadRanges != nil {
+		mmGetReloadRanges.mock.t.Fatalf("CheckpointStorageMock.GetReloadRanges mock is already set by Set")
+	}
+
+	if mmGetReloadRanges.defaultExpectation == nil {
+		mmGetReloadRanges.defaultExpectation = &CheckpointStorageMockGetReloadRangesExpectation{}
+	}
+
+	return mmGetReloadRanges
+}
+
+// Inspect accepts an inspector function that has same arguments as the CheckpointStorage.GetReloadRanges
+func (mmGetReloadRanges *mCheckpointStorageMockGetReloadRanges) Inspect(f func()) *mCheckpointStorageMockGetReloadRanges {
+	if mmGetReloadRanges.mock.inspectFuncGetReloadRanges != nil {
+		mmGetReloadRanges.mock.t.Fatalf("Inspect function is already set for CheckpointStorageMock.GetReloadRanges")
+	}
+
+	mmGetReloadRanges.mock.inspectFuncGetReloadRanges = f
+
+	return mmGetReloadRanges
+}
+
+// Return sets up results that will be returned by CheckpointStorage.GetReloadRanges
+func (mmGetReloadRanges *mCheckpointStorageMockGetReloadRanges) Return(ra1 []models.ReloadRange, err error) *CheckpointStorageMock {
+	if mmGetReloadRanges.mock.funcGetReloadRanges != nil {
+		mmGetReloadRanges.mock.t.Fatalf("CheckpointStorageMock.GetReloadRanges mock is already set by Set")
+	}
+
+	if mmGetReloadRanges.defaultExpectation == nil {
+		mmGetReloadRanges.defaultExpectation = &CheckpointStorageMockGetReloadRangesExpectation{mock: mmGetReloadRanges.mock}
+	}
+	mmGetReloadRanges.defaultExpectation.results = &CheckpointStorageMockGetReloadRangesResults{ra1, err}
+	return mmGetReloadRanges.mock
+}
+
+//Set uses given function f to mock the CheckpointStorage.GetReloadRanges method
+func (mmGetReloadRanges *mCheckpointStorageMockGetReloadRanges) Set(f func() (ra1 []models.ReloadRange, err error)) *CheckpointStorageMock {
+	if mmGetReloadRanges.defaultExpectation != nil {
+		mmGetReloadRanges.mock.t.Fatalf("Default expectation is already set for the CheckpointStorage.GetReloadRanges method")
+	}
+
+	if len(mmGetReloadRanges.expectations) > 0 {
+		mmGetReloadRanges.mock.t.Fatalf("Some expectations are already set for the CheckpointStorage.GetReloadRanges method")
+	}
+
+	mmGetReloadRanges.mock.funcGetReloadRanges = f
+	return mmGetReloadRanges.mock
+}
+
+// GetReloadRanges implements interfaces.CheckpointStorage
+func (mmGetReloadRanges *CheckpointStorageMock) GetReloadRanges() (ra1 []models.ReloadRange, err error) {
+	mm_atomic.AddUint64(&mmGetReloadRanges.beforeGetReloadRangesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetReloadRanges.afterGetReloadRangesCounter, 1)
+
+	if mmGetReloadRanges.inspectFuncGetReloadRanges != nil {
+		mmGetReloadRanges.inspectFuncGetReloadRanges()
+	}
+
+	if mmGetReloadRanges.GetReloadRangesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetReloadRanges.GetReloadRangesMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmGetReloadRanges.GetReloadRangesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetReloadRanges.t.Fatal("No results are set for the CheckpointStorageMock.GetReloadRanges")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmGetReloadRanges.funcGetReloadRanges != nil {
+		return mmGetReloadRanges.funcGetReloadRanges()
+	}
+	mmGetReloadRanges.t.Fatalf("Unexpected call to CheckpointStorageMock.GetReloadRanges.")
+	return
+}
+
+// GetReloadRangesAfterCounter returns a count of finished CheckpointStorageMock.GetReloadRanges invocations
+func (mmGetReloadRanges *CheckpointStorageMock) GetReloadRangesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReloadRanges.afterGetReloadRangesCounter)
+}
+
+// GetReloadRangesBeforeCounter returns a count of CheckpointStorageMock.GetReloadRanges invocations
+func (mmGetReloadRanges *CheckpointStorageMock) GetReloadRangesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReloadRanges.beforeGetReloadRangesCounter)
+}
+
+// MinimockGetReloadRangesDone returns true if the count of the GetReloadRanges invocations corresponds
+// the number of defined expectations
+func (m *CheckpointStorageMock) MinimockGetReloadRangesDone() bool {
+	for _, e := range m.GetReloadRangesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetReloadRangesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetReloadRangesCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetReloadRanges != nil && mm_atomic.LoadUint64(&m.afterGetReloadRangesCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetReloadRangesInspect logs each unmet expectation
+func (m *CheckpointStorageMock) MinimockGetReloadRangesInspect() {
+	for _, e := range m.GetReloadRangesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to CheckpointStorageMock.GetReloadRanges")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetReloadRangesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetReloadRangesCounter) < 1 {
+		m.t.Error("Expected call to CheckpointStorageMock.GetReloadRanges")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetReloadRanges != nil && mm_atomic.LoadUint64(&m.afterGetReloadRangesCounter) < 1 {
+		m.t.Error("Expected call to CheckpointStorageMock.GetReloadRanges")
+	}
+}
+
+type mCheckpointStorageMockSaveCheckpoint struct {
+	mock               *CheckpointStorageMock
+	defaultExpectation *CheckpointStorageMockSaveCheckpointExpectation
+	expectations       []*CheckpointStorageMockSaveCheckpointExpectation
+
+	callArgs []*CheckpointStorageMockSaveCheckpointParams
+	mutex    sync.RWMutex
+}
+
+// CheckpointStorageMockSaveCheckpointExpectation specifies expectation struct of the CheckpointStorage.SaveCheckpoint
+type CheckpointStorageMockSaveCheckpointExpectation struct {
+	mock    *CheckpointStorageMock
+	params  *CheckpointStorageMockSaveCheckpointParams
+	results *CheckpointStorageMockSaveCheckpointResults
+	Counter uint64
+}
+
+// CheckpointStorageMockSaveCheckpointParams contains parameters of the CheckpointStorage.SaveCheckpoint
+type CheckpointStorageMockSaveCheckpointParams struct {
+	checkpoint models.Checkpoint
+}
+
+// CheckpointStorageMockSaveCheckpointResults contains results of the CheckpointStorage.SaveCheckpoint
+type CheckpointStorageMockSaveCheckpointResults struct {
+	err error
+}
+
+// Expect sets up expected params for CheckpointStorage.SaveCheckpoint
+func (mmSaveCheckpoint *mCheckpointStorageMockSaveCheckpoint) Expect(checkpoint models.Checkpoint) *mCheckpointStorageMockSaveCheckpoint {
+	if mmSaveCheckpoint.mock.funcSaveCheckpoint != nil {
+		mmSaveCheckpoint.mock.t.Fatalf("CheckpointStorageMock.SaveCheckpoint mock is already set by Set")
+	}
+
+	if mmSaveCheckpoint.defaultExpectation == nil {
+		mmSaveCheckpoint.defaultExpectation = &CheckpointStorageMockSaveCheckpointExpectation{}
+	}
+
+	mmSaveCheckpoint.defaultExpectation.params = &CheckpointStorageMockSaveCheckpointParams{checkpoint}
+	for _, e := range mmSaveCheckpoint.expectations {
+		if minimock.Equal(e.params, mmSaveCheckpoint.defaultExpectation.params) {
+			mmSaveCheckpoint.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveCheckpoint.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveCheckpoint
+}
+
+// Inspect accepts an inspector function that has same arguments as the CheckpointStorage.SaveCheckpoint
+func (mmSaveCheckpoint *mCheckpointStorageMockSaveCheckpoint) Inspect(f func(checkpoint models.Checkpoint)) *mCheckpointStorageMockSaveCheckpoint {
+	if mmSaveCheckpoint.mock.inspectFuncSaveCheckpoint != nil {
+		mmSaveCheckpoint.mock.t.Fatalf("Inspect function is already set for CheckpointStorageMock.SaveCheckpoint")
+	}
+
+	mmSaveCheckpoint.mock.inspectFuncSaveCheckpoint = f
+
+	return mmSaveCheckpoint
+}
+
+// Return sets up results that will be returned by CheckpointStorage.SaveCheckpoint
+func (mmSaveCheckpoint *mCheckpointStorageMockSaveCheckpoint) Return(err error) *CheckpointStorageMock {
+	if mmSaveCheckpoint.mock.funcSaveCheckpoint != nil {
+		mmSaveCheckpoint.mock.t.Fatalf("CheckpointStorageMock.SaveCheckpoint mock is already set by Set")
+	}
+
+	if mmSaveCheckpoint.defaultExpectation == nil {
+		mmSaveCheckpoint.defaultExpectation = &CheckpointStorageMockSaveCheckpointExpectation{mock: mmSaveCheckpoint.mock}
+	}
+	mmSaveCheckpoint.defaultExpectation.results = &CheckpointStorageMockSaveCheckpointResults{err}
+	return mmSaveCheckpoint.mock
+}
+
+//Set uses given function f to mock the CheckpointStorage.SaveCheckpoint method
+func (mmSaveCheckpoint *mCheckpointStorageMockSaveCheckpoint) Set(f func(checkpoint models.Checkpoint) (err error)) *CheckpointStorageMock {
+	if mmSaveCheckpoint.defaultExpectation != nil {
+		mmSaveCheckpoint.mock.t.Fatalf("Default expectation is already set for the CheckpointStorage.SaveCheckpoint method")
+	}
+
+	if len(mmSaveCheckpoint.expectations) > 0 {
+		mmSaveCheckpoint.mock.t.Fatalf("Some expectations are already set for the CheckpointStorage.SaveCheckpoint method")
+	}
+
+	mmSaveCheckpoint.mock.funcSaveCheckpoint = f
+	return mmSaveCheckpoint.mock
+}
+
+// When sets expectation for the CheckpointStorage.SaveCheckpoint which will trigger the result defined by the following
+// Then helper
+func (mmSaveCheckpoint *mCheckpointStorageMockSaveCheckpoint) When(checkpoint models.Checkpoint) *CheckpointStorageMockSaveCheckpointExpectation {
+	if mmSaveCheckpoint.mock.funcSaveCheckpoint != nil {
+		mmSaveCheckpoint.mock.t.Fatalf("CheckpointStorageMock.SaveCheckpoint mock is already set by Set")
+	}
+
+	expectation := &CheckpointStorageMockSaveCheckpointExpectation{
+		mock:   mmSaveCheckpoint.mock,
+		params: &CheckpointStorageMockSaveCheckpointParams{checkpoint},
+	}
+	mmSaveCheckpoint.expectations = append(mmSaveCheckpoint.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CheckpointStorage.SaveCheckpoint return parameters for the expectation previously defined by the When method
+func (e *CheckpointStorageMockSaveCheckpointExpectation) Then(err error) *CheckpointStorageMock {
+	e.results = &CheckpointStorageMockSaveCheckpointResults{err}
+	return e.mock
+}
+
+// SaveCheckpoint implements interfaces.CheckpointStorage
+func (mmSaveCheckpoint *CheckpointStorageMock) SaveCheckpoint(checkpoint models.Checkpoint) (err error) {
+	mm_atomic.AddUint64(&mmSaveCheckpoint.beforeSaveCheckpointCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveCheckpoint.afterSaveCheckpointCounter, 1)
+
+	if mmSaveCheckpoint.inspectFuncSaveCheckpoint != nil {
+		mmSaveCheckpoint.inspectFuncSaveCheckpoint(checkpoint)
+	}
+
+	mm_params := &CheckpointStorageMockSaveCheckpointParams{checkpoint}
+
+	// Record call args
+	mmSaveCheckpoint.SaveCheckpointMock.mutex.Lock()
+	mmSaveCheckpoint.SaveCheckpointMock.callArgs = append(mmSaveCheckpoint.SaveCheckpointMock.callArgs, mm_params)
+	mmSaveCheckpoint.SaveCheckpointMock.mutex.Unlock()
+
+	for _, e := range mmSaveCheckpoint.SaveCheckpointMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveCheckpoint.SaveCheckpointMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveCheckpoint.SaveCheckpointMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveCheckpoint.SaveCheckpointMock.defaultExpectation.params
+		mm_got := CheckpointStorageMockSaveCheckpointParams{checkpoint}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveCheckpoint.t.Errorf("CheckpointStorageMock.SaveCheckpoint got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveCheckpoint.SaveCheckpointMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveCheckpoint.t.Fatal("No results are set for the CheckpointStorageMock.SaveCheckpoint")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveCheckpoint.funcSaveCheckpoint != nil {
+		return mmSaveCheckpoint.funcSaveCheckpoint(checkpoint)
+	}
+	mmSaveCheckpoint.t.Fatalf("Unexpected call to CheckpointStorageMock.SaveCheckpoint. %v", checkpoint)
+	return
+}
+
+// SaveCheckpointAfterCounter returns a count of finished CheckpointStorageMock.SaveCheckpoint invocations
+func (mmSaveCheckpoint *CheckpointStorageMock) SaveCheckpointAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveCheckpoint.afterSaveCheckpointCounter)
+}
+
+// SaveCheckpointBeforeCounter returns a count of CheckpointStorageMock.SaveCheckpoint invocations
+func (mmSaveCheckpoint *CheckpointStorageMock) SaveCheckpointBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveCheckpoint.beforeSaveCheckpointCounter)
+}
+
+// Calls returns a list of arguments used in each call to CheckpointStorageMock.SaveCheckpoint.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveCheckpoint *mCheckpointStorageMockSaveCheckpoint) Calls() []*CheckpointStorageMockSaveCheckpointParams {
+	mmSaveCheckpoint.mutex.RLock()
+
+	argCopy := make([]*CheckpointStorageMockSaveCheckpointParams, len(mmSaveCheckpoint.callArgs))
+	copy(argCopy, mmSaveCheckpoint.callArgs)
+
+	mmSaveCheckpoint.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveCheckpointDone returns true if the count of the SaveCheckpoint invocations corresponds
+// the number of defined expectations
+func (m *CheckpointStorageMock) MinimockSaveCheckpointDone() bool {
+	for _, e := range m.SaveCheckpointMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveCheckpointMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveCheckpointCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveCheckpoint != nil && mm_atomic.LoadUint64(&m.afterSaveCheckpointCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSaveCheckpointInspect logs each unmet expectation
+func (m *CheckpointStorageMock) MinimockSaveCheckpointInspect() {
+	for _, e := range m.SaveCheckpointMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CheckpointStorageMock.SaveCheckpoint with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveCheckpointMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveCheckpointCounter) < 1 {
+		if m.SaveCheckpointMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to CheckpointStorageMock.SaveCheckpoint")
+		} else {
+			m.t.Errorf("Expected call to CheckpointStorageMock.SaveCheckpoint with params: %#v", *m.SaveCheckpointMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveCheckpoint != nil && mm_atomic.LoadUint64(&m.afterSaveCheckpointCounter) < 1 {
+		m.t.Error("Expected call to CheckpointStorageMock.SaveCheckpoint")
+	}
+}
+
+type mCheckpointStorageMockSaveReloadAttempt struct {
+	mock               *CheckpointStorageMock
+	defaultExpectation *CheckpointStorageMockSaveReloadAttemptExpectation
+	expectations       []*CheckpointStorageMockSaveReloadAttemptExpectation
+
+	callArgs []*CheckpointStorageMockSaveReloadAttemptParams
+	mutex    sync.RWMutex
+}
+
+// CheckpointStorageMockSaveReloadAttemptExpectation specifies expectation struct of the CheckpointStorage.SaveReloadAttempt
+type CheckpointStorageMockSaveReloadAttemptExpectation struct {
+	mock    *CheckpointStorageMock
+	params  *CheckpointStorageMockSaveReloadAttemptParams
+	results *CheckpointStorageMockSaveReloadAttemptResults
+	Counter uint64
+}
+
+// CheckpointStorageMockSaveReloadAttemptParams contains parameters of the CheckpointStorage.SaveReloadAttempt
+type CheckpointStorageMockSaveReloadAttemptParams struct {
+	fromPulseNumber int64
+	toPulseNumber   int64
+}
+
+// CheckpointStorageMockSaveReloadAttemptResults contains results of the CheckpointStorage.SaveReloadAttempt
+type CheckpointStorageMockSaveReloadAttemptResults struct {
+	r1  models.ReloadRange
+	err error
+}
+
+// Expect sets up expected params for CheckpointStorage.SaveReloadAttempt
+func (mmSaveReloadAttempt *mCheckpointStorageMockSaveReloadAttempt) Expect(fromPulseNumber int64, toPulseNumber int64) *mCheckpointStorageMockSaveReloadAttempt {
+	if mmSaveReloadAttempt.mock.funcSaveReloadAttempt != nil {
+		mmSaveReloadAttempt.mock.t.Fatalf("CheckpointStorageMock.SaveReloadAttempt mock is already set by Set")
+	}
+
+	if mmSaveReloadAttempt.defaultExpectation == nil {
+		mmSaveReloadAttempt.defaultExpectation = &CheckpointStorageMockSaveReloadAttemptExpectation{}
+	}
+
+	mmSaveReloadAttempt.defaultExpectation.params = &CheckpointStorageMockSaveReloadAttemptParams{fromPulseNumber, toPulseNumber}
+	for _, e := range mmSaveReloadAttempt.expectations {
+		if minimock.Equal(e.params, mmSaveReloadAttempt.defaultExpectation.params) {
+			mmSaveReloadAttempt.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveReloadAttempt.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveReloadAttempt
+}
+
+// Inspect accepts an inspector function that has same arguments as the CheckpointStorage.SaveReloadAttempt
+func (mmSaveReloadAttempt *mCheckpointStorageMockSaveReloadAttempt) Inspect(f func(fromPulseNumber int64, toPulseNumber int64)) *mCheckpointStorageMockSaveReloadAttempt {
+	if mmSaveReloadAttempt.mock.inspectFuncSaveReloadAttempt != nil {
+		mmSaveReloadAttempt.mock.t.Fatalf("Inspect function is already set for CheckpointStorageMock.SaveReloadAttempt")
+	}
+
+	mmSaveReloadAttempt.mock.inspectFuncSaveReloadAttempt = f
+
+	return mmSaveReloadAttempt
+}
+
+// Return sets up results that will be returned by CheckpointStorage.SaveReloadAttempt
+func (mmSaveReloadAttempt *mCheckpointStorageMockSaveReloadAttempt) Return(r1 models.ReloadRange, err error) *CheckpointStorageMock {
+	if mmSaveReloadAttempt.mock.funcSaveReloadAttempt != nil {
+		mmSaveReloadAttempt.mock.t.Fatalf("CheckpointStorageMock.SaveReloadAttempt mock is already set by Set")
+	}
+
+	if mmSaveReloadAttempt.defaultExpectation == nil {
+		mmSaveReloadAttempt.defaultExpectation = &CheckpointStorageMockSaveReloadAttemptExpectation{mock: mmSaveReloadAttempt.mock}
+	}
+	mmSaveReloadAttempt.defaultExpectation.results = &CheckpointStorageMockSaveReloadAttemptResults{r1, err}
+	return mmSaveReloadAttempt.mock
+}
+
+//Set uses given function f to mock the CheckpointStorage.SaveReloadAttempt method
+func (mmSaveReloadAttempt *mCheckpointStorageMockSaveReloadAttempt) Set(f func(fromPulseNumber int64, toPulseNumber int64) (r1 models.ReloadRange, err error)) *CheckpointStorageMock {
+	if mmSaveReloadAttempt.defaultExpectation != nil {
+		mmSaveReloadAttempt.mock.t.Fatalf("Default expectation is already set for the CheckpointStorage.SaveReloadAttempt method")
+	}
+
+	if len(mmSaveReloadAttempt.expectations) > 0 {
+		mmSaveReloadAttempt.mock.t.Fatalf("Some expectations are already set for the CheckpointStorage.SaveReloadAttempt method")
+	}
+
+	mmSaveReloadAttempt.mock.funcSaveReloadAttempt = f
+	return mmSaveReloadAttempt.mock
+}
+
+// When sets expectation for the CheckpointStorage.SaveReloadAttempt which will trigger the result defined by the following
+// Then helper
+func (mmSaveReloadAttempt *mCheckpointStorageMockSaveReloadAttempt) When(fromPulseNumber int64, toPulseNumber int64) *CheckpointStorageMockSaveReloadAttemptExpectation {
+	if mmSaveReloadAttempt.mock.funcSaveReloadAttempt != nil {
+		mmSaveReloadAttempt.mock.t.Fatalf("CheckpointStorageMock.SaveReloadAttempt mock is already set by Set")
+	}
+
+	expectation := &CheckpointStorageMockSaveReloadAttemptExpectation{
+		mock:   mmSaveReloadAttempt.mock,
+		params: &CheckpointStorageMockSaveReloadAttemptParams{fromPulseNumber, toPulseNumber},
+	}
+	mmSaveReloadAttempt.expectations = append(mmSaveReloadAttempt.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CheckpointStorage.SaveReloadAttempt return parameters for the expectation previously defined by the When method
+func (e *CheckpointStorageMockSaveReloadAttemptExpectation) Then(r1 models.ReloadRange, err error) *CheckpointStorageMock {
+	e.results = &CheckpointStorageMockSaveReloadAttemptResults{r1, err}
+	return e.mock
+}
+
+// SaveReloadAttempt implements interfaces.CheckpointStorage
+func (mmSaveReloadAttempt *CheckpointStorageMock) SaveReloadAttempt(fromPulseNumber int64, toPulseNumber int64) (r1 models.ReloadRange, err error) {
+	mm_atomic.AddUint64(&mmSaveReloadAttempt.beforeSaveReloadAttemptCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveReloadAttempt.afterSaveReloadAttemptCounter, 1)
+
+	if mmSaveReloadAttempt.inspectFuncSaveReloadAttempt != nil {
+		mmSaveReloadAttempt.inspectFuncSaveReloadAttempt(fromPulseNumber, toPulseNumber)
+	}
+
+	mm_params := &CheckpointStorageMockSaveReloadAttemptParams{fromPulseNumber, toPulseNumber}
+
+	// Record call args
+	mmSaveReloadAttempt.SaveReloadAttemptMock.mutex.Lock()
+	mmSaveReloadAttempt.SaveReloadAttemptMock.callArgs = append(mmSaveReloadAttempt.SaveReloadAttemptMock.callArgs, mm_params)
+	mmSaveReloadAttempt.SaveReloadAttemptMock.mutex.Unlock()
+
+	for _, e := range mmSaveReloadAttempt.SaveReloadAttemptMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.r1, e.results.err
+		}
+	}
+
+	if mmSaveReloadAttempt.SaveReloadAttemptMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveReloadAttempt.SaveReloadAttemptMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveReloadAttempt.SaveReloadAttemptMock.defaultExpectation.params
+		mm_got := CheckpointStorageMockSaveReloadAttemptParams{fromPulseNumber, toPulseNumber}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveReloadAttempt.t.Errorf("CheckpointStorageMock.SaveReloadAttempt got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveReloadAttempt.SaveReloadAttemptMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveReloadAttempt.t.Fatal("No results are set for the CheckpointStorageMock.SaveReloadAttempt")
+		}
+		return (*mm_results).r1, (*mm_results).err
+	}
+	if mmSaveReloadAttempt.funcSaveReloadAttempt != nil {
+		return mmSaveReloadAttempt.funcSaveReloadAttempt(fromPulseNumber, toPulseNumber)
+	}
+	mmSaveReloadAttempt.t.Fatalf("Unexpected call to CheckpointStorageMock.SaveReloadAttempt. %v %v", fromPulseNumber, toPulseNumber)
+	return
+}
+
+// SaveReloadAttemptAfterCounter returns a count of finished CheckpointStorageMock.SaveReloadAttempt invocations
+func (mmSaveReloadAttempt *CheckpointStorageMock) SaveReloadAttemptAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveReloadAttempt.afterSaveReloadAttemptCounter)
+}
+
+// SaveReloadAttemptBeforeCounter returns a count of CheckpointStorageMock.SaveReloadAttempt invocations
+func (mmSaveReloadAttempt *CheckpointStorageMock) SaveReloadAttemptBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveReloadAttempt.beforeSaveReloadAttemptCounter)
+}
+
+// Calls returns a list of arguments used in each call to CheckpointStorageMock.SaveReloadAttempt.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveReloadAttempt *mCheckpointStorageMockSaveReloadAttempt) Calls() []*CheckpointStorageMockSaveReloadAttemptParams {
+	mmSaveReloadAttempt.mutex.RLock()
+
+	argCopy := make([]*CheckpointStorageMockSaveReloadAttemptParams, len(mmSaveReloadAttempt.callArgs))
+	copy(argCopy, mmSaveReloadAttempt.callArgs)
+
+	mmSaveReloadAttempt.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveReloadAttemptDone returns true if the count of the SaveReloadAttempt invocations corresponds
+// the number of defined expectations
+func (m *CheckpointStorageMock) MinimockSaveReloadAttemptDone() bool {
+	for _, e := range m.SaveReloadAttemptMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveReloadAttemptMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveReloadAttemptCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveReloadAttempt != nil && mm_atomic.LoadUint64(&m.afterSaveReloadAttemptCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSaveReloadAttemptInspect logs each unmet expectation
+func (m *CheckpointStorageMock) MinimockSaveReloadAttemptInspect() {
+	for _, e := range m.SaveReloadAttemptMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CheckpointStorageMock.SaveReloadAttempt with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveReloadAttemptMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveReloadAttemptCounter) < 1 {
+		if m.SaveReloadAttemptMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to CheckpointStorageMock.SaveReloadAttempt")
+		} else {
+			m.t.Errorf("Expected call to CheckpointStorageMock.SaveReloadAttempt with params: %#v", *m.SaveReloadAttemptMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveReloadAttempt != nil && mm_atomic.LoadUint64(&m.afterSaveReloadAttemptCounter) < 1 {
+		m.t.Error("Expected call to CheckpointStorageMock.SaveReloadAttempt")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CheckpointStorageMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockDeleteReloadRangesInspect()
+
+		m.MinimockGetCheckpointInspect()
+
+		m.MinimockGetReloadRangesInspect()
+
+		m.MinimockSaveCheckpointInspect()
+
+		m.MinimockSaveReloadAttemptInspect()
+		m.t.FailNow()
+	}
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *CheckpointStorageMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *CheckpointStorageMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDeleteReloadRangesDone() &&
+		m.MinimockGetCheckpointDone() &&
+		m.MinimockGetReloadRangesDone() &&
+		m.MinimockSaveCheckpointDone() &&
+		m.MinimockSaveReloadAttemptDone()
+}
@@ -0,0 +1,967 @@
+package mock
+
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/insolar/block-explorer/etl/types"
+)
+
+// DeadLetterQueueMock implements interfaces.DeadLetterQueue
+type DeadLetterQueueMock struct {
+	t minimock.Tester
+
+	funcDeadLetter          func(id int64) (d1 types.DeadLetter, err error)
+	inspectFuncDeadLetter   func(id int64)
+	afterDeadLetterCounter  uint64
+	beforeDeadLetterCounter uint64
+	DeadLetterMock          mDeadLetterQueueMockDeadLetter
+
+	funcDeadLetters          func(limit int, offset int) (da1 []types.DeadLetter, err error)
+	inspectFuncDeadLetters   func(limit int, offset int)
+	afterDeadLettersCounter  uint64
+	beforeDeadLettersCounter uint64
+	DeadLettersMock          mDeadLetterQueueMockDeadLetters
+
+	funcDiscardDeadLetter          func(id int64) (err error)
+	inspectFuncDiscardDeadLetter   func(id int64)
+	afterDiscardDeadLetterCounter  uint64
+	beforeDiscardDeadLetterCounter uint64
+	DiscardDeadLetterMock          mDeadLetterQueueMockDiscardDeadLetter
+
+	funcRetryDeadLetter          func(ctx context.Context, id int64) (err error)
+	inspectFuncRetryDeadLetter   func(ctx context.Context, id int64)
+	afterRetryDeadLetterCounter  uint64
+	beforeRetryDeadLetterCounter uint64
+	RetryDeadLetterMock          mDeadLetterQueueMockRetryDeadLetter
+}
+
+// NewDeadLetterQueueMock returns a mock for interfaces.DeadLetterQueue
+func NewDeadLetterQueueMock(t minimock.Tester) *DeadLetterQueueMock {
+	m := &DeadLetterQueueMock{t: t}
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DeadLetterMock = mDeadLetterQueueMockDeadLetter{mock: m}
+	m.DeadLetterMock.callArgs = []*DeadLetterQueueMockDeadLetterParams{}
+
+	m.DeadLettersMock = mDeadLetterQueueMockDeadLetters{mock: m}
+	m.DeadLettersMock.callArgs = []*DeadLetterQueueMockDeadLettersParams{}
+
+	m.DiscardDeadLetterMock = mDeadLetterQueueMockDiscardDeadLetter{mock: m}
+	m.DiscardDeadLetterMock.callArgs = []*DeadLetterQueueMockDiscardDeadLetterParams{}
+
+	m.RetryDeadLetterMock = mDeadLetterQueueMockRetryDeadLetter{mock: m}
+	m.RetryDeadLetterMock.callArgs = []*DeadLetterQueueMockRetryDeadLetterParams{}
+
+	return m
+}
+
+type mDeadLetterQueueMockDeadLetter struct {
+	mock               *DeadLetterQueueMock
+	defaultExpectation *DeadLetterQueueMockDeadLetterExpectation
+	expectations       []*DeadLetterQueueMockDeadLetterExpectation
+
+	callArgs []*DeadLetterQueueMockDeadLetterParams
+	mutex    sync.RWMutex
+}
+
+// DeadLetterQueueMockDeadLetterExpectation specifies expectation struct of the DeadLetterQueue.DeadLetter
+type DeadLetterQueueMockDeadLetterExpectation struct {
+	mock    *DeadLetterQueueMock
+	params  *DeadLetterQueueMockDeadLetterParams
+	results *DeadLetterQueueMockDeadLetterResults
+	Counter uint64
+}
+
+// DeadLetterQueueMockDeadLetterParams contains parameters of the DeadLetterQueue.DeadLetter
+type DeadLetterQueueMockDeadLetterParams struct {
+	id int64
+}
+
+// DeadLetterQueueMockDeadLetterResults contains results of the DeadLetterQueue.DeadLetter
+type DeadLetterQueueMockDeadLetterResults struct {
+	d1  types.DeadLetter
+	err error
+}
+
+// Expect sets up expected params for DeadLetterQueue.DeadLetter
+func (mmDeadLetter *mDeadLetterQueueMockDeadLetter) Expect(id int64) *mDeadLetterQueueMockDeadLetter {
+	if mmDeadLetter.mock.funcDeadLetter != nil {
+		mmDeadLetter.mock.t.Fatalf("DeadLetterQueueMock.DeadLetter mock is already set by Set")
+	}
+
+	if mmDeadLetter.defaultExpectation == nil {
+		mmDeadLetter.defaultExpectation = &DeadLetterQueueMockDeadLetterExpectation{}
+	}
+
+	mmDeadLetter.defaultExpectation.params = &DeadLetterQueueMockDeadLetterParams{id}
+	for _, e := range mmDeadLetter.expectations {
+		if minimock.Equal(e.params, mmDeadLetter.defaultExpectation.params) {
+			mmDeadLetter.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeadLetter.defaultExpectation.params)
+		}
+	}
+
+	return mmDeadLetter
+}
+
+// Inspect accepts an inspector function that has same arguments as the DeadLetterQueue.DeadLetter
+func (mmDeadLetter *mDeadLetterQueueMockDeadLetter) Inspect(f func(id int64)) *mDeadLetterQueueMockDeadLetter {
+	if mmDeadLetter.mock.inspectFuncDeadLetter != nil {
+		mmDeadLetter.mock.t.Fatalf("Inspect function is already set for DeadLetterQueueMock.DeadLetter")
+	}
+
+	mmDeadLetter.mock.inspectFuncDeadLetter = f
+
+	return mmDeadLetter
+}
+
+// Return sets up results that will be returned by DeadLetterQueue.DeadLetter
+func (mmDeadLetter *mDeadLetterQueueMockDeadLetter) Return(d1 types.DeadLetter, err error) *DeadLetterQueueMock {
+	if mmDeadLetter.mock.funcDeadLetter != nil {
+		mmDeadLetter.mock.t.Fatalf("DeadLetterQueueMock.DeadLetter mock is already set by Set")
+	}
+
+	if mmDeadLetter.defaultExpectation == nil {
+		mmDeadLetter.defaultExpectation = &DeadLetterQueueMockDeadLetterExpectation{mock: mmDeadLetter.mock}
+	}
+	mmDeadLetter.defaultExpectation.results = &DeadLetterQueueMockDeadLetterResults{d1, err}
+	return mmDeadLetter.mock
+}
+
+//Set uses given function f to mock the DeadLetterQueue.DeadLetter method
+func (mmDeadLetter *mDeadLetterQueueMockDeadLetter) Set(f func(id int64) (d1 types.DeadLetter, err error)) *DeadLetterQueueMock {
+	if mmDeadLetter.defaultExpectation != nil {
+		mmDeadLetter.mock.t.Fatalf("Default expectation is already set for the DeadLetterQueue.DeadLetter method")
+	}
+
+	if len(mmDeadLetter.expectations) > 0 {
+		mmDeadLetter.mock.t.Fatalf("Some expectations are already set for the DeadLetterQueue.DeadLetter method")
+	}
+
+	mmDeadLetter.mock.funcDeadLetter = f
+	return mmDeadLetter.mock
+}
+
+// When sets expectation for the DeadLetterQueue.DeadLetter which will trigger the result defined by the following
+// Then helper
+func (mmDeadLetter *mDeadLetterQueueMockDeadLetter) When(id int64) *DeadLetterQueueMockDeadLetterExpectation {
+	if mmDeadLetter.mock.funcDeadLetter != nil {
+		mmDeadLetter.mock.t.Fatalf("DeadLetterQueueMock.DeadLetter mock is already set by Set")
+	}
+
+	expectation := &DeadLetterQueueMockDeadLetterExpectation{
+		mock:   mmDeadLetter.mock,
+		params: &DeadLetterQueueMockDeadLetterParams{id},
+	}
+	mmDeadLetter.expectations = append(mmDeadLetter.expectations, expectation)
+	return expectation
+}
+
+// Then sets up DeadLetterQueue.DeadLetter return parameters for the expectation previously defined by the When method
+func (e *DeadLetterQueueMockDeadLetterExpectation) Then(d1 types.DeadLetter, err error) *DeadLetterQueueMock {
+	e.results = &DeadLetterQueueMockDeadLetterResults{d1, err}
+	return e.mock
+}
+
+// DeadLetter implements interfaces.DeadLetterQueue
+func (mmDeadLetter *DeadLetterQueueMock) DeadLetter(id int64) (d1 types.DeadLetter, err error) {
+	mm_atomic.AddUint64(&mmDeadLetter.beforeDeadLetterCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeadLetter.afterDeadLetterCounter, 1)
+
+	if mmDeadLetter.inspectFuncDeadLetter != nil {
+		mmDeadLetter.inspectFuncDeadLetter(id)
+	}
+
+	mm_params := &DeadLetterQueueMockDeadLetterParams{id}
+
+	// Record call args
+	mmDeadLetter.DeadLetterMock.mutex.Lock()
+	mmDeadLetter.DeadLetterMock.callArgs = append(mmDeadLetter.DeadLetterMock.callArgs, mm_params)
+	mmDeadLetter.DeadLetterMock.mutex.Unlock()
+
+	for _, e := range mmDeadLetter.DeadLetterMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.d1, e.results.err
+		}
+	}
+
+	if mmDeadLetter.DeadLetterMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeadLetter.DeadLetterMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeadLetter.DeadLetterMock.defaultExpectation.params
+		mm_got := DeadLetterQueueMockDeadLetterParams{id}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeadLetter.t.Errorf("DeadLetterQueueMock.DeadLetter got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeadLetter.DeadLetterMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeadLetter.t.Fatal("No results are set for the DeadLetterQueueMock.DeadLetter")
+		}
+		return (*mm_results).d1, (*mm_results).err
+	}
+	if mmDeadLetter.funcDeadLetter != nil {
+		return mmDeadLetter.funcDeadLetter(id)
+	}
+	mmDeadLetter.t.Fatalf("Unexpected call to DeadLetterQueueMock.DeadLetter. %v", id)
+	return
+}
+
+// DeadLetterAfterCounter returns a count of finished DeadLetterQueueMock.DeadLetter invocations
+func (mmDeadLetter *DeadLetterQueueMock) DeadLetterAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeadLetter.afterDeadLetterCounter)
+}
+
+// DeadLetterBeforeCounter returns a count of DeadLetterQueueMock.DeadLetter invocations
+func (mmDeadLetter *DeadLetterQueueMock) DeadLetterBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeadLetter.beforeDeadLetterCounter)
+}
+
+// Calls returns a list of arguments used in each call to DeadLetterQueueMock.DeadLetter.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeadLetter *mDeadLetterQueueMockDeadLetter) Calls() []*DeadLetterQueueMockDeadLetterParams {
+	mmDeadLetter.mutex.RLock()
+
+	argCopy := make([]*DeadLetterQueueMockDeadLetterParams, len(mmDeadLetter.callArgs))
+	copy(argCopy, mmDeadLetter.callArgs)
+
+	mmDeadLetter.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeadLetterDone returns true if the count of the DeadLetter invocations corresponds
+// the number of defined expectations
+func (m *DeadLetterQueueMock) MinimockDeadLetterDone() bool {
+	for _, e := range m.DeadLetterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeadLetterMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeadLetterCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeadLetter != nil && mm_atomic.LoadUint64(&m.afterDeadLetterCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDeadLetterInspect logs each unmet expectation
+func (m *DeadLetterQueueMock) MinimockDeadLetterInspect() {
+	for _, e := range m.DeadLetterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DeadLetterQueueMock.DeadLetter with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeadLetterMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeadLetterCounter) < 1 {
+		if m.DeadLetterMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to DeadLetterQueueMock.DeadLetter")
+		} else {
+			m.t.Errorf("Expected call to DeadLetterQueueMock.DeadLetter with params: %#v", *m.DeadLetterMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeadLetter != nil && mm_atomic.LoadUint64(&m.afterDeadLetterCounter) < 1 {
+		m.t.Error("Expected call to DeadLetterQueueMock.DeadLetter")
+	}
+}
+
+type mDeadLetterQueueMockDeadLetters struct {
+	mock               *DeadLetterQueueMock
+	defaultExpectation *DeadLetterQueueMockDeadLettersExpectation
+	expectations       []*DeadLetterQueueMockDeadLettersExpectation
+
+	callArgs []*DeadLetterQueueMockDeadLettersParams
+	mutex    sync.RWMutex
+}
+
+// DeadLetterQueueMockDeadLettersExpectation specifies expectation struct of the DeadLetterQueue.DeadLetters
+type DeadLetterQueueMockDeadLettersExpectation struct {
+	mock    *DeadLetterQueueMock
+	params  *DeadLetterQueueMockDeadLettersParams
+	results *DeadLetterQueueMockDeadLettersResults
+	Counter uint64
+}
+
+// DeadLetterQueueMockDeadLettersParams contains parameters of the DeadLetterQueue.DeadLetters
+type DeadLetterQueueMockDeadLettersParams struct {
+	limit  int
+	offset int
+}
+
+// DeadLetterQueueMockDeadLettersResults contains results of the DeadLetterQueue.DeadLetters
+type DeadLetterQueueMockDeadLettersResults struct {
+	da1 []types.DeadLetter
+	err error
+}
+
+// Expect sets up expected params for DeadLetterQueue.DeadLetters
+func (mmDeadLetters *mDeadLetterQueueMockDeadLetters) Expect(limit int, offset int) *mDeadLetterQueueMockDeadLetters {
+	if mmDeadLetters.mock.funcDeadLetters != nil {
+		mmDeadLetters.mock.t.Fatalf("DeadLetterQueueMock.DeadLetters mock is already set by Set")
+	}
+
+	if mmDeadLetters.defaultExpectation == nil {
+		mmDeadLetters.defaultExpectation = &DeadLetterQueueMockDeadLettersExpectation{}
+	}
+
+	mmDeadLetters.defaultExpectation.params = &DeadLetterQueueMockDeadLettersParams{limit, offset}
+	for _, e := range mmDeadLetters.expectations {
+		if minimock.Equal(e.params, mmDeadLetters.defaultExpectation.params) {
+			mmDeadLetters.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeadLetters.defaultExpectation.params)
+		}
+	}
+
+	return mmDeadLetters
+}
+
+// Inspect accepts an inspector function that has same arguments as the DeadLetterQueue.DeadLetters
+func (mmDeadLetters *mDeadLetterQueueMockDeadLetters) Inspect(f func(limit int, offset int)) *mDeadLetterQueueMockDeadLetters {
+	if mmDeadLetters.mock.inspectFuncDeadLetters != nil {
+		mmDeadLetters.mock.t.Fatalf("Inspect function is already set for DeadLetterQueueMock.DeadLetters")
+	}
+
+	mmDeadLetters.mock.inspectFuncDeadLetters = f
+
+	return mmDeadLetters
+}
+
+// Return sets up results that will be returned by DeadLetterQueue.DeadLetters
+func (mmDeadLetters *mDeadLetterQueueMockDeadLetters) Return(da1 []types.DeadLetter, err error) *DeadLetterQueueMock {
+	if mmDeadLetters.mock.funcDeadLetters != nil {
+		mmDeadLetters.mock.t.Fatalf("DeadLetterQueueMock.DeadLetters mock is already set by Set")
+	}
+
+	if mmDeadLetters.defaultExpectation == nil {
+		mmDeadLetters.defaultExpectation = &DeadLetterQueueMockDeadLettersExpectation{mock: mmDeadLetters.mock}
+	}
+	mmDeadLetters.defaultExpectation.results = &DeadLetterQueueMockDeadLettersResults{da1, err}
+	return mmDeadLetters.mock
+}
+
+//Set uses given function f to mock the DeadLetterQueue.DeadLetters method
+func (mmDeadLetters *mDeadLetterQueueMockDeadLetters) Set(f func(limit int, offset int) (da1 []types.DeadLetter, err error)) *DeadLetterQueueMock {
+	if mmDeadLetters.defaultExpectation != nil {
+		mmDeadLetters.mock.t.Fatalf("Default expectation is already set for the DeadLetterQueue.DeadLetters method")
+	}
+
+	if len(mmDeadLetters.expectations) > 0 {
+		mmDeadLetters.mock.t.Fatalf("Some expectations are already set for the DeadLetterQueue.DeadLetters method")
+	}
+
+	mmDeadLetters.mock.funcDeadLetters = f
+	return mmDeadLetters.mock
+}
+
+// When sets expectation for the DeadLetterQueue.DeadLetters which will trigger the result defined by the following
+// Then helper
+func (mmDeadLetters *mDeadLetterQueueMockDeadLetters) When(limit int, offset int) *DeadLetterQueueMockDeadLettersExpectation {
+	if mmDeadLetters.mock.funcDeadLetters != nil {
+		mmDeadLetters.mock.t.Fatalf("DeadLetterQueueMock.DeadLetters mock is already set by Set")
+	}
+
+	expectation := &DeadLetterQueueMockDeadLettersExpectation{
+		mock:   mmDeadLetters.mock,
+		params: &DeadLetterQueueMockDeadLettersParams{limit, offset},
+	}
+	mmDeadLetters.expectations = append(mmDeadLetters.expectations, expectation)
+	return expectation
+}
+
+// Then sets up DeadLetterQueue.DeadLetters return parameters for the expectation previously defined by the When method
+func (e *DeadLetterQueueMockDeadLettersExpectation) Then(da1 []types.DeadLetter, err error) *DeadLetterQueueMock {
+	e.results = &DeadLetterQueueMockDeadLettersResults{da1, err}
+	return e.mock
+}
+
+// DeadLetters implements interfaces.DeadLetterQueue
+func (mmDeadLetters *DeadLetterQueueMock) DeadLetters(limit int, offset int) (da1 []types.DeadLetter, err error) {
+	mm_atomic.AddUint64(&mmDeadLetters.beforeDeadLettersCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeadLetters.afterDeadLettersCounter, 1)
+
+	if mmDeadLetters.inspectFuncDeadLetters != nil {
+		mmDeadLetters.inspectFuncDeadLetters(limit, offset)
+	}
+
+	mm_params := &DeadLetterQueueMockDeadLettersParams{limit, offset}
+
+	// Record call args
+	mmDeadLetters.DeadLettersMock.mutex.Lock()
+	mmDeadLetters.DeadLettersMock.callArgs = append(mmDeadLetters.DeadLettersMock.callArgs, mm_params)
+	mmDeadLetters.DeadLettersMock.mutex.Unlock()
+
+	for _, e := range mmDeadLetters.DeadLettersMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.da1, e.results.err
+		}
+	}
+
+	if mmDeadLetters.DeadLettersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeadLetters.DeadLettersMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeadLetters.DeadLettersMock.defaultExpectation.params
+		mm_got := DeadLetterQueueMockDeadLettersParams{limit, offset}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeadLetters.t.Errorf("DeadLetterQueueMock.DeadLetters got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeadLetters.DeadLettersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeadLetters.t.Fatal("No results are set for the DeadLetterQueueMock.DeadLetters")
+		}
+		return (*mm_results).da1, (*mm_results).err
+	}
+	if mmDeadLetters.funcDeadLetters != nil {
+		return mmDeadLetters.funcDeadLetters(limit, offset)
+	}
+	mmDeadLetters.t.Fatalf("Unexpected call to DeadLetterQueueMock.DeadLetters. %v %v", limit, offset)
+	return
+}
+
+// DeadLettersAfterCounter returns a count of finished DeadLetterQueueMock.DeadLetters invocations
+func (mmDeadLetters *DeadLetterQueueMock) DeadLettersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeadLetters.afterDeadLettersCounter)
+}
+
+// DeadLettersBeforeCounter returns a count of DeadLetterQueueMock.DeadLetters invocations
+func (mmDeadLetters *DeadLetterQueueMock) DeadLettersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeadLetters.beforeDeadLettersCounter)
+}
+
+// Calls returns a list of arguments used in each call to DeadLetterQueueMock.DeadLetters.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeadLetters *mDeadLetterQueueMockDeadLetters) Calls() []*DeadLetterQueueMockDeadLettersParams {
+	mmDeadLetters.mutex.RLock()
+
+	argCopy := make([]*DeadLetterQueueMockDeadLettersParams, len(mmDeadLetters.callArgs))
+	copy(argCopy, mmDeadLetters.callArgs)
+
+	mmDeadLetters.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeadLettersDone returns true if the count of the DeadLetters invocations corresponds
+// the number of defined expectations
+func (m *DeadLetterQueueMock) MinimockDeadLettersDone() bool {
+	for _, e := range m.DeadLettersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeadLettersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeadLettersCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeadLetters != nil && mm_atomic.LoadUint64(&m.afterDeadLettersCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDeadLettersInspect logs each unmet expectation
+func (m *DeadLetterQueueMock) MinimockDeadLettersInspect() {
+	for _, e := range m.DeadLettersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DeadLetterQueueMock.DeadLetters with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeadLettersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeadLettersCounter) < 1 {
+		if m.DeadLettersMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to DeadLetterQueueMock.DeadLetters")
+		} else {
+			m.t.Errorf("Expected call to DeadLetterQueueMock.DeadLetters with params: %#v", *m.DeadLettersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeadLetters != nil && mm_atomic.LoadUint64(&m.afterDeadLettersCounter) < 1 {
+		m.t.Error("Expected call to DeadLetterQueueMock.DeadLetters")
+	}
+}
+
+type mDeadLetterQueueMockDiscardDeadLetter struct {
+	mock               *DeadLetterQueueMock
+	defaultExpectation *DeadLetterQueueMockDiscardDeadLetterExpectation
+	expectations       []*DeadLetterQueueMockDiscardDeadLetterExpectation
+
+	callArgs []*DeadLetterQueueMockDiscardDeadLetterParams
+	mutex    sync.RWMutex
+}
+
+// DeadLetterQueueMockDiscardDeadLetterExpectation specifies expectation struct of the DeadLetterQueue.DiscardDeadLetter
+type DeadLetterQueueMockDiscardDeadLetterExpectation struct {
+	mock    *DeadLetterQueueMock
+	params  *DeadLetterQueueMockDiscardDeadLetterParams
+	results *DeadLetterQueueMockDiscardDeadLetterResults
+	Counter uint64
+}
+
+// DeadLetterQueueMockDiscardDeadLetterParams contains parameters of the DeadLetterQueue.DiscardDeadLetter
+type DeadLetterQueueMockDiscardDeadLetterParams struct {
+	id int64
+}
+
+// DeadLetterQueueMockDiscardDeadLetterResults contains results of the DeadLetterQueue.DiscardDeadLetter
+type DeadLetterQueueMockDiscardDeadLetterResults struct {
+	err error
+}
+
+// Expect sets up expected params for DeadLetterQueue.DiscardDeadLetter
+func (mmDiscardDeadLetter *mDeadLetterQueueMockDiscardDeadLetter) Expect(id int64) *mDeadLetterQueueMockDiscardDeadLetter {
+	if mmDiscardDeadLetter.mock.funcDiscardDeadLetter != nil {
+		mmDiscardDeadLetter.mock.t.Fatalf("DeadLetterQueueMock.DiscardDeadLetter mock is already set by Set")
+	}
+
+	if mmDiscardDeadLetter.defaultExpectation == nil {
+		mmDiscardDeadLetter.defaultExpectation = &DeadLetterQueueMockDiscardDeadLetterExpectation{}
+	}
+
+	mmDiscardDeadLetter.defaultExpectation.params = &DeadLetterQueueMockDiscardDeadLetterParams{id}
+	for _, e := range mmDiscardDeadLetter.expectations {
+		if minimock.Equal(e.params, mmDiscardDeadLetter.defaultExpectation.params) {
+			mmDiscardDeadLetter.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDiscardDeadLetter.defaultExpectation.params)
+		}
+	}
+
+	return mmDiscardDeadLetter
+}
+
+// Inspect accepts an inspector function that has same arguments as the DeadLetterQueue.DiscardDeadLetter
+func (mmDiscardDeadLetter *mDeadLetterQueueMockDiscardDeadLetter) Inspect(f func(id int64)) *mDeadLetterQueueMockDiscardDeadLetter {
+	if mmDiscardDeadLetter.mock.inspectFuncDiscardDeadLetter != nil {
+		mmDiscardDeadLetter.mock.t.Fatalf("Inspect function is already set for DeadLetterQueueMock.DiscardDeadLetter")
+	}
+
+	mmDiscardDeadLetter.mock.inspectFuncDiscardDeadLetter = f
+
+	return mmDiscardDeadLetter
+}
+
+// Return sets up results that will be returned by DeadLetterQueue.DiscardDeadLetter
+func (mmDiscardDeadLetter *mDeadLetterQueueMockDiscardDeadLetter) Return(err error) *DeadLetterQueueMock {
+	if mmDiscardDeadLetter.mock.funcDiscardDeadLetter != nil {
+		mmDiscardDeadLetter.mock.t.Fatalf("DeadLetterQueueMock.DiscardDeadLetter mock is already set by Set")
+	}
+
+	if mmDiscardDeadLetter.defaultExpectation == nil {
+		mmDiscardDeadLetter.defaultExpectation = &DeadLetterQueueMockDiscardDeadLetterExpectation{mock: mmDiscardDeadLetter.mock}
+	}
+	mmDiscardDeadLetter.defaultExpectation.results = &DeadLetterQueueMockDiscardDeadLetterResults{err}
+	return mmDiscardDeadLetter.mock
+}
+
+//Set uses given function f to mock the DeadLetterQueue.DiscardDeadLetter method
+func (mmDiscardDeadLetter *mDeadLetterQueueMockDiscardDeadLetter) Set(f func(id int64) (err error)) *DeadLetterQueueMock {
+	if mmDiscardDeadLetter.defaultExpectation != nil {
+		mmDiscardDeadLetter.mock.t.Fatalf("Default expectation is already set for the DeadLetterQueue.DiscardDeadLetter method")
+	}
+
+	if len(mmDiscardDeadLetter.expectations) > 0 {
+		mmDiscardDeadLetter.mock.t.Fatalf("Some expectations are already set for the DeadLetterQueue.DiscardDeadLetter method")
+	}
+
+	mmDiscardDeadLetter.mock.funcDiscardDeadLetter = f
+	return mmDiscardDeadLetter.mock
+}
+
+// When sets expectation for the DeadLetterQueue.DiscardDeadLetter which will trigger the result defined by the following
+// Then helper
+func (mmDiscardDeadLetter *mDeadLetterQueueMockDiscardDeadLetter) When(id int64) *DeadLetterQueueMockDiscardDeadLetterExpectation {
+	if mmDiscardDeadLetter.mock.funcDiscardDeadLetter != nil {
+		mmDiscardDeadLetter.mock.t.Fatalf("DeadLetterQueueMock.DiscardDeadLetter mock is already set by Set")
+	}
+
+	expectation := &DeadLetterQueueMockDiscardDeadLetterExpectation{
+		mock:   mmDiscardDeadLetter.mock,
+		params: &DeadLetterQueueMockDiscardDeadLetterParams{id},
+	}
+	mmDiscardDeadLetter.expectations = append(mmDiscardDeadLetter.expectations, expectation)
+	return expectation
+}
+
+// Then sets up DeadLetterQueue.DiscardDeadLetter return parameters for the expectation previously defined by the When method
+func (e *DeadLetterQueueMockDiscardDeadLetterExpectation) Then(err error) *DeadLetterQueueMock {
+	e.results = &DeadLetterQueueMockDiscardDeadLetterResults{err}
+	return e.mock
+}
+
+// DiscardDeadLetter implements interfaces.DeadLetterQueue
+func (mmDiscardDeadLetter *DeadLetterQueueMock) DiscardDeadLetter(id int64) (err error) {
+	mm_atomic.AddUint64(&mmDiscardDeadLetter.beforeDiscardDeadLetterCounter, 1)
+	defer mm_atomic.AddUint64(&mmDiscardDeadLetter.afterDiscardDeadLetterCounter, 1)
+
+	if mmDiscardDeadLetter.inspectFuncDiscardDeadLetter != nil {
+		mmDiscardDeadLetter.inspectFuncDiscardDeadLetter(id)
+	}
+
+	mm_params := &DeadLetterQueueMockDiscardDeadLetterParams{id}
+
+	// Record call args
+	mmDiscardDeadLetter.DiscardDeadLetterMock.mutex.Lock()
+	mmDiscardDeadLetter.DiscardDeadLetterMock.callArgs = append(mmDiscardDeadLetter.DiscardDeadLetterMock.callArgs, mm_params)
+	mmDiscardDeadLetter.DiscardDeadLetterMock.mutex.Unlock()
+
+	for _, e := range mmDiscardDeadLetter.DiscardDeadLetterMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDiscardDeadLetter.DiscardDeadLetterMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDiscardDeadLetter.DiscardDeadLetterMock.defaultExpectation.Counter, 1)
+		mm_want := mmDiscardDeadLetter.DiscardDeadLetterMock.defaultExpectation.params
+		mm_got := DeadLetterQueueMockDiscardDeadLetterParams{id}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDiscardDeadLetter.t.Errorf("DeadLetterQueueMock.DiscardDeadLetter got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDiscardDeadLetter.DiscardDeadLetterMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDiscardDeadLetter.t.Fatal("No results are set for the DeadLetterQueueMock.DiscardDeadLetter")
+		}
+		return (*mm_results).err
+	}
+	if mmDiscardDeadLetter.funcDiscardDeadLetter != nil {
+		return mmDiscardDeadLetter.funcDiscardDeadLetter(id)
+	}
+	mmDiscardDeadLetter.t.Fatalf("Unexpected call to DeadLetterQueueMock.DiscardDeadLetter. %v", id)
+	return
+}
+
+// DiscardDeadLetterAfterCounter returns a count of finished DeadLetterQueueMock.DiscardDeadLetter invocations
+func (mmDiscardDeadLetter *DeadLetterQueueMock) DiscardDeadLetterAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDiscardDeadLetter.afterDiscardDeadLetterCounter)
+}
+
+// DiscardDeadLetterBeforeCounter returns a count of DeadLetterQueueMock.DiscardDeadLetter invocations
+func (mmDiscardDeadLetter *DeadLetterQueueMock) DiscardDeadLetterBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDiscardDeadLetter.beforeDiscardDeadLetterCounter)
+}
+
+// Calls returns a list of arguments used in each call to DeadLetterQueueMock.DiscardDeadLetter.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDiscardDeadLetter *mDeadLetterQueueMockDiscardDeadLetter) Calls() []*DeadLetterQueueMockDiscardDeadLetterParams {
+	mmDiscardDeadLetter.mutex.RLock()
+
+	argCopy := make([]*DeadLetterQueueMockDiscardDeadLetterParams, len(mmDiscardDeadLetter.callArgs))
+	copy(argCopy, mmDiscardDeadLetter.callArgs)
+
+	mmDiscardDeadLetter.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDiscardDeadLetterDone returns true if the count of the DiscardDeadLetter invocations corresponds
+// the number of defined expectations
+func (m *DeadLetterQueueMock) MinimockDiscardDeadLetterDone() bool {
+	for _, e := range m.DiscardDeadLetterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DiscardDeadLetterMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDiscardDeadLetterCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDiscardDeadLetter != nil && mm_atomic.LoadUint64(&m.afterDiscardDeadLetterCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDiscardDeadLetterInspect logs each unmet expectation
+func (m *DeadLetterQueueMock) MinimockDiscardDeadLetterInspect() {
+	for _, e := range m.DiscardDeadLetterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DeadLetterQueueMock.DiscardDeadLetter with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DiscardDeadLetterMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDiscardDeadLetterCounter) < 1 {
+		if m.DiscardDeadLetterMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to DeadLetterQueueMock.DiscardDeadLetter")
+		} else {
+			m.t.Errorf("Expected call to DeadLetterQueueMock.DiscardDeadLetter with params: %#v", *m.DiscardDeadLetterMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDiscardDeadLetter != nil && mm_atomic.LoadUint64(&m.afterDiscardDeadLetterCounter) < 1 {
+		m.t.Error("Expected call to DeadLetterQueueMock.DiscardDeadLetter")
+	}
+}
+
+type mDeadLetterQueueMockRetryDeadLetter struct {
+	mock               *DeadLetterQueueMock
+	defaultExpectation *DeadLetterQueueMockRetryDeadLetterExpectation
+	expectations       []*DeadLetterQueueMockRetryDeadLetterExpectation
+
+	callArgs []*DeadLetterQueueMockRetryDeadLetterParams
+	mutex    sync.RWMutex
+}
+
+// DeadLetterQueueMockRetryDeadLetterExpectation specifies expectation struct of the DeadLetterQueue.RetryDeadLetter
+type DeadLetterQueueMockRetryDeadLetterExpectation struct {
+	mock    *DeadLetterQueueMock
+	params  *DeadLetterQueueMockRetryDeadLetterParams
+	results *DeadLetterQueueMockRetryDeadLetterResults
+	Counter uint64
+}
+
+// DeadLetterQueueMockRetryDeadLetterParams contains parameters of the DeadLetterQueue.RetryDeadLetter
+type DeadLetterQueueMockRetryDeadLetterParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// DeadLetterQueueMockRetryDeadLetterResults contains results of the DeadLetterQueue.RetryDeadLetter
+type DeadLetterQueueMockRetryDeadLetterResults struct {
+	err error
+}
+
+// Expect sets up expected params for DeadLetterQueue.RetryDeadLetter
+func (mmRetryDeadLetter *mDeadLetterQueueMockRetryDeadLetter) Expect(ctx context.Context, id int64) *mDeadLetterQueueMockRetryDeadLetter {
+	if mmRetryDeadLetter.mock.funcRetryDeadLetter != nil {
+		mmRetryDeadLetter.mock.t.Fatalf("DeadLetterQueueMock.RetryDeadLetter mock is already set by Set")
+	}
+
+	if mmRetryDeadLetter.defaultExpectation == nil {
+		mmRetryDeadLetter.defaultExpectation = &DeadLetterQueueMockRetryDeadLetterExpectation{}
+	}
+
+	mmRetryDeadLetter.defaultExpectation.params = &DeadLetterQueueMockRetryDeadLetterParams{ctx, id}
+	for _, e := range mmRetryDeadLetter.expectations {
+		if minimock.Equal(e.params, mmRetryDeadLetter.defaultExpectation.params) {
+			mmRetryDeadLetter.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRetryDeadLetter.defaultExpectation.params)
+		}
+	}
+
+	return mmRetryDeadLetter
+}
+
+// Inspect accepts an inspector function that has same arguments as the DeadLetterQueue.RetryDeadLetter
+func (mmRetryDeadLetter *mDeadLetterQueueMockRetryDeadLetter) Inspect(f func(ctx context.Context, id int64)) *mDeadLetterQueueMockRetryDeadLetter {
+	if mmRetryDeadLetter.mock.inspectFuncRetryDeadLetter != nil {
+		mmRetryDeadLetter.mock.t.Fatalf("Inspect function is already set for DeadLetterQueueMock.RetryDeadLetter")
+	}
+
+	mmRetryDeadLetter.mock.inspectFuncRetryDeadLetter = f
+
+	return mmRetryDeadLetter
+}
+
+// Return sets up results that will be returned by DeadLetterQueue.RetryDeadLetter
+func (mmRetryDeadLetter *mDeadLetterQueueMockRetryDeadLetter) Return(err error) *DeadLetterQueueMock {
+	if mmRetryDeadLetter.mock.funcRetryDeadLetter != nil {
+		mmRetryDeadLetter.mock.t.Fatalf("DeadLetterQueueMock.RetryDeadLetter mock is already set by Set")
+	}
+
+	if mmRetryDeadLetter.defaultExpectation == nil {
+		mmRetryDeadLetter.defaultExpectation = &DeadLetterQueueMockRetryDeadLetterExpectation{mock: mmRetryDeadLetter.mock}
+	}
+	mmRetryDeadLetter.defaultExpectation.results = &DeadLetterQueueMockRetryDeadLetterResults{err}
+	return mmRetryDeadLetter.mock
+}
+
+//Set uses given function f to mock the DeadLetterQueue.RetryDeadLetter method
+func (mmRetryDeadLetter *mDeadLetterQueueMockRetryDeadLetter) Set(f func(ctx context.Context, id int64) (err error)) *DeadLetterQueueMock {
+	if mmRetryDeadLetter.defaultExpectation != nil {
+		mmRetryDeadLetter.mock.t.Fatalf("Default expectation is already set for the DeadLetterQueue.RetryDeadLetter method")
+	}
+
+	if len(mmRetryDeadLetter.expectations) > 0 {
+		mmRetryDeadLetter.mock.t.Fatalf("Some expectations are already set for the DeadLetterQueue.RetryDeadLetter method")
+	}
+
+	mmRetryDeadLetter.mock.funcRetryDeadLetter = f
+	return mmRetryDeadLetter.mock
+}
+
+// When sets expectation for the DeadLetterQueue.RetryDeadLetter which will trigger the result defined by the following
+// Then helper
+func (mmRetryDeadLetter *mDeadLetterQueueMockRetryDeadLetter) When(ctx context.Context, id int64) *DeadLetterQueueMockRetryDeadLetterExpectation {
+	if mmRetryDeadLetter.mock.funcRetryDeadLetter != nil {
+		mmRetryDeadLetter.mock.t.Fatalf("DeadLetterQueueMock.RetryDeadLetter mock is already set by Set")
+	}
+
+	expectation := &DeadLetterQueueMockRetryDeadLetterExpectation{
+		mock:   mmRetryDeadLetter.mock,
+		params: &DeadLetterQueueMockRetryDeadLetterParams{ctx, id},
+	}
+	mmRetryDeadLetter.expectations = append(mmRetryDeadLetter.expectations, expectation)
+	return expectation
+}
+
+// Then sets up DeadLetterQueue.RetryDeadLetter return parameters for the expectation previously defined by the When method
+func (e *DeadLetterQueueMockRetryDeadLetterExpectation) Then(err error) *DeadLetterQueueMock {
+	e.results = &DeadLetterQueueMockRetryDeadLetterResults{err}
+	return e.mock
+}
+
+// RetryDeadLetter implements interfaces.DeadLetterQueue
+func (mmRetryDeadLetter *DeadLetterQueueMock) RetryDeadLetter(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmRetryDeadLetter.beforeRetryDeadLetterCounter, 1)
+	defer mm_atomic.AddUint64(&mmRetryDeadLetter.afterRetryDeadLetterCounter, 1)
+
+	if mmRetryDeadLetter.inspectFuncRetryDeadLetter != nil {
+		mmRetryDeadLetter.inspectFuncRetryDeadLetter(ctx, id)
+	}
+
+	mm_params := &DeadLetterQueueMockRetryDeadLetterParams{ctx, id}
+
+	// Record call args
+	mmRetryDeadLetter.RetryDeadLetterMock.mutex.Lock()
+	mmRetryDeadLetter.RetryDeadLetterMock.callArgs = append(mmRetryDeadLetter.RetryDeadLetterMock.callArgs, mm_params)
+	mmRetryDeadLetter.RetryDeadLetterMock.mutex.Unlock()
+
+	for _, e := range mmRetryDeadLetter.RetryDeadLetterMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRetryDeadLetter.RetryDeadLetterMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRetryDeadLetter.RetryDeadLetterMock.defaultExpectation.Counter, 1)
+		mm_want := mmRetryDeadLetter.RetryDeadLetterMock.defaultExpectation.params
+		mm_got := DeadLetterQueueMockRetryDeadLetterParams{ctx, id}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRetryDeadLetter.t.Errorf("DeadLetterQueueMock.RetryDeadLetter got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRetryDeadLetter.RetryDeadLetterMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRetryDeadLetter.t.Fatal("No results are set for the DeadLetterQueueMock.RetryDeadLetter")
+		}
+		return (*mm_results).err
+	}
+	if mmRetryDeadLetter.funcRetryDeadLetter != nil {
+		return mmRetryDeadLetter.funcRetryDeadLetter(ctx, id)
+	}
+	mmRetryDeadLetter.t.Fatalf("Unexpected call to DeadLetterQueueMock.RetryDeadLetter. %v %v", ctx, id)
+	return
+}
+
+// RetryDeadLetterAfterCounter returns a count of finished DeadLetterQueueMock.RetryDeadLetter invocations
+func (mmRetryDeadLetter *DeadLetterQueueMock) RetryDeadLetterAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRetryDeadLetter.afterRetryDeadLetterCounter)
+}
+
+// RetryDeadLetterBeforeCounter returns a count of DeadLetterQueueMock.RetryDeadLetter invocations
+func (mmRetryDeadLetter *DeadLetterQueueMock) RetryDeadLetterBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRetryDeadLetter.beforeRetryDeadLetterCounter)
+}
+
+// Calls returns a list of arguments used in each call to DeadLetterQueueMock.RetryDeadLetter.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRetryDeadLetter *mDeadLetterQueueMockRetryDeadLetter) Calls() []*DeadLetterQueueMockRetryDeadLetterParams {
+	mmRetryDeadLetter.mutex.RLock()
+
+	argCopy := make([]*DeadLetterQueueMockRetryDeadLetterParams, len(mmRetryDeadLetter.callArgs))
+	copy(argCopy, mmRetryDeadLetter.callArgs)
+
+	mmRetryDeadLetter.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRetryDeadLetterDone returns true if the count of the RetryDeadLetter invocations corresponds
+// the number of defined expectations
+func (m *DeadLetterQueueMock) MinimockRetryDeadLetterDone() bool {
+	for _, e := range m.RetryDeadLetterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RetryDeadLetterMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRetryDeadLetterCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRetryDeadLetter != nil && mm_atomic.LoadUint64(&m.afterRetryDeadLetterCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRetryDeadLetterInspect logs each unmet expectation
+func (m *DeadLetterQueueMock) MinimockRetryDeadLetterInspect() {
+	for _, e := range m.RetryDeadLetterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DeadLetterQueueMock.RetryDeadLetter with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RetryDeadLetterMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRetryDeadLetterCounter) < 1 {
+		if m.RetryDeadLetterMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to DeadLetterQueueMock.RetryDeadLetter")
+		} else {
+			m.t.Errorf("Expected call to DeadLetterQueueMock.RetryDeadLetter with params: %#v", *m.RetryDeadLetterMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRetryDeadLetter != nil && mm_atomic.LoadUint64(&m.afterRetryDeadLetterCounter) < 1 {
+		m.t.Error("Expected call to DeadLetterQueueMock.RetryDeadLetter")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *DeadLetterQueueMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockDeadLetterInspect()
+
+		m.MinimockDeadLettersInspect()
+
+		m.MinimockDiscardDeadLetterInspect()
+
+		m.MinimockRetryDeadLetterInspect()
+		m.t.FailNow()
+	}
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *DeadLetterQueueMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *DeadLetterQueueMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDeadLetterDone() &&
+		m.MinimockDeadLettersDone() &&
+		m.MinimockDiscardDeadLetterDone() &&
+		m.MinimockRetryDeadLetterDone()
+}
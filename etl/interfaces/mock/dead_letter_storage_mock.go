@@ -0,0 +1,965 @@
+package mock
+
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+import (
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/insolar/block-explorer/etl/models"
+)
+
+// DeadLetterStorageMock implements interfaces.DeadLetterStorage
+type DeadLetterStorageMock struct {
+	t minimock.Tester
+
+	funcDeleteDeadLetter          func(id int64) (err error)
+	inspectFuncDeleteDeadLetter   func(id int64)
+	afterDeleteDeadLetterCounter  uint64
+	beforeDeleteDeadLetterCounter uint64
+	DeleteDeadLetterMock          mDeadLetterStorageMockDeleteDeadLetter
+
+	funcGetDeadLetter          func(id int64) (d1 models.DeadLetter, err error)
+	inspectFuncGetDeadLetter   func(id int64)
+	afterGetDeadLetterCounter  uint64
+	beforeGetDeadLetterCounter uint64
+	GetDeadLetterMock          mDeadLetterStorageMockGetDeadLetter
+
+	funcGetDeadLetters          func(limit int, offset int) (da1 []models.DeadLetter, err error)
+	inspectFuncGetDeadLetters   func(limit int, offset int)
+	afterGetDeadLettersCounter  uint64
+	beforeGetDeadLettersCounter uint64
+	GetDeadLettersMock          mDeadLetterStorageMockGetDeadLetters
+
+	funcSaveDeadLetter          func(deadLetter models.DeadLetter) (err error)
+	inspectFuncSaveDeadLetter   func(deadLetter models.DeadLetter)
+	afterSaveDeadLetterCounter  uint64
+	beforeSaveDeadLetterCounter uint64
+	SaveDeadLetterMock          mDeadLetterStorageMockSaveDeadLetter
+}
+
+// NewDeadLetterStorageMock returns a mock for interfaces.DeadLetterStorage
+func NewDeadLetterStorageMock(t minimock.Tester) *DeadLetterStorageMock {
+	m := &DeadLetterStorageMock{t: t}
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DeleteDeadLetterMock = mDeadLetterStorageMockDeleteDeadLetter{mock: m}
+	m.DeleteDeadLetterMock.callArgs = []*DeadLetterStorageMockDeleteDeadLetterParams{}
+
+	m.GetDeadLetterMock = mDeadLetterStorageMockGetDeadLetter{mock: m}
+	m.GetDeadLetterMock.callArgs = []*DeadLetterStorageMockGetDeadLetterParams{}
+
+	m.GetDeadLettersMock = mDeadLetterStorageMockGetDeadLetters{mock: m}
+	m.GetDeadLettersMock.callArgs = []*DeadLetterStorageMockGetDeadLettersParams{}
+
+	m.SaveDeadLetterMock = mDeadLetterStorageMockSaveDeadLetter{mock: m}
+	m.SaveDeadLetterMock.callArgs = []*DeadLetterStorageMockSaveDeadLetterParams{}
+
+	return m
+}
+
+type mDeadLetterStorageMockDeleteDeadLetter struct {
+	mock               *DeadLetterStorageMock
+	defaultExpectation *DeadLetterStorageMockDeleteDeadLetterExpectation
+	expectations       []*DeadLetterStorageMockDeleteDeadLetterExpectation
+
+	callArgs []*DeadLetterStorageMockDeleteDeadLetterParams
+	mutex    sync.RWMutex
+}
+
+// DeadLetterStorageMockDeleteDeadLetterExpectation specifies expectation struct of the DeadLetterStorage.DeleteDeadLetter
+type DeadLetterStorageMockDeleteDeadLetterExpectation struct {
+	mock    *DeadLetterStorageMock
+	params  *DeadLetterStorageMockDeleteDeadLetterParams
+	results *DeadLetterStorageMockDeleteDeadLetterResults
+	Counter uint64
+}
+
+// DeadLetterStorageMockDeleteDeadLetterParams contains parameters of the DeadLetterStorage.DeleteDeadLetter
+type DeadLetterStorageMockDeleteDeadLetterParams struct {
+	id int64
+}
+
+// DeadLetterStorageMockDeleteDeadLetterResults contains results of the DeadLetterStorage.DeleteDeadLetter
+type DeadLetterStorageMockDeleteDeadLetterResults struct {
+	err error
+}
+
+// Expect sets up expected params for DeadLetterStorage.DeleteDeadLetter
+func (mmDeleteDeadLetter *mDeadLetterStorageMockDeleteDeadLetter) Expect(id int64) *mDeadLetterStorageMockDeleteDeadLetter {
+	if mmDeleteDeadLetter.mock.funcDeleteDeadLetter != nil {
+		mmDeleteDeadLetter.mock.t.Fatalf("DeadLetterStorageMock.DeleteDeadLetter mock is already set by Set")
+	}
+
+	if mmDeleteDeadLetter.defaultExpectation == nil {
+		mmDeleteDeadLetter.defaultExpectation = &DeadLetterStorageMockDeleteDeadLetterExpectation{}
+	}
+
+	mmDeleteDeadLetter.defaultExpectation.params = &DeadLetterStorageMockDeleteDeadLetterParams{id}
+	for _, e := range mmDeleteDeadLetter.expectations {
+		if minimock.Equal(e.params, mmDeleteDeadLetter.defaultExpectation.params) {
+			mmDeleteDeadLetter.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteDeadLetter.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteDeadLetter
+}
+
+// Inspect accepts an inspector function that has same arguments as the DeadLetterStorage.DeleteDeadLetter
+func (mmDeleteDeadLetter *mDeadLetterStorageMockDeleteDeadLetter) Inspect(f func(id int64)) *mDeadLetterStorageMockDeleteDeadLetter {
+	if mmDeleteDeadLetter.mock.inspectFuncDeleteDeadLetter != nil {
+		mmDeleteDeadLetter.mock.t.Fatalf("Inspect function is already set for DeadLetterStorageMock.DeleteDeadLetter")
+	}
+
+	mmDeleteDeadLetter.mock.inspectFuncDeleteDeadLetter = f
+
+	return mmDeleteDeadLetter
+}
+
+// Return sets up results that will be returned by DeadLetterStorage.DeleteDeadLetter
+func (mmDeleteDeadLetter *mDeadLetterStorageMockDeleteDeadLetter) Return(err error) *DeadLetterStorageMock {
+	if mmDeleteDeadLetter.mock.funcDeleteDeadLetter != nil {
+		mmDeleteDeadLetter.mock.t.Fatalf("DeadLetterStorageMock.DeleteDeadLetter mock is already set by Set")
+	}
+
+	if mmDeleteDeadLetter.defaultExpectation == nil {
+		mmDeleteDeadLetter.defaultExpectation = &DeadLetterStorageMockDeleteDeadLetterExpectation{mock: mmDeleteDeadLetter.mock}
+	}
+	mmDeleteDeadLetter.defaultExpectation.results = &DeadLetterStorageMockDeleteDeadLetterResults{err}
+	return mmDeleteDeadLetter.mock
+}
+
+//Set uses given function f to mock the DeadLetterStorage.DeleteDeadLetter method
+func (mmDeleteDeadLetter *mDeadLetterStorageMockDeleteDeadLetter) Set(f func(id int64) (err error)) *DeadLetterStorageMock {
+	if mmDeleteDeadLetter.defaultExpectation != nil {
+		mmDeleteDeadLetter.mock.t.Fatalf("Default expectation is already set for the DeadLetterStorage.DeleteDeadLetter method")
+	}
+
+	if len(mmDeleteDeadLetter.expectations) > 0 {
+		mmDeleteDeadLetter.mock.t.Fatalf("Some expectations are already set for the DeadLetterStorage.DeleteDeadLetter method")
+	}
+
+	mmDeleteDeadLetter.mock.funcDeleteDeadLetter = f
+	return mmDeleteDeadLetter.mock
+}
+
+// When sets expectation for the DeadLetterStorage.DeleteDeadLetter which will trigger the result defined by the following
+// Then helper
+func (mmDeleteDeadLetter *mDeadLetterStorageMockDeleteDeadLetter) When(id int64) *DeadLetterStorageMockDeleteDeadLetterExpectation {
+	if mmDeleteDeadLetter.mock.funcDeleteDeadLetter != nil {
+		mmDeleteDeadLetter.mock.t.Fatalf("DeadLetterStorageMock.DeleteDeadLetter mock is already set by Set")
+	}
+
+	expectation := &DeadLetterStorageMockDeleteDeadLetterExpectation{
+		mock:   mmDeleteDeadLetter.mock,
+		params: &DeadLetterStorageMockDeleteDeadLetterParams{id},
+	}
+	mmDeleteDeadLetter.expectations = append(mmDeleteDeadLetter.expectations, expectation)
+	return expectation
+}
+
+// Then sets up DeadLetterStorage.DeleteDeadLetter return parameters for the expectation previously defined by the When method
+func (e *DeadLetterStorageMockDeleteDeadLetterExpectation) Then(err error) *DeadLetterStorageMock {
+	e.results = &DeadLetterStorageMockDeleteDeadLetterResults{err}
+	return e.mock
+}
+
+// DeleteDeadLetter implements interfaces.DeadLetterStorage
+func (mmDeleteDeadLetter *DeadLetterStorageMock) DeleteDeadLetter(id int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteDeadLetter.beforeDeleteDeadLetterCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteDeadLetter.afterDeleteDeadLetterCounter, 1)
+
+	if mmDeleteDeadLetter.inspectFuncDeleteDeadLetter != nil {
+		mmDeleteDeadLetter.inspectFuncDeleteDeadLetter(id)
+	}
+
+	mm_params := &DeadLetterStorageMockDeleteDeadLetterParams{id}
+
+	// Record call args
+	mmDeleteDeadLetter.DeleteDeadLetterMock.mutex.Lock()
+	mmDeleteDeadLetter.DeleteDeadLetterMock.callArgs = append(mmDeleteDeadLetter.DeleteDeadLetterMock.callArgs, mm_params)
+	mmDeleteDeadLetter.DeleteDeadLetterMock.mutex.Unlock()
+
+	for _, e := range mmDeleteDeadLetter.DeleteDeadLetterMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteDeadLetter.DeleteDeadLetterMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteDeadLetter.DeleteDeadLetterMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteDeadLetter.DeleteDeadLetterMock.defaultExpectation.params
+		mm_got := DeadLetterStorageMockDeleteDeadLetterParams{id}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteDeadLetter.t.Errorf("DeadLetterStorageMock.DeleteDeadLetter got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteDeadLetter.DeleteDeadLetterMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteDeadLetter.t.Fatal("No results are set for the DeadLetterStorageMock.DeleteDeadLetter")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteDeadLetter.funcDeleteDeadLetter != nil {
+		return mmDeleteDeadLetter.funcDeleteDeadLetter(id)
+	}
+	mmDeleteDeadLetter.t.Fatalf("Unexpected call to DeadLetterStorageMock.DeleteDeadLetter. %v", id)
+	return
+}
+
+// DeleteDeadLetterAfterCounter returns a count of finished DeadLetterStorageMock.DeleteDeadLetter invocations
+func (mmDeleteDeadLetter *DeadLetterStorageMock) DeleteDeadLetterAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteDeadLetter.afterDeleteDeadLetterCounter)
+}
+
+// DeleteDeadLetterBeforeCounter returns a count of DeadLetterStorageMock.DeleteDeadLetter invocations
+func (mmDeleteDeadLetter *DeadLetterStorageMock) DeleteDeadLetterBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteDeadLetter.beforeDeleteDeadLetterCounter)
+}
+
+// Calls returns a list of arguments used in each call to DeadLetterStorageMock.DeleteDeadLetter.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteDeadLetter *mDeadLetterStorageMockDeleteDeadLetter) Calls() []*DeadLetterStorageMockDeleteDeadLetterParams {
+	mmDeleteDeadLetter.mutex.RLock()
+
+	argCopy := make([]*DeadLetterStorageMockDeleteDeadLetterParams, len(mmDeleteDeadLetter.callArgs))
+	copy(argCopy, mmDeleteDeadLetter.callArgs)
+
+	mmDeleteDeadLetter.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDeadLetterDone returns true if the count of the DeleteDeadLetter invocations corresponds
+// the number of defined expectations
+func (m *DeadLetterStorageMock) MinimockDeleteDeadLetterDone() bool {
+	for _, e := range m.DeleteDeadLetterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteDeadLetterMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteDeadLetterCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteDeadLetter != nil && mm_atomic.LoadUint64(&m.afterDeleteDeadLetterCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDeleteDeadLetterInspect logs each unmet expectation
+func (m *DeadLetterStorageMock) MinimockDeleteDeadLetterInspect() {
+	for _, e := range m.DeleteDeadLetterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DeadLetterStorageMock.DeleteDeadLetter with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteDeadLetterMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteDeadLetterCounter) < 1 {
+		if m.DeleteDeadLetterMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to DeadLetterStorageMock.DeleteDeadLetter")
+		} else {
+			m.t.Errorf("Expected call to DeadLetterStorageMock.DeleteDeadLetter with params: %#v", *m.DeleteDeadLetterMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteDeadLetter != nil && mm_atomic.LoadUint64(&m.afterDeleteDeadLetterCounter) < 1 {
+		m.t.Error("Expected call to DeadLetterStorageMock.DeleteDeadLetter")
+	}
+}
+
+type mDeadLetterStorageMockGetDeadLetter struct {
+	mock               *DeadLetterStorageMock
+	defaultExpectation *DeadLetterStorageMockGetDeadLetterExpectation
+	expectations       []*DeadLetterStorageMockGetDeadLetterExpectation
+
+	callArgs []*DeadLetterStorageMockGetDeadLetterParams
+	mutex    sync.RWMutex
+}
+
+// DeadLetterStorageMockGetDeadLetterExpectation specifies expectation struct of the DeadLetterStorage.GetDeadLetter
+type DeadLetterStorageMockGetDeadLetterExpectation struct {
+	mock    *DeadLetterStorageMock
+	params  *DeadLetterStorageMockGetDeadLetterParams
+	results *DeadLetterStorageMockGetDeadLetterResults
+	Counter uint64
+}
+
+// DeadLetterStorageMockGetDeadLetterParams contains parameters of the DeadLetterStorage.GetDeadLetter
+type DeadLetterStorageMockGetDeadLetterParams struct {
+	id int64
+}
+
+// DeadLetterStorageMockGetDeadLetterResults contains results of the DeadLetterStorage.GetDeadLetter
+type DeadLetterStorageMockGetDeadLetterResults struct {
+	d1  models.DeadLetter
+	err error
+}
+
+// Expect sets up expected params for DeadLetterStorage.GetDeadLetter
+func (mmGetDeadLetter *mDeadLetterStorageMockGetDeadLetter) Expect(id int64) *mDeadLetterStorageMockGetDeadLetter {
+	if mmGetDeadLetter.mock.funcGetDeadLetter != nil {
+		mmGetDeadLetter.mock.t.Fatalf("DeadLetterStorageMock.GetDeadLetter mock is already set by Set")
+	}
+
+	if mmGetDeadLetter.defaultExpectation == nil {
+		mmGetDeadLetter.defaultExpectation = &DeadLetterStorageMockGetDeadLetterExpectation{}
+	}
+
+	mmGetDeadLetter.defaultExpectation.params = &DeadLetterStorageMockGetDeadLetterParams{id}
+	for _, e := range mmGetDeadLetter.expectations {
+		if minimock.Equal(e.params, mmGetDeadLetter.defaultExpectation.params) {
+			mmGetDeadLetter.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetDeadLetter.defaultExpectation.params)
+		}
+	}
+
+	return mmGetDeadLetter
+}
+
+// Inspect accepts an inspector function that has same arguments as the DeadLetterStorage.GetDeadLetter
+func (mmGetDeadLetter *mDeadLetterStorageMockGetDeadLetter) Inspect(f func(id int64)) *mDeadLetterStorageMockGetDeadLetter {
+	if mmGetDeadLetter.mock.inspectFuncGetDeadLetter != nil {
+		mmGetDeadLetter.mock.t.Fatalf("Inspect function is already set for DeadLetterStorageMock.GetDeadLetter")
+	}
+
+	mmGetDeadLetter.mock.inspectFuncGetDeadLetter = f
+
+	return mmGetDeadLetter
+}
+
+// Return sets up results that will be returned by DeadLetterStorage.GetDeadLetter
+func (mmGetDeadLetter *mDeadLetterStorageMockGetDeadLetter) Return(d1 models.DeadLetter, err error) *DeadLetterStorageMock {
+	if mmGetDeadLetter.mock.funcGetDeadLetter != nil {
+		mmGetDeadLetter.mock.t.Fatalf("DeadLetterStorageMock.GetDeadLetter mock is already set by Set")
+	}
+
+	if mmGetDeadLetter.defaultExpectation == nil {
+		mmGetDeadLetter.defaultExpectation = &DeadLetterStorageMockGetDeadLetterExpectation{mock: mmGetDeadLetter.mock}
+	}
+	mmGetDeadLetter.defaultExpectation.results = &DeadLetterStorageMockGetDeadLetterResults{d1, err}
+	return mmGetDeadLetter.mock
+}
+
+//Set uses given function f to mock the DeadLetterStorage.GetDeadLetter method
+func (mmGetDeadLetter *mDeadLetterStorageMockGetDeadLetter) Set(f func(id int64) (d1 models.DeadLetter, err error)) *DeadLetterStorageMock {
+	if mmGetDeadLetter.defaultExpectation != nil {
+		mmGetDeadLetter.mock.t.Fatalf("Default expectation is already set for the DeadLetterStorage.GetDeadLetter method")
+	}
+
+	if len(mmGetDeadLetter.expectations) > 0 {
+		mmGetDeadLetter.mock.t.Fatalf("Some expectations are already set for the DeadLetterStorage.GetDeadLetter method")
+	}
+
+	mmGetDeadLetter.mock.funcGetDeadLetter = f
+	return mmGetDeadLetter.mock
+}
+
+// When sets expectation for the DeadLetterStorage.GetDeadLetter which will trigger the result defined by the following
+// Then helper
+func (mmGetDeadLetter *mDeadLetterStorageMockGetDeadLetter) When(id int64) *DeadLetterStorageMockGetDeadLetterExpectation {
+	if mmGetDeadLetter.mock.funcGetDeadLetter != nil {
+		mmGetDeadLetter.mock.t.Fatalf("DeadLetterStorageMock.GetDeadLetter mock is already set by Set")
+	}
+
+	expectation := &DeadLetterStorageMockGetDeadLetterExpectation{
+		mock:   mmGetDeadLetter.mock,
+		params: &DeadLetterStorageMockGetDeadLetterParams{id},
+	}
+	mmGetDeadLetter.expectations = append(mmGetDeadLetter.expectations, expectation)
+	return expectation
+}
+
+// Then sets up DeadLetterStorage.GetDeadLetter return parameters for the expectation previously defined by the When method
+func (e *DeadLetterStorageMockGetDeadLetterExpectation) Then(d1 models.DeadLetter, err error) *DeadLetterStorageMock {
+	e.results = &DeadLetterStorageMockGetDeadLetterResults{d1, err}
+	return e.mock
+}
+
+// GetDeadLetter implements interfaces.DeadLetterStorage
+func (mmGetDeadLetter *DeadLetterStorageMock) GetDeadLetter(id int64) (d1 models.DeadLetter, err error) {
+	mm_atomic.AddUint64(&mmGetDeadLetter.beforeGetDeadLetterCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetDeadLetter.afterGetDeadLetterCounter, 1)
+
+	if mmGetDeadLetter.inspectFuncGetDeadLetter != nil {
+		mmGetDeadLetter.inspectFuncGetDeadLetter(id)
+	}
+
+	mm_params := &DeadLetterStorageMockGetDeadLetterParams{id}
+
+	// Record call args
+	mmGetDeadLetter.GetDeadLetterMock.mutex.Lock()
+	mmGetDeadLetter.GetDeadLetterMock.callArgs = append(mmGetDeadLetter.GetDeadLetterMock.callArgs, mm_params)
+	mmGetDeadLetter.GetDeadLetterMock.mutex.Unlock()
+
+	for _, e := range mmGetDeadLetter.GetDeadLetterMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.d1, e.results.err
+		}
+	}
+
+	if mmGetDeadLetter.GetDeadLetterMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetDeadLetter.GetDeadLetterMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetDeadLetter.GetDeadLetterMock.defaultExpectation.params
+		mm_got := DeadLetterStorageMockGetDeadLetterParams{id}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetDeadLetter.t.Errorf("DeadLetterStorageMock.GetDeadLetter got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetDeadLetter.GetDeadLetterMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetDeadLetter.t.Fatal("No results are set for the DeadLetterStorageMock.GetDeadLetter")
+		}
+		return (*mm_results).d1, (*mm_results).err
+	}
+	if mmGetDeadLetter.funcGetDeadLetter != nil {
+		return mmGetDeadLetter.funcGetDeadLetter(id)
+	}
+	mmGetDeadLetter.t.Fatalf("Unexpected call to DeadLetterStorageMock.GetDeadLetter. %v", id)
+	return
+}
+
+// GetDeadLetterAfterCounter returns a count of finished DeadLetterStorageMock.GetDeadLetter invocations
+func (mmGetDeadLetter *DeadLetterStorageMock) GetDeadLetterAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDeadLetter.afterGetDeadLetterCounter)
+}
+
+// GetDeadLetterBeforeCounter returns a count of DeadLetterStorageMock.GetDeadLetter invocations
+func (mmGetDeadLetter *DeadLetterStorageMock) GetDeadLetterBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDeadLetter.beforeGetDeadLetterCounter)
+}
+
+// Calls returns a list of arguments used in each call to DeadLetterStorageMock.GetDeadLetter.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetDeadLetter *mDeadLetterStorageMockGetDeadLetter) Calls() []*DeadLetterStorageMockGetDeadLetterParams {
+	mmGetDeadLetter.mutex.RLock()
+
+	argCopy := make([]*DeadLetterStorageMockGetDeadLetterParams, len(mmGetDeadLetter.callArgs))
+	copy(argCopy, mmGetDeadLetter.callArgs)
+
+	mmGetDeadLetter.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDeadLetterDone returns true if the count of the GetDeadLetter invocations corresponds
+// the number of defined expectations
+func (m *DeadLetterStorageMock) MinimockGetDeadLetterDone() bool {
+	for _, e := range m.GetDeadLetterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetDeadLetterMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetDeadLetterCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetDeadLetter != nil && mm_atomic.LoadUint64(&m.afterGetDeadLetterCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetDeadLetterInspect logs each unmet expectation
+func (m *DeadLetterStorageMock) MinimockGetDeadLetterInspect() {
+	for _, e := range m.GetDeadLetterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DeadLetterStorageMock.GetDeadLetter with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetDeadLetterMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetDeadLetterCounter) < 1 {
+		if m.GetDeadLetterMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to DeadLetterStorageMock.GetDeadLetter")
+		} else {
+			m.t.Errorf("Expected call to DeadLetterStorageMock.GetDeadLetter with params: %#v", *m.GetDeadLetterMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetDeadLetter != nil && mm_atomic.LoadUint64(&m.afterGetDeadLetterCounter) < 1 {
+		m.t.Error("Expected call to DeadLetterStorageMock.GetDeadLetter")
+	}
+}
+
+type mDeadLetterStorageMockGetDeadLetters struct {
+	mock               *DeadLetterStorageMock
+	defaultExpectation *DeadLetterStorageMockGetDeadLettersExpectation
+	expectations       []*DeadLetterStorageMockGetDeadLettersExpectation
+
+	callArgs []*DeadLetterStorageMockGetDeadLettersParams
+	mutex    sync.RWMutex
+}
+
+// DeadLetterStorageMockGetDeadLettersExpectation specifies expectation struct of the DeadLetterStorage.GetDeadLetters
+type DeadLetterStorageMockGetDeadLettersExpectation struct {
+	mock    *DeadLetterStorageMock
+	params  *DeadLetterStorageMockGetDeadLettersParams
+	results *DeadLetterStorageMockGetDeadLettersResults
+	Counter uint64
+}
+
+// DeadLetterStorageMockGetDeadLettersParams contains parameters of the DeadLetterStorage.GetDeadLetters
+type DeadLetterStorageMockGetDeadLettersParams struct {
+	limit  int
+	offset int
+}
+
+// DeadLetterStorageMockGetDeadLettersResults contains results of the DeadLetterStorage.GetDeadLetters
+type DeadLetterStorageMockGetDeadLettersResults struct {
+	da1 []models.DeadLetter
+	err error
+}
+
+// Expect sets up expected params for DeadLetterStorage.GetDeadLetters
+func (mmGetDeadLetters *mDeadLetterStorageMockGetDeadLetters) Expect(limit int, offset int) *mDeadLetterStorageMockGetDeadLetters {
+	if mmGetDeadLetters.mock.funcGetDeadLetters != nil {
+		mmGetDeadLetters.mock.t.Fatalf("DeadLetterStorageMock.GetDeadLetters mock is already set by Set")
+	}
+
+	if mmGetDeadLetters.defaultExpectation == nil {
+		mmGetDeadLetters.defaultExpectation = &DeadLetterStorageMockGetDeadLettersExpectation{}
+	}
+
+	mmGetDeadLetters.defaultExpectation.params = &DeadLetterStorageMockGetDeadLettersParams{limit, offset}
+	for _, e := range mmGetDeadLetters.expectations {
+		if minimock.Equal(e.params, mmGetDeadLetters.defaultExpectation.params) {
+			mmGetDeadLetters.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetDeadLetters.defaultExpectation.params)
+		}
+	}
+
+	return mmGetDeadLetters
+}
+
+// Inspect accepts an inspector function that has same arguments as the DeadLetterStorage.GetDeadLetters
+func (mmGetDeadLetters *mDeadLetterStorageMockGetDeadLetters) Inspect(f func(limit int, offset int)) *mDeadLetterStorageMockGetDeadLetters {
+	if mmGetDeadLetters.mock.inspectFuncGetDeadLetters != nil {
+		mmGetDeadLetters.mock.t.Fatalf("Inspect function is already set for DeadLetterStorageMock.GetDeadLetters")
+	}
+
+	mmGetDeadLetters.mock.inspectFuncGetDeadLetters = f
+
+	return mmGetDeadLetters
+}
+
+// Return sets up results that will be returned by DeadLetterStorage.GetDeadLetters
+func (mmGetDeadLetters *mDeadLetterStorageMockGetDeadLetters) Return(da1 []models.DeadLetter, err error) *DeadLetterStorageMock {
+	if mmGetDeadLetters.mock.funcGetDeadLetters != nil {
+		mmGetDeadLetters.mock.t.Fatalf("DeadLetterStorageMock.GetDeadLetters mock is already set by Set")
+	}
+
+	if mmGetDeadLetters.defaultExpectation == nil {
+		mmGetDeadLetters.defaultExpectation = &DeadLetterStorageMockGetDeadLettersExpectation{mock: mmGetDeadLetters.mock}
+	}
+	mmGetDeadLetters.defaultExpectation.results = &DeadLetterStorageMockGetDeadLettersResults{da1, err}
+	return mmGetDeadLetters.mock
+}
+
+//Set uses given function f to mock the DeadLetterStorage.GetDeadLetters method
+func (mmGetDeadLetters *mDeadLetterStorageMockGetDeadLetters) Set(f func(limit int, offset int) (da1 []models.DeadLetter, err error)) *DeadLetterStorageMock {
+	if mmGetDeadLetters.defaultExpectation != nil {
+		mmGetDeadLetters.mock.t.Fatalf("Default expectation is already set for the DeadLetterStorage.GetDeadLetters method")
+	}
+
+	if len(mmGetDeadLetters.expectations) > 0 {
+		mmGetDeadLetters.mock.t.Fatalf("Some expectations are already set for the DeadLetterStorage.GetDeadLetters method")
+	}
+
+	mmGetDeadLetters.mock.funcGetDeadLetters = f
+	return mmGetDeadLetters.mock
+}
+
+// When sets expectation for the DeadLetterStorage.GetDeadLetters which will trigger the result defined by the following
+// Then helper
+func (mmGetDeadLetters *mDeadLetterStorageMockGetDeadLetters) When(limit int, offset int) *DeadLetterStorageMockGetDeadLettersExpectation {
+	if mmGetDeadLetters.mock.funcGetDeadLetters != nil {
+		mmGetDeadLetters.mock.t.Fatalf("DeadLetterStorageMock.GetDeadLetters mock is already set by Set")
+	}
+
+	expectation := &DeadLetterStorageMockGetDeadLettersExpectation{
+		mock:   mmGetDeadLetters.mock,
+		params: &DeadLetterStorageMockGetDeadLettersParams{limit, offset},
+	}
+	mmGetDeadLetters.expectations = append(mmGetDeadLetters.expectations, expectation)
+	return expectation
+}
+
+// Then sets up DeadLetterStorage.GetDeadLetters return parameters for the expectation previously defined by the When method
+func (e *DeadLetterStorageMockGetDeadLettersExpectation) Then(da1 []models.DeadLetter, err error) *DeadLetterStorageMock {
+	e.results = &DeadLetterStorageMockGetDeadLettersResults{da1, err}
+	return e.mock
+}
+
+// GetDeadLetters implements interfaces.DeadLetterStorage
+func (mmGetDeadLetters *DeadLetterStorageMock) GetDeadLetters(limit int, offset int) (da1 []models.DeadLetter, err error) {
+	mm_atomic.AddUint64(&mmGetDeadLetters.beforeGetDeadLettersCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetDeadLetters.afterGetDeadLettersCounter, 1)
+
+	if mmGetDeadLetters.inspectFuncGetDeadLetters != nil {
+		mmGetDeadLetters.inspectFuncGetDeadLetters(limit, offset)
+	}
+
+	mm_params := &DeadLetterStorageMockGetDeadLettersParams{limit, offset}
+
+	// Record call args
+	mmGetDeadLetters.GetDeadLettersMock.mutex.Lock()
+	mmGetDeadLetters.GetDeadLettersMock.callArgs = append(mmGetDeadLetters.GetDeadLettersMock.callArgs, mm_params)
+	mmGetDeadLetters.GetDeadLettersMock.mutex.Unlock()
+
+	for _, e := range mmGetDeadLetters.GetDeadLettersMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.da1, e.results.err
+		}
+	}
+
+	if mmGetDeadLetters.GetDeadLettersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetDeadLetters.GetDeadLettersMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetDeadLetters.GetDeadLettersMock.defaultExpectation.params
+		mm_got := DeadLetterStorageMockGetDeadLettersParams{limit, offset}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetDeadLetters.t.Errorf("DeadLetterStorageMock.GetDeadLetters got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetDeadLetters.GetDeadLettersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetDeadLetters.t.Fatal("No results are set for the DeadLetterStorageMock.GetDeadLetters")
+		}
+		return (*mm_results).da1, (*mm_results).err
+	}
+	if mmGetDeadLetters.funcGetDeadLetters != nil {
+		return mmGetDeadLetters.funcGetDeadLetters(limit, offset)
+	}
+	mmGetDeadLetters.t.Fatalf("Unexpected call to DeadLetterStorageMock.GetDeadLetters. %v %v", limit, offset)
+	return
+}
+
+// GetDeadLettersAfterCounter returns a count of finished DeadLetterStorageMock.GetDeadLetters invocations
+func (mmGetDeadLetters *DeadLetterStorageMock) GetDeadLettersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDeadLetters.afterGetDeadLettersCounter)
+}
+
+// GetDeadLettersBeforeCounter returns a count of DeadLetterStorageMock.GetDeadLetters invocations
+func (mmGetDeadLetters *DeadLetterStorageMock) GetDeadLettersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDeadLetters.beforeGetDeadLettersCounter)
+}
+
+// Calls returns a list of arguments used in each call to DeadLetterStorageMock.GetDeadLetters.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetDeadLetters *mDeadLetterStorageMockGetDeadLetters) Calls() []*DeadLetterStorageMockGetDeadLettersParams {
+	mmGetDeadLetters.mutex.RLock()
+
+	argCopy := make([]*DeadLetterStorageMockGetDeadLettersParams, len(mmGetDeadLetters.callArgs))
+	copy(argCopy, mmGetDeadLetters.callArgs)
+
+	mmGetDeadLetters.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDeadLettersDone returns true if the count of the GetDeadLetters invocations corresponds
+// the number of defined expectations
+func (m *DeadLetterStorageMock) MinimockGetDeadLettersDone() bool {
+	for _, e := range m.GetDeadLettersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetDeadLettersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetDeadLettersCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetDeadLetters != nil && mm_atomic.LoadUint64(&m.afterGetDeadLettersCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetDeadLettersInspect logs each unmet expectation
+func (m *DeadLetterStorageMock) MinimockGetDeadLettersInspect() {
+	for _, e := range m.GetDeadLettersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DeadLetterStorageMock.GetDeadLetters with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetDeadLettersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetDeadLettersCounter) < 1 {
+		if m.GetDeadLettersMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to DeadLetterStorageMock.GetDeadLetters")
+		} else {
+			m.t.Errorf("Expected call to DeadLetterStorageMock.GetDeadLetters with params: %#v", *m.GetDeadLettersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetDeadLetters != nil && mm_atomic.LoadUint64(&m.afterGetDeadLettersCounter) < 1 {
+		m.t.Error("Expected call to DeadLetterStorageMock.GetDeadLetters")
+	}
+}
+
+type mDeadLetterStorageMockSaveDeadLetter struct {
+	mock               *DeadLetterStorageMock
+	defaultExpectation *DeadLetterStorageMockSaveDeadLetterExpectation
+	expectations       []*DeadLetterStorageMockSaveDeadLetterExpectation
+
+	callArgs []*DeadLetterStorageMockSaveDeadLetterParams
+	mutex    sync.RWMutex
+}
+
+// DeadLetterStorageMockSaveDeadLetterExpectation specifies expectation struct of the DeadLetterStorage.SaveDeadLetter
+type DeadLetterStorageMockSaveDeadLetterExpectation struct {
+	mock    *DeadLetterStorageMock
+	params  *DeadLetterStorageMockSaveDeadLetterParams
+	results *DeadLetterStorageMockSaveDeadLetterResults
+	Counter uint64
+}
+
+// DeadLetterStorageMockSaveDeadLetterParams contains parameters of the DeadLetterStorage.SaveDeadLetter
+type DeadLetterStorageMockSaveDeadLetterParams struct {
+	deadLetter models.DeadLetter
+}
+
+// DeadLetterStorageMockSaveDeadLetterResults contains results of the DeadLetterStorage.SaveDeadLetter
+type DeadLetterStorageMockSaveDeadLetterResults struct {
+	err error
+}
+
+// Expect sets up expected params for DeadLetterStorage.SaveDeadLetter
+func (mmSaveDeadLetter *mDeadLetterStorageMockSaveDeadLetter) Expect(deadLetter models.DeadLetter) *mDeadLetterStorageMockSaveDeadLetter {
+	if mmSaveDeadLetter.mock.funcSaveDeadLetter != nil {
+		mmSaveDeadLetter.mock.t.Fatalf("DeadLetterStorageMock.SaveDeadLetter mock is already set by Set")
+	}
+
+	if mmSaveDeadLetter.defaultExpectation == nil {
+		mmSaveDeadLetter.defaultExpectation = &DeadLetterStorageMockSaveDeadLetterExpectation{}
+	}
+
+	mmSaveDeadLetter.defaultExpectation.params = &DeadLetterStorageMockSaveDeadLetterParams{deadLetter}
+	for _, e := range mmSaveDeadLetter.expectations {
+		if minimock.Equal(e.params, mmSaveDeadLetter.defaultExpectation.params) {
+			mmSaveDeadLetter.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveDeadLetter.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveDeadLetter
+}
+
+// Inspect accepts an inspector function that has same arguments as the DeadLetterStorage.SaveDeadLetter
+func (mmSaveDeadLetter *mDeadLetterStorageMockSaveDeadLetter) Inspect(f func(deadLetter models.DeadLetter)) *mDeadLetterStorageMockSaveDeadLetter {
+	if mmSaveDeadLetter.mock.inspectFuncSaveDeadLetter != nil {
+		mmSaveDeadLetter.mock.t.Fatalf("Inspect function is already set for DeadLetterStorageMock.SaveDeadLetter")
+	}
+
+	mmSaveDeadLetter.mock.inspectFuncSaveDeadLetter = f
+
+	return mmSaveDeadLetter
+}
+
+// Return sets up results that will be returned by DeadLetterStorage.SaveDeadLetter
+func (mmSaveDeadLetter *mDeadLetterStorageMockSaveDeadLetter) Return(err error) *DeadLetterStorageMock {
+	if mmSaveDeadLetter.mock.funcSaveDeadLetter != nil {
+		mmSaveDeadLetter.mock.t.Fatalf("DeadLetterStorageMock.SaveDeadLetter mock is already set by Set")
+	}
+
+	if mmSaveDeadLetter.defaultExpectation == nil {
+		mmSaveDeadLetter.defaultExpectation = &DeadLetterStorageMockSaveDeadLetterExpectation{mock: mmSaveDeadLetter.mock}
+	}
+	mmSaveDeadLetter.defaultExpectation.results = &DeadLetterStorageMockSaveDeadLetterResults{err}
+	return mmSaveDeadLetter.mock
+}
+
+//Set uses given function f to mock the DeadLetterStorage.SaveDeadLetter method
+func (mmSaveDeadLetter *mDeadLetterStorageMockSaveDeadLetter) Set(f func(deadLetter models.DeadLetter) (err error)) *DeadLetterStorageMock {
+	if mmSaveDeadLetter.defaultExpectation != nil {
+		mmSaveDeadLetter.mock.t.Fatalf("Default expectation is already set for the DeadLetterStorage.SaveDeadLetter method")
+	}
+
+	if len(mmSaveDeadLetter.expectations) > 0 {
+		mmSaveDeadLetter.mock.t.Fatalf("Some expectations are already set for the DeadLetterStorage.SaveDeadLetter method")
+	}
+
+	mmSaveDeadLetter.mock.funcSaveDeadLetter = f
+	return mmSaveDeadLetter.mock
+}
+
+// When sets expectation for the DeadLetterStorage.SaveDeadLetter which will trigger the result defined by the following
+// Then helper
+func (mmSaveDeadLetter *mDeadLetterStorageMockSaveDeadLetter) When(deadLetter models.DeadLetter) *DeadLetterStorageMockSaveDeadLetterExpectation {
+	if mmSaveDeadLetter.mock.funcSaveDeadLetter != nil {
+		mmSaveDeadLetter.mock.t.Fatalf("DeadLetterStorageMock.SaveDeadLetter mock is already set by Set")
+	}
+
+	expectation := &DeadLetterStorageMockSaveDeadLetterExpectation{
+		mock:   mmSaveDeadLetter.mock,
+		params: &DeadLetterStorageMockSaveDeadLetterParams{deadLetter},
+	}
+	mmSaveDeadLetter.expectations = append(mmSaveDeadLetter.expectations, expectation)
+	return expectation
+}
+
+// Then sets up DeadLetterStorage.SaveDeadLetter return parameters for the expectation previously defined by the When method
+func (e *DeadLetterStorageMockSaveDeadLetterExpectation) Then(err error) *DeadLetterStorageMock {
+	e.results = &DeadLetterStorageMockSaveDeadLetterResults{err}
+	return e.mock
+}
+
+// SaveDeadLetter implements interfaces.DeadLetterStorage
+func (mmSaveDeadLetter *DeadLetterStorageMock) SaveDeadLetter(deadLetter models.DeadLetter) (err error) {
+	mm_atomic.AddUint64(&mmSaveDeadLetter.beforeSaveDeadLetterCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveDeadLetter.afterSaveDeadLetterCounter, 1)
+
+	if mmSaveDeadLetter.inspectFuncSaveDeadLetter != nil {
+		mmSaveDeadLetter.inspectFuncSaveDeadLetter(deadLetter)
+	}
+
+	mm_params := &DeadLetterStorageMockSaveDeadLetterParams{deadLetter}
+
+	// Record call args
+	mmSaveDeadLetter.SaveDeadLetterMock.mutex.Lock()
+	mmSaveDeadLetter.SaveDeadLetterMock.callArgs = append(mmSaveDeadLetter.SaveDeadLetterMock.callArgs, mm_params)
+	mmSaveDeadLetter.SaveDeadLetterMock.mutex.Unlock()
+
+	for _, e := range mmSaveDeadLetter.SaveDeadLetterMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveDeadLetter.SaveDeadLetterMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveDeadLetter.SaveDeadLetterMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveDeadLetter.SaveDeadLetterMock.defaultExpectation.params
+		mm_got := DeadLetterStorageMockSaveDeadLetterParams{deadLetter}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveDeadLetter.t.Errorf("DeadLetterStorageMock.SaveDeadLetter got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveDeadLetter.SaveDeadLetterMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveDeadLetter.t.Fatal("No results are set for the DeadLetterStorageMock.SaveDeadLetter")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveDeadLetter.funcSaveDeadLetter != nil {
+		return mmSaveDeadLetter.funcSaveDeadLetter(deadLetter)
+	}
+	mmSaveDeadLetter.t.Fatalf("Unexpected call to DeadLetterStorageMock.SaveDeadLetter. %v", deadLetter)
+	return
+}
+
+// SaveDeadLetterAfterCounter returns a count of finished DeadLetterStorageMock.SaveDeadLetter invocations
+func (mmSaveDeadLetter *DeadLetterStorageMock) SaveDeadLetterAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveDeadLetter.afterSaveDeadLetterCounter)
+}
+
+// SaveDeadLetterBeforeCounter returns a count of DeadLetterStorageMock.SaveDeadLetter invocations
+func (mmSaveDeadLetter *DeadLetterStorageMock) SaveDeadLetterBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveDeadLetter.beforeSaveDeadLetterCounter)
+}
+
+// Calls returns a list of arguments used in each call to DeadLetterStorageMock.SaveDeadLetter.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveDeadLetter *mDeadLetterStorageMockSaveDeadLetter) Calls() []*DeadLetterStorageMockSaveDeadLetterParams {
+	mmSaveDeadLetter.mutex.RLock()
+
+	argCopy := make([]*DeadLetterStorageMockSaveDeadLetterParams, len(mmSaveDeadLetter.callArgs))
+	copy(argCopy, mmSaveDeadLetter.callArgs)
+
+	mmSaveDeadLetter.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveDeadLetterDone returns true if the count of the SaveDeadLetter invocations corresponds
+// the number of defined expectations
+func (m *DeadLetterStorageMock) MinimockSaveDeadLetterDone() bool {
+	for _, e := range m.SaveDeadLetterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveDeadLetterMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveDeadLetterCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveDeadLetter != nil && mm_atomic.LoadUint64(&m.afterSaveDeadLetterCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSaveDeadLetterInspect logs each unmet expectation
+func (m *DeadLetterStorageMock) MinimockSaveDeadLetterInspect() {
+	for _, e := range m.SaveDeadLetterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DeadLetterStorageMock.SaveDeadLetter with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveDeadLetterMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveDeadLetterCounter) < 1 {
+		if m.SaveDeadLetterMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to DeadLetterStorageMock.SaveDeadLetter")
+		} else {
+			m.t.Errorf("Expected call to DeadLetterStorageMock.SaveDeadLetter with params: %#v", *m.SaveDeadLetterMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveDeadLetter != nil && mm_atomic.LoadUint64(&m.afterSaveDeadLetterCounter) < 1 {
+		m.t.Error("Expected call to DeadLetterStorageMock.SaveDeadLetter")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *DeadLetterStorageMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockDeleteDeadLetterInspect()
+
+		m.MinimockGetDeadLetterInspect()
+
+		m.MinimockGetDeadLettersInspect()
+
+		m.MinimockSaveDeadLetterInspect()
+		m.t.FailNow()
+	}
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *DeadLetterStorageMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *DeadLetterStorageMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDeleteDeadLetterDone() &&
+		m.MinimockGetDeadLetterDone() &&
+		m.MinimockGetDeadLettersDone() &&
+		m.MinimockSaveDeadLetterDone()
+}
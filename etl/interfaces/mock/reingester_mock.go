@@ -0,0 +1,439 @@
+package mock
+
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/insolar/block-explorer/etl/types"
+)
+
+// ReingesterMock implements interfaces.Reingester
+type ReingesterMock struct {
+	t minimock.Tester
+
+	funcReingest          func(ctx context.Context, fromPulseNumber int64, toPulseNumber int64) (err error)
+	inspectFuncReingest   func(ctx context.Context, fromPulseNumber int64, toPulseNumber int64)
+	afterReingestCounter  uint64
+	beforeReingestCounter uint64
+	ReingestMock          mReingesterMockReingest
+
+	funcReingestProgress          func() (r1 types.ReingestProgress, b1 bool)
+	inspectFuncReingestProgress   func()
+	afterReingestProgressCounter  uint64
+	beforeReingestProgressCounter uint64
+	ReingestProgressMock          mReingesterMockReingestProgress
+}
+
+// NewReingesterMock returns a mock for interfaces.Reingester
+func NewReingesterMock(t minimock.Tester) *ReingesterMock {
+	m := &ReingesterMock{t: t}
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ReingestMock = mReingesterMockReingest{mock: m}
+	m.ReingestMock.callArgs = []*ReingesterMockReingestParams{}
+
+	m.ReingestProgressMock = mReingesterMockReingestProgress{mock: m}
+
+	return m
+}
+
+type mReingesterMockReingest struct {
+	mock               *ReingesterMock
+	defaultExpectation *ReingesterMockReingestExpectation
+	expectations       []*ReingesterMockReingestExpectation
+
+	callArgs []*ReingesterMockReingestParams
+	mutex    sync.RWMutex
+}
+
+// ReingesterMockReingestExpectation specifies expectation struct of the Reingester.Reingest
+type ReingesterMockReingestExpectation struct {
+	mock    *ReingesterMock
+	params  *ReingesterMockReingestParams
+	results *ReingesterMockReingestResults
+	Counter uint64
+}
+
+// ReingesterMockReingestParams contains parameters of the Reingester.Reingest
+type ReingesterMockReingestParams struct {
+	ctx             context.Context
+	fromPulseNumber int64
+	toPulseNumber   int64
+}
+
+// ReingesterMockReingestResults contains results of the Reingester.Reingest
+type ReingesterMockReingestResults struct {
+	err error
+}
+
+// Expect sets up expected params for Reingester.Reingest
+func (mmReingest *mReingesterMockReingest) Expect(ctx context.Context, fromPulseNumber int64, toPulseNumber int64) *mReingesterMockReingest {
+	if mmReingest.mock.funcReingest != nil {
+		mmReingest.mock.t.Fatalf("ReingesterMock.Reingest mock is already set by Set")
+	}
+
+	if mmReingest.defaultExpectation == nil {
+		mmReingest.defaultExpectation = &ReingesterMockReingestExpectation{}
+	}
+
+	mmReingest.defaultExpectation.params = &ReingesterMockReingestParams{ctx, fromPulseNumber, toPulseNumber}
+	for _, e := range mmReingest.expectations {
+		if minimock.Equal(e.params, mmReingest.defaultExpectation.params) {
+			mmReingest.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReingest.defaultExpectation.params)
+		}
+	}
+
+	return mmReingest
+}
+
+// Inspect accepts an inspector function that has same arguments as the Reingester.Reingest
+func (mmReingest *mReingesterMockReingest) Inspect(f func(ctx context.Context, fromPulseNumber int64, toPulseNumber int64)) *mReingesterMockReingest {
+	if mmReingest.mock.inspectFuncReingest != nil {
+		mmReingest.mock.t.Fatalf("Inspect function is already set for ReingesterMock.Reingest")
+	}
+
+	mmReingest.mock.inspectFuncReingest = f
+
+	return mmReingest
+}
+
+// Return sets up results that will be returned by Reingester.Reingest
+func (mmReingest *mReingesterMockReingest) Return(err error) *ReingesterMock {
+	if mmReingest.mock.funcReingest != nil {
+		mmReingest.mock.t.Fatalf("ReingesterMock.Reingest mock is already set by Set")
+	}
+
+	if mmReingest.defaultExpectation == nil {
+		mmReingest.defaultExpectation = &ReingesterMockReingestExpectation{mock: mmReingest.mock}
+	}
+	mmReingest.defaultExpectation.results = &ReingesterMockReingestResults{err}
+	return mmReingest.mock
+}
+
+//Set uses given function f to mock the Reingester.Reingest method
+func (mmReingest *mReingesterMockReingest) Set(f func(ctx context.Context, fromPulseNumber int64, toPulseNumber int64) (err error)) *ReingesterMock {
+	if mmReingest.defaultExpectation != nil {
+		mmReingest.mock.t.Fatalf("Default expectation is already set for the Reingester.Reingest method")
+	}
+
+	if len(mmReingest.expectations) > 0 {
+		mmReingest.mock.t.Fatalf("Some expectations are already set for the Reingester.Reingest method")
+	}
+
+	mmReingest.mock.funcReingest = f
+	return mmReingest.mock
+}
+
+// When sets expectation for the Reingester.Reingest which will trigger the result defined by the following
+// Then helper
+func (mmReingest *mReingesterMockReingest) When(ctx context.Context, fromPulseNumber int64, toPulseNumber int64) *ReingesterMockReingestExpectation {
+	if mmReingest.mock.funcReingest != nil {
+		mmReingest.mock.t.Fatalf("ReingesterMock.Reingest mock is already set by Set")
+	}
+
+	expectation := &ReingesterMockReingestExpectation{
+		mock:   mmReingest.mock,
+		params: &ReingesterMockReingestParams{ctx, fromPulseNumber, toPulseNumber},
+	}
+	mmReingest.expectations = append(mmReingest.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Reingester.Reingest return parameters for the expectation previously defined by the When method
+func (e *ReingesterMockReingestExpectation) Then(err error) *ReingesterMock {
+	e.results = &ReingesterMockReingestResults{err}
+	return e.mock
+}
+
+// Reingest implements interfaces.Reingester
+func (mmReingest *ReingesterMock) Reingest(ctx context.Context, fromPulseNumber int64, toPulseNumber int64) (err error) {
+	mm_atomic.AddUint64(&mmReingest.beforeReingestCounter, 1)
+	defer mm_atomic.AddUint64(&mmReingest.afterReingestCounter, 1)
+
+	if mmReingest.inspectFuncReingest != nil {
+		mmReingest.inspectFuncReingest(ctx, fromPulseNumber, toPulseNumber)
+	}
+
+	mm_params := &ReingesterMockReingestParams{ctx, fromPulseNumber, toPulseNumber}
+
+	// Record call args
+	mmReingest.ReingestMock.mutex.Lock()
+	mmReingest.ReingestMock.callArgs = append(mmReingest.ReingestMock.callArgs, mm_params)
+	mmReingest.ReingestMock.mutex.Unlock()
+
+	for _, e := range mmReingest.ReingestMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReingest.ReingestMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReingest.ReingestMock.defaultExpectation.Counter, 1)
+		mm_want := mmReingest.ReingestMock.defaultExpectation.params
+		mm_got := ReingesterMockReingestParams{ctx, fromPulseNumber, toPulseNumber}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReingest.t.Errorf("ReingesterMock.Reingest got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReingest.ReingestMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReingest.t.Fatal("No results are set for the ReingesterMock.Reingest")
+		}
+		return (*mm_results).err
+	}
+	if mmReingest.funcReingest != nil {
+		return mmReingest.funcReingest(ctx, fromPulseNumber, toPulseNumber)
+	}
+	mmReingest.t.Fatalf("Unexpected call to ReingesterMock.Reingest. %v %v %v", ctx, fromPulseNumber, toPulseNumber)
+	return
+}
+
+// ReingestAfterCounter returns a count of finished ReingesterMock.Reingest invocations
+func (mmReingest *ReingesterMock) ReingestAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReingest.afterReingestCounter)
+}
+
+// ReingestBeforeCounter returns a count of ReingesterMock.Reingest invocations
+func (mmReingest *ReingesterMock) ReingestBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReingest.beforeReingestCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReingesterMock.Reingest.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReingest *mReingesterMockReingest) Calls() []*ReingesterMockReingestParams {
+	mmReingest.mutex.RLock()
+
+	argCopy := make([]*ReingesterMockReingestParams, len(mmReingest.callArgs))
+	copy(argCopy, mmReingest.callArgs)
+
+	mmReingest.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReingestDone returns true if the count of the Reingest invocations corresponds
+// the number of defined expectations
+func (m *ReingesterMock) MinimockReingestDone() bool {
+	for _, e := range m.ReingestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReingestMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReingestCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReingest != nil && mm_atomic.LoadUint64(&m.afterReingestCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockReingestInspect logs each unmet expectation
+func (m *ReingesterMock) MinimockReingestInspect() {
+	for _, e := range m.ReingestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReingesterMock.Reingest with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReingestMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReingestCounter) < 1 {
+		if m.ReingestMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ReingesterMock.Reingest")
+		} else {
+			m.t.Errorf("Expected call to ReingesterMock.Reingest with params: %#v", *m.ReingestMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReingest != nil && mm_atomic.LoadUint64(&m.afterReingestCounter) < 1 {
+		m.t.Error("Expected call to ReingesterMock.Reingest")
+	}
+}
+
+type mReingesterMockReingestProgress struct {
+	mock               *ReingesterMock
+	defaultExpectation *ReingesterMockReingestProgressExpectation
+	expectations       []*ReingesterMockReingestProgressExpectation
+}
+
+// ReingesterMockReingestProgressExpectation specifies expectation struct of the Reingester.ReingestProgress
+type ReingesterMockReingestProgressExpectation struct {
+	mock *ReingesterMock
+
+	results *ReingesterMockReingestProgressResults
+	Counter uint64
+}
+
+// ReingesterMockReingestProgressResults contains results of the Reingester.ReingestProgress
+type ReingesterMockReingestProgressResults struct {
+	r1 types.ReingestProgress
+	b1 bool
+}
+
+// Expect sets up expected params for Reingester.ReingestProgress
+func (mmReingestProgress *mReingesterMockReingestProgress) Expect() *mReingesterMockReingestProgress {
+	if mmReingestProgress.mock.funcReingestProgress != nil {
+		mmReingestProgress.mock.t.Fatalf("ReingesterMock.ReingestProgress mock is already set by Set")
+	}
+
+	if mmReingestProgress.defaultExpectation == nil {
+		mmReingestProgress.defaultExpectation = &ReingesterMockReingestProgressExpectation{}
+	}
+
+	return mmReingestProgress
+}
+
+// Inspect accepts an inspector function that has same arguments as the Reingester.ReingestProgress
+func (mmReingestProgress *mReingesterMockReingestProgress) Inspect(f func()) *mReingesterMockReingestProgress {
+	if mmReingestProgress.mock.inspectFuncReingestProgress != nil {
+		mmReingestProgress.mock.t.Fatalf("Inspect function is already set for ReingesterMock.ReingestProgress")
+	}
+
+	mmReingestProgress.mock.inspectFuncReingestProgress = f
+
+	return mmReingestProgress
+}
+
+// Return sets up results that will be returned by Reingester.ReingestProgress
+func (mmReingestProgress *mReingesterMockReingestProgress) Return(r1 types.ReingestProgress, b1 bool) *ReingesterMock {
+	if mmReingestProgress.mock.funcReingestProgress != nil {
+		mmReingestProgress.mock.t.Fatalf("ReingesterMock.ReingestProgress mock is already set by Set")
+	}
+
+	if mmReingestProgress.defaultExpectation == nil {
+		mmReingestProgress.defaultExpectation = &ReingesterMockReingestProgressExpectation{mock: mmReingestProgress.mock}
+	}
+	mmReingestProgress.defaultExpectation.results = &ReingesterMockReingestProgressResults{r1, b1}
+	return mmReingestProgress.mock
+}
+
+//Set uses given function f to mock the Reingester.ReingestProgress method
+func (mmReingestProgress *mReingesterMockReingestProgress) Set(f func() (r1 types.ReingestProgress, b1 bool)) *ReingesterMock {
+	if mmReingestProgress.defaultExpectation != nil {
+		mmReingestProgress.mock.t.Fatalf("Default expectation is already set for the Reingester.ReingestProgress method")
+	}
+
+	if len(mmReingestProgress.expectations) > 0 {
+		mmReingestProgress.mock.t.Fatalf("Some expectations are already set for the Reingester.ReingestProgress method")
+	}
+
+	mmReingestProgress.mock.funcReingestProgress = f
+	return mmReingestProgress.mock
+}
+
+// ReingestProgress implements interfaces.Reingester
+func (mmReingestProgress *ReingesterMock) ReingestProgress() (r1 types.ReingestProgress, b1 bool) {
+	mm_atomic.AddUint64(&mmReingestProgress.beforeReingestProgressCounter, 1)
+	defer mm_atomic.AddUint64(&mmReingestProgress.afterReingestProgressCounter, 1)
+
+	if mmReingestProgress.inspectFuncReingestProgress != nil {
+		mmReingestProgress.inspectFuncReingestProgress()
+	}
+
+	if mmReingestProgress.ReingestProgressMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReingestProgress.ReingestProgressMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmReingestProgress.ReingestProgressMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReingestProgress.t.Fatal("No results are set for the ReingesterMock.ReingestProgress")
+		}
+		return (*mm_results).r1, (*mm_results).b1
+	}
+	if mmReingestProgress.funcReingestProgress != nil {
+		return mmReingestProgress.funcReingestProgress()
+	}
+	mmReingestProgress.t.Fatalf("Unexpected call to ReingesterMock.ReingestProgress.")
+	return
+}
+
+// ReingestProgressAfterCounter returns a count of finished ReingesterMock.ReingestProgress invocations
+func (mmReingestProgress *ReingesterMock) ReingestProgressAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReingestProgress.afterReingestProgressCounter)
+}
+
+// ReingestProgressBeforeCounter returns a count of ReingesterMock.ReingestProgress invocations
+func (mmReingestProgress *ReingesterMock) ReingestProgressBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReingestProgress.beforeReingestProgressCounter)
+}
+
+// MinimockReingestProgressDone returns true if the count of the ReingestProgress invocations corresponds
+// the number of defined expectations
+func (m *ReingesterMock) MinimockReingestProgressDone() bool {
+	for _, e := range m.ReingestProgressMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReingestProgressMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReingestProgressCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReingestProgress != nil && mm_atomic.LoadUint64(&m.afterReingestProgressCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockReingestProgressInspect logs each unmet expectation
+func (m *ReingesterMock) MinimockReingestProgressInspect() {
+	for _, e := range m.ReingestProgressMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to ReingesterMock.ReingestProgress")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReingestProgressMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReingestProgressCounter) < 1 {
+		m.t.Error("Expected call to ReingesterMock.ReingestProgress")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReingestProgress != nil && mm_atomic.LoadUint64(&m.afterReingestProgressCounter) < 1 {
+		m.t.Error("Expected call to ReingesterMock.ReingestProgress")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ReingesterMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockReingestInspect()
+
+		m.MinimockReingestProgressInspect()
+		m.t.FailNow()
+	}
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ReingesterMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ReingesterMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockReingestDone() &&
+		m.MinimockReingestProgressDone()
+}
@@ -21,6 +21,24 @@ type StorageMock struct {
 	beforeCompletePulseCounter uint64
 	CompletePulseMock          mStorageMockCompletePulse
 
+	funcDeletePulses          func(fromPulseNumber int64, toPulseNumber int64) (err error)
+	inspectFuncDeletePulses   func(fromPulseNumber int64, toPulseNumber int64)
+	afterDeletePulsesCounter  uint64
+	beforeDeletePulsesCounter uint64
+	DeletePulsesMock          mStorageMockDeletePulses
+
+	funcDeleteReloadRanges          func(toPulseNumber int64) (err error)
+	inspectFuncDeleteReloadRanges   func(toPulseNumber int64)
+	afterDeleteReloadRangesCounter  uint64
+	beforeDeleteReloadRangesCounter uint64
+	DeleteReloadRangesMock          mStorageMockDeleteReloadRanges
+
+	funcGetCheckpoint          func(name string) (c1 models.Checkpoint, err error)
+	inspectFuncGetCheckpoint   func(name string)
+	afterGetCheckpointCounter  uint64
+	beforeGetCheckpointCounter uint64
+	GetCheckpointMock          mStorageMockGetCheckpoint
+
 	funcGetIncompletePulses          func() (pa1 []models.Pulse, err error)
 	inspectFuncGetIncompletePulses   func()
 	afterGetIncompletePulsesCounter  uint64
@@ -33,42 +51,108 @@ type StorageMock struct {
 	beforeGetJetDropsCounter uint64
 	GetJetDropsMock          mStorageMockGetJetDrops
 
-	funcGetNextCompletePulseFilterByPrototypeReference          func(prevPulse int64, prototypes [][]byte) (p1 models.Pulse, err error)
-	inspectFuncGetNextCompletePulseFilterByPrototypeReference   func(prevPulse int64, prototypes [][]byte)
-	afterGetNextCompletePulseFilterByPrototypeReferenceCounter  uint64
-	beforeGetNextCompletePulseFilterByPrototypeReferenceCounter uint64
-	GetNextCompletePulseFilterByPrototypeReferenceMock          mStorageMockGetNextCompletePulseFilterByPrototypeReference
-
 	funcGetNextSavedPulse          func(fromPulseNumber models.Pulse, completedOnly bool) (p1 models.Pulse, err error)
 	inspectFuncGetNextSavedPulse   func(fromPulseNumber models.Pulse, completedOnly bool)
 	afterGetNextSavedPulseCounter  uint64
 	beforeGetNextSavedPulseCounter uint64
 	GetNextSavedPulseMock          mStorageMockGetNextSavedPulse
 
+	funcGetNextSequentialPulse          func(fromPulseNumber int64) (p1 models.Pulse, err error)
+	inspectFuncGetNextSequentialPulse   func(fromPulseNumber int64)
+	afterGetNextSequentialPulseCounter  uint64
+	beforeGetNextSequentialPulseCounter uint64
+	GetNextSequentialPulseMock          mStorageMockGetNextSequentialPulse
+
+	funcGetNextSequentialPulseFilterByRecords          func(fromPulseNumber int64, prototypes [][]byte, objectReferences [][]byte, recordTypes []string) (p1 models.Pulse, err error)
+	inspectFuncGetNextSequentialPulseFilterByRecords   func(fromPulseNumber int64, prototypes [][]byte, objectReferences [][]byte, recordTypes []string)
+	afterGetNextSequentialPulseFilterByRecordsCounter  uint64
+	beforeGetNextSequentialPulseFilterByRecordsCounter uint64
+	GetNextSequentialPulseFilterByRecordsMock          mStorageMockGetNextSequentialPulseFilterByRecords
+
+	funcGetPulse          func(pulseNumber int64) (p1 models.Pulse, err error)
+	inspectFuncGetPulse   func(pulseNumber int64)
+	afterGetPulseCounter  uint64
+	beforeGetPulseCounter uint64
+	GetPulseMock          mStorageMockGetPulse
+
 	funcGetPulseByPrev          func(prevPulse models.Pulse) (p1 models.Pulse, err error)
 	inspectFuncGetPulseByPrev   func(prevPulse models.Pulse)
 	afterGetPulseByPrevCounter  uint64
 	beforeGetPulseByPrevCounter uint64
 	GetPulseByPrevMock          mStorageMockGetPulseByPrev
 
+	funcGetPulseJets          func(pulseNumber int64) (pa1 []models.PulseJet, err error)
+	inspectFuncGetPulseJets   func(pulseNumber int64)
+	afterGetPulseJetsCounter  uint64
+	beforeGetPulseJetsCounter uint64
+	GetPulseJetsMock          mStorageMockGetPulseJets
+
+	funcGetRecordAmountsByPulse          func(pulseNumber int64, prototypes [][]byte, objectReferences [][]byte, recordTypes []string) (ja1 []models.JetDropRecordAmount, err error)
+	inspectFuncGetRecordAmountsByPulse   func(pulseNumber int64, prototypes [][]byte, objectReferences [][]byte, recordTypes []string)
+	afterGetRecordAmountsByPulseCounter  uint64
+	beforeGetRecordAmountsByPulseCounter uint64
+	GetRecordAmountsByPulseMock          mStorageMockGetRecordAmountsByPulse
+
+	funcGetRecordsByPulse          func(pulseNumber int64, fromJetID string, fromOrder int, prototypes [][]byte, objectReferences [][]byte, recordTypes []string, limit int) (ra1 []models.Record, err error)
+	inspectFuncGetRecordsByPulse   func(pulseNumber int64, fromJetID string, fromOrder int, prototypes [][]byte, objectReferences [][]byte, recordTypes []string, limit int)
+	afterGetRecordsByPulseCounter  uint64
+	beforeGetRecordsByPulseCounter uint64
+	GetRecordsByPulseMock          mStorageMockGetRecordsByPulse
+
+	funcGetReloadRanges          func() (ra1 []models.ReloadRange, err error)
+	inspectFuncGetReloadRanges   func()
+	afterGetReloadRangesCounter  uint64
+	beforeGetReloadRangesCounter uint64
+	GetReloadRangesMock          mStorageMockGetReloadRanges
+
 	funcGetSequentialPulse          func() (p1 models.Pulse, err error)
 	inspectFuncGetSequentialPulse   func()
 	afterGetSequentialPulseCounter  uint64
 	beforeGetSequentialPulseCounter uint64
 	GetSequentialPulseMock          mStorageMockGetSequentialPulse
 
+	funcSaveCheckpoint          func(checkpoint models.Checkpoint) (err error)
+	inspectFuncSaveCheckpoint   func(checkpoint models.Checkpoint)
+	afterSaveCheckpointCounter  uint64
+	beforeSaveCheckpointCounter uint64
+	SaveCheckpointMock          mStorageMockSaveCheckpoint
+
 	funcSaveJetDropData          func(jetDrop models.JetDrop, records []models.Record, pulseNumber int64) (err error)
 	inspectFuncSaveJetDropData   func(jetDrop models.JetDrop, records []models.Record, pulseNumber int64)
 	afterSaveJetDropDataCounter  uint64
 	beforeSaveJetDropDataCounter uint64
 	SaveJetDropDataMock          mStorageMockSaveJetDropData
 
+	funcSaveJetDropsBatch          func(pulse models.Pulse, pulseJetIDs []string, jetDrops []models.JetDropData) (err error)
+	inspectFuncSaveJetDropsBatch   func(pulse models.Pulse, pulseJetIDs []string, jetDrops []models.JetDropData)
+	afterSaveJetDropsBatchCounter  uint64
+	beforeSaveJetDropsBatchCounter uint64
+	SaveJetDropsBatchMock          mStorageMockSaveJetDropsBatch
+
 	funcSavePulse          func(pulse models.Pulse) (err error)
 	inspectFuncSavePulse   func(pulse models.Pulse)
 	afterSavePulseCounter  uint64
 	beforeSavePulseCounter uint64
 	SavePulseMock          mStorageMockSavePulse
 
+	funcSavePulseJets          func(pulseNumber int64, jetIDs []string) (err error)
+	inspectFuncSavePulseJets   func(pulseNumber int64, jetIDs []string)
+	afterSavePulseJetsCounter  uint64
+	beforeSavePulseJetsCounter uint64
+	SavePulseJetsMock          mStorageMockSavePulseJets
+
+	funcSaveRecordExtensions          func(extensions []models.RecordExtension) (err error)
+	inspectFuncSaveRecordExtensions   func(extensions []models.RecordExtension)
+	afterSaveRecordExtensionsCounter  uint64
+	beforeSaveRecordExtensionsCounter uint64
+	SaveRecordExtensionsMock          mStorageMockSaveRecordExtensions
+
+	funcSaveReloadAttempt          func(fromPulseNumber int64, toPulseNumber int64) (r1 models.ReloadRange, err error)
+	inspectFuncSaveReloadAttempt   func(fromPulseNumber int64, toPulseNumber int64)
+	afterSaveReloadAttemptCounter  uint64
+	beforeSaveReloadAttemptCounter uint64
+	SaveReloadAttemptMock          mStorageMockSaveReloadAttempt
+
 	funcSequencePulse          func(pulseNumber int64) (err error)
 	inspectFuncSequencePulse   func(pulseNumber int64)
 	afterSequencePulseCounter  uint64
@@ -86,28 +170,69 @@ func NewStorageMock(t minimock.Tester) *StorageMock {
 	m.CompletePulseMock = mStorageMockCompletePulse{mock: m}
 	m.CompletePulseMock.callArgs = []*StorageMockCompletePulseParams{}
 
+	m.DeletePulsesMock = mStorageMockDeletePulses{mock: m}
+	m.DeletePulsesMock.callArgs = []*StorageMockDeletePulsesParams{}
+
+	m.DeleteReloadRangesMock = mStorageMockDeleteReloadRanges{mock: m}
+	m.DeleteReloadRangesMock.callArgs = []*StorageMockDeleteReloadRangesParams{}
+
+	m.GetCheckpointMock = mStorageMockGetCheckpoint{mock: m}
+	m.GetCheckpointMock.callArgs = []*StorageMockGetCheckpointParams{}
+
 	m.GetIncompletePulsesMock = mStorageMockGetIncompletePulses{mock: m}
 
 	m.GetJetDropsMock = mStorageMockGetJetDrops{mock: m}
 	m.GetJetDropsMock.callArgs = []*StorageMockGetJetDropsParams{}
 
-	m.GetNextCompletePulseFilterByPrototypeReferenceMock = mStorageMockGetNextCompletePulseFilterByPrototypeReference{mock: m}
-	m.GetNextCompletePulseFilterByPrototypeReferenceMock.callArgs = []*StorageMockGetNextCompletePulseFilterByPrototypeReferenceParams{}
-
 	m.GetNextSavedPulseMock = mStorageMockGetNextSavedPulse{mock: m}
 	m.GetNextSavedPulseMock.callArgs = []*StorageMockGetNextSavedPulseParams{}
 
+	m.GetNextSequentialPulseMock = mStorageMockGetNextSequentialPulse{mock: m}
+	m.GetNextSequentialPulseMock.callArgs = []*StorageMockGetNextSequentialPulseParams{}
+
+	m.GetNextSequentialPulseFilterByRecordsMock = mStorageMockGetNextSequentialPulseFilterByRecords{mock: m}
+	m.GetNextSequentialPulseFilterByRecordsMock.callArgs = []*StorageMockGetNextSequentialPulseFilterByRecordsParams{}
+
+	m.GetPulseMock = mStorageMockGetPulse{mock: m}
+	m.GetPulseMock.callArgs = []*StorageMockGetPulseParams{}
+
 	m.GetPulseByPrevMock = mStorageMockGetPulseByPrev{mock: m}
 	m.GetPulseByPrevMock.callArgs = []*StorageMockGetPulseByPrevParams{}
 
+	m.GetPulseJetsMock = mStorageMockGetPulseJets{mock: m}
+	m.GetPulseJetsMock.callArgs = []*StorageMockGetPulseJetsParams{}
+
+	m.GetRecordAmountsByPulseMock = mStorageMockGetRecordAmountsByPulse{mock: m}
+	m.GetRecordAmountsByPulseMock.callArgs = []*StorageMockGetRecordAmountsByPulseParams{}
+
+	m.GetRecordsByPulseMock = mStorageMockGetRecordsByPulse{mock: m}
+	m.GetRecordsByPulseMock.callArgs = []*StorageMockGetRecordsByPulseParams{}
+
+	m.GetReloadRangesMock = mStorageMockGetReloadRanges{mock: m}
+
 	m.GetSequentialPulseMock = mStorageMockGetSequentialPulse{mock: m}
 
+	m.SaveCheckpointMock = mStorageMockSaveCheckpoint{mock: m}
+	m.SaveCheckpointMock.callArgs = []*StorageMockSaveCheckpointParams{}
+
 	m.SaveJetDropDataMock = mStorageMockSaveJetDropData{mock: m}
 	m.SaveJetDropDataMock.callArgs = []*StorageMockSaveJetDropDataParams{}
 
+	m.SaveJetDropsBatchMock = mStorageMockSaveJetDropsBatch{mock: m}
+	m.SaveJetDropsBatchMock.callArgs = []*StorageMockSaveJetDropsBatchParams{}
+
 	m.SavePulseMock = mStorageMockSavePulse{mock: m}
 	m.SavePulseMock.callArgs = []*StorageMockSavePulseParams{}
 
+	m.SavePulseJetsMock = mStorageMockSavePulseJets{mock: m}
+	m.SavePulseJetsMock.callArgs = []*StorageMockSavePulseJetsParams{}
+
+	m.SaveRecordExtensionsMock = mStorageMockSaveRecordExtensions{mock: m}
+	m.SaveRecordExtensionsMock.callArgs = []*StorageMockSaveRecordExtensionsParams{}
+
+	m.SaveReloadAttemptMock = mStorageMockSaveReloadAttempt{mock: m}
+	m.SaveReloadAttemptMock.callArgs = []*StorageMockSaveReloadAttemptParams{}
+
 	m.SequencePulseMock = mStorageMockSequencePulse{mock: m}
 	m.SequencePulseMock.callArgs = []*StorageMockSequencePulseParams{}
 
@@ -329,1589 +454,4551 @@ func (m *StorageMock) MinimockCompletePulseInspect() {
 	}
 }
 
-type mStorageMockGetIncompletePulses struct {
+type mStorageMockDeletePulses struct {
 	mock               *StorageMock
-	defaultExpectation *StorageMockGetIncompletePulsesExpectation
-	expectations       []*StorageMockGetIncompletePulsesExpectation
-}
+	defaultExpectation *StorageMockDeletePulsesExpectation
+	expectations       []*StorageMockDeletePulsesExpectation
 
-// StorageMockGetIncompletePulsesExpectation specifies expectation struct of the Storage.GetIncompletePulses
-type StorageMockGetIncompletePulsesExpectation struct {
-	mock *StorageMock
+	callArgs []*StorageMockDeletePulsesParams
+	mutex    sync.RWMutex
+}
 
-	results *StorageMockGetIncompletePulsesResults
+// StorageMockDeletePulsesExpectation specifies expectation struct of the Storage.DeletePulses
+type StorageMockDeletePulsesExpectation struct {
+	mock    *StorageMock
+	params  *StorageMockDeletePulsesParams
+	results *StorageMockDeletePulsesResults
 	Counter uint64
 }
 
-// StorageMockGetIncompletePulsesResults contains results of the Storage.GetIncompletePulses
-type StorageMockGetIncompletePulsesResults struct {
-	pa1 []models.Pulse
+// StorageMockDeletePulsesParams contains parameters of the Storage.DeletePulses
+type StorageMockDeletePulsesParams struct {
+	fromPulseNumber int64
+	toPulseNumber   int64
+}
+
+// StorageMockDeletePulsesResults contains results of the Storage.DeletePulses
+type StorageMockDeletePulsesResults struct {
 	err error
 }
 
-// Expect sets up expected params for Storage.GetIncompletePulses
-func (mmGetIncompletePulses *mStorageMockGetIncompletePulses) Expect() *mStorageMockGetIncompletePulses {
-	if mmGetIncompletePulses.mock.funcGetIncompletePulses != nil {
-		mmGetIncompletePulses.mock.t.Fatalf("StorageMock.GetIncompletePulses mock is already set by Set")
+// Expect sets up expected params for Storage.DeletePulses
+func (mmDeletePulses *mStorageMockDeletePulses) Expect(fromPulseNumber int64, toPulseNumber int64) *mStorageMockDeletePulses {
+	if mmDeletePulses.mock.funcDeletePulses != nil {
+		mmDeletePulses.mock.t.Fatalf("StorageMock.DeletePulses mock is already set by Set")
 	}
 
-	if mmGetIncompletePulses.defaultExpectation == nil {
-		mmGetIncompletePulses.defaultExpectation = &StorageMockGetIncompletePulsesExpectation{}
+	if mmDeletePulses.defaultExpectation == nil {
+		mmDeletePulses.defaultExpectation = &StorageMockDeletePulsesExpectation{}
 	}
 
-	return mmGetIncompletePulses
+	mmDeletePulses.defaultExpectation.params = &StorageMockDeletePulsesParams{fromPulseNumber, toPulseNumber}
+	for _, e := range mmDeletePulses.expectations {
+		if minimock.Equal(e.params, mmDeletePulses.defaultExpectation.params) {
+			mmDeletePulses.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeletePulses.defaultExpectation.params)
+		}
+	}
+
+	return mmDeletePulses
 }
 
-// Inspect accepts an inspector function that has same arguments as the Storage.GetIncompletePulses
-func (mmGetIncompletePulses *mStorageMockGetIncompletePulses) Inspect(f func()) *mStorageMockGetIncompletePulses {
-	if mmGetIncompletePulses.mock.inspectFuncGetIncompletePulses != nil {
-		mmGetIncompletePulses.mock.t.Fatalf("Inspect function is already set for StorageMock.GetIncompletePulses")
+// Inspect accepts an inspector function that has same arguments as the Storage.DeletePulses
+func (mmDeletePulses *mStorageMockDeletePulses) Inspect(f func(fromPulseNumber int64, toPulseNumber int64)) *mStorageMockDeletePulses {
+	if mmDeletePulses.mock.inspectFuncDeletePulses != nil {
+		mmDeletePulses.mock.t.Fatalf("Inspect function is already set for StorageMock.DeletePulses")
 	}
 
-	mmGetIncompletePulses.mock.inspectFuncGetIncompletePulses = f
+	mmDeletePulses.mock.inspectFuncDeletePulses = f
 
-	return mmGetIncompletePulses
+	return mmDeletePulses
 }
 
-// Return sets up results that will be returned by Storage.GetIncompletePulses
-func (mmGetIncompletePulses *mStorageMockGetIncompletePulses) Return(pa1 []models.Pulse, err error) *StorageMock {
-	if mmGetIncompletePulses.mock.funcGetIncompletePulses != nil {
-		mmGetIncompletePulses.mock.t.Fatalf("StorageMock.GetIncompletePulses mock is already set by Set")
+// Return sets up results that will be returned by Storage.DeletePulses
+func (mmDeletePulses *mStorageMockDeletePulses) Return(err error) *StorageMock {
+	if mmDeletePulses.mock.funcDeletePulses != nil {
+		mmDeletePulses.mock.t.Fatalf("StorageMock.DeletePulses mock is already set by Set")
 	}
 
-	if mmGetIncompletePulses.defaultExpectation == nil {
-		mmGetIncompletePulses.defaultExpectation = &StorageMockGetIncompletePulsesExpectation{mock: mmGetIncompletePulses.mock}
+	if mmDeletePulses.defaultExpectation == nil {
+		mmDeletePulses.defaultExpectation = &StorageMockDeletePulsesExpectation{mock: mmDeletePulses.mock}
 	}
-	mmGetIncompletePulses.defaultExpectation.results = &StorageMockGetIncompletePulsesResults{pa1, err}
-	return mmGetIncompletePulses.mock
+	mmDeletePulses.defaultExpectation.results = &StorageMockDeletePulsesResults{err}
+	return mmDeletePulses.mock
 }
 
-//Set uses given function f to mock the Storage.GetIncompletePulses method
-func (mmGetIncompletePulses *mStorageMockGetIncompletePulses) Set(f func() (pa1 []models.Pulse, err error)) *StorageMock {
-	if mmGetIncompletePulses.defaultExpectation != nil {
-		mmGetIncompletePulses.mock.t.Fatalf("Default expectation is already set for the Storage.GetIncompletePulses method")
+//Set uses given function f to mock the Storage.DeletePulses method
+func (mmDeletePulses *mStorageMockDeletePulses) Set(f func(fromPulseNumber int64, toPulseNumber int64) (err error)) *StorageMock {
+	if mmDeletePulses.defaultExpectation != nil {
+		mmDeletePulses.mock.t.Fatalf("Default expectation is already set for the Storage.DeletePulses method")
 	}
 
-	if len(mmGetIncompletePulses.expectations) > 0 {
-		mmGetIncompletePulses.mock.t.Fatalf("Some expectations are already set for the Storage.GetIncompletePulses method")
+	if len(mmDeletePulses.expectations) > 0 {
+		mmDeletePulses.mock.t.Fatalf("Some expectations are already set for the Storage.DeletePulses method")
 	}
 
-	mmGetIncompletePulses.mock.funcGetIncompletePulses = f
-	return mmGetIncompletePulses.mock
+	mmDeletePulses.mock.funcDeletePulses = f
+	return mmDeletePulses.mock
 }
 
-// GetIncompletePulses implements interfaces.Storage
-func (mmGetIncompletePulses *StorageMock) GetIncompletePulses() (pa1 []models.Pulse, err error) {
-	mm_atomic.AddUint64(&mmGetIncompletePulses.beforeGetIncompletePulsesCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetIncompletePulses.afterGetIncompletePulsesCounter, 1)
+// When sets expectation for the Storage.DeletePulses which will trigger the result defined by the following
+// Then helper
+func (mmDeletePulses *mStorageMockDeletePulses) When(fromPulseNumber int64, toPulseNumber int64) *StorageMockDeletePulsesExpectation {
+	if mmDeletePulses.mock.funcDeletePulses != nil {
+		mmDeletePulses.mock.t.Fatalf("StorageMock.DeletePulses mock is already set by Set")
+	}
 
-	if mmGetIncompletePulses.inspectFuncGetIncompletePulses != nil {
-		mmGetIncompletePulses.inspectFuncGetIncompletePulses()
+	expectation := &StorageMockDeletePulsesExpectation{
+		mock:   mmDeletePulses.mock,
+		params: &StorageMockDeletePulsesParams{fromPulseNumber, toPulseNumber},
 	}
+	mmDeletePulses.expectations = append(mmDeletePulses.expectations, expectation)
+	return expectation
+}
 
-	if mmGetIncompletePulses.GetIncompletePulsesMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetIncompletePulses.GetIncompletePulsesMock.defaultExpectation.Counter, 1)
+// Then sets up Storage.DeletePulses return parameters for the expectation previously defined by the When method
+func (e *StorageMockDeletePulsesExpectation) Then(err error) *StorageMock {
+	e.results = &StorageMockDeletePulsesResults{err}
+	return e.mock
+}
 
-		mm_results := mmGetIncompletePulses.GetIncompletePulsesMock.defaultExpectation.results
+// DeletePulses implements interfaces.Storage
+func (mmDeletePulses *StorageMock) DeletePulses(fromPulseNumber int64, toPulseNumber int64) (err error) {
+	mm_atomic.AddUint64(&mmDeletePulses.beforeDeletePulsesCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeletePulses.afterDeletePulsesCounter, 1)
+
+	if mmDeletePulses.inspectFuncDeletePulses != nil {
+		mmDeletePulses.inspectFuncDeletePulses(fromPulseNumber, toPulseNumber)
+	}
+
+	mm_params := &StorageMockDeletePulsesParams{fromPulseNumber, toPulseNumber}
+
+	// Record call args
+	mmDeletePulses.DeletePulsesMock.mutex.Lock()
+	mmDeletePulses.DeletePulsesMock.callArgs = append(mmDeletePulses.DeletePulsesMock.callArgs, mm_params)
+	mmDeletePulses.DeletePulsesMock.mutex.Unlock()
+
+	for _, e := range mmDeletePulses.DeletePulsesMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeletePulses.DeletePulsesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeletePulses.DeletePulsesMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeletePulses.DeletePulsesMock.defaultExpectation.params
+		mm_got := StorageMockDeletePulsesParams{fromPulseNumber, toPulseNumber}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeletePulses.t.Errorf("StorageMock.DeletePulses got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeletePulses.DeletePulsesMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetIncompletePulses.t.Fatal("No results are set for the StorageMock.GetIncompletePulses")
+			mmDeletePulses.t.Fatal("No results are set for the StorageMock.DeletePulses")
 		}
-		return (*mm_results).pa1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetIncompletePulses.funcGetIncompletePulses != nil {
-		return mmGetIncompletePulses.funcGetIncompletePulses()
+	if mmDeletePulses.funcDeletePulses != nil {
+		return mmDeletePulses.funcDeletePulses(fromPulseNumber, toPulseNumber)
 	}
-	mmGetIncompletePulses.t.Fatalf("Unexpected call to StorageMock.GetIncompletePulses.")
+	mmDeletePulses.t.Fatalf("Unexpected call to StorageMock.DeletePulses. %v %v", fromPulseNumber, toPulseNumber)
 	return
 }
 
-// GetIncompletePulsesAfterCounter returns a count of finished StorageMock.GetIncompletePulses invocations
-func (mmGetIncompletePulses *StorageMock) GetIncompletePulsesAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetIncompletePulses.afterGetIncompletePulsesCounter)
+// DeletePulsesAfterCounter returns a count of finished StorageMock.DeletePulses invocations
+func (mmDeletePulses *StorageMock) DeletePulsesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePulses.afterDeletePulsesCounter)
 }
 
-// GetIncompletePulsesBeforeCounter returns a count of StorageMock.GetIncompletePulses invocations
-func (mmGetIncompletePulses *StorageMock) GetIncompletePulsesBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetIncompletePulses.beforeGetIncompletePulsesCounter)
+// DeletePulsesBeforeCounter returns a count of StorageMock.DeletePulses invocations
+func (mmDeletePulses *StorageMock) DeletePulsesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePulses.beforeDeletePulsesCounter)
 }
 
-// MinimockGetIncompletePulsesDone returns true if the count of the GetIncompletePulses invocations corresponds
+// Calls returns a list of arguments used in each call to StorageMock.DeletePulses.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeletePulses *mStorageMockDeletePulses) Calls() []*StorageMockDeletePulsesParams {
+	mmDeletePulses.mutex.RLock()
+
+	argCopy := make([]*StorageMockDeletePulsesParams, len(mmDeletePulses.callArgs))
+	copy(argCopy, mmDeletePulses.callArgs)
+
+	mmDeletePulses.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeletePulsesDone returns true if the count of the DeletePulses invocations corresponds
 // the number of defined expectations
-func (m *StorageMock) MinimockGetIncompletePulsesDone() bool {
-	for _, e := range m.GetIncompletePulsesMock.expectations {
+func (m *StorageMock) MinimockDeletePulsesDone() bool {
+	for _, e := range m.DeletePulsesMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetIncompletePulsesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetIncompletePulsesCounter) < 1 {
+	if m.DeletePulsesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeletePulsesCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetIncompletePulses != nil && mm_atomic.LoadUint64(&m.afterGetIncompletePulsesCounter) < 1 {
+	if m.funcDeletePulses != nil && mm_atomic.LoadUint64(&m.afterDeletePulsesCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockGetIncompletePulsesInspect logs each unmet expectation
-func (m *StorageMock) MinimockGetIncompletePulsesInspect() {
-	for _, e := range m.GetIncompletePulsesMock.expectations {
+// MinimockDeletePulsesInspect logs each unmet expectation
+func (m *StorageMock) MinimockDeletePulsesInspect() {
+	for _, e := range m.DeletePulsesMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Error("Expected call to StorageMock.GetIncompletePulses")
+			m.t.Errorf("Expected call to StorageMock.DeletePulses with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetIncompletePulsesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetIncompletePulsesCounter) < 1 {
-		m.t.Error("Expected call to StorageMock.GetIncompletePulses")
+	if m.DeletePulsesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeletePulsesCounter) < 1 {
+		if m.DeletePulsesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StorageMock.DeletePulses")
+		} else {
+			m.t.Errorf("Expected call to StorageMock.DeletePulses with params: %#v", *m.DeletePulsesMock.defaultExpectation.params)
+		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetIncompletePulses != nil && mm_atomic.LoadUint64(&m.afterGetIncompletePulsesCounter) < 1 {
-		m.t.Error("Expected call to StorageMock.GetIncompletePulses")
+	if m.funcDeletePulses != nil && mm_atomic.LoadUint64(&m.afterDeletePulsesCounter) < 1 {
+		m.t.Error("Expected call to StorageMock.DeletePulses")
 	}
 }
 
-type mStorageMockGetJetDrops struct {
+type mStorageMockDeleteReloadRanges struct {
 	mock               *StorageMock
-	defaultExpectation *StorageMockGetJetDropsExpectation
-	expectations       []*StorageMockGetJetDropsExpectation
+	defaultExpectation *StorageMockDeleteReloadRangesExpectation
+	expectations       []*StorageMockDeleteReloadRangesExpectation
 
-	callArgs []*StorageMockGetJetDropsParams
+	callArgs []*StorageMockDeleteReloadRangesParams
 	mutex    sync.RWMutex
 }
 
-// StorageMockGetJetDropsExpectation specifies expectation struct of the Storage.GetJetDrops
-type StorageMockGetJetDropsExpectation struct {
+// StorageMockDeleteReloadRangesExpectation specifies expectation struct of the Storage.DeleteReloadRanges
+type StorageMockDeleteReloadRangesExpectation struct {
 	mock    *StorageMock
-	params  *StorageMockGetJetDropsParams
-	results *StorageMockGetJetDropsResults
+	params  *StorageMockDeleteReloadRangesParams
+	results *StorageMockDeleteReloadRangesResults
 	Counter uint64
 }
 
-// StorageMockGetJetDropsParams contains parameters of the Storage.GetJetDrops
-type StorageMockGetJetDropsParams struct {
-	pulse models.Pulse
+// StorageMockDeleteReloadRangesParams contains parameters of the Storage.DeleteReloadRanges
+type StorageMockDeleteReloadRangesParams struct {
+	toPulseNumber int64
 }
 
-// StorageMockGetJetDropsResults contains results of the Storage.GetJetDrops
-type StorageMockGetJetDropsResults struct {
-	ja1 []models.JetDrop
+// StorageMockDeleteReloadRangesResults contains results of the Storage.DeleteReloadRanges
+type StorageMockDeleteReloadRangesResults struct {
 	err error
 }
 
-// Expect sets up expected params for Storage.GetJetDrops
-func (mmGetJetDrops *mStorageMockGetJetDrops) Expect(pulse models.Pulse) *mStorageMockGetJetDrops {
-	if mmGetJetDrops.mock.funcGetJetDrops != nil {
-		mmGetJetDrops.mock.t.Fatalf("StorageMock.GetJetDrops mock is already set by Set")
+// Expect sets up expected params for Storage.DeleteReloadRanges
+func (mmDeleteReloadRanges *mStorageMockDeleteReloadRanges) Expect(toPulseNumber int64) *mStorageMockDeleteReloadRanges {
+	if mmDeleteReloadRanges.mock.funcDeleteReloadRanges != nil {
+		mmDeleteReloadRanges.mock.t.Fatalf("StorageMock.DeleteReloadRanges mock is already set by Set")
 	}
 
-	if mmGetJetDrops.defaultExpectation == nil {
-		mmGetJetDrops.defaultExpectation = &StorageMockGetJetDropsExpectation{}
+	if mmDeleteReloadRanges.defaultExpectation == nil {
+		mmDeleteReloadRanges.defaultExpectation = &StorageMockDeleteReloadRangesExpectation{}
 	}
 
-	mmGetJetDrops.defaultExpectation.params = &StorageMockGetJetDropsParams{pulse}
-	for _, e := range mmGetJetDrops.expectations {
-		if minimock.Equal(e.params, mmGetJetDrops.defaultExpectation.params) {
-			mmGetJetDrops.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetJetDrops.defaultExpectation.params)
+	mmDeleteReloadRanges.defaultExpectation.params = &StorageMockDeleteReloadRangesParams{toPulseNumber}
+	for _, e := range mmDeleteReloadRanges.expectations {
+		if minimock.Equal(e.params, mmDeleteReloadRanges.defaultExpectation.params) {
+			mmDeleteReloadRanges.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteReloadRanges.defaultExpectation.params)
 		}
 	}
 
-	return mmGetJetDrops
+	return mmDeleteReloadRanges
 }
 
-// Inspect accepts an inspector function that has same arguments as the Storage.GetJetDrops
-func (mmGetJetDrops *mStorageMockGetJetDrops) Inspect(f func(pulse models.Pulse)) *mStorageMockGetJetDrops {
-	if mmGetJetDrops.mock.inspectFuncGetJetDrops != nil {
-		mmGetJetDrops.mock.t.Fatalf("Inspect function is already set for StorageMock.GetJetDrops")
+// Inspect accepts an inspector function that has same arguments as the Storage.DeleteReloadRanges
+func (mmDeleteReloadRanges *mStorageMockDeleteReloadRanges) Inspect(f func(toPulseNumber int64)) *mStorageMockDeleteReloadRanges {
+	if mmDeleteReloadRanges.mock.inspectFuncDeleteReloadRanges != nil {
+		mmDeleteReloadRanges.mock.t.Fatalf("Inspect function is already set for StorageMock.DeleteReloadRanges")
 	}
 
-	mmGetJetDrops.mock.inspectFuncGetJetDrops = f
+	mmDeleteReloadRanges.mock.inspectFuncDeleteReloadRanges = f
 
-	return mmGetJetDrops
+	return mmDeleteReloadRanges
 }
 
-// Return sets up results that will be returned by Storage.GetJetDrops
-func (mmGetJetDrops *mStorageMockGetJetDrops) Return(ja1 []models.JetDrop, err error) *StorageMock {
-	if mmGetJetDrops.mock.funcGetJetDrops != nil {
-		mmGetJetDrops.mock.t.Fatalf("StorageMock.GetJetDrops mock is already set by Set")
+// Return sets up results that will be returned by Storage.DeleteReloadRanges
+func (mmDeleteReloadRanges *mStorageMockDeleteReloadRanges) Return(err error) *StorageMock {
+	if mmDeleteReloadRanges.mock.funcDeleteReloadRanges != nil {
+		mmDeleteReloadRanges.mock.t.Fatalf("StorageMock.DeleteReloadRanges mock is already set by Set")
 	}
 
-	if mmGetJetDrops.defaultExpectation == nil {
-		mmGetJetDrops.defaultExpectation = &StorageMockGetJetDropsExpectation{mock: mmGetJetDrops.mock}
+	if mmDeleteReloadRanges.defaultExpectation == nil {
+		mmDeleteReloadRanges.defaultExpectation = &StorageMockDeleteReloadRangesExpectation{mock: mmDeleteReloadRanges.mock}
 	}
-	mmGetJetDrops.defaultExpectation.results = &StorageMockGetJetDropsResults{ja1, err}
-	return mmGetJetDrops.mock
+	mmDeleteReloadRanges.defaultExpectation.results = &StorageMockDeleteReloadRangesResults{err}
+	return mmDeleteReloadRanges.mock
 }
 
-//Set uses given function f to mock the Storage.GetJetDrops method
-func (mmGetJetDrops *mStorageMockGetJetDrops) Set(f func(pulse models.Pulse) (ja1 []models.JetDrop, err error)) *StorageMock {
-	if mmGetJetDrops.defaultExpectation != nil {
-		mmGetJetDrops.mock.t.Fatalf("Default expectation is already set for the Storage.GetJetDrops method")
+//Set uses given function f to mock the Storage.DeleteReloadRanges method
+func (mmDeleteReloadRanges *mStorageMockDeleteReloadRanges) Set(f func(toPulseNumber int64) (err error)) *StorageMock {
+	if mmDeleteReloadRanges.defaultExpectation != nil {
+		mmDeleteReloadRanges.mock.t.Fatalf("Default expectation is already set for the Storage.DeleteReloadRanges method")
 	}
 
-	if len(mmGetJetDrops.expectations) > 0 {
-		mmGetJetDrops.mock.t.Fatalf("Some expectations are already set for the Storage.GetJetDrops method")
+	if len(mmDeleteReloadRanges.expectations) > 0 {
+		mmDeleteReloadRanges.mock.t.Fatalf("Some expectations are already set for the Storage.DeleteReloadRanges method")
 	}
 
-	mmGetJetDrops.mock.funcGetJetDrops = f
-	return mmGetJetDrops.mock
+	mmDeleteReloadRanges.mock.funcDeleteReloadRanges = f
+	return mmDeleteReloadRanges.mock
 }
 
-// When sets expectation for the Storage.GetJetDrops which will trigger the result defined by the following
+// When sets expectation for the Storage.DeleteReloadRanges which will trigger the result defined by the following
 // Then helper
-func (mmGetJetDrops *mStorageMockGetJetDrops) When(pulse models.Pulse) *StorageMockGetJetDropsExpectation {
-	if mmGetJetDrops.mock.funcGetJetDrops != nil {
-		mmGetJetDrops.mock.t.Fatalf("StorageMock.GetJetDrops mock is already set by Set")
+func (mmDeleteReloadRanges *mStorageMockDeleteReloadRanges) When(toPulseNumber int64) *StorageMockDeleteReloadRangesExpectation {
+	if mmDeleteReloadRanges.mock.funcDeleteReloadRanges != nil {
+		mmDeleteReloadRanges.mock.t.Fatalf("StorageMock.DeleteReloadRanges mock is already set by Set")
 	}
 
-	expectation := &StorageMockGetJetDropsExpectation{
-		mock:   mmGetJetDrops.mock,
-		params: &StorageMockGetJetDropsParams{pulse},
+	expectation := &StorageMockDeleteReloadRangesExpectation{
+		mock:   mmDeleteReloadRanges.mock,
+		params: &StorageMockDeleteReloadRangesParams{toPulseNumber},
 	}
-	mmGetJetDrops.expectations = append(mmGetJetDrops.expectations, expectation)
+	mmDeleteReloadRanges.expectations = append(mmDeleteReloadRanges.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Storage.GetJetDrops return parameters for the expectation previously defined by the When method
-func (e *StorageMockGetJetDropsExpectation) Then(ja1 []models.JetDrop, err error) *StorageMock {
-	e.results = &StorageMockGetJetDropsResults{ja1, err}
+// Then sets up Storage.DeleteReloadRanges return parameters for the expectation previously defined by the When method
+func (e *StorageMockDeleteReloadRangesExpectation) Then(err error) *StorageMock {
+	e.results = &StorageMockDeleteReloadRangesResults{err}
 	return e.mock
 }
 
-// GetJetDrops implements interfaces.Storage
-func (mmGetJetDrops *StorageMock) GetJetDrops(pulse models.Pulse) (ja1 []models.JetDrop, err error) {
-	mm_atomic.AddUint64(&mmGetJetDrops.beforeGetJetDropsCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetJetDrops.afterGetJetDropsCounter, 1)
+// DeleteReloadRanges implements interfaces.Storage
+func (mmDeleteReloadRanges *StorageMock) DeleteReloadRanges(toPulseNumber int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteReloadRanges.beforeDeleteReloadRangesCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteReloadRanges.afterDeleteReloadRangesCounter, 1)
 
-	if mmGetJetDrops.inspectFuncGetJetDrops != nil {
-		mmGetJetDrops.inspectFuncGetJetDrops(pulse)
+	if mmDeleteReloadRanges.inspectFuncDeleteReloadRanges != nil {
+		mmDeleteReloadRanges.inspectFuncDeleteReloadRanges(toPulseNumber)
 	}
 
-	mm_params := &StorageMockGetJetDropsParams{pulse}
+	mm_params := &StorageMockDeleteReloadRangesParams{toPulseNumber}
 
 	// Record call args
-	mmGetJetDrops.GetJetDropsMock.mutex.Lock()
-	mmGetJetDrops.GetJetDropsMock.callArgs = append(mmGetJetDrops.GetJetDropsMock.callArgs, mm_params)
-	mmGetJetDrops.GetJetDropsMock.mutex.Unlock()
+	mmDeleteReloadRanges.DeleteReloadRangesMock.mutex.Lock()
+	mmDeleteReloadRanges.DeleteReloadRangesMock.callArgs = append(mmDeleteReloadRanges.DeleteReloadRangesMock.callArgs, mm_params)
+	mmDeleteReloadRanges.DeleteReloadRangesMock.mutex.Unlock()
 
-	for _, e := range mmGetJetDrops.GetJetDropsMock.expectations {
+	for _, e := range mmDeleteReloadRanges.DeleteReloadRangesMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ja1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetJetDrops.GetJetDropsMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetJetDrops.GetJetDropsMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetJetDrops.GetJetDropsMock.defaultExpectation.params
-		mm_got := StorageMockGetJetDropsParams{pulse}
+	if mmDeleteReloadRanges.DeleteReloadRangesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteReloadRanges.DeleteReloadRangesMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteReloadRanges.DeleteReloadRangesMock.defaultExpectation.params
+		mm_got := StorageMockDeleteReloadRangesParams{toPulseNumber}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetJetDrops.t.Errorf("StorageMock.GetJetDrops got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmDeleteReloadRanges.t.Errorf("StorageMock.DeleteReloadRanges got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetJetDrops.GetJetDropsMock.defaultExpectation.results
+		mm_results := mmDeleteReloadRanges.DeleteReloadRangesMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetJetDrops.t.Fatal("No results are set for the StorageMock.GetJetDrops")
+			mmDeleteReloadRanges.t.Fatal("No results are set for the StorageMock.DeleteReloadRanges")
 		}
-		return (*mm_results).ja1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetJetDrops.funcGetJetDrops != nil {
-		return mmGetJetDrops.funcGetJetDrops(pulse)
+	if mmDeleteReloadRanges.funcDeleteReloadRanges != nil {
+		return mmDeleteReloadRanges.funcDeleteReloadRanges(toPulseNumber)
 	}
-	mmGetJetDrops.t.Fatalf("Unexpected call to StorageMock.GetJetDrops. %v", pulse)
+	mmDeleteReloadRanges.t.Fatalf("Unexpected call to StorageMock.DeleteReloadRanges. %v", toPulseNumber)
 	return
 }
 
-// GetJetDropsAfterCounter returns a count of finished StorageMock.GetJetDrops invocations
-func (mmGetJetDrops *StorageMock) GetJetDropsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetJetDrops.afterGetJetDropsCounter)
+// DeleteReloadRangesAfterCounter returns a count of finished StorageMock.DeleteReloadRanges invocations
+func (mmDeleteReloadRanges *StorageMock) DeleteReloadRangesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteReloadRanges.afterDeleteReloadRangesCounter)
 }
 
-// GetJetDropsBeforeCounter returns a count of StorageMock.GetJetDrops invocations
-func (mmGetJetDrops *StorageMock) GetJetDropsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetJetDrops.beforeGetJetDropsCounter)
+// DeleteReloadRangesBeforeCounter returns a count of StorageMock.DeleteReloadRanges invocations
+func (mmDeleteReloadRanges *StorageMock) DeleteReloadRangesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteReloadRanges.beforeDeleteReloadRangesCounter)
 }
 
-// Calls returns a list of arguments used in each call to StorageMock.GetJetDrops.
+// Calls returns a list of arguments used in each call to StorageMock.DeleteReloadRanges.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetJetDrops *mStorageMockGetJetDrops) Calls() []*StorageMockGetJetDropsParams {
-	mmGetJetDrops.mutex.RLock()
+func (mmDeleteReloadRanges *mStorageMockDeleteReloadRanges) Calls() []*StorageMockDeleteReloadRangesParams {
+	mmDeleteReloadRanges.mutex.RLock()
 
-	argCopy := make([]*StorageMockGetJetDropsParams, len(mmGetJetDrops.callArgs))
-	copy(argCopy, mmGetJetDrops.callArgs)
+	argCopy := make([]*StorageMockDeleteReloadRangesParams, len(mmDeleteReloadRanges.callArgs))
+	copy(argCopy, mmDeleteReloadRanges.callArgs)
 
-	mmGetJetDrops.mutex.RUnlock()
+	mmDeleteReloadRanges.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetJetDropsDone returns true if the count of the GetJetDrops invocations corresponds
+// MinimockDeleteReloadRangesDone returns true if the count of the DeleteReloadRanges invocations corresponds
 // the number of defined expectations
-func (m *StorageMock) MinimockGetJetDropsDone() bool {
-	for _, e := range m.GetJetDropsMock.expectations {
+func (m *StorageMock) MinimockDeleteReloadRangesDone() bool {
+	for _, e := range m.DeleteReloadRangesMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetJetDropsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetJetDropsCounter) < 1 {
+	if m.DeleteReloadRangesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteReloadRangesCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetJetDrops != nil && mm_atomic.LoadUint64(&m.afterGetJetDropsCounter) < 1 {
+	if m.funcDeleteReloadRanges != nil && mm_atomic.LoadUint64(&m.afterDeleteReloadRangesCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockGetJetDropsInspect logs each unmet expectation
-func (m *StorageMock) MinimockGetJetDropsInspect() {
-	for _, e := range m.GetJetDropsMock.expectations {
+// MinimockDeleteReloadRangesInspect logs each unmet expectation
+func (m *StorageMock) MinimockDeleteReloadRangesInspect() {
+	for _, e := range m.DeleteReloadRangesMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StorageMock.GetJetDrops with params: %#v", *e.params)
+			m.t.Errorf("Expected call to StorageMock.DeleteReloadRanges with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetJetDropsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetJetDropsCounter) < 1 {
-		if m.GetJetDropsMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to StorageMock.GetJetDrops")
+	if m.DeleteReloadRangesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteReloadRangesCounter) < 1 {
+		if m.DeleteReloadRangesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StorageMock.DeleteReloadRanges")
 		} else {
-			m.t.Errorf("Expected call to StorageMock.GetJetDrops with params: %#v", *m.GetJetDropsMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StorageMock.DeleteReloadRanges with params: %#v", *m.DeleteReloadRangesMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetJetDrops != nil && mm_atomic.LoadUint64(&m.afterGetJetDropsCounter) < 1 {
-		m.t.Error("Expected call to StorageMock.GetJetDrops")
+	if m.funcDeleteReloadRanges != nil && mm_atomic.LoadUint64(&m.afterDeleteReloadRangesCounter) < 1 {
+		m.t.Error("Expected call to StorageMock.DeleteReloadRanges")
 	}
 }
 
-type mStorageMockGetNextCompletePulseFilterByPrototypeReference struct {
+type mStorageMockGetCheckpoint struct {
 	mock               *StorageMock
-	defaultExpectation *StorageMockGetNextCompletePulseFilterByPrototypeReferenceExpectation
-	expectations       []*StorageMockGetNextCompletePulseFilterByPrototypeReferenceExpectation
+	defaultExpectation *StorageMockGetCheckpointExpectation
+	expectations       []*StorageMockGetCheckpointExpectation
 
-	callArgs []*StorageMockGetNextCompletePulseFilterByPrototypeReferenceParams
+	callArgs []*StorageMockGetCheckpointParams
 	mutex    sync.RWMutex
 }
 
-// StorageMockGetNextCompletePulseFilterByPrototypeReferenceExpectation specifies expectation struct of the Storage.GetNextCompletePulseFilterByPrototypeReference
-type StorageMockGetNextCompletePulseFilterByPrototypeReferenceExpectation struct {
+// StorageMockGetCheckpointExpectation specifies expectation struct of the Storage.GetCheckpoint
+type StorageMockGetCheckpointExpectation struct {
 	mock    *StorageMock
-	params  *StorageMockGetNextCompletePulseFilterByPrototypeReferenceParams
-	results *StorageMockGetNextCompletePulseFilterByPrototypeReferenceResults
+	params  *StorageMockGetCheckpointParams
+	results *StorageMockGetCheckpointResults
 	Counter uint64
 }
 
-// StorageMockGetNextCompletePulseFilterByPrototypeReferenceParams contains parameters of the Storage.GetNextCompletePulseFilterByPrototypeReference
-type StorageMockGetNextCompletePulseFilterByPrototypeReferenceParams struct {
-	prevPulse  int64
-	prototypes [][]byte
+// StorageMockGetCheckpointParams contains parameters of the Storage.GetCheckpoint
+type StorageMockGetCheckpointParams struct {
+	name string
 }
 
-// StorageMockGetNextCompletePulseFilterByPrototypeReferenceResults contains results of the Storage.GetNextCompletePulseFilterByPrototypeReference
-type StorageMockGetNextCompletePulseFilterByPrototypeReferenceResults struct {
-	p1  models.Pulse
+// StorageMockGetCheckpointResults contains results of the Storage.GetCheckpoint
+type StorageMockGetCheckpointResults struct {
+	c1  models.Checkpoint
 	err error
 }
 
-// Expect sets up expected params for Storage.GetNextCompletePulseFilterByPrototypeReference
-func (mmGetNextCompletePulseFilterByPrototypeReference *mStorageMockGetNextCompletePulseFilterByPrototypeReference) Expect(prevPulse int64, prototypes [][]byte) *mStorageMockGetNextCompletePulseFilterByPrototypeReference {
-	if mmGetNextCompletePulseFilterByPrototypeReference.mock.funcGetNextCompletePulseFilterByPrototypeReference != nil {
-		mmGetNextCompletePulseFilterByPrototypeReference.mock.t.Fatalf("StorageMock.GetNextCompletePulseFilterByPrototypeReference mock is already set by Set")
+// Expect sets up expected params for Storage.GetCheckpoint
+func (mmGetCheckpoint *mStorageMockGetCheckpoint) Expect(name string) *mStorageMockGetCheckpoint {
+	if mmGetCheckpoint.mock.funcGetCheckpoint != nil {
+		mmGetCheckpoint.mock.t.Fatalf("StorageMock.GetCheckpoint mock is already set by Set")
 	}
 
-	if mmGetNextCompletePulseFilterByPrototypeReference.defaultExpectation == nil {
-		mmGetNextCompletePulseFilterByPrototypeReference.defaultExpectation = &StorageMockGetNextCompletePulseFilterByPrototypeReferenceExpectation{}
+	if mmGetCheckpoint.defaultExpectation == nil {
+		mmGetCheckpoint.defaultExpectation = &StorageMockGetCheckpointExpectation{}
 	}
 
-	mmGetNextCompletePulseFilterByPrototypeReference.defaultExpectation.params = &StorageMockGetNextCompletePulseFilterByPrototypeReferenceParams{prevPulse, prototypes}
-	for _, e := range mmGetNextCompletePulseFilterByPrototypeReference.expectations {
-		if minimock.Equal(e.params, mmGetNextCompletePulseFilterByPrototypeReference.defaultExpectation.params) {
-			mmGetNextCompletePulseFilterByPrototypeReference.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetNextCompletePulseFilterByPrototypeReference.defaultExpectation.params)
+	mmGetCheckpoint.defaultExpectation.params = &StorageMockGetCheckpointParams{name}
+	for _, e := range mmGetCheckpoint.expectations {
+		if minimock.Equal(e.params, mmGetCheckpoint.defaultExpectation.params) {
+			mmGetCheckpoint.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCheckpoint.defaultExpectation.params)
 		}
 	}
 
-	return mmGetNextCompletePulseFilterByPrototypeReference
+	return mmGetCheckpoint
 }
 
-// Inspect accepts an inspector function that has same arguments as the Storage.GetNextCompletePulseFilterByPrototypeReference
-func (mmGetNextCompletePulseFilterByPrototypeReference *mStorageMockGetNextCompletePulseFilterByPrototypeReference) Inspect(f func(prevPulse int64, prototypes [][]byte)) *mStorageMockGetNextCompletePulseFilterByPrototypeReference {
-	if mmGetNextCompletePulseFilterByPrototypeReference.mock.inspectFuncGetNextCompletePulseFilterByPrototypeReference != nil {
-		mmGetNextCompletePulseFilterByPrototypeReference.mock.t.Fatalf("Inspect function is already set for StorageMock.GetNextCompletePulseFilterByPrototypeReference")
+// Inspect accepts an inspector function that has same arguments as the Storage.GetCheckpoint
+func (mmGetCheckpoint *mStorageMockGetCheckpoint) Inspect(f func(name string)) *mStorageMockGetCheckpoint {
+	if mmGetCheckpoint.mock.inspectFuncGetCheckpoint != nil {
+		mmGetCheckpoint.mock.t.Fatalf("Inspect function is already set for StorageMock.GetCheckpoint")
 	}
 
-	mmGetNextCompletePulseFilterByPrototypeReference.mock.inspectFuncGetNextCompletePulseFilterByPrototypeReference = f
+	mmGetCheckpoint.mock.inspectFuncGetCheckpoint = f
 
-	return mmGetNextCompletePulseFilterByPrototypeReference
+	return mmGetCheckpoint
 }
 
-// Return sets up results that will be returned by Storage.GetNextCompletePulseFilterByPrototypeReference
-func (mmGetNextCompletePulseFilterByPrototypeReference *mStorageMockGetNextCompletePulseFilterByPrototypeReference) Return(p1 models.Pulse, err error) *StorageMock {
-	if mmGetNextCompletePulseFilterByPrototypeReference.mock.funcGetNextCompletePulseFilterByPrototypeReference != nil {
-		mmGetNextCompletePulseFilterByPrototypeReference.mock.t.Fatalf("StorageMock.GetNextCompletePulseFilterByPrototypeReference mock is already set by Set")
+// Return sets up results that will be returned by Storage.GetCheckpoint
+func (mmGetCheckpoint *mStorageMockGetCheckpoint) Return(c1 models.Checkpoint, err error) *StorageMock {
+	if mmGetCheckpoint.mock.funcGetCheckpoint != nil {
+		mmGetCheckpoint.mock.t.Fatalf("StorageMock.GetCheckpoint mock is already set by Set")
 	}
 
-	if mmGetNextCompletePulseFilterByPrototypeReference.defaultExpectation == nil {
-		mmGetNextCompletePulseFilterByPrototypeReference.defaultExpectation = &StorageMockGetNextCompletePulseFilterByPrototypeReferenceExpectation{mock: mmGetNextCompletePulseFilterByPrototypeReference.mock}
+	if mmGetCheckpoint.defaultExpectation == nil {
+		mmGetCheckpoint.defaultExpectation = &StorageMockGetCheckpointExpectation{mock: mmGetCheckpoint.mock}
 	}
-	mmGetNextCompletePulseFilterByPrototypeReference.defaultExpectation.results = &StorageMockGetNextCompletePulseFilterByPrototypeReferenceResults{p1, err}
-	return mmGetNextCompletePulseFilterByPrototypeReference.mock
+	mmGetCheckpoint.defaultExpectation.results = &StorageMockGetCheckpointResults{c1, err}
+	return mmGetCheckpoint.mock
 }
 
-//Set uses given function f to mock the Storage.GetNextCompletePulseFilterByPrototypeReference method
-func (mmGetNextCompletePulseFilterByPrototypeReference *mStorageMockGetNextCompletePulseFilterByPrototypeReference) Set(f func(prevPulse int64, prototypes [][]byte) (p1 models.Pulse, err error)) *StorageMock {
-	if mmGetNextCompletePulseFilterByPrototypeReference.defaultExpectation != nil {
-		mmGetNextCompletePulseFilterByPrototypeReference.mock.t.Fatalf("Default expectation is already set for the Storage.GetNextCompletePulseFilterByPrototypeReference method")
+//Set uses given function f to mock the Storage.GetCheckpoint method
+func (mmGetCheckpoint *mStorageMockGetCheckpoint) Set(f func(name string) (c1 models.Checkpoint, err error)) *StorageMock {
+	if mmGetCheckpoint.defaultExpectation != nil {
+		mmGetCheckpoint.mock.t.Fatalf("Default expectation is already set for the Storage.GetCheckpoint method")
 	}
 
-	if len(mmGetNextCompletePulseFilterByPrototypeReference.expectations) > 0 {
-		mmGetNextCompletePulseFilterByPrototypeReference.mock.t.Fatalf("Some expectations are already set for the Storage.GetNextCompletePulseFilterByPrototypeReference method")
+	if len(mmGetCheckpoint.expectations) > 0 {
+		mmGetCheckpoint.mock.t.Fatalf("Some expectations are already set for the Storage.GetCheckpoint method")
 	}
 
-	mmGetNextCompletePulseFilterByPrototypeReference.mock.funcGetNextCompletePulseFilterByPrototypeReference = f
-	return mmGetNextCompletePulseFilterByPrototypeReference.mock
+	mmGetCheckpoint.mock.funcGetCheckpoint = f
+	return mmGetCheckpoint.mock
 }
 
-// When sets expectation for the Storage.GetNextCompletePulseFilterByPrototypeReference which will trigger the result defined by the following
+// When sets expectation for the Storage.GetCheckpoint which will trigger the result defined by the following
 // Then helper
-func (mmGetNextCompletePulseFilterByPrototypeReference *mStorageMockGetNextCompletePulseFilterByPrototypeReference) When(prevPulse int64, prototypes [][]byte) *StorageMockGetNextCompletePulseFilterByPrototypeReferenceExpectation {
-	if mmGetNextCompletePulseFilterByPrototypeReference.mock.funcGetNextCompletePulseFilterByPrototypeReference != nil {
-		mmGetNextCompletePulseFilterByPrototypeReference.mock.t.Fatalf("StorageMock.GetNextCompletePulseFilterByPrototypeReference mock is already set by Set")
+func (mmGetCheckpoint *mStorageMockGetCheckpoint) When(name string) *StorageMockGetCheckpointExpectation {
+	if mmGetCheckpoint.mock.funcGetCheckpoint != nil {
+		mmGetCheckpoint.mock.t.Fatalf("StorageMock.GetCheckpoint mock is already set by Set")
 	}
 
-	expectation := &StorageMockGetNextCompletePulseFilterByPrototypeReferenceExpectation{
-		mock:   mmGetNextCompletePulseFilterByPrototypeReference.mock,
-		params: &StorageMockGetNextCompletePulseFilterByPrototypeReferenceParams{prevPulse, prototypes},
+	expectation := &StorageMockGetCheckpointExpectation{
+		mock:   mmGetCheckpoint.mock,
+		params: &StorageMockGetCheckpointParams{name},
 	}
-	mmGetNextCompletePulseFilterByPrototypeReference.expectations = append(mmGetNextCompletePulseFilterByPrototypeReference.expectations, expectation)
+	mmGetCheckpoint.expectations = append(mmGetCheckpoint.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Storage.GetNextCompletePulseFilterByPrototypeReference return parameters for the expectation previously defined by the When method
-func (e *StorageMockGetNextCompletePulseFilterByPrototypeReferenceExpectation) Then(p1 models.Pulse, err error) *StorageMock {
-	e.results = &StorageMockGetNextCompletePulseFilterByPrototypeReferenceResults{p1, err}
+// Then sets up Storage.GetCheckpoint return parameters for the expectation previously defined by the When method
+func (e *StorageMockGetCheckpointExpectation) Then(c1 models.Checkpoint, err error) *StorageMock {
+	e.results = &StorageMockGetCheckpointResults{c1, err}
 	return e.mock
 }
 
-// GetNextCompletePulseFilterByPrototypeReference implements interfaces.Storage
-func (mmGetNextCompletePulseFilterByPrototypeReference *StorageMock) GetNextCompletePulseFilterByPrototypeReference(prevPulse int64, prototypes [][]byte) (p1 models.Pulse, err error) {
-	mm_atomic.AddUint64(&mmGetNextCompletePulseFilterByPrototypeReference.beforeGetNextCompletePulseFilterByPrototypeReferenceCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetNextCompletePulseFilterByPrototypeReference.afterGetNextCompletePulseFilterByPrototypeReferenceCounter, 1)
+// GetCheckpoint implements interfaces.Storage
+func (mmGetCheckpoint *StorageMock) GetCheckpoint(name string) (c1 models.Checkpoint, err error) {
+	mm_atomic.AddUint64(&mmGetCheckpoint.beforeGetCheckpointCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCheckpoint.afterGetCheckpointCounter, 1)
 
-	if mmGetNextCompletePulseFilterByPrototypeReference.inspectFuncGetNextCompletePulseFilterByPrototypeReference != nil {
-		mmGetNextCompletePulseFilterByPrototypeReference.inspectFuncGetNextCompletePulseFilterByPrototypeReference(prevPulse, prototypes)
+	if mmGetCheckpoint.inspectFuncGetCheckpoint != nil {
+		mmGetCheckpoint.inspectFuncGetCheckpoint(name)
 	}
 
-	mm_params := &StorageMockGetNextCompletePulseFilterByPrototypeReferenceParams{prevPulse, prototypes}
+	mm_params := &StorageMockGetCheckpointParams{name}
 
 	// Record call args
-	mmGetNextCompletePulseFilterByPrototypeReference.GetNextCompletePulseFilterByPrototypeReferenceMock.mutex.Lock()
-	mmGetNextCompletePulseFilterByPrototypeReference.GetNextCompletePulseFilterByPrototypeReferenceMock.callArgs = append(mmGetNextCompletePulseFilterByPrototypeReference.GetNextCompletePulseFilterByPrototypeReferenceMock.callArgs, mm_params)
-	mmGetNextCompletePulseFilterByPrototypeReference.GetNextCompletePulseFilterByPrototypeReferenceMock.mutex.Unlock()
+	mmGetCheckpoint.GetCheckpointMock.mutex.Lock()
+	mmGetCheckpoint.GetCheckpointMock.callArgs = append(mmGetCheckpoint.GetCheckpointMock.callArgs, mm_params)
+	mmGetCheckpoint.GetCheckpointMock.mutex.Unlock()
 
-	for _, e := range mmGetNextCompletePulseFilterByPrototypeReference.GetNextCompletePulseFilterByPrototypeReferenceMock.expectations {
+	for _, e := range mmGetCheckpoint.GetCheckpointMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.p1, e.results.err
+			return e.results.c1, e.results.err
 		}
 	}
 
-	if mmGetNextCompletePulseFilterByPrototypeReference.GetNextCompletePulseFilterByPrototypeReferenceMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetNextCompletePulseFilterByPrototypeReference.GetNextCompletePulseFilterByPrototypeReferenceMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetNextCompletePulseFilterByPrototypeReference.GetNextCompletePulseFilterByPrototypeReferenceMock.defaultExpectation.params
-		mm_got := StorageMockGetNextCompletePulseFilterByPrototypeReferenceParams{prevPulse, prototypes}
+	if mmGetCheckpoint.GetCheckpointMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCheckpoint.GetCheckpointMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCheckpoint.GetCheckpointMock.defaultExpectation.params
+		mm_got := StorageMockGetCheckpointParams{name}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetNextCompletePulseFilterByPrototypeReference.t.Errorf("StorageMock.GetNextCompletePulseFilterByPrototypeReference got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmGetCheckpoint.t.Errorf("StorageMock.GetCheckpoint got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetNextCompletePulseFilterByPrototypeReference.GetNextCompletePulseFilterByPrototypeReferenceMock.defaultExpectation.results
+		mm_results := mmGetCheckpoint.GetCheckpointMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetNextCompletePulseFilterByPrototypeReference.t.Fatal("No results are set for the StorageMock.GetNextCompletePulseFilterByPrototypeReference")
+			mmGetCheckpoint.t.Fatal("No results are set for the StorageMock.GetCheckpoint")
 		}
-		return (*mm_results).p1, (*mm_results).err
+		return (*mm_results).c1, (*mm_results).err
 	}
-	if mmGetNextCompletePulseFilterByPrototypeReference.funcGetNextCompletePulseFilterByPrototypeReference != nil {
-		return mmGetNextCompletePulseFilterByPrototypeReference.funcGetNextCompletePulseFilterByPrototypeReference(prevPulse, prototypes)
+	if mmGetCheckpoint.funcGetCheckpoint != nil {
+		return mmGetCheckpoint.funcGetCheckpoint(name)
 	}
-	mmGetNextCompletePulseFilterByPrototypeReference.t.Fatalf("Unexpected call to StorageMock.GetNextCompletePulseFilterByPrototypeReference. %v %v", prevPulse, prototypes)
+	mmGetCheckpoint.t.Fatalf("Unexpected call to StorageMock.GetCheckpoint. %v", name)
 	return
 }
 
-// GetNextCompletePulseFilterByPrototypeReferenceAfterCounter returns a count of finished StorageMock.GetNextCompletePulseFilterByPrototypeReference invocations
-func (mmGetNextCompletePulseFilterByPrototypeReference *StorageMock) GetNextCompletePulseFilterByPrototypeReferenceAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetNextCompletePulseFilterByPrototypeReference.afterGetNextCompletePulseFilterByPrototypeReferenceCounter)
+// GetCheckpointAfterCounter returns a count of finished StorageMock.GetCheckpoint invocations
+func (mmGetCheckpoint *StorageMock) GetCheckpointAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCheckpoint.afterGetCheckpointCounter)
 }
 
-// GetNextCompletePulseFilterByPrototypeReferenceBeforeCounter returns a count of StorageMock.GetNextCompletePulseFilterByPrototypeReference invocations
-func (mmGetNextCompletePulseFilterByPrototypeReference *StorageMock) GetNextCompletePulseFilterByPrototypeReferenceBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetNextCompletePulseFilterByPrototypeReference.beforeGetNextCompletePulseFilterByPrototypeReferenceCounter)
+// GetCheckpointBeforeCounter returns a count of StorageMock.GetCheckpoint invocations
+func (mmGetCheckpoint *StorageMock) GetCheckpointBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCheckpoint.beforeGetCheckpointCounter)
 }
 
-// Calls returns a list of arguments used in each call to StorageMock.GetNextCompletePulseFilterByPrototypeReference.
+// Calls returns a list of arguments used in each call to StorageMock.GetCheckpoint.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetNextCompletePulseFilterByPrototypeReference *mStorageMockGetNextCompletePulseFilterByPrototypeReference) Calls() []*StorageMockGetNextCompletePulseFilterByPrototypeReferenceParams {
-	mmGetNextCompletePulseFilterByPrototypeReference.mutex.RLock()
+func (mmGetCheckpoint *mStorageMockGetCheckpoint) Calls() []*StorageMockGetCheckpointParams {
+	mmGetCheckpoint.mutex.RLock()
 
-	argCopy := make([]*StorageMockGetNextCompletePulseFilterByPrototypeReferenceParams, len(mmGetNextCompletePulseFilterByPrototypeReference.callArgs))
-	copy(argCopy, mmGetNextCompletePulseFilterByPrototypeReference.callArgs)
+	argCopy := make([]*StorageMockGetCheckpointParams, len(mmGetCheckpoint.callArgs))
+	copy(argCopy, mmGetCheckpoint.callArgs)
 
-	mmGetNextCompletePulseFilterByPrototypeReference.mutex.RUnlock()
+	mmGetCheckpoint.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetNextCompletePulseFilterByPrototypeReferenceDone returns true if the count of the GetNextCompletePulseFilterByPrototypeReference invocations corresponds
+// MinimockGetCheckpointDone returns true if the count of the GetCheckpoint invocations corresponds
 // the number of defined expectations
-func (m *StorageMock) MinimockGetNextCompletePulseFilterByPrototypeReferenceDone() bool {
-	for _, e := range m.GetNextCompletePulseFilterByPrototypeReferenceMock.expectations {
+func (m *StorageMock) MinimockGetCheckpointDone() bool {
+	for _, e := range m.GetCheckpointMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetNextCompletePulseFilterByPrototypeReferenceMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetNextCompletePulseFilterByPrototypeReferenceCounter) < 1 {
+	if m.GetCheckpointMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetCheckpointCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetNextCompletePulseFilterByPrototypeReference != nil && mm_atomic.LoadUint64(&m.afterGetNextCompletePulseFilterByPrototypeReferenceCounter) < 1 {
+	if m.funcGetCheckpoint != nil && mm_atomic.LoadUint64(&m.afterGetCheckpointCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockGetNextCompletePulseFilterByPrototypeReferenceInspect logs each unmet expectation
-func (m *StorageMock) MinimockGetNextCompletePulseFilterByPrototypeReferenceInspect() {
-	for _, e := range m.GetNextCompletePulseFilterByPrototypeReferenceMock.expectations {
+// MinimockGetCheckpointInspect logs each unmet expectation
+func (m *StorageMock) MinimockGetCheckpointInspect() {
+	for _, e := range m.GetCheckpointMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StorageMock.GetNextCompletePulseFilterByPrototypeReference with params: %#v", *e.params)
+			m.t.Errorf("Expected call to StorageMock.GetCheckpoint with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetNextCompletePulseFilterByPrototypeReferenceMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetNextCompletePulseFilterByPrototypeReferenceCounter) < 1 {
-		if m.GetNextCompletePulseFilterByPrototypeReferenceMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to StorageMock.GetNextCompletePulseFilterByPrototypeReference")
+	if m.GetCheckpointMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetCheckpointCounter) < 1 {
+		if m.GetCheckpointMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StorageMock.GetCheckpoint")
 		} else {
-			m.t.Errorf("Expected call to StorageMock.GetNextCompletePulseFilterByPrototypeReference with params: %#v", *m.GetNextCompletePulseFilterByPrototypeReferenceMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StorageMock.GetCheckpoint with params: %#v", *m.GetCheckpointMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetNextCompletePulseFilterByPrototypeReference != nil && mm_atomic.LoadUint64(&m.afterGetNextCompletePulseFilterByPrototypeReferenceCounter) < 1 {
-		m.t.Error("Expected call to StorageMock.GetNextCompletePulseFilterByPrototypeReference")
+	if m.funcGetCheckpoint != nil && mm_atomic.LoadUint64(&m.afterGetCheckpointCounter) < 1 {
+		m.t.Error("Expected call to StorageMock.GetCheckpoint")
 	}
 }
 
-type mStorageMockGetNextSavedPulse struct {
+type mStorageMockGetIncompletePulses struct {
 	mock               *StorageMock
-	defaultExpectation *StorageMockGetNextSavedPulseExpectation
-	expectations       []*StorageMockGetNextSavedPulseExpectation
+	defaultExpectation *StorageMockGetIncompletePulsesExpectation
+	expectations       []*StorageMockGetIncompletePulsesExpectation
+}
+
+// StorageMockGetIncompletePulsesExpectation specifies expectation struct of the Storage.GetIncompletePulses
+type StorageMockGetIncompletePulsesExpectation struct {
+	mock *StorageMock
+
+	results *StorageMockGetIncompletePulsesResults
+	Counter uint64
+}
+
+// StorageMockGetIncompletePulsesResults contains results of the Storage.GetIncompletePulses
+type StorageMockGetIncompletePulsesResults struct {
+	pa1 []models.Pulse
+	err error
+}
+
+// Expect sets up expected params for Storage.GetIncompletePulses
+func (mmGetIncompletePulses *mStorageMockGetIncompletePulses) Expect() *mStorageMockGetIncompletePulses {
+	if mmGetIncompletePulses.mock.funcGetIncompletePulses != nil {
+		mmGetIncompletePulses.mock.t.Fatalf("StorageMock.GetIncompletePulses mock is already set by Set")
+	}
+
+	if mmGetIncompletePulses.defaultExpectation == nil {
+		mmGetIncompletePulses.defaultExpectation = &StorageMockGetIncompletePulsesExpectation{}
+	}
+
+	return mmGetIncompletePulses
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.GetIncompletePulses
+func (mmGetIncompletePulses *mStorageMockGetIncompletePulses) Inspect(f func()) *mStorageMockGetIncompletePulses {
+	if mmGetIncompletePulses.mock.inspectFuncGetIncompletePulses != nil {
+		mmGetIncompletePulses.mock.t.Fatalf("Inspect function is already set for StorageMock.GetIncompletePulses")
+	}
+
+	mmGetIncompletePulses.mock.inspectFuncGetIncompletePulses = f
+
+	return mmGetIncompletePulses
+}
+
+// Return sets up results that will be returned by Storage.GetIncompletePulses
+func (mmGetIncompletePulses *mStorageMockGetIncompletePulses) Return(pa1 []models.Pulse, err error) *StorageMock {
+	if mmGetIncompletePulses.mock.funcGetIncompletePulses != nil {
+		mmGetIncompletePulses.mock.t.Fatalf("StorageMock.GetIncompletePulses mock is already set by Set")
+	}
+
+	if mmGetIncompletePulses.defaultExpectation == nil {
+		mmGetIncompletePulses.defaultExpectation = &StorageMockGetIncompletePulsesExpectation{mock: mmGetIncompletePulses.mock}
+	}
+	mmGetIncompletePulses.defaultExpectation.results = &StorageMockGetIncompletePulsesResults{pa1, err}
+	return mmGetIncompletePulses.mock
+}
+
+//Set uses given function f to mock the Storage.GetIncompletePulses method
+func (mmGetIncompletePulses *mStorageMockGetIncompletePulses) Set(f func() (pa1 []models.Pulse, err error)) *StorageMock {
+	if mmGetIncompletePulses.defaultExpectation != nil {
+		mmGetIncompletePulses.mock.t.Fatalf("Default expectation is already set for the Storage.GetIncompletePulses method")
+	}
+
+	if len(mmGetIncompletePulses.expectations) > 0 {
+		mmGetIncompletePulses.mock.t.Fatalf("Some expectations are already set for the Storage.GetIncompletePulses method")
+	}
+
+	mmGetIncompletePulses.mock.funcGetIncompletePulses = f
+	return mmGetIncompletePulses.mock
+}
+
+// GetIncompletePulses implements interfaces.Storage
+func (mmGetIncompletePulses *StorageMock) GetIncompletePulses() (pa1 []models.Pulse, err error) {
+	mm_atomic.AddUint64(&mmGetIncompletePulses.beforeGetIncompletePulsesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetIncompletePulses.afterGetIncompletePulsesCounter, 1)
+
+	if mmGetIncompletePulses.inspectFuncGetIncompletePulses != nil {
+		mmGetIncompletePulses.inspectFuncGetIncompletePulses()
+	}
+
+	if mmGetIncompletePulses.GetIncompletePulsesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetIncompletePulses.GetIncompletePulsesMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmGetIncompletePulses.GetIncompletePulsesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetIncompletePulses.t.Fatal("No results are set for the StorageMock.GetIncompletePulses")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmGetIncompletePulses.funcGetIncompletePulses != nil {
+		return mmGetIncompletePulses.funcGetIncompletePulses()
+	}
+	mmGetIncompletePulses.t.Fatalf("Unexpected call to StorageMock.GetIncompletePulses.")
+	return
+}
+
+// GetIncompletePulsesAfterCounter returns a count of finished StorageMock.GetIncompletePulses invocations
+func (mmGetIncompletePulses *StorageMock) GetIncompletePulsesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetIncompletePulses.afterGetIncompletePulsesCounter)
+}
+
+// GetIncompletePulsesBeforeCounter returns a count of StorageMock.GetIncompletePulses invocations
+func (mmGetIncompletePulses *StorageMock) GetIncompletePulsesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetIncompletePulses.beforeGetIncompletePulsesCounter)
+}
+
+// MinimockGetIncompletePulsesDone returns true if the count of the GetIncompletePulses invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockGetIncompletePulsesDone() bool {
+	for _, e := range m.GetIncompletePulsesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetIncompletePulsesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetIncompletePulsesCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetIncompletePulses != nil && mm_atomic.LoadUint64(&m.afterGetIncompletePulsesCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetIncompletePulsesInspect logs each unmet expectation
+func (m *StorageMock) MinimockGetIncompletePulsesInspect() {
+	for _, e := range m.GetIncompletePulsesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to StorageMock.GetIncompletePulses")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetIncompletePulsesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetIncompletePulsesCounter) < 1 {
+		m.t.Error("Expected call to StorageMock.GetIncompletePulses")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetIncompletePulses != nil && mm_atomic.LoadUint64(&m.afterGetIncompletePulsesCounter) < 1 {
+		m.t.Error("Expected call to StorageMock.GetIncompletePulses")
+	}
+}
+
+type mStorageMockGetJetDrops struct {
+	mock               *StorageMock
+	defaultExpectation *StorageMockGetJetDropsExpectation
+	expectations       []*StorageMockGetJetDropsExpectation
+
+	callArgs []*StorageMockGetJetDropsParams
+	mutex    sync.RWMutex
+}
+
+// StorageMockGetJetDropsExpectation specifies expectation struct of the Storage.GetJetDrops
+type StorageMockGetJetDropsExpectation struct {
+	mock    *StorageMock
+	params  *StorageMockGetJetDropsParams
+	results *StorageMockGetJetDropsResults
+	Counter uint64
+}
+
+// StorageMockGetJetDropsParams contains parameters of the Storage.GetJetDrops
+type StorageMockGetJetDropsParams struct {
+	pulse models.Pulse
+}
+
+// StorageMockGetJetDropsResults contains results of the Storage.GetJetDrops
+type StorageMockGetJetDropsResults struct {
+	ja1 []models.JetDrop
+	err error
+}
+
+// Expect sets up expected params for Storage.GetJetDrops
+func (mmGetJetDrops *mStorageMockGetJetDrops) Expect(pulse models.Pulse) *mStorageMockGetJetDrops {
+	if mmGetJetDrops.mock.funcGetJetDrops != nil {
+		mmGetJetDrops.mock.t.Fatalf("StorageMock.GetJetDrops mock is already set by Set")
+	}
+
+	if mmGetJetDrops.defaultExpectation == nil {
+		mmGetJetDrops.defaultExpectation = &StorageMockGetJetDropsExpectation{}
+	}
+
+	mmGetJetDrops.defaultExpectation.params = &StorageMockGetJetDropsParams{pulse}
+	for _, e := range mmGetJetDrops.expectations {
+		if minimock.Equal(e.params, mmGetJetDrops.defaultExpectation.params) {
+			mmGetJetDrops.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetJetDrops.defaultExpectation.params)
+		}
+	}
+
+	return mmGetJetDrops
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.GetJetDrops
+func (mmGetJetDrops *mStorageMockGetJetDrops) Inspect(f func(pulse models.Pulse)) *mStorageMockGetJetDrops {
+	if mmGetJetDrops.mock.inspectFuncGetJetDrops != nil {
+		mmGetJetDrops.mock.t.Fatalf("Inspect function is already set for StorageMock.GetJetDrops")
+	}
+
+	mmGetJetDrops.mock.inspectFuncGetJetDrops = f
+
+	return mmGetJetDrops
+}
+
+// Return sets up results that will be returned by Storage.GetJetDrops
+func (mmGetJetDrops *mStorageMockGetJetDrops) Return(ja1 []models.JetDrop, err error) *StorageMock {
+	if mmGetJetDrops.mock.funcGetJetDrops != nil {
+		mmGetJetDrops.mock.t.Fatalf("StorageMock.GetJetDrops mock is already set by Set")
+	}
+
+	if mmGetJetDrops.defaultExpectation == nil {
+		mmGetJetDrops.defaultExpectation = &StorageMockGetJetDropsExpectation{mock: mmGetJetDrops.mock}
+	}
+	mmGetJetDrops.defaultExpectation.results = &StorageMockGetJetDropsResults{ja1, err}
+	return mmGetJetDrops.mock
+}
+
+//Set uses given function f to mock the Storage.GetJetDrops method
+func (mmGetJetDrops *mStorageMockGetJetDrops) Set(f func(pulse models.Pulse) (ja1 []models.JetDrop, err error)) *StorageMock {
+	if mmGetJetDrops.defaultExpectation != nil {
+		mmGetJetDrops.mock.t.Fatalf("Default expectation is already set for the Storage.GetJetDrops method")
+	}
+
+	if len(mmGetJetDrops.expectations) > 0 {
+		mmGetJetDrops.mock.t.Fatalf("Some expectations are already set for the Storage.GetJetDrops method")
+	}
+
+	mmGetJetDrops.mock.funcGetJetDrops = f
+	return mmGetJetDrops.mock
+}
+
+// When sets expectation for the Storage.GetJetDrops which will trigger the result defined by the following
+// Then helper
+func (mmGetJetDrops *mStorageMockGetJetDrops) When(pulse models.Pulse) *StorageMockGetJetDropsExpectation {
+	if mmGetJetDrops.mock.funcGetJetDrops != nil {
+		mmGetJetDrops.mock.t.Fatalf("StorageMock.GetJetDrops mock is already set by Set")
+	}
+
+	expectation := &StorageMockGetJetDropsExpectation{
+		mock:   mmGetJetDrops.mock,
+		params: &StorageMockGetJetDropsParams{pulse},
+	}
+	mmGetJetDrops.expectations = append(mmGetJetDrops.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.GetJetDrops return parameters for the expectation previously defined by the When method
+func (e *StorageMockGetJetDropsExpectation) Then(ja1 []models.JetDrop, err error) *StorageMock {
+	e.results = &StorageMockGetJetDropsResults{ja1, err}
+	return e.mock
+}
+
+// GetJetDrops implements interfaces.Storage
+func (mmGetJetDrops *StorageMock) GetJetDrops(pulse models.Pulse) (ja1 []models.JetDrop, err error) {
+	mm_atomic.AddUint64(&mmGetJetDrops.beforeGetJetDropsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetJetDrops.afterGetJetDropsCounter, 1)
+
+	if mmGetJetDrops.inspectFuncGetJetDrops != nil {
+		mmGetJetDrops.inspectFuncGetJetDrops(pulse)
+	}
+
+	mm_params := &StorageMockGetJetDropsParams{pulse}
+
+	// Record call args
+	mmGetJetDrops.GetJetDropsMock.mutex.Lock()
+	mmGetJetDrops.GetJetDropsMock.callArgs = append(mmGetJetDrops.GetJetDropsMock.callArgs, mm_params)
+	mmGetJetDrops.GetJetDropsMock.mutex.Unlock()
+
+	for _, e := range mmGetJetDrops.GetJetDropsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ja1, e.results.err
+		}
+	}
+
+	if mmGetJetDrops.GetJetDropsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetJetDrops.GetJetDropsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetJetDrops.GetJetDropsMock.defaultExpectation.params
+		mm_got := StorageMockGetJetDropsParams{pulse}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetJetDrops.t.Errorf("StorageMock.GetJetDrops got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetJetDrops.GetJetDropsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetJetDrops.t.Fatal("No results are set for the StorageMock.GetJetDrops")
+		}
+		return (*mm_results).ja1, (*mm_results).err
+	}
+	if mmGetJetDrops.funcGetJetDrops != nil {
+		return mmGetJetDrops.funcGetJetDrops(pulse)
+	}
+	mmGetJetDrops.t.Fatalf("Unexpected call to StorageMock.GetJetDrops. %v", pulse)
+	return
+}
+
+// GetJetDropsAfterCounter returns a count of finished StorageMock.GetJetDrops invocations
+func (mmGetJetDrops *StorageMock) GetJetDropsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetJetDrops.afterGetJetDropsCounter)
+}
+
+// GetJetDropsBeforeCounter returns a count of StorageMock.GetJetDrops invocations
+func (mmGetJetDrops *StorageMock) GetJetDropsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetJetDrops.beforeGetJetDropsCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.GetJetDrops.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetJetDrops *mStorageMockGetJetDrops) Calls() []*StorageMockGetJetDropsParams {
+	mmGetJetDrops.mutex.RLock()
+
+	argCopy := make([]*StorageMockGetJetDropsParams, len(mmGetJetDrops.callArgs))
+	copy(argCopy, mmGetJetDrops.callArgs)
+
+	mmGetJetDrops.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetJetDropsDone returns true if the count of the GetJetDrops invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockGetJetDropsDone() bool {
+	for _, e := range m.GetJetDropsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetJetDropsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetJetDropsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetJetDrops != nil && mm_atomic.LoadUint64(&m.afterGetJetDropsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetJetDropsInspect logs each unmet expectation
+func (m *StorageMock) MinimockGetJetDropsInspect() {
+	for _, e := range m.GetJetDropsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.GetJetDrops with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetJetDropsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetJetDropsCounter) < 1 {
+		if m.GetJetDropsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StorageMock.GetJetDrops")
+		} else {
+			m.t.Errorf("Expected call to StorageMock.GetJetDrops with params: %#v", *m.GetJetDropsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetJetDrops != nil && mm_atomic.LoadUint64(&m.afterGetJetDropsCounter) < 1 {
+		m.t.Error("Expected call to StorageMock.GetJetDrops")
+	}
+}
+
+type mStorageMockGetNextSavedPulse struct {
+	mock               *StorageMock
+	defaultExpectation *StorageMockGetNextSavedPulseExpectation
+	expectations       []*StorageMockGetNextSavedPulseExpectation
+
+	callArgs []*StorageMockGetNextSavedPulseParams
+	mutex    sync.RWMutex
+}
+
+// StorageMockGetNextSavedPulseExpectation specifies expectation struct of the Storage.GetNextSavedPulse
+type StorageMockGetNextSavedPulseExpectation struct {
+	mock    *StorageMock
+	params  *StorageMockGetNextSavedPulseParams
+	results *StorageMockGetNextSavedPulseResults
+	Counter uint64
+}
+
+// StorageMockGetNextSavedPulseParams contains parameters of the Storage.GetNextSavedPulse
+type StorageMockGetNextSavedPulseParams struct {
+	fromPulseNumber models.Pulse
+	completedOnly   bool
+}
+
+// StorageMockGetNextSavedPulseResults contains results of the Storage.GetNextSavedPulse
+type StorageMockGetNextSavedPulseResults struct {
+	p1  models.Pulse
+	err error
+}
+
+// Expect sets up expected params for Storage.GetNextSavedPulse
+func (mmGetNextSavedPulse *mStorageMockGetNextSavedPulse) Expect(fromPulseNumber models.Pulse, completedOnly bool) *mStorageMockGetNextSavedPulse {
+	if mmGetNextSavedPulse.mock.funcGetNextSavedPulse != nil {
+		mmGetNextSavedPulse.mock.t.Fatalf("StorageMock.GetNextSavedPulse mock is already set by Set")
+	}
+
+	if mmGetNextSavedPulse.defaultExpectation == nil {
+		mmGetNextSavedPulse.defaultExpectation = &StorageMockGetNextSavedPulseExpectation{}
+	}
+
+	mmGetNextSavedPulse.defaultExpectation.params = &StorageMockGetNextSavedPulseParams{fromPulseNumber, completedOnly}
+	for _, e := range mmGetNextSavedPulse.expectations {
+		if minimock.Equal(e.params, mmGetNextSavedPulse.defaultExpectation.params) {
+			mmGetNextSavedPulse.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetNextSavedPulse.defaultExpectation.params)
+		}
+	}
+
+	return mmGetNextSavedPulse
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.GetNextSavedPulse
+func (mmGetNextSavedPulse *mStorageMockGetNextSavedPulse) Inspect(f func(fromPulseNumber models.Pulse, completedOnly bool)) *mStorageMockGetNextSavedPulse {
+	if mmGetNextSavedPulse.mock.inspectFuncGetNextSavedPulse != nil {
+		mmGetNextSavedPulse.mock.t.Fatalf("Inspect function is already set for StorageMock.GetNextSavedPulse")
+	}
+
+	mmGetNextSavedPulse.mock.inspectFuncGetNextSavedPulse = f
+
+	return mmGetNextSavedPulse
+}
+
+// Return sets up results that will be returned by Storage.GetNextSavedPulse
+func (mmGetNextSavedPulse *mStorageMockGetNextSavedPulse) Return(p1 models.Pulse, err error) *StorageMock {
+	if mmGetNextSavedPulse.mock.funcGetNextSavedPulse != nil {
+		mmGetNextSavedPulse.mock.t.Fatalf("StorageMock.GetNextSavedPulse mock is already set by Set")
+	}
+
+	if mmGetNextSavedPulse.defaultExpectation == nil {
+		mmGetNextSavedPulse.defaultExpectation = &StorageMockGetNextSavedPulseExpectation{mock: mmGetNextSavedPulse.mock}
+	}
+	mmGetNextSavedPulse.defaultExpectation.results = &StorageMockGetNextSavedPulseResults{p1, err}
+	return mmGetNextSavedPulse.mock
+}
+
+//Set uses given function f to mock the Storage.GetNextSavedPulse method
+func (mmGetNextSavedPulse *mStorageMockGetNextSavedPulse) Set(f func(fromPulseNumber models.Pulse, completedOnly bool) (p1 models.Pulse, err error)) *StorageMock {
+	if mmGetNextSavedPulse.defaultExpectation != nil {
+		mmGetNextSavedPulse.mock.t.Fatalf("Default expectation is already set for the Storage.GetNextSavedPulse method")
+	}
+
+	if len(mmGetNextSavedPulse.expectations) > 0 {
+		mmGetNextSavedPulse.mock.t.Fatalf("Some expectations are already set for the Storage.GetNextSavedPulse method")
+	}
+
+	mmGetNextSavedPulse.mock.funcGetNextSavedPulse = f
+	return mmGetNextSavedPulse.mock
+}
+
+// When sets expectation for the Storage.GetNextSavedPulse which will trigger the result defined by the following
+// Then helper
+func (mmGetNextSavedPulse *mStorageMockGetNextSavedPulse) When(fromPulseNumber models.Pulse, completedOnly bool) *StorageMockGetNextSavedPulseExpectation {
+	if mmGetNextSavedPulse.mock.funcGetNextSavedPulse != nil {
+		mmGetNextSavedPulse.mock.t.Fatalf("StorageMock.GetNextSavedPulse mock is already set by Set")
+	}
+
+	expectation := &StorageMockGetNextSavedPulseExpectation{
+		mock:   mmGetNextSavedPulse.mock,
+		params: &StorageMockGetNextSavedPulseParams{fromPulseNumber, completedOnly},
+	}
+	mmGetNextSavedPulse.expectations = append(mmGetNextSavedPulse.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.GetNextSavedPulse return parameters for the expectation previously defined by the When method
+func (e *StorageMockGetNextSavedPulseExpectation) Then(p1 models.Pulse, err error) *StorageMock {
+	e.results = &StorageMockGetNextSavedPulseResults{p1, err}
+	return e.mock
+}
+
+// GetNextSavedPulse implements interfaces.Storage
+func (mmGetNextSavedPulse *StorageMock) GetNextSavedPulse(fromPulseNumber models.Pulse, completedOnly bool) (p1 models.Pulse, err error) {
+	mm_atomic.AddUint64(&mmGetNextSavedPulse.beforeGetNextSavedPulseCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetNextSavedPulse.afterGetNextSavedPulseCounter, 1)
+
+	if mmGetNextSavedPulse.inspectFuncGetNextSavedPulse != nil {
+		mmGetNextSavedPulse.inspectFuncGetNextSavedPulse(fromPulseNumber, completedOnly)
+	}
+
+	mm_params := &StorageMockGetNextSavedPulseParams{fromPulseNumber, completedOnly}
+
+	// Record call args
+	mmGetNextSavedPulse.GetNextSavedPulseMock.mutex.Lock()
+	mmGetNextSavedPulse.GetNextSavedPulseMock.callArgs = append(mmGetNextSavedPulse.GetNextSavedPulseMock.callArgs, mm_params)
+	mmGetNextSavedPulse.GetNextSavedPulseMock.mutex.Unlock()
+
+	for _, e := range mmGetNextSavedPulse.GetNextSavedPulseMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmGetNextSavedPulse.GetNextSavedPulseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetNextSavedPulse.GetNextSavedPulseMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetNextSavedPulse.GetNextSavedPulseMock.defaultExpectation.params
+		mm_got := StorageMockGetNextSavedPulseParams{fromPulseNumber, completedOnly}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetNextSavedPulse.t.Errorf("StorageMock.GetNextSavedPulse got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetNextSavedPulse.GetNextSavedPulseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetNextSavedPulse.t.Fatal("No results are set for the StorageMock.GetNextSavedPulse")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmGetNextSavedPulse.funcGetNextSavedPulse != nil {
+		return mmGetNextSavedPulse.funcGetNextSavedPulse(fromPulseNumber, completedOnly)
+	}
+	mmGetNextSavedPulse.t.Fatalf("Unexpected call to StorageMock.GetNextSavedPulse. %v %v", fromPulseNumber, completedOnly)
+	return
+}
+
+// GetNextSavedPulseAfterCounter returns a count of finished StorageMock.GetNextSavedPulse invocations
+func (mmGetNextSavedPulse *StorageMock) GetNextSavedPulseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetNextSavedPulse.afterGetNextSavedPulseCounter)
+}
+
+// GetNextSavedPulseBeforeCounter returns a count of StorageMock.GetNextSavedPulse invocations
+func (mmGetNextSavedPulse *StorageMock) GetNextSavedPulseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetNextSavedPulse.beforeGetNextSavedPulseCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.GetNextSavedPulse.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetNextSavedPulse *mStorageMockGetNextSavedPulse) Calls() []*StorageMockGetNextSavedPulseParams {
+	mmGetNextSavedPulse.mutex.RLock()
+
+	argCopy := make([]*StorageMockGetNextSavedPulseParams, len(mmGetNextSavedPulse.callArgs))
+	copy(argCopy, mmGetNextSavedPulse.callArgs)
+
+	mmGetNextSavedPulse.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetNextSavedPulseDone returns true if the count of the GetNextSavedPulse invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockGetNextSavedPulseDone() bool {
+	for _, e := range m.GetNextSavedPulseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetNextSavedPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetNextSavedPulseCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetNextSavedPulse != nil && mm_atomic.LoadUint64(&m.afterGetNextSavedPulseCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetNextSavedPulseInspect logs each unmet expectation
+func (m *StorageMock) MinimockGetNextSavedPulseInspect() {
+	for _, e := range m.GetNextSavedPulseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.GetNextSavedPulse with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetNextSavedPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetNextSavedPulseCounter) < 1 {
+		if m.GetNextSavedPulseMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StorageMock.GetNextSavedPulse")
+		} else {
+			m.t.Errorf("Expected call to StorageMock.GetNextSavedPulse with params: %#v", *m.GetNextSavedPulseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetNextSavedPulse != nil && mm_atomic.LoadUint64(&m.afterGetNextSavedPulseCounter) < 1 {
+		m.t.Error("Expected call to StorageMock.GetNextSavedPulse")
+	}
+}
+
+type mStorageMockGetNextSequentialPulse struct {
+	mock               *StorageMock
+	defaultExpectation *StorageMockGetNextSequentialPulseExpectation
+	expectations       []*StorageMockGetNextSequentialPulseExpectation
+
+	callArgs []*StorageMockGetNextSequentialPulseParams
+	mutex    sync.RWMutex
+}
+
+// StorageMockGetNextSequentialPulseExpectation specifies expectation struct of the Storage.GetNextSequentialPulse
+type StorageMockGetNextSequentialPulseExpectation struct {
+	mock    *StorageMock
+	params  *StorageMockGetNextSequentialPulseParams
+	results *StorageMockGetNextSequentialPulseResults
+	Counter uint64
+}
+
+// StorageMockGetNextSequentialPulseParams contains parameters of the Storage.GetNextSequentialPulse
+type StorageMockGetNextSequentialPulseParams struct {
+	fromPulseNumber int64
+}
+
+// StorageMockGetNextSequentialPulseResults contains results of the Storage.GetNextSequentialPulse
+type StorageMockGetNextSequentialPulseResults struct {
+	p1  models.Pulse
+	err error
+}
+
+// Expect sets up expected params for Storage.GetNextSequentialPulse
+func (mmGetNextSequentialPulse *mStorageMockGetNextSequentialPulse) Expect(fromPulseNumber int64) *mStorageMockGetNextSequentialPulse {
+	if mmGetNextSequentialPulse.mock.funcGetNextSequentialPulse != nil {
+		mmGetNextSequentialPulse.mock.t.Fatalf("StorageMock.GetNextSequentialPulse mock is already set by Set")
+	}
+
+	if mmGetNextSequentialPulse.defaultExpectation == nil {
+		mmGetNextSequentialPulse.defaultExpectation = &StorageMockGetNextSequentialPulseExpectation{}
+	}
+
+	mmGetNextSequentialPulse.defaultExpectation.params = &StorageMockGetNextSequentialPulseParams{fromPulseNumber}
+	for _, e := range mmGetNextSequentialPulse.expectations {
+		if minimock.Equal(e.params, mmGetNextSequentialPulse.defaultExpectation.params) {
+			mmGetNextSequentialPulse.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetNextSequentialPulse.defaultExpectation.params)
+		}
+	}
+
+	return mmGetNextSequentialPulse
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.GetNextSequentialPulse
+func (mmGetNextSequentialPulse *mStorageMockGetNextSequentialPulse) Inspect(f func(fromPulseNumber int64)) *mStorageMockGetNextSequentialPulse {
+	if mmGetNextSequentialPulse.mock.inspectFuncGetNextSequentialPulse != nil {
+		mmGetNextSequentialPulse.mock.t.Fatalf("Inspect function is already set for StorageMock.GetNextSequentialPulse")
+	}
+
+	mmGetNextSequentialPulse.mock.inspectFuncGetNextSequentialPulse = f
+
+	return mmGetNextSequentialPulse
+}
+
+// Return sets up results that will be returned by Storage.GetNextSequentialPulse
+func (mmGetNextSequentialPulse *mStorageMockGetNextSequentialPulse) Return(p1 models.Pulse, err error) *StorageMock {
+	if mmGetNextSequentialPulse.mock.funcGetNextSequentialPulse != nil {
+		mmGetNextSequentialPulse.mock.t.Fatalf("StorageMock.GetNextSequentialPulse mock is already set by Set")
+	}
+
+	if mmGetNextSequentialPulse.defaultExpectation == nil {
+		mmGetNextSequentialPulse.defaultExpectation = &StorageMockGetNextSequentialPulseExpectation{mock: mmGetNextSequentialPulse.mock}
+	}
+	mmGetNextSequentialPulse.defaultExpectation.results = &StorageMockGetNextSequentialPulseResults{p1, err}
+	return mmGetNextSequentialPulse.mock
+}
+
+//Set uses given function f to mock the Storage.GetNextSequentialPulse method
+func (mmGetNextSequentialPulse *mStorageMockGetNextSequentialPulse) Set(f func(fromPulseNumber int64) (p1 models.Pulse, err error)) *StorageMock {
+	if mmGetNextSequentialPulse.defaultExpectation != nil {
+		mmGetNextSequentialPulse.mock.t.Fatalf("Default expectation is already set for the Storage.GetNextSequentialPulse method")
+	}
+
+	if len(mmGetNextSequentialPulse.expectations) > 0 {
+		mmGetNextSequentialPulse.mock.t.Fatalf("Some expectations are already set for the Storage.GetNextSequentialPulse method")
+	}
+
+	mmGetNextSequentialPulse.mock.funcGetNextSequentialPulse = f
+	return mmGetNextSequentialPulse.mock
+}
+
+// When sets expectation for the Storage.GetNextSequentialPulse which will trigger the result defined by the following
+// Then helper
+func (mmGetNextSequentialPulse *mStorageMockGetNextSequentialPulse) When(fromPulseNumber int64) *StorageMockGetNextSequentialPulseExpectation {
+	if mmGetNextSequentialPulse.mock.funcGetNextSequentialPulse != nil {
+		mmGetNextSequentialPulse.mock.t.Fatalf("StorageMock.GetNextSequentialPulse mock is already set by Set")
+	}
+
+	expectation := &StorageMockGetNextSequentialPulseExpectation{
+		mock:   mmGetNextSequentialPulse.mock,
+		params: &StorageMockGetNextSequentialPulseParams{fromPulseNumber},
+	}
+	mmGetNextSequentialPulse.expectations = append(mmGetNextSequentialPulse.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.GetNextSequentialPulse return parameters for the expectation previously defined by the When method
+func (e *StorageMockGetNextSequentialPulseExpectation) Then(p1 models.Pulse, err error) *StorageMock {
+	e.results = &StorageMockGetNextSequentialPulseResults{p1, err}
+	return e.mock
+}
+
+// GetNextSequentialPulse implements interfaces.Storage
+func (mmGetNextSequentialPulse *StorageMock) GetNextSequentialPulse(fromPulseNumber int64) (p1 models.Pulse, err error) {
+	mm_atomic.AddUint64(&mmGetNextSequentialPulse.beforeGetNextSequentialPulseCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetNextSequentialPulse.afterGetNextSequentialPulseCounter, 1)
+
+	if mmGetNextSequentialPulse.inspectFuncGetNextSequentialPulse != nil {
+		mmGetNextSequentialPulse.inspectFuncGetNextSequentialPulse(fromPulseNumber)
+	}
+
+	mm_params := &StorageMockGetNextSequentialPulseParams{fromPulseNumber}
+
+	// Record call args
+	mmGetNextSequentialPulse.GetNextSequentialPulseMock.mutex.Lock()
+	mmGetNextSequentialPulse.GetNextSequentialPulseMock.callArgs = append(mmGetNextSequentialPulse.GetNextSequentialPulseMock.callArgs, mm_params)
+	mmGetNextSequentialPulse.GetNextSequentialPulseMock.mutex.Unlock()
+
+	for _, e := range mmGetNextSequentialPulse.GetNextSequentialPulseMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmGetNextSequentialPulse.GetNextSequentialPulseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetNextSequentialPulse.GetNextSequentialPulseMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetNextSequentialPulse.GetNextSequentialPulseMock.defaultExpectation.params
+		mm_got := StorageMockGetNextSequentialPulseParams{fromPulseNumber}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetNextSequentialPulse.t.Errorf("StorageMock.GetNextSequentialPulse got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetNextSequentialPulse.GetNextSequentialPulseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetNextSequentialPulse.t.Fatal("No results are set for the StorageMock.GetNextSequentialPulse")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmGetNextSequentialPulse.funcGetNextSequentialPulse != nil {
+		return mmGetNextSequentialPulse.funcGetNextSequentialPulse(fromPulseNumber)
+	}
+	mmGetNextSequentialPulse.t.Fatalf("Unexpected call to StorageMock.GetNextSequentialPulse. %v", fromPulseNumber)
+	return
+}
+
+// GetNextSequentialPulseAfterCounter returns a count of finished StorageMock.GetNextSequentialPulse invocations
+func (mmGetNextSequentialPulse *StorageMock) GetNextSequentialPulseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetNextSequentialPulse.afterGetNextSequentialPulseCounter)
+}
+
+// GetNextSequentialPulseBeforeCounter returns a count of StorageMock.GetNextSequentialPulse invocations
+func (mmGetNextSequentialPulse *StorageMock) GetNextSequentialPulseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetNextSequentialPulse.beforeGetNextSequentialPulseCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.GetNextSequentialPulse.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetNextSequentialPulse *mStorageMockGetNextSequentialPulse) Calls() []*StorageMockGetNextSequentialPulseParams {
+	mmGetNextSequentialPulse.mutex.RLock()
+
+	argCopy := make([]*StorageMockGetNextSequentialPulseParams, len(mmGetNextSequentialPulse.callArgs))
+	copy(argCopy, mmGetNextSequentialPulse.callArgs)
+
+	mmGetNextSequentialPulse.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetNextSequentialPulseDone returns true if the count of the GetNextSequentialPulse invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockGetNextSequentialPulseDone() bool {
+	for _, e := range m.GetNextSequentialPulseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetNextSequentialPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetNextSequentialPulseCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetNextSequentialPulse != nil && mm_atomic.LoadUint64(&m.afterGetNextSequentialPulseCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetNextSequentialPulseInspect logs each unmet expectation
+func (m *StorageMock) MinimockGetNextSequentialPulseInspect() {
+	for _, e := range m.GetNextSequentialPulseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.GetNextSequentialPulse with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetNextSequentialPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetNextSequentialPulseCounter) < 1 {
+		if m.GetNextSequentialPulseMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StorageMock.GetNextSequentialPulse")
+		} else {
+			m.t.Errorf("Expected call to StorageMock.GetNextSequentialPulse with params: %#v", *m.GetNextSequentialPulseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetNextSequentialPulse != nil && mm_atomic.LoadUint64(&m.afterGetNextSequentialPulseCounter) < 1 {
+		m.t.Error("Expected call to StorageMock.GetNextSequentialPulse")
+	}
+}
+
+type mStorageMockGetNextSequentialPulseFilterByRecords struct {
+	mock               *StorageMock
+	defaultExpectation *StorageMockGetNextSequentialPulseFilterByRecordsExpectation
+	expectations       []*StorageMockGetNextSequentialPulseFilterByRecordsExpectation
+
+	callArgs []*StorageMockGetNextSequentialPulseFilterByRecordsParams
+	mutex    sync.RWMutex
+}
+
+// StorageMockGetNextSequentialPulseFilterByRecordsExpectation specifies expectation struct of the Storage.GetNextSequentialPulseFilterByRecords
+type StorageMockGetNextSequentialPulseFilterByRecordsExpectation struct {
+	mock    *StorageMock
+	params  *StorageMockGetNextSequentialPulseFilterByRecordsParams
+	results *StorageMockGetNextSequentialPulseFilterByRecordsResults
+	Counter uint64
+}
+
+// StorageMockGetNextSequentialPulseFilterByRecordsParams contains parameters of the Storage.GetNextSequentialPulseFilterByRecords
+type StorageMockGetNextSequentialPulseFilterByRecordsParams struct {
+	fromPulseNumber  int64
+	prototypes       [][]byte
+	objectReferences [][]byte
+	recordTypes      []string
+}
+
+// StorageMockGetNextSequentialPulseFilterByRecordsResults contains results of the Storage.GetNextSequentialPulseFilterByRecords
+type StorageMockGetNextSequentialPulseFilterByRecordsResults struct {
+	p1  models.Pulse
+	err error
+}
+
+// Expect sets up expected params for Storage.GetNextSequentialPulseFilterByRecords
+func (mmGetNextSequentialPulseFilterByRecords *mStorageMockGetNextSequentialPulseFilterByRecords) Expect(fromPulseNumber int64, prototypes [][]byte, objectReferences [][]byte, recordTypes []string) *mStorageMockGetNextSequentialPulseFilterByRecords {
+	if mmGetNextSequentialPulseFilterByRecords.mock.funcGetNextSequentialPulseFilterByRecords != nil {
+		mmGetNextSequentialPulseFilterByRecords.mock.t.Fatalf("StorageMock.GetNextSequentialPulseFilterByRecords mock is already set by Set")
+	}
+
+	if mmGetNextSequentialPulseFilterByRecords.defaultExpectation == nil {
+		mmGetNextSequentialPulseFilterByRecords.defaultExpectation = &StorageMockGetNextSequentialPulseFilterByRecordsExpectation{}
+	}
+
+	mmGetNextSequentialPulseFilterByRecords.defaultExpectation.params = &StorageMockGetNextSequentialPulseFilterByRecordsParams{fromPulseNumber, prototypes, objectReferences, recordTypes}
+	for _, e := range mmGetNextSequentialPulseFilterByRecords.expectations {
+		if minimock.Equal(e.params, mmGetNextSequentialPulseFilterByRecords.defaultExpectation.params) {
+			mmGetNextSequentialPulseFilterByRecords.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetNextSequentialPulseFilterByRecords.defaultExpectation.params)
+		}
+	}
+
+	return mmGetNextSequentialPulseFilterByRecords
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.GetNextSequentialPulseFilterByRecords
+func (mmGetNextSequentialPulseFilterByRecords *mStorageMockGetNextSequentialPulseFilterByRecords) Inspect(f func(fromPulseNumber int64, prototypes [][]byte, objectReferences [][]byte, recordTypes []string)) *mStorageMockGetNextSequentialPulseFilterByRecords {
+	if mmGetNextSequentialPulseFilterByRecords.mock.inspectFuncGetNextSequentialPulseFilterByRecords != nil {
+		mmGetNextSequentialPulseFilterByRecords.mock.t.Fatalf("Inspect function is already set for StorageMock.GetNextSequentialPulseFilterByRecords")
+	}
+
+	mmGetNextSequentialPulseFilterByRecords.mock.inspectFuncGetNextSequentialPulseFilterByRecords = f
+
+	return mmGetNextSequentialPulseFilterByRecords
+}
+
+// Return sets up results that will be returned by Storage.GetNextSequentialPulseFilterByRecords
+func (mmGetNextSequentialPulseFilterByRecords *mStorageMockGetNextSequentialPulseFilterByRecords) Return(p1 models.Pulse, err error) *StorageMock {
+	if mmGetNextSequentialPulseFilterByRecords.mock.funcGetNextSequentialPulseFilterByRecords != nil {
+		mmGetNextSequentialPulseFilterByRecords.mock.t.Fatalf("StorageMock.GetNextSequentialPulseFilterByRecords mock is already set by Set")
+	}
+
+	if mmGetNextSequentialPulseFilterByRecords.defaultExpectation == nil {
+		mmGetNextSequentialPulseFilterByRecords.defaultExpectation = &StorageMockGetNextSequentialPulseFilterByRecordsExpectation{mock: mmGetNextSequentialPulseFilterByRecords.mock}
+	}
+	mmGetNextSequentialPulseFilterByRecords.defaultExpectation.results = &StorageMockGetNextSequentialPulseFilterByRecordsResults{p1, err}
+	return mmGetNextSequentialPulseFilterByRecords.mock
+}
+
+//Set uses given function f to mock the Storage.GetNextSequentialPulseFilterByRecords method
+func (mmGetNextSequentialPulseFilterByRecords *mStorageMockGetNextSequentialPulseFilterByRecords) Set(f func(fromPulseNumber int64, prototypes [][]byte, objectReferences [][]byte, recordTypes []string) (p1 models.Pulse, err error)) *StorageMock {
+	if mmGetNextSequentialPulseFilterByRecords.defaultExpectation != nil {
+		mmGetNextSequentialPulseFilterByRecords.mock.t.Fatalf("Default expectation is already set for the Storage.GetNextSequentialPulseFilterByRecords method")
+	}
+
+	if len(mmGetNextSequentialPulseFilterByRecords.expectations) > 0 {
+		mmGetNextSequentialPulseFilterByRecords.mock.t.Fatalf("Some expectations are already set for the Storage.GetNextSequentialPulseFilterByRecords method")
+	}
+
+	mmGetNextSequentialPulseFilterByRecords.mock.funcGetNextSequentialPulseFilterByRecords = f
+	return mmGetNextSequentialPulseFilterByRecords.mock
+}
+
+// When sets expectation for the Storage.GetNextSequentialPulseFilterByRecords which will trigger the result defined by the following
+// Then helper
+func (mmGetNextSequentialPulseFilterByRecords *mStorageMockGetNextSequentialPulseFilterByRecords) When(fromPulseNumber int64, prototypes [][]byte, objectReferences [][]byte, recordTypes []string) *StorageMockGetNextSequentialPulseFilterByRecordsExpectation {
+	if mmGetNextSequentialPulseFilterByRecords.mock.funcGetNextSequentialPulseFilterByRecords != nil {
+		mmGetNextSequentialPulseFilterByRecords.mock.t.Fatalf("StorageMock.GetNextSequentialPulseFilterByRecords mock is already set by Set")
+	}
+
+	expectation := &StorageMockGetNextSequentialPulseFilterByRecordsExpectation{
+		mock:   mmGetNextSequentialPulseFilterByRecords.mock,
+		params: &StorageMockGetNextSequentialPulseFilterByRecordsParams{fromPulseNumber, prototypes, objectReferences, recordTypes},
+	}
+	mmGetNextSequentialPulseFilterByRecords.expectations = append(mmGetNextSequentialPulseFilterByRecords.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.GetNextSequentialPulseFilterByRecords return parameters for the expectation previously defined by the When method
+func (e *StorageMockGetNextSequentialPulseFilterByRecordsExpectation) Then(p1 models.Pulse, err error) *StorageMock {
+	e.results = &StorageMockGetNextSequentialPulseFilterByRecordsResults{p1, err}
+	return e.mock
+}
+
+// GetNextSequentialPulseFilterByRecords implements interfaces.Storage
+func (mmGetNextSequentialPulseFilterByRecords *StorageMock) GetNextSequentialPulseFilterByRecords(fromPulseNumber int64, prototypes [][]byte, objectReferences [][]byte, recordTypes []string) (p1 models.Pulse, err error) {
+	mm_atomic.AddUint64(&mmGetNextSequentialPulseFilterByRecords.beforeGetNextSequentialPulseFilterByRecordsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetNextSequentialPulseFilterByRecords.afterGetNextSequentialPulseFilterByRecordsCounter, 1)
+
+	if mmGetNextSequentialPulseFilterByRecords.inspectFuncGetNextSequentialPulseFilterByRecords != nil {
+		mmGetNextSequentialPulseFilterByRecords.inspectFuncGetNextSequentialPulseFilterByRecords(fromPulseNumber, prototypes, objectReferences, recordTypes)
+	}
+
+	mm_params := &StorageMockGetNextSequentialPulseFilterByRecordsParams{fromPulseNumber, prototypes, objectReferences, recordTypes}
+
+	// Record call args
+	mmGetNextSequentialPulseFilterByRecords.GetNextSequentialPulseFilterByRecordsMock.mutex.Lock()
+	mmGetNextSequentialPulseFilterByRecords.GetNextSequentialPulseFilterByRecordsMock.callArgs = append(mmGetNextSequentialPulseFilterByRecords.GetNextSequentialPulseFilterByRecordsMock.callArgs, mm_params)
+	mmGetNextSequentialPulseFilterByRecords.GetNextSequentialPulseFilterByRecordsMock.mutex.Unlock()
+
+	for _, e := range mmGetNextSequentialPulseFilterByRecords.GetNextSequentialPulseFilterByRecordsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmGetNextSequentialPulseFilterByRecords.GetNextSequentialPulseFilterByRecordsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetNextSequentialPulseFilterByRecords.GetNextSequentialPulseFilterByRecordsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetNextSequentialPulseFilterByRecords.GetNextSequentialPulseFilterByRecordsMock.defaultExpectation.params
+		mm_got := StorageMockGetNextSequentialPulseFilterByRecordsParams{fromPulseNumber, prototypes, objectReferences, recordTypes}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetNextSequentialPulseFilterByRecords.t.Errorf("StorageMock.GetNextSequentialPulseFilterByRecords got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetNextSequentialPulseFilterByRecords.GetNextSequentialPulseFilterByRecordsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetNextSequentialPulseFilterByRecords.t.Fatal("No results are set for the StorageMock.GetNextSequentialPulseFilterByRecords")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmGetNextSequentialPulseFilterByRecords.funcGetNextSequentialPulseFilterByRecords != nil {
+		return mmGetNextSequentialPulseFilterByRecords.funcGetNextSequentialPulseFilterByRecords(fromPulseNumber, prototypes, objectReferences, recordTypes)
+	}
+	mmGetNextSequentialPulseFilterByRecords.t.Fatalf("Unexpected call to StorageMock.GetNextSequentialPulseFilterByRecords. %v %v %v %v", fromPulseNumber, prototypes, objectReferences, recordTypes)
+	return
+}
+
+// GetNextSequentialPulseFilterByRecordsAfterCounter returns a count of finished StorageMock.GetNextSequentialPulseFilterByRecords invocations
+func (mmGetNextSequentialPulseFilterByRecords *StorageMock) GetNextSequentialPulseFilterByRecordsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetNextSequentialPulseFilterByRecords.afterGetNextSequentialPulseFilterByRecordsCounter)
+}
+
+// GetNextSequentialPulseFilterByRecordsBeforeCounter returns a count of StorageMock.GetNextSequentialPulseFilterByRecords invocations
+func (mmGetNextSequentialPulseFilterByRecords *StorageMock) GetNextSequentialPulseFilterByRecordsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetNextSequentialPulseFilterByRecords.beforeGetNextSequentialPulseFilterByRecordsCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.GetNextSequentialPulseFilterByRecords.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetNextSequentialPulseFilterByRecords *mStorageMockGetNextSequentialPulseFilterByRecords) Calls() []*StorageMockGetNextSequentialPulseFilterByRecordsParams {
+	mmGetNextSequentialPulseFilterByRecords.mutex.RLock()
+
+	argCopy := make([]*StorageMockGetNextSequentialPulseFilterByRecordsParams, len(mmGetNextSequentialPulseFilterByRecords.callArgs))
+	copy(argCopy, mmGetNextSequentialPulseFilterByRecords.callArgs)
+
+	mmGetNextSequentialPulseFilterByRecords.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetNextSequentialPulseFilterByRecordsDone returns true if the count of the GetNextSequentialPulseFilterByRecords invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockGetNextSequentialPulseFilterByRecordsDone() bool {
+	for _, e := range m.GetNextSequentialPulseFilterByRecordsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetNextSequentialPulseFilterByRecordsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetNextSequentialPulseFilterByRecordsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetNextSequentialPulseFilterByRecords != nil && mm_atomic.LoadUint64(&m.afterGetNextSequentialPulseFilterByRecordsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetNextSequentialPulseFilterByRecordsInspect logs each unmet expectation
+func (m *StorageMock) MinimockGetNextSequentialPulseFilterByRecordsInspect() {
+	for _, e := range m.GetNextSequentialPulseFilterByRecordsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.GetNextSequentialPulseFilterByRecords with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetNextSequentialPulseFilterByRecordsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetNextSequentialPulseFilterByRecordsCounter) < 1 {
+		if m.GetNextSequentialPulseFilterByRecordsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StorageMock.GetNextSequentialPulseFilterByRecords")
+		} else {
+			m.t.Errorf("Expected call to StorageMock.GetNextSequentialPulseFilterByRecords with params: %#v", *m.GetNextSequentialPulseFilterByRecordsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetNextSequentialPulseFilterByRecords != nil && mm_atomic.LoadUint64(&m.afterGetNextSequentialPulseFilterByRecordsCounter) < 1 {
+		m.t.Error("Expected call to StorageMock.GetNextSequentialPulseFilterByRecords")
+	}
+}
+
+type mStorageMockGetPulse struct {
+	mock               *StorageMock
+	defaultExpectation *StorageMockGetPulseExpectation
+	expectations       []*StorageMockGetPulseExpectation
+
+	callArgs []*StorageMockGetPulseParams
+	mutex    sync.RWMutex
+}
+
+// StorageMockGetPulseExpectation specifies expectation struct of the Storage.GetPulse
+type StorageMockGetPulseExpectation struct {
+	mock    *StorageMock
+	params  *StorageMockGetPulseParams
+	results *StorageMockGetPulseResults
+	Counter uint64
+}
+
+// StorageMockGetPulseParams contains parameters of the Storage.GetPulse
+type StorageMockGetPulseParams struct {
+	pulseNumber int64
+}
+
+// StorageMockGetPulseResults contains results of the Storage.GetPulse
+type StorageMockGetPulseResults struct {
+	p1  models.Pulse
+	err error
+}
+
+// Expect sets up expected params for Storage.GetPulse
+func (mmGetPulse *mStorageMockGetPulse) Expect(pulseNumber int64) *mStorageMockGetPulse {
+	if mmGetPulse.mock.funcGetPulse != nil {
+		mmGetPulse.mock.t.Fatalf("StorageMock.GetPulse mock is already set by Set")
+	}
+
+	if mmGetPulse.defaultExpectation == nil {
+		mmGetPulse.defaultExpectation = &StorageMockGetPulseExpectation{}
+	}
+
+	mmGetPulse.defaultExpectation.params = &StorageMockGetPulseParams{pulseNumber}
+	for _, e := range mmGetPulse.expectations {
+		if minimock.Equal(e.params, mmGetPulse.defaultExpectation.params) {
+			mmGetPulse.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPulse.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPulse
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.GetPulse
+func (mmGetPulse *mStorageMockGetPulse) Inspect(f func(pulseNumber int64)) *mStorageMockGetPulse {
+	if mmGetPulse.mock.inspectFuncGetPulse != nil {
+		mmGetPulse.mock.t.Fatalf("Inspect function is already set for StorageMock.GetPulse")
+	}
+
+	mmGetPulse.mock.inspectFuncGetPulse = f
+
+	return mmGetPulse
+}
+
+// Return sets up results that will be returned by Storage.GetPulse
+func (mmGetPulse *mStorageMockGetPulse) Return(p1 models.Pulse, err error) *StorageMock {
+	if mmGetPulse.mock.funcGetPulse != nil {
+		mmGetPulse.mock.t.Fatalf("StorageMock.GetPulse mock is already set by Set")
+	}
+
+	if mmGetPulse.defaultExpectation == nil {
+		mmGetPulse.defaultExpectation = &StorageMockGetPulseExpectation{mock: mmGetPulse.mock}
+	}
+	mmGetPulse.defaultExpectation.results = &StorageMockGetPulseResults{p1, err}
+	return mmGetPulse.mock
+}
+
+//Set uses given function f to mock the Storage.GetPulse method
+func (mmGetPulse *mStorageMockGetPulse) Set(f func(pulseNumber int64) (p1 models.Pulse, err error)) *StorageMock {
+	if mmGetPulse.defaultExpectation != nil {
+		mmGetPulse.mock.t.Fatalf("Default expectation is already set for the Storage.GetPulse method")
+	}
+
+	if len(mmGetPulse.expectations) > 0 {
+		mmGetPulse.mock.t.Fatalf("Some expectations are already set for the Storage.GetPulse method")
+	}
+
+	mmGetPulse.mock.funcGetPulse = f
+	return mmGetPulse.mock
+}
+
+// When sets expectation for the Storage.GetPulse which will trigger the result defined by the following
+// Then helper
+func (mmGetPulse *mStorageMockGetPulse) When(pulseNumber int64) *StorageMockGetPulseExpectation {
+	if mmGetPulse.mock.funcGetPulse != nil {
+		mmGetPulse.mock.t.Fatalf("StorageMock.GetPulse mock is already set by Set")
+	}
+
+	expectation := &StorageMockGetPulseExpectation{
+		mock:   mmGetPulse.mock,
+		params: &StorageMockGetPulseParams{pulseNumber},
+	}
+	mmGetPulse.expectations = append(mmGetPulse.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.GetPulse return parameters for the expectation previously defined by the When method
+func (e *StorageMockGetPulseExpectation) Then(p1 models.Pulse, err error) *StorageMock {
+	e.results = &StorageMockGetPulseResults{p1, err}
+	return e.mock
+}
+
+// GetPulse implements interfaces.Storage
+func (mmGetPulse *StorageMock) GetPulse(pulseNumber int64) (p1 models.Pulse, err error) {
+	mm_atomic.AddUint64(&mmGetPulse.beforeGetPulseCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPulse.afterGetPulseCounter, 1)
+
+	if mmGetPulse.inspectFuncGetPulse != nil {
+		mmGetPulse.inspectFuncGetPulse(pulseNumber)
+	}
+
+	mm_params := &StorageMockGetPulseParams{pulseNumber}
+
+	// Record call args
+	mmGetPulse.GetPulseMock.mutex.Lock()
+	mmGetPulse.GetPulseMock.callArgs = append(mmGetPulse.GetPulseMock.callArgs, mm_params)
+	mmGetPulse.GetPulseMock.mutex.Unlock()
+
+	for _, e := range mmGetPulse.GetPulseMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmGetPulse.GetPulseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPulse.GetPulseMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPulse.GetPulseMock.defaultExpectation.params
+		mm_got := StorageMockGetPulseParams{pulseNumber}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPulse.t.Errorf("StorageMock.GetPulse got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPulse.GetPulseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPulse.t.Fatal("No results are set for the StorageMock.GetPulse")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmGetPulse.funcGetPulse != nil {
+		return mmGetPulse.funcGetPulse(pulseNumber)
+	}
+	mmGetPulse.t.Fatalf("Unexpected call to StorageMock.GetPulse. %v", pulseNumber)
+	return
+}
+
+// GetPulseAfterCounter returns a count of finished StorageMock.GetPulse invocations
+func (mmGetPulse *StorageMock) GetPulseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPulse.afterGetPulseCounter)
+}
+
+// GetPulseBeforeCounter returns a count of StorageMock.GetPulse invocations
+func (mmGetPulse *StorageMock) GetPulseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPulse.beforeGetPulseCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.GetPulse.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPulse *mStorageMockGetPulse) Calls() []*StorageMockGetPulseParams {
+	mmGetPulse.mutex.RLock()
+
+	argCopy := make([]*StorageMockGetPulseParams, len(mmGetPulse.callArgs))
+	copy(argCopy, mmGetPulse.callArgs)
+
+	mmGetPulse.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPulseDone returns true if the count of the GetPulse invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockGetPulseDone() bool {
+	for _, e := range m.GetPulseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetPulseCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPulse != nil && mm_atomic.LoadUint64(&m.afterGetPulseCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetPulseInspect logs each unmet expectation
+func (m *StorageMock) MinimockGetPulseInspect() {
+	for _, e := range m.GetPulseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.GetPulse with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetPulseCounter) < 1 {
+		if m.GetPulseMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StorageMock.GetPulse")
+		} else {
+			m.t.Errorf("Expected call to StorageMock.GetPulse with params: %#v", *m.GetPulseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPulse != nil && mm_atomic.LoadUint64(&m.afterGetPulseCounter) < 1 {
+		m.t.Error("Expected call to StorageMock.GetPulse")
+	}
+}
+
+type mStorageMockGetPulseByPrev struct {
+	mock               *StorageMock
+	defaultExpectation *StorageMockGetPulseByPrevExpectation
+	expectations       []*StorageMockGetPulseByPrevExpectation
+
+	callArgs []*StorageMockGetPulseByPrevParams
+	mutex    sync.RWMutex
+}
+
+// StorageMockGetPulseByPrevExpectation specifies expectation struct of the Storage.GetPulseByPrev
+type StorageMockGetPulseByPrevExpectation struct {
+	mock    *StorageMock
+	params  *StorageMockGetPulseByPrevParams
+	results *StorageMockGetPulseByPrevResults
+	Counter uint64
+}
+
+// StorageMockGetPulseByPrevParams contains parameters of the Storage.GetPulseByPrev
+type StorageMockGetPulseByPrevParams struct {
+	prevPulse models.Pulse
+}
+
+// StorageMockGetPulseByPrevResults contains results of the Storage.GetPulseByPrev
+type StorageMockGetPulseByPrevResults struct {
+	p1  models.Pulse
+	err error
+}
+
+// Expect sets up expected params for Storage.GetPulseByPrev
+func (mmGetPulseByPrev *mStorageMockGetPulseByPrev) Expect(prevPulse models.Pulse) *mStorageMockGetPulseByPrev {
+	if mmGetPulseByPrev.mock.funcGetPulseByPrev != nil {
+		mmGetPulseByPrev.mock.t.Fatalf("StorageMock.GetPulseByPrev mock is already set by Set")
+	}
+
+	if mmGetPulseByPrev.defaultExpectation == nil {
+		mmGetPulseByPrev.defaultExpectation = &StorageMockGetPulseByPrevExpectation{}
+	}
+
+	mmGetPulseByPrev.defaultExpectation.params = &StorageMockGetPulseByPrevParams{prevPulse}
+	for _, e := range mmGetPulseByPrev.expectations {
+		if minimock.Equal(e.params, mmGetPulseByPrev.defaultExpectation.params) {
+			mmGetPulseByPrev.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPulseByPrev.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPulseByPrev
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.GetPulseByPrev
+func (mmGetPulseByPrev *mStorageMockGetPulseByPrev) Inspect(f func(prevPulse models.Pulse)) *mStorageMockGetPulseByPrev {
+	if mmGetPulseByPrev.mock.inspectFuncGetPulseByPrev != nil {
+		mmGetPulseByPrev.mock.t.Fatalf("Inspect function is already set for StorageMock.GetPulseByPrev")
+	}
+
+	mmGetPulseByPrev.mock.inspectFuncGetPulseByPrev = f
+
+	return mmGetPulseByPrev
+}
+
+// Return sets up results that will be returned by Storage.GetPulseByPrev
+func (mmGetPulseByPrev *mStorageMockGetPulseByPrev) Return(p1 models.Pulse, err error) *StorageMock {
+	if mmGetPulseByPrev.mock.funcGetPulseByPrev != nil {
+		mmGetPulseByPrev.mock.t.Fatalf("StorageMock.GetPulseByPrev mock is already set by Set")
+	}
+
+	if mmGetPulseByPrev.defaultExpectation == nil {
+		mmGetPulseByPrev.defaultExpectation = &StorageMockGetPulseByPrevExpectation{mock: mmGetPulseByPrev.mock}
+	}
+	mmGetPulseByPrev.defaultExpectation.results = &StorageMockGetPulseByPrevResults{p1, err}
+	return mmGetPulseByPrev.mock
+}
+
+//Set uses given function f to mock the Storage.GetPulseByPrev method
+func (mmGetPulseByPrev *mStorageMockGetPulseByPrev) Set(f func(prevPulse models.Pulse) (p1 models.Pulse, err error)) *StorageMock {
+	if mmGetPulseByPrev.defaultExpectation != nil {
+		mmGetPulseByPrev.mock.t.Fatalf("Default expectation is already set for the Storage.GetPulseByPrev method")
+	}
+
+	if len(mmGetPulseByPrev.expectations) > 0 {
+		mmGetPulseByPrev.mock.t.Fatalf("Some expectations are already set for the Storage.GetPulseByPrev method")
+	}
+
+	mmGetPulseByPrev.mock.funcGetPulseByPrev = f
+	return mmGetPulseByPrev.mock
+}
+
+// When sets expectation for the Storage.GetPulseByPrev which will trigger the result defined by the following
+// Then helper
+func (mmGetPulseByPrev *mStorageMockGetPulseByPrev) When(prevPulse models.Pulse) *StorageMockGetPulseByPrevExpectation {
+	if mmGetPulseByPrev.mock.funcGetPulseByPrev != nil {
+		mmGetPulseByPrev.mock.t.Fatalf("StorageMock.GetPulseByPrev mock is already set by Set")
+	}
+
+	expectation := &StorageMockGetPulseByPrevExpectation{
+		mock:   mmGetPulseByPrev.mock,
+		params: &StorageMockGetPulseByPrevParams{prevPulse},
+	}
+	mmGetPulseByPrev.expectations = append(mmGetPulseByPrev.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.GetPulseByPrev return parameters for the expectation previously defined by the When method
+func (e *StorageMockGetPulseByPrevExpectation) Then(p1 models.Pulse, err error) *StorageMock {
+	e.results = &StorageMockGetPulseByPrevResults{p1, err}
+	return e.mock
+}
+
+// GetPulseByPrev implements interfaces.Storage
+func (mmGetPulseByPrev *StorageMock) GetPulseByPrev(prevPulse models.Pulse) (p1 models.Pulse, err error) {
+	mm_atomic.AddUint64(&mmGetPulseByPrev.beforeGetPulseByPrevCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPulseByPrev.afterGetPulseByPrevCounter, 1)
+
+	if mmGetPulseByPrev.inspectFuncGetPulseByPrev != nil {
+		mmGetPulseByPrev.inspectFuncGetPulseByPrev(prevPulse)
+	}
+
+	mm_params := &StorageMockGetPulseByPrevParams{prevPulse}
+
+	// Record call args
+	mmGetPulseByPrev.GetPulseByPrevMock.mutex.Lock()
+	mmGetPulseByPrev.GetPulseByPrevMock.callArgs = append(mmGetPulseByPrev.GetPulseByPrevMock.callArgs, mm_params)
+	mmGetPulseByPrev.GetPulseByPrevMock.mutex.Unlock()
+
+	for _, e := range mmGetPulseByPrev.GetPulseByPrevMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmGetPulseByPrev.GetPulseByPrevMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPulseByPrev.GetPulseByPrevMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPulseByPrev.GetPulseByPrevMock.defaultExpectation.params
+		mm_got := StorageMockGetPulseByPrevParams{prevPulse}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPulseByPrev.t.Errorf("StorageMock.GetPulseByPrev got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPulseByPrev.GetPulseByPrevMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPulseByPrev.t.Fatal("No results are set for the StorageMock.GetPulseByPrev")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmGetPulseByPrev.funcGetPulseByPrev != nil {
+		return mmGetPulseByPrev.funcGetPulseByPrev(prevPulse)
+	}
+	mmGetPulseByPrev.t.Fatalf("Unexpected call to StorageMock.GetPulseByPrev. %v", prevPulse)
+	return
+}
+
+// GetPulseByPrevAfterCounter returns a count of finished StorageMock.GetPulseByPrev invocations
+func (mmGetPulseByPrev *StorageMock) GetPulseByPrevAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPulseByPrev.afterGetPulseByPrevCounter)
+}
+
+// GetPulseByPrevBeforeCounter returns a count of StorageMock.GetPulseByPrev invocations
+func (mmGetPulseByPrev *StorageMock) GetPulseByPrevBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPulseByPrev.beforeGetPulseByPrevCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.GetPulseByPrev.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPulseByPrev *mStorageMockGetPulseByPrev) Calls() []*StorageMockGetPulseByPrevParams {
+	mmGetPulseByPrev.mutex.RLock()
+
+	argCopy := make([]*StorageMockGetPulseByPrevParams, len(mmGetPulseByPrev.callArgs))
+	copy(argCopy, mmGetPulseByPrev.callArgs)
+
+	mmGetPulseByPrev.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPulseByPrevDone returns true if the count of the GetPulseByPrev invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockGetPulseByPrevDone() bool {
+	for _, e := range m.GetPulseByPrevMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPulseByPrevMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetPulseByPrevCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPulseByPrev != nil && mm_atomic.LoadUint64(&m.afterGetPulseByPrevCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetPulseByPrevInspect logs each unmet expectation
+func (m *StorageMock) MinimockGetPulseByPrevInspect() {
+	for _, e := range m.GetPulseByPrevMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.GetPulseByPrev with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPulseByPrevMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetPulseByPrevCounter) < 1 {
+		if m.GetPulseByPrevMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StorageMock.GetPulseByPrev")
+		} else {
+			m.t.Errorf("Expected call to StorageMock.GetPulseByPrev with params: %#v", *m.GetPulseByPrevMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPulseByPrev != nil && mm_atomic.LoadUint64(&m.afterGetPulseByPrevCounter) < 1 {
+		m.t.Error("Expected call to StorageMock.GetPulseByPrev")
+	}
+}
+
+type mStorageMockGetPulseJets struct {
+	mock               *StorageMock
+	defaultExpectation *StorageMockGetPulseJetsExpectation
+	expectations       []*StorageMockGetPulseJetsExpectation
+
+	callArgs []*StorageMockGetPulseJetsParams
+	mutex    sync.RWMutex
+}
+
+// StorageMockGetPulseJetsExpectation specifies expectation struct of the Storage.GetPulseJets
+type StorageMockGetPulseJetsExpectation struct {
+	mock    *StorageMock
+	params  *StorageMockGetPulseJetsParams
+	results *StorageMockGetPulseJetsResults
+	Counter uint64
+}
+
+// StorageMockGetPulseJetsParams contains parameters of the Storage.GetPulseJets
+type StorageMockGetPulseJetsParams struct {
+	pulseNumber int64
+}
+
+// StorageMockGetPulseJetsResults contains results of the Storage.GetPulseJets
+type StorageMockGetPulseJetsResults struct {
+	pa1 []models.PulseJet
+	err error
+}
+
+// Expect sets up expected params for Storage.GetPulseJets
+func (mmGetPulseJets *mStorageMockGetPulseJets) Expect(pulseNumber int64) *mStorageMockGetPulseJets {
+	if mmGetPulseJets.mock.funcGetPulseJets != nil {
+		mmGetPulseJets.mock.t.Fatalf("StorageMock.GetPulseJets mock is already set by Set")
+	}
+
+	if mmGetPulseJets.defaultExpectation == nil {
+		mmGetPulseJets.defaultExpectation = &StorageMockGetPulseJetsExpectation{}
+	}
+
+	mmGetPulseJets.defaultExpectation.params = &StorageMockGetPulseJetsParams{pulseNumber}
+	for _, e := range mmGetPulseJets.expectations {
+		if minimock.Equal(e.params, mmGetPulseJets.defaultExpectation.params) {
+			mmGetPulseJets.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPulseJets.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPulseJets
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.GetPulseJets
+func (mmGetPulseJets *mStorageMockGetPulseJets) Inspect(f func(pulseNumber int64)) *mStorageMockGetPulseJets {
+	if mmGetPulseJets.mock.inspectFuncGetPulseJets != nil {
+		mmGetPulseJets.mock.t.Fatalf("Inspect function is already set for StorageMock.GetPulseJets")
+	}
+
+	mmGetPulseJets.mock.inspectFuncGetPulseJets = f
+
+	return mmGetPulseJets
+}
+
+// Return sets up results that will be returned by Storage.GetPulseJets
+func (mmGetPulseJets *mStorageMockGetPulseJets) Return(pa1 []models.PulseJet, err error) *StorageMock {
+	if mmGetPulseJets.mock.funcGetPulseJets != nil {
+		mmGetPulseJets.mock.t.Fatalf("StorageMock.GetPulseJets mock is already set by Set")
+	}
+
+	if mmGetPulseJets.defaultExpectation == nil {
+		mmGetPulseJets.defaultExpectation = &StorageMockGetPulseJetsExpectation{mock: mmGetPulseJets.mock}
+	}
+	mmGetPulseJets.defaultExpectation.results = &StorageMockGetPulseJetsResults{pa1, err}
+	return mmGetPulseJets.mock
+}
+
+//Set uses given function f to mock the Storage.GetPulseJets method
+func (mmGetPulseJets *mStorageMockGetPulseJets) Set(f func(pulseNumber int64) (pa1 []models.PulseJet, err error)) *StorageMock {
+	if mmGetPulseJets.defaultExpectation != nil {
+		mmGetPulseJets.mock.t.Fatalf("Default expectation is already set for the Storage.GetPulseJets method")
+	}
+
+	if len(mmGetPulseJets.expectations) > 0 {
+		mmGetPulseJets.mock.t.Fatalf("Some expectations are already set for the Storage.GetPulseJets method")
+	}
+
+	mmGetPulseJets.mock.funcGetPulseJets = f
+	return mmGetPulseJets.mock
+}
+
+// When sets expectation for the Storage.GetPulseJets which will trigger the result defined by the following
+// Then helper
+func (mmGetPulseJets *mStorageMockGetPulseJets) When(pulseNumber int64) *StorageMockGetPulseJetsExpectation {
+	if mmGetPulseJets.mock.funcGetPulseJets != nil {
+		mmGetPulseJets.mock.t.Fatalf("StorageMock.GetPulseJets mock is already set by Set")
+	}
+
+	expectation := &StorageMockGetPulseJetsExpectation{
+		mock:   mmGetPulseJets.mock,
+		params: &StorageMockGetPulseJetsParams{pulseNumber},
+	}
+	mmGetPulseJets.expectations = append(mmGetPulseJets.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.GetPulseJets return parameters for the expectation previously defined by the When method
+func (e *StorageMockGetPulseJetsExpectation) Then(pa1 []models.PulseJet, err error) *StorageMock {
+	e.results = &StorageMockGetPulseJetsResults{pa1, err}
+	return e.mock
+}
+
+// GetPulseJets implements interfaces.Storage
+func (mmGetPulseJets *StorageMock) GetPulseJets(pulseNumber int64) (pa1 []models.PulseJet, err error) {
+	mm_atomic.AddUint64(&mmGetPulseJets.beforeGetPulseJetsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPulseJets.afterGetPulseJetsCounter, 1)
+
+	if mmGetPulseJets.inspectFuncGetPulseJets != nil {
+		mmGetPulseJets.inspectFuncGetPulseJets(pulseNumber)
+	}
+
+	mm_params := &StorageMockGetPulseJetsParams{pulseNumber}
+
+	// Record call args
+	mmGetPulseJets.GetPulseJetsMock.mutex.Lock()
+	mmGetPulseJets.GetPulseJetsMock.callArgs = append(mmGetPulseJets.GetPulseJetsMock.callArgs, mm_params)
+	mmGetPulseJets.GetPulseJetsMock.mutex.Unlock()
+
+	for _, e := range mmGetPulseJets.GetPulseJetsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmGetPulseJets.GetPulseJetsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPulseJets.GetPulseJetsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPulseJets.GetPulseJetsMock.defaultExpectation.params
+		mm_got := StorageMockGetPulseJetsParams{pulseNumber}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPulseJets.t.Errorf("StorageMock.GetPulseJets got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPulseJets.GetPulseJetsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPulseJets.t.Fatal("No results are set for the StorageMock.GetPulseJets")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmGetPulseJets.funcGetPulseJets != nil {
+		return mmGetPulseJets.funcGetPulseJets(pulseNumber)
+	}
+	mmGetPulseJets.t.Fatalf("Unexpected call to StorageMock.GetPulseJets. %v", pulseNumber)
+	return
+}
+
+// GetPulseJetsAfterCounter returns a count of finished StorageMock.GetPulseJets invocations
+func (mmGetPulseJets *StorageMock) GetPulseJetsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPulseJets.afterGetPulseJetsCounter)
+}
+
+// GetPulseJetsBeforeCounter returns a count of StorageMock.GetPulseJets invocations
+func (mmGetPulseJets *StorageMock) GetPulseJetsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPulseJets.beforeGetPulseJetsCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.GetPulseJets.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPulseJets *mStorageMockGetPulseJets) Calls() []*StorageMockGetPulseJetsParams {
+	mmGetPulseJets.mutex.RLock()
+
+	argCopy := make([]*StorageMockGetPulseJetsParams, len(mmGetPulseJets.callArgs))
+	copy(argCopy, mmGetPulseJets.callArgs)
+
+	mmGetPulseJets.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPulseJetsDone returns true if the count of the GetPulseJets invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockGetPulseJetsDone() bool {
+	for _, e := range m.GetPulseJetsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPulseJetsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetPulseJetsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPulseJets != nil && mm_atomic.LoadUint64(&m.afterGetPulseJetsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetPulseJetsInspect logs each unmet expectation
+func (m *StorageMock) MinimockGetPulseJetsInspect() {
+	for _, e := range m.GetPulseJetsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.GetPulseJets with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPulseJetsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetPulseJetsCounter) < 1 {
+		if m.GetPulseJetsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StorageMock.GetPulseJets")
+		} else {
+			m.t.Errorf("Expected call to StorageMock.GetPulseJets with params: %#v", *m.GetPulseJetsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPulseJets != nil && mm_atomic.LoadUint64(&m.afterGetPulseJetsCounter) < 1 {
+		m.t.Error("Expected call to StorageMock.GetPulseJets")
+	}
+}
+
+type mStorageMockGetRecordAmountsByPulse struct {
+	mock               *StorageMock
+	defaultExpectation *StorageMockGetRecordAmountsByPulseExpectation
+	expectations       []*StorageMockGetRecordAmountsByPulseExpectation
+
+	callArgs []*StorageMockGetRecordAmountsByPulseParams
+	mutex    sync.RWMutex
+}
+
+// StorageMockGetRecordAmountsByPulseExpectation specifies expectation struct of the Storage.GetRecordAmountsByPulse
+type StorageMockGetRecordAmountsByPulseExpectation struct {
+	mock    *StorageMock
+	params  *StorageMockGetRecordAmountsByPulseParams
+	results *StorageMockGetRecordAmountsByPulseResults
+	Counter uint64
+}
+
+// StorageMockGetRecordAmountsByPulseParams contains parameters of the Storage.GetRecordAmountsByPulse
+type StorageMockGetRecordAmountsByPulseParams struct {
+	pulseNumber      int64
+	prototypes       [][]byte
+	objectReferences [][]byte
+	recordTypes      []string
+}
+
+// StorageMockGetRecordAmountsByPulseResults contains results of the Storage.GetRecordAmountsByPulse
+type StorageMockGetRecordAmountsByPulseResults struct {
+	ja1 []models.JetDropRecordAmount
+	err error
+}
+
+// Expect sets up expected params for Storage.GetRecordAmountsByPulse
+func (mmGetRecordAmountsByPulse *mStorageMockGetRecordAmountsByPulse) Expect(pulseNumber int64, prototypes [][]byte, objectReferences [][]byte, recordTypes []string) *mStorageMockGetRecordAmountsByPulse {
+	if mmGetRecordAmountsByPulse.mock.funcGetRecordAmountsByPulse != nil {
+		mmGetRecordAmountsByPulse.mock.t.Fatalf("StorageMock.GetRecordAmountsByPulse mock is already set by Set")
+	}
+
+	if mmGetRecordAmountsByPulse.defaultExpectation == nil {
+		mmGetRecordAmountsByPulse.defaultExpectation = &StorageMockGetRecordAmountsByPulseExpectation{}
+	}
+
+	mmGetRecordAmountsByPulse.defaultExpectation.params = &StorageMockGetRecordAmountsByPulseParams{pulseNumber, prototypes, objectReferences, recordTypes}
+	for _, e := range mmGetRecordAmountsByPulse.expectations {
+		if minimock.Equal(e.params, mmGetRecordAmountsByPulse.defaultExpectation.params) {
+			mmGetRecordAmountsByPulse.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRecordAmountsByPulse.defaultExpectation.params)
+		}
+	}
+
+	return mmGetRecordAmountsByPulse
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.GetRecordAmountsByPulse
+func (mmGetRecordAmountsByPulse *mStorageMockGetRecordAmountsByPulse) Inspect(f func(pulseNumber int64, prototypes [][]byte, objectReferences [][]byte, recordTypes []string)) *mStorageMockGetRecordAmountsByPulse {
+	if mmGetRecordAmountsByPulse.mock.inspectFuncGetRecordAmountsByPulse != nil {
+		mmGetRecordAmountsByPulse.mock.t.Fatalf("Inspect function is already set for StorageMock.GetRecordAmountsByPulse")
+	}
+
+	mmGetRecordAmountsByPulse.mock.inspectFuncGetRecordAmountsByPulse = f
+
+	return mmGetRecordAmountsByPulse
+}
+
+// Return sets up results that will be returned by Storage.GetRecordAmountsByPulse
+func (mmGetRecordAmountsByPulse *mStorageMockGetRecordAmountsByPulse) Return(ja1 []models.JetDropRecordAmount, err error) *StorageMock {
+	if mmGetRecordAmountsByPulse.mock.funcGetRecordAmountsByPulse != nil {
+		mmGetRecordAmountsByPulse.mock.t.Fatalf("StorageMock.GetRecordAmountsByPulse mock is already set by Set")
+	}
+
+	if mmGetRecordAmountsByPulse.defaultExpectation == nil {
+		mmGetRecordAmountsByPulse.defaultExpectation = &StorageMockGetRecordAmountsByPulseExpectation{mock: mmGetRecordAmountsByPulse.mock}
+	}
+	mmGetRecordAmountsByPulse.defaultExpectation.results = &StorageMockGetRecordAmountsByPulseResults{ja1, err}
+	return mmGetRecordAmountsByPulse.mock
+}
+
+//Set uses given function f to mock the Storage.GetRecordAmountsByPulse method
+func (mmGetRecordAmountsByPulse *mStorageMockGetRecordAmountsByPulse) Set(f func(pulseNumber int64, prototypes [][]byte, objectReferences [][]byte, recordTypes []string) (ja1 []models.JetDropRecordAmount, err error)) *StorageMock {
+	if mmGetRecordAmountsByPulse.defaultExpectation != nil {
+		mmGetRecordAmountsByPulse.mock.t.Fatalf("Default expectation is already set for the Storage.GetRecordAmountsByPulse method")
+	}
+
+	if len(mmGetRecordAmountsByPulse.expectations) > 0 {
+		mmGetRecordAmountsByPulse.mock.t.Fatalf("Some expectations are already set for the Storage.GetRecordAmountsByPulse method")
+	}
+
+	mmGetRecordAmountsByPulse.mock.funcGetRecordAmountsByPulse = f
+	return mmGetRecordAmountsByPulse.mock
+}
+
+// When sets expectation for the Storage.GetRecordAmountsByPulse which will trigger the result defined by the following
+// Then helper
+func (mmGetRecordAmountsByPulse *mStorageMockGetRecordAmountsByPulse) When(pulseNumber int64, prototypes [][]byte, objectReferences [][]byte, recordTypes []string) *StorageMockGetRecordAmountsByPulseExpectation {
+	if mmGetRecordAmountsByPulse.mock.funcGetRecordAmountsByPulse != nil {
+		mmGetRecordAmountsByPulse.mock.t.Fatalf("StorageMock.GetRecordAmountsByPulse mock is already set by Set")
+	}
+
+	expectation := &StorageMockGetRecordAmountsByPulseExpectation{
+		mock:   mmGetRecordAmountsByPulse.mock,
+		params: &StorageMockGetRecordAmountsByPulseParams{pulseNumber, prototypes, objectReferences, recordTypes},
+	}
+	mmGetRecordAmountsByPulse.expectations = append(mmGetRecordAmountsByPulse.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.GetRecordAmountsByPulse return parameters for the expectation previously defined by the When method
+func (e *StorageMockGetRecordAmountsByPulseExpectation) Then(ja1 []models.JetDropRecordAmount, err error) *StorageMock {
+	e.results = &StorageMockGetRecordAmountsByPulseResults{ja1, err}
+	return e.mock
+}
+
+// GetRecordAmountsByPulse implements interfaces.Storage
+func (mmGetRecordAmountsByPulse *StorageMock) GetRecordAmountsByPulse(pulseNumber int64, prototypes [][]byte, objectReferences [][]byte, recordTypes []string) (ja1 []models.JetDropRecordAmount, err error) {
+	mm_atomic.AddUint64(&mmGetRecordAmountsByPulse.beforeGetRecordAmountsByPulseCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRecordAmountsByPulse.afterGetRecordAmountsByPulseCounter, 1)
+
+	if mmGetRecordAmountsByPulse.inspectFuncGetRecordAmountsByPulse != nil {
+		mmGetRecordAmountsByPulse.inspectFuncGetRecordAmountsByPulse(pulseNumber, prototypes, objectReferences, recordTypes)
+	}
+
+	mm_params := &StorageMockGetRecordAmountsByPulseParams{pulseNumber, prototypes, objectReferences, recordTypes}
+
+	// Record call args
+	mmGetRecordAmountsByPulse.GetRecordAmountsByPulseMock.mutex.Lock()
+	mmGetRecordAmountsByPulse.GetRecordAmountsByPulseMock.callArgs = append(mmGetRecordAmountsByPulse.GetRecordAmountsByPulseMock.callArgs, mm_params)
+	mmGetRecordAmountsByPulse.GetRecordAmountsByPulseMock.mutex.Unlock()
+
+	for _, e := range mmGetRecordAmountsByPulse.GetRecordAmountsByPulseMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ja1, e.results.err
+		}
+	}
+
+	if mmGetRecordAmountsByPulse.GetRecordAmountsByPulseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRecordAmountsByPulse.GetRecordAmountsByPulseMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRecordAmountsByPulse.GetRecordAmountsByPulseMock.defaultExpectation.params
+		mm_got := StorageMockGetRecordAmountsByPulseParams{pulseNumber, prototypes, objectReferences, recordTypes}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetRecordAmountsByPulse.t.Errorf("StorageMock.GetRecordAmountsByPulse got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetRecordAmountsByPulse.GetRecordAmountsByPulseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetRecordAmountsByPulse.t.Fatal("No results are set for the StorageMock.GetRecordAmountsByPulse")
+		}
+		return (*mm_results).ja1, (*mm_results).err
+	}
+	if mmGetRecordAmountsByPulse.funcGetRecordAmountsByPulse != nil {
+		return mmGetRecordAmountsByPulse.funcGetRecordAmountsByPulse(pulseNumber, prototypes, objectReferences, recordTypes)
+	}
+	mmGetRecordAmountsByPulse.t.Fatalf("Unexpected call to StorageMock.GetRecordAmountsByPulse. %v %v %v %v", pulseNumber, prototypes, objectReferences, recordTypes)
+	return
+}
+
+// GetRecordAmountsByPulseAfterCounter returns a count of finished StorageMock.GetRecordAmountsByPulse invocations
+func (mmGetRecordAmountsByPulse *StorageMock) GetRecordAmountsByPulseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRecordAmountsByPulse.afterGetRecordAmountsByPulseCounter)
+}
+
+// GetRecordAmountsByPulseBeforeCounter returns a count of StorageMock.GetRecordAmountsByPulse invocations
+func (mmGetRecordAmountsByPulse *StorageMock) GetRecordAmountsByPulseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRecordAmountsByPulse.beforeGetRecordAmountsByPulseCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.GetRecordAmountsByPulse.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetRecordAmountsByPulse *mStorageMockGetRecordAmountsByPulse) Calls() []*StorageMockGetRecordAmountsByPulseParams {
+	mmGetRecordAmountsByPulse.mutex.RLock()
+
+	argCopy := make([]*StorageMockGetRecordAmountsByPulseParams, len(mmGetRecordAmountsByPulse.callArgs))
+	copy(argCopy, mmGetRecordAmountsByPulse.callArgs)
+
+	mmGetRecordAmountsByPulse.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetRecordAmountsByPulseDone returns true if the count of the GetRecordAmountsByPulse invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockGetRecordAmountsByPulseDone() bool {
+	for _, e := range m.GetRecordAmountsByPulseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRecordAmountsByPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetRecordAmountsByPulseCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRecordAmountsByPulse != nil && mm_atomic.LoadUint64(&m.afterGetRecordAmountsByPulseCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetRecordAmountsByPulseInspect logs each unmet expectation
+func (m *StorageMock) MinimockGetRecordAmountsByPulseInspect() {
+	for _, e := range m.GetRecordAmountsByPulseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.GetRecordAmountsByPulse with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRecordAmountsByPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetRecordAmountsByPulseCounter) < 1 {
+		if m.GetRecordAmountsByPulseMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StorageMock.GetRecordAmountsByPulse")
+		} else {
+			m.t.Errorf("Expected call to StorageMock.GetRecordAmountsByPulse with params: %#v", *m.GetRecordAmountsByPulseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRecordAmountsByPulse != nil && mm_atomic.LoadUint64(&m.afterGetRecordAmountsByPulseCounter) < 1 {
+		m.t.Error("Expected call to StorageMock.GetRecordAmountsByPulse")
+	}
+}
+
+type mStorageMockGetRecordsByPulse struct {
+	mock               *StorageMock
+	defaultExpectation *StorageMockGetRecordsByPulseExpectation
+	expectations       []*StorageMockGetRecordsByPulseExpectation
+
+	callArgs []*StorageMockGetRecordsByPulseParams
+	mutex    sync.RWMutex
+}
+
+// StorageMockGetRecordsByPulseExpectation specifies expectation struct of the Storage.GetRecordsByPulse
+type StorageMockGetRecordsByPulseExpectation struct {
+	mock    *StorageMock
+	params  *StorageMockGetRecordsByPulseParams
+	results *StorageMockGetRecordsByPulseResults
+	Counter uint64
+}
+
+// StorageMockGetRecordsByPulseParams contains parameters of the Storage.GetRecordsByPulse
+type StorageMockGetRecordsByPulseParams struct {
+	pulseNumber      int64
+	fromJetID        string
+	fromOrder        int
+	prototypes       [][]byte
+	objectReferences [][]byte
+	recordTypes      []string
+	limit            int
+}
+
+// StorageMockGetRecordsByPulseResults contains results of the Storage.GetRecordsByPulse
+type StorageMockGetRecordsByPulseResults struct {
+	ra1 []models.Record
+	err error
+}
+
+// Expect sets up expected params for Storage.GetRecordsByPulse
+func (mmGetRecordsByPulse *mStorageMockGetRecordsByPulse) Expect(pulseNumber int64, fromJetID string, fromOrder int, prototypes [][]byte, objectReferences [][]byte, recordTypes []string, limit int) *mStorageMockGetRecordsByPulse {
+	if mmGetRecordsByPulse.mock.funcGetRecordsByPulse != nil {
+		mmGetRecordsByPulse.mock.t.Fatalf("StorageMock.GetRecordsByPulse mock is already set by Set")
+	}
+
+	if mmGetRecordsByPulse.defaultExpectation == nil {
+		mmGetRecordsByPulse.defaultExpectation = &StorageMockGetRecordsByPulseExpectation{}
+	}
+
+	mmGetRecordsByPulse.defaultExpectation.params = &StorageMockGetRecordsByPulseParams{pulseNumber, fromJetID, fromOrder, prototypes, objectReferences, recordTypes, limit}
+	for _, e := range mmGetRecordsByPulse.expectations {
+		if minimock.Equal(e.params, mmGetRecordsByPulse.defaultExpectation.params) {
+			mmGetRecordsByPulse.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRecordsByPulse.defaultExpectation.params)
+		}
+	}
+
+	return mmGetRecordsByPulse
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.GetRecordsByPulse
+func (mmGetRecordsByPulse *mStorageMockGetRecordsByPulse) Inspect(f func(pulseNumber int64, fromJetID string, fromOrder int, prototypes [][]byte, objectReferences [][]byte, recordTypes []string, limit int)) *mStorageMockGetRecordsByPulse {
+	if mmGetRecordsByPulse.mock.inspectFuncGetRecordsByPulse != nil {
+		mmGetRecordsByPulse.mock.t.Fatalf("Inspect function is already set for StorageMock.GetRecordsByPulse")
+	}
+
+	mmGetRecordsByPulse.mock.inspectFuncGetRecordsByPulse = f
+
+	return mmGetRecordsByPulse
+}
+
+// Return sets up results that will be returned by Storage.GetRecordsByPulse
+func (mmGetRecordsByPulse *mStorageMockGetRecordsByPulse) Return(ra1 []models.Record, err error) *StorageMock {
+	if mmGetRecordsByPulse.mock.funcGetRecordsByPulse != nil {
+		mmGetRecordsByPulse.mock.t.Fatalf("StorageMock.GetRecordsByPulse mock is already set by Set")
+	}
+
+	if mmGetRecordsByPulse.defaultExpectation == nil {
+		mmGetRecordsByPulse.defaultExpectation = &StorageMockGetRecordsByPulseExpectation{mock: mmGetRecordsByPulse.mock}
+	}
+	mmGetRecordsByPulse.defaultExpectation.results = &StorageMockGetRecordsByPulseResults{ra1, err}
+	return mmGetRecordsByPulse.mock
+}
+
+//Set uses given function f to mock the Storage.GetRecordsByPulse method
+func (mmGetRecordsByPulse *mStorageMockGetRecordsByPulse) Set(f func(pulseNumber int64, fromJetID string, fromOrder int, prototypes [][]byte, objectReferences [][]byte, recordTypes []string, limit int) (ra1 []models.Record, err error)) *StorageMock {
+	if mmGetRecordsByPulse.defaultExpectation != nil {
+		mmGetRecordsByPulse.mock.t.Fatalf("Default expectation is already set for the Storage.GetRecordsByPulse method")
+	}
+
+	if len(mmGetRecordsByPulse.expectations) > 0 {
+		mmGetRecordsByPulse.mock.t.Fatalf("Some expectations are already set for the Storage.GetRecordsByPulse method")
+	}
+
+	mmGetRecordsByPulse.mock.funcGetRecordsByPulse = f
+	return mmGetRecordsByPulse.mock
+}
+
+// When sets expectation for the Storage.GetRecordsByPulse which will trigger the result defined by the following
+// Then helper
+func (mmGetRecordsByPulse *mStorageMockGetRecordsByPulse) When(pulseNumber int64, fromJetID string, fromOrder int, prototypes [][]byte, objectReferences [][]byte, recordTypes []string, limit int) *StorageMockGetRecordsByPulseExpectation {
+	if mmGetRecordsByPulse.mock.funcGetRecordsByPulse != nil {
+		mmGetRecordsByPulse.mock.t.Fatalf("StorageMock.GetRecordsByPulse mock is already set by Set")
+	}
+
+	expectation := &StorageMockGetRecordsByPulseExpectation{
+		mock:   mmGetRecordsByPulse.mock,
+		params: &StorageMockGetRecordsByPulseParams{pulseNumber, fromJetID, fromOrder, prototypes, objectReferences, recordTypes, limit},
+	}
+	mmGetRecordsByPulse.expectations = append(mmGetRecordsByPulse.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.GetRecordsByPulse return parameters for the expectation previously defined by the When method
+func (e *StorageMockGetRecordsByPulseExpectation) Then(ra1 []models.Record, err error) *StorageMock {
+	e.results = &StorageMockGetRecordsByPulseResults{ra1, err}
+	return e.mock
+}
+
+// GetRecordsByPulse implements interfaces.Storage
+func (mmGetRecordsByPulse *StorageMock) GetRecordsByPulse(pulseNumber int64, fromJetID string, fromOrder int, prototypes [][]byte, objectReferences [][]byte, recordTypes []string, limit int) (ra1 []models.Record, err error) {
+	mm_atomic.AddUint64(&mmGetRecordsByPulse.beforeGetRecordsByPulseCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRecordsByPulse.afterGetRecordsByPulseCounter, 1)
+
+	if mmGetRecordsByPulse.inspectFuncGetRecordsByPulse != nil {
+		mmGetRecordsByPulse.inspectFuncGetRecordsByPulse(pulseNumber, fromJetID, fromOrder, prototypes, objectReferences, recordTypes, limit)
+	}
+
+	mm_params := &StorageMockGetRecordsByPulseParams{pulseNumber, fromJetID, fromOrder, prototypes, objectReferences, recordTypes, limit}
+
+	// Record call args
+	mmGetRecordsByPulse.GetRecordsByPulseMock.mutex.Lock()
+	mmGetRecordsByPulse.GetRecordsByPulseMock.callArgs = append(mmGetRecordsByPulse.GetRecordsByPulseMock.callArgs, mm_params)
+	mmGetRecordsByPulse.GetRecordsByPulseMock.mutex.Unlock()
+
+	for _, e := range mmGetRecordsByPulse.GetRecordsByPulseMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmGetRecordsByPulse.GetRecordsByPulseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRecordsByPulse.GetRecordsByPulseMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRecordsByPulse.GetRecordsByPulseMock.defaultExpectation.params
+		mm_got := StorageMockGetRecordsByPulseParams{pulseNumber, fromJetID, fromOrder, prototypes, objectReferences, recordTypes, limit}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetRecordsByPulse.t.Errorf("StorageMock.GetRecordsByPulse got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetRecordsByPulse.GetRecordsByPulseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetRecordsByPulse.t.Fatal("No results are set for the StorageMock.GetRecordsByPulse")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmGetRecordsByPulse.funcGetRecordsByPulse != nil {
+		return mmGetRecordsByPulse.funcGetRecordsByPulse(pulseNumber, fromJetID, fromOrder, prototypes, objectReferences, recordTypes, limit)
+	}
+	mmGetRecordsByPulse.t.Fatalf("Unexpected call to StorageMock.GetRecordsByPulse. %v %v %v %v %v %v %v", pulseNumber, fromJetID, fromOrder, prototypes, objectReferences, recordTypes, limit)
+	return
+}
+
+// GetRecordsByPulseAfterCounter returns a count of finished StorageMock.GetRecordsByPulse invocations
+func (mmGetRecordsByPulse *StorageMock) GetRecordsByPulseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRecordsByPulse.afterGetRecordsByPulseCounter)
+}
+
+// GetRecordsByPulseBeforeCounter returns a count of StorageMock.GetRecordsByPulse invocations
+func (mmGetRecordsByPulse *StorageMock) GetRecordsByPulseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRecordsByPulse.beforeGetRecordsByPulseCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.GetRecordsByPulse.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetRecordsByPulse *mStorageMockGetRecordsByPulse) Calls() []*StorageMockGetRecordsByPulseParams {
+	mmGetRecordsByPulse.mutex.RLock()
+
+	argCopy := make([]*StorageMockGetRecordsByPulseParams, len(mmGetRecordsByPulse.callArgs))
+	copy(argCopy, mmGetRecordsByPulse.callArgs)
+
+	mmGetRecordsByPulse.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetRecordsByPulseDone returns true if the count of the GetRecordsByPulse invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockGetRecordsByPulseDone() bool {
+	for _, e := range m.GetRecordsByPulseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRecordsByPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetRecordsByPulseCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRecordsByPulse != nil && mm_atomic.LoadUint64(&m.afterGetRecordsByPulseCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetRecordsByPulseInspect logs each unmet expectation
+func (m *StorageMock) MinimockGetRecordsByPulseInspect() {
+	for _, e := range m.GetRecordsByPulseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.GetRecordsByPulse with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRecordsByPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetRecordsByPulseCounter) < 1 {
+		if m.GetRecordsByPulseMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StorageMock.GetRecordsByPulse")
+		} else {
+			m.t.Errorf("Expected call to StorageMock.GetRecordsByPulse with params: %#v", *m.GetRecordsByPulseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRecordsByPulse != nil && mm_atomic.LoadUint64(&m.afterGetRecordsByPulseCounter) < 1 {
+		m.t.Error("Expected call to StorageMock.GetRecordsByPulse")
+	}
+}
+
+type mStorageMockGetReloadRanges struct {
+	mock               *StorageMock
+	defaultExpectation *StorageMockGetReloadRangesExpectation
+	expectations       []*StorageMockGetReloadRangesExpectation
+}
+
+// StorageMockGetReloadRangesExpectation specifies expectation struct of the Storage.GetReloadRanges
+type StorageMockGetReloadRangesExpectation struct {
+	mock *StorageMock
+
+	results *StorageMockGetReloadRangesResults
+	Counter uint64
+}
+
+// StorageMockGetReloadRangesResults contains results of the Storage.GetReloadRanges
+type StorageMockGetReloadRangesResults struct {
+	ra1 []models.ReloadRange
+	err error
+}
+
+// Expect sets up expected params for Storage.GetReloadRanges
+func (mmGetReloadRanges *mStorageMockGetReloadRanges) Expect() *mStorageMockGetReloadRanges {
+	if mmGetReloadRanges.mock.funcGetReloadRanges != nil {
+		mmGetReloadRanges.mock.t.Fatalf("StorageMock.GetReloadRanges mock is already set by Set")
+	}
+
+	if mmGetReloadRanges.defaultExpectation == nil {
+		mmGetReloadRanges.defaultExpectation = &StorageMockGetReloadRangesExpectation{}
+	}
+
+	return mmGetReloadRanges
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.GetReloadRanges
+func (mmGetReloadRanges *mStorageMockGetReloadRanges) Inspect(f func()) *mStorageMockGetReloadRanges {
+	if mmGetReloadRanges.mock.inspectFuncGetReloadRanges != nil {
+		mmGetReloadRanges.mock.t.Fatalf("Inspect function is already set for StorageMock.GetReloadRanges")
+	}
+
+	mmGetReloadRanges.mock.inspectFuncGetReloadRanges = f
+
+	return mmGetReloadRanges
+}
+
+// Return sets up results that will be returned by Storage.GetReloadRanges
+func (mmGetReloadRanges *mStorageMockGetReloadRanges) Return(ra1 []models.ReloadRange, err error) *StorageMock {
+	if mmGetReloadRanges.mock.funcGetReloadRanges != nil {
+		mmGetReloadRanges.mock.t.Fatalf("StorageMock.GetReloadRanges mock is already set by Set")
+	}
+
+	if mmGetReloadRanges.defaultExpectation == nil {
+		mmGetReloadRanges.defaultExpectation = &StorageMockGetReloadRangesExpectation{mock: mmGetReloadRanges.mock}
+	}
+	mmGetReloadRanges.defaultExpectation.results = &StorageMockGetReloadRangesResults{ra1, err}
+	return mmGetReloadRanges.mock
+}
+
+//Set uses given function f to mock the Storage.GetReloadRanges method
+func (mmGetReloadRanges *mStorageMockGetReloadRanges) Set(f func() (ra1 []models.ReloadRange, err error)) *StorageMock {
+	if mmGetReloadRanges.defaultExpectation != nil {
+		mmGetReloadRanges.mock.t.Fatalf("Default expectation is already set for the Storage.GetReloadRanges method")
+	}
+
+	if len(mmGetReloadRanges.expectations) > 0 {
+		mmGetReloadRanges.mock.t.Fatalf("Some expectations are already set for the Storage.GetReloadRanges method")
+	}
+
+	mmGetReloadRanges.mock.funcGetReloadRanges = f
+	return mmGetReloadRanges.mock
+}
+
+// GetReloadRanges implements interfaces.Storage
+func (mmGetReloadRanges *StorageMock) GetReloadRanges() (ra1 []models.ReloadRange, err error) {
+	mm_atomic.AddUint64(&mmGetReloadRanges.beforeGetReloadRangesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetReloadRanges.afterGetReloadRangesCounter, 1)
+
+	if mmGetReloadRanges.inspectFuncGetReloadRanges != nil {
+		mmGetReloadRanges.inspectFuncGetReloadRanges()
+	}
+
+	if mmGetReloadRanges.GetReloadRangesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetReloadRanges.GetReloadRangesMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmGetReloadRanges.GetReloadRangesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetReloadRanges.t.Fatal("No results are set for the StorageMock.GetReloadRanges")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmGetReloadRanges.funcGetReloadRanges != nil {
+		return mmGetReloadRanges.funcGetReloadRanges()
+	}
+	mmGetReloadRanges.t.Fatalf("Unexpected call to StorageMock.GetReloadRanges.")
+	return
+}
+
+// GetReloadRangesAfterCounter returns a count of finished StorageMock.GetReloadRanges invocations
+func (mmGetReloadRanges *StorageMock) GetReloadRangesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReloadRanges.afterGetReloadRangesCounter)
+}
+
+// GetReloadRangesBeforeCounter returns a count of StorageMock.GetReloadRanges invocations
+func (mmGetReloadRanges *StorageMock) GetReloadRangesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReloadRanges.beforeGetReloadRangesCounter)
+}
+
+// MinimockGetReloadRangesDone returns true if the count of the GetReloadRanges invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockGetReloadRangesDone() bool {
+	for _, e := range m.GetReloadRangesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetReloadRangesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetReloadRangesCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetReloadRanges != nil && mm_atomic.LoadUint64(&m.afterGetReloadRangesCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetReloadRangesInspect logs each unmet expectation
+func (m *StorageMock) MinimockGetReloadRangesInspect() {
+	for _, e := range m.GetReloadRangesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to StorageMock.GetReloadRanges")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetReloadRangesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetReloadRangesCounter) < 1 {
+		m.t.Error("Expected call to StorageMock.GetReloadRanges")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetReloadRanges != nil && mm_atomic.LoadUint64(&m.afterGetReloadRangesCounter) < 1 {
+		m.t.Error("Expected call to StorageMock.GetReloadRanges")
+	}
+}
+
+type mStorageMockGetSequentialPulse struct {
+	mock               *StorageMock
+	defaultExpectation *StorageMockGetSequentialPulseExpectation
+	expectations       []*StorageMockGetSequentialPulseExpectation
+}
+
+// StorageMockGetSequentialPulseExpectation specifies expectation struct of the Storage.GetSequentialPulse
+type StorageMockGetSequentialPulseExpectation struct {
+	mock *StorageMock
+
+	results *StorageMockGetSequentialPulseResults
+	Counter uint64
+}
+
+// StorageMockGetSequentialPulseResults contains results of the Storage.GetSequentialPulse
+type StorageMockGetSequentialPulseResults struct {
+	p1  models.Pulse
+	err error
+}
+
+// Expect sets up expected params for Storage.GetSequentialPulse
+func (mmGetSequentialPulse *mStorageMockGetSequentialPulse) Expect() *mStorageMockGetSequentialPulse {
+	if mmGetSequentialPulse.mock.funcGetSequentialPulse != nil {
+		mmGetSequentialPulse.mock.t.Fatalf("StorageMock.GetSequentialPulse mock is already set by Set")
+	}
+
+	if mmGetSequentialPulse.defaultExpectation == nil {
+		mmGetSequentialPulse.defaultExpectation = &StorageMockGetSequentialPulseExpectation{}
+	}
+
+	return mmGetSequentialPulse
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.GetSequentialPulse
+func (mmGetSequentialPulse *mStorageMockGetSequentialPulse) Inspect(f func()) *mStorageMockGetSequentialPulse {
+	if mmGetSequentialPulse.mock.inspectFuncGetSequentialPulse != nil {
+		mmGetSequentialPulse.mock.t.Fatalf("Inspect function is already set for StorageMock.GetSequentialPulse")
+	}
+
+	mmGetSequentialPulse.mock.inspectFuncGetSequentialPulse = f
+
+	return mmGetSequentialPulse
+}
+
+// Return sets up results that will be returned by Storage.GetSequentialPulse
+func (mmGetSequentialPulse *mStorageMockGetSequentialPulse) Return(p1 models.Pulse, err error) *StorageMock {
+	if mmGetSequentialPulse.mock.funcGetSequentialPulse != nil {
+		mmGetSequentialPulse.mock.t.Fatalf("StorageMock.GetSequentialPulse mock is already set by Set")
+	}
+
+	if mmGetSequentialPulse.defaultExpectation == nil {
+		mmGetSequentialPulse.defaultExpectation = &StorageMockGetSequentialPulseExpectation{mock: mmGetSequentialPulse.mock}
+	}
+	mmGetSequentialPulse.defaultExpectation.results = &StorageMockGetSequentialPulseResults{p1, err}
+	return mmGetSequentialPulse.mock
+}
+
+//Set uses given function f to mock the Storage.GetSequentialPulse method
+func (mmGetSequentialPulse *mStorageMockGetSequentialPulse) Set(f func() (p1 models.Pulse, err error)) *StorageMock {
+	if mmGetSequentialPulse.defaultExpectation != nil {
+		mmGetSequentialPulse.mock.t.Fatalf("Default expectation is already set for the Storage.GetSequentialPulse method")
+	}
+
+	if len(mmGetSequentialPulse.expectations) > 0 {
+		mmGetSequentialPulse.mock.t.Fatalf("Some expectations are already set for the Storage.GetSequentialPulse method")
+	}
+
+	mmGetSequentialPulse.mock.funcGetSequentialPulse = f
+	return mmGetSequentialPulse.mock
+}
+
+// GetSequentialPulse implements interfaces.Storage
+func (mmGetSequentialPulse *StorageMock) GetSequentialPulse() (p1 models.Pulse, err error) {
+	mm_atomic.AddUint64(&mmGetSequentialPulse.beforeGetSequentialPulseCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetSequentialPulse.afterGetSequentialPulseCounter, 1)
+
+	if mmGetSequentialPulse.inspectFuncGetSequentialPulse != nil {
+		mmGetSequentialPulse.inspectFuncGetSequentialPulse()
+	}
+
+	if mmGetSequentialPulse.GetSequentialPulseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetSequentialPulse.GetSequentialPulseMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmGetSequentialPulse.GetSequentialPulseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetSequentialPulse.t.Fatal("No results are set for the StorageMock.GetSequentialPulse")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmGetSequentialPulse.funcGetSequentialPulse != nil {
+		return mmGetSequentialPulse.funcGetSequentialPulse()
+	}
+	mmGetSequentialPulse.t.Fatalf("Unexpected call to StorageMock.GetSequentialPulse.")
+	return
+}
+
+// GetSequentialPulseAfterCounter returns a count of finished StorageMock.GetSequentialPulse invocations
+func (mmGetSequentialPulse *StorageMock) GetSequentialPulseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSequentialPulse.afterGetSequentialPulseCounter)
+}
+
+// GetSequentialPulseBeforeCounter returns a count of StorageMock.GetSequentialPulse invocations
+func (mmGetSequentialPulse *StorageMock) GetSequentialPulseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSequentialPulse.beforeGetSequentialPulseCounter)
+}
+
+// MinimockGetSequentialPulseDone returns true if the count of the GetSequentialPulse invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockGetSequentialPulseDone() bool {
+	for _, e := range m.GetSequentialPulseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSequentialPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetSequentialPulseCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSequentialPulse != nil && mm_atomic.LoadUint64(&m.afterGetSequentialPulseCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetSequentialPulseInspect logs each unmet expectation
+func (m *StorageMock) MinimockGetSequentialPulseInspect() {
+	for _, e := range m.GetSequentialPulseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to StorageMock.GetSequentialPulse")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSequentialPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetSequentialPulseCounter) < 1 {
+		m.t.Error("Expected call to StorageMock.GetSequentialPulse")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSequentialPulse != nil && mm_atomic.LoadUint64(&m.afterGetSequentialPulseCounter) < 1 {
+		m.t.Error("Expected call to StorageMock.GetSequentialPulse")
+	}
+}
+
+type mStorageMockSaveCheckpoint struct {
+	mock               *StorageMock
+	defaultExpectation *StorageMockSaveCheckpointExpectation
+	expectations       []*StorageMockSaveCheckpointExpectation
+
+	callArgs []*StorageMockSaveCheckpointParams
+	mutex    sync.RWMutex
+}
+
+// StorageMockSaveCheckpointExpectation specifies expectation struct of the Storage.SaveCheckpoint
+type StorageMockSaveCheckpointExpectation struct {
+	mock    *StorageMock
+	params  *StorageMockSaveCheckpointParams
+	results *StorageMockSaveCheckpointResults
+	Counter uint64
+}
+
+// StorageMockSaveCheckpointParams contains parameters of the Storage.SaveCheckpoint
+type StorageMockSaveCheckpointParams struct {
+	checkpoint models.Checkpoint
+}
+
+// StorageMockSaveCheckpointResults contains results of the Storage.SaveCheckpoint
+type StorageMockSaveCheckpointResults struct {
+	err error
+}
+
+// Expect sets up expected params for Storage.SaveCheckpoint
+func (mmSaveCheckpoint *mStorageMockSaveCheckpoint) Expect(checkpoint models.Checkpoint) *mStorageMockSaveCheckpoint {
+	if mmSaveCheckpoint.mock.funcSaveCheckpoint != nil {
+		mmSaveCheckpoint.mock.t.Fatalf("StorageMock.SaveCheckpoint mock is already set by Set")
+	}
+
+	if mmSaveCheckpoint.defaultExpectation == nil {
+		mmSaveCheckpoint.defaultExpectation = &StorageMockSaveCheckpointExpectation{}
+	}
+
+	mmSaveCheckpoint.defaultExpectation.params = &StorageMockSaveCheckpointParams{checkpoint}
+	for _, e := range mmSaveCheckpoint.expectations {
+		if minimock.Equal(e.params, mmSaveCheckpoint.defaultExpectation.params) {
+			mmSaveCheckpoint.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveCheckpoint.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveCheckpoint
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.SaveCheckpoint
+func (mmSaveCheckpoint *mStorageMockSaveCheckpoint) Inspect(f func(checkpoint models.Checkpoint)) *mStorageMockSaveCheckpoint {
+	if mmSaveCheckpoint.mock.inspectFuncSaveCheckpoint != nil {
+		mmSaveCheckpoint.mock.t.Fatalf("Inspect function is already set for StorageMock.SaveCheckpoint")
+	}
+
+	mmSaveCheckpoint.mock.inspectFuncSaveCheckpoint = f
+
+	return mmSaveCheckpoint
+}
+
+// Return sets up results that will be returned by Storage.SaveCheckpoint
+func (mmSaveCheckpoint *mStorageMockSaveCheckpoint) Return(err error) *StorageMock {
+	if mmSaveCheckpoint.mock.funcSaveCheckpoint != nil {
+		mmSaveCheckpoint.mock.t.Fatalf("StorageMock.SaveCheckpoint mock is already set by Set")
+	}
+
+	if mmSaveCheckpoint.defaultExpectation == nil {
+		mmSaveCheckpoint.defaultExpectation = &StorageMockSaveCheckpointExpectation{mock: mmSaveCheckpoint.mock}
+	}
+	mmSaveCheckpoint.defaultExpectation.results = &StorageMockSaveCheckpointResults{err}
+	return mmSaveCheckpoint.mock
+}
+
+//Set uses given function f to mock the Storage.SaveCheckpoint method
+func (mmSaveCheckpoint *mStorageMockSaveCheckpoint) Set(f func(checkpoint models.Checkpoint) (err error)) *StorageMock {
+	if mmSaveCheckpoint.defaultExpectation != nil {
+		mmSaveCheckpoint.mock.t.Fatalf("Default expectation is already set for the Storage.SaveCheckpoint method")
+	}
+
+	if len(mmSaveCheckpoint.expectations) > 0 {
+		mmSaveCheckpoint.mock.t.Fatalf("Some expectations are already set for the Storage.SaveCheckpoint method")
+	}
+
+	mmSaveCheckpoint.mock.funcSaveCheckpoint = f
+	return mmSaveCheckpoint.mock
+}
+
+// When sets expectation for the Storage.SaveCheckpoint which will trigger the result defined by the following
+// Then helper
+func (mmSaveCheckpoint *mStorageMockSaveCheckpoint) When(checkpoint models.Checkpoint) *StorageMockSaveCheckpointExpectation {
+	if mmSaveCheckpoint.mock.funcSaveCheckpoint != nil {
+		mmSaveCheckpoint.mock.t.Fatalf("StorageMock.SaveCheckpoint mock is already set by Set")
+	}
+
+	expectation := &StorageMockSaveCheckpointExpectation{
+		mock:   mmSaveCheckpoint.mock,
+		params: &StorageMockSaveCheckpointParams{checkpoint},
+	}
+	mmSaveCheckpoint.expectations = append(mmSaveCheckpoint.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.SaveCheckpoint return parameters for the expectation previously defined by the When method
+func (e *StorageMockSaveCheckpointExpectation) Then(err error) *StorageMock {
+	e.results = &StorageMockSaveCheckpointResults{err}
+	return e.mock
+}
+
+// SaveCheckpoint implements interfaces.Storage
+func (mmSaveCheckpoint *StorageMock) SaveCheckpoint(checkpoint models.Checkpoint) (err error) {
+	mm_atomic.AddUint64(&mmSaveCheckpoint.beforeSaveCheckpointCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveCheckpoint.afterSaveCheckpointCounter, 1)
+
+	if mmSaveCheckpoint.inspectFuncSaveCheckpoint != nil {
+		mmSaveCheckpoint.inspectFuncSaveCheckpoint(checkpoint)
+	}
+
+	mm_params := &StorageMockSaveCheckpointParams{checkpoint}
+
+	// Record call args
+	mmSaveCheckpoint.SaveCheckpointMock.mutex.Lock()
+	mmSaveCheckpoint.SaveCheckpointMock.callArgs = append(mmSaveCheckpoint.SaveCheckpointMock.callArgs, mm_params)
+	mmSaveCheckpoint.SaveCheckpointMock.mutex.Unlock()
+
+	for _, e := range mmSaveCheckpoint.SaveCheckpointMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveCheckpoint.SaveCheckpointMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveCheckpoint.SaveCheckpointMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveCheckpoint.SaveCheckpointMock.defaultExpectation.params
+		mm_got := StorageMockSaveCheckpointParams{checkpoint}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveCheckpoint.t.Errorf("StorageMock.SaveCheckpoint got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveCheckpoint.SaveCheckpointMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveCheckpoint.t.Fatal("No results are set for the StorageMock.SaveCheckpoint")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveCheckpoint.funcSaveCheckpoint != nil {
+		return mmSaveCheckpoint.funcSaveCheckpoint(checkpoint)
+	}
+	mmSaveCheckpoint.t.Fatalf("Unexpected call to StorageMock.SaveCheckpoint. %v", checkpoint)
+	return
+}
+
+// SaveCheckpointAfterCounter returns a count of finished StorageMock.SaveCheckpoint invocations
+func (mmSaveCheckpoint *StorageMock) SaveCheckpointAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveCheckpoint.afterSaveCheckpointCounter)
+}
+
+// SaveCheckpointBeforeCounter returns a count of StorageMock.SaveCheckpoint invocations
+func (mmSaveCheckpoint *StorageMock) SaveCheckpointBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveCheckpoint.beforeSaveCheckpointCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.SaveCheckpoint.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveCheckpoint *mStorageMockSaveCheckpoint) Calls() []*StorageMockSaveCheckpointParams {
+	mmSaveCheckpoint.mutex.RLock()
+
+	argCopy := make([]*StorageMockSaveCheckpointParams, len(mmSaveCheckpoint.callArgs))
+	copy(argCopy, mmSaveCheckpoint.callArgs)
+
+	mmSaveCheckpoint.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveCheckpointDone returns true if the count of the SaveCheckpoint invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockSaveCheckpointDone() bool {
+	for _, e := range m.SaveCheckpointMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveCheckpointMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveCheckpointCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveCheckpoint != nil && mm_atomic.LoadUint64(&m.afterSaveCheckpointCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSaveCheckpointInspect logs each unmet expectation
+func (m *StorageMock) MinimockSaveCheckpointInspect() {
+	for _, e := range m.SaveCheckpointMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.SaveCheckpoint with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveCheckpointMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveCheckpointCounter) < 1 {
+		if m.SaveCheckpointMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StorageMock.SaveCheckpoint")
+		} else {
+			m.t.Errorf("Expected call to StorageMock.SaveCheckpoint with params: %#v", *m.SaveCheckpointMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveCheckpoint != nil && mm_atomic.LoadUint64(&m.afterSaveCheckpointCounter) < 1 {
+		m.t.Error("Expected call to StorageMock.SaveCheckpoint")
+	}
+}
+
+type mStorageMockSaveJetDropData struct {
+	mock               *StorageMock
+	defaultExpectation *StorageMockSaveJetDropDataExpectation
+	expectations       []*StorageMockSaveJetDropDataExpectation
+
+	callArgs []*StorageMockSaveJetDropDataParams
+	mutex    sync.RWMutex
+}
+
+// StorageMockSaveJetDropDataExpectation specifies expectation struct of the Storage.SaveJetDropData
+type StorageMockSaveJetDropDataExpectation struct {
+	mock    *StorageMock
+	params  *StorageMockSaveJetDropDataParams
+	results *StorageMockSaveJetDropDataResults
+	Counter uint64
+}
+
+// StorageMockSaveJetDropDataParams contains parameters of the Storage.SaveJetDropData
+type StorageMockSaveJetDropDataParams struct {
+	jetDrop     models.JetDrop
+	records     []models.Record
+	pulseNumber int64
+}
+
+// StorageMockSaveJetDropDataResults contains results of the Storage.SaveJetDropData
+type StorageMockSaveJetDropDataResults struct {
+	err error
+}
+
+// Expect sets up expected params for Storage.SaveJetDropData
+func (mmSaveJetDropData *mStorageMockSaveJetDropData) Expect(jetDrop models.JetDrop, records []models.Record, pulseNumber int64) *mStorageMockSaveJetDropData {
+	if mmSaveJetDropData.mock.funcSaveJetDropData != nil {
+		mmSaveJetDropData.mock.t.Fatalf("StorageMock.SaveJetDropData mock is already set by Set")
+	}
+
+	if mmSaveJetDropData.defaultExpectation == nil {
+		mmSaveJetDropData.defaultExpectation = &StorageMockSaveJetDropDataExpectation{}
+	}
+
+	mmSaveJetDropData.defaultExpectation.params = &StorageMockSaveJetDropDataParams{jetDrop, records, pulseNumber}
+	for _, e := range mmSaveJetDropData.expectations {
+		if minimock.Equal(e.params, mmSaveJetDropData.defaultExpectation.params) {
+			mmSaveJetDropData.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveJetDropData.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveJetDropData
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.SaveJetDropData
+func (mmSaveJetDropData *mStorageMockSaveJetDropData) Inspect(f func(jetDrop models.JetDrop, records []models.Record, pulseNumber int64)) *mStorageMockSaveJetDropData {
+	if mmSaveJetDropData.mock.inspectFuncSaveJetDropData != nil {
+		mmSaveJetDropData.mock.t.Fatalf("Inspect function is already set for StorageMock.SaveJetDropData")
+	}
+
+	mmSaveJetDropData.mock.inspectFuncSaveJetDropData = f
+
+	return mmSaveJetDropData
+}
+
+// Return sets up results that will be returned by Storage.SaveJetDropData
+func (mmSaveJetDropData *mStorageMockSaveJetDropData) Return(err error) *StorageMock {
+	if mmSaveJetDropData.mock.funcSaveJetDropData != nil {
+		mmSaveJetDropData.mock.t.Fatalf("StorageMock.SaveJetDropData mock is already set by Set")
+	}
+
+	if mmSaveJetDropData.defaultExpectation == nil {
+		mmSaveJetDropData.defaultExpectation = &StorageMockSaveJetDropDataExpectation{mock: mmSaveJetDropData.mock}
+	}
+	mmSaveJetDropData.defaultExpectation.results = &StorageMockSaveJetDropDataResults{err}
+	return mmSaveJetDropData.mock
+}
+
+//Set uses given function f to mock the Storage.SaveJetDropData method
+func (mmSaveJetDropData *mStorageMockSaveJetDropData) Set(f func(jetDrop models.JetDrop, records []models.Record, pulseNumber int64) (err error)) *StorageMock {
+	if mmSaveJetDropData.defaultExpectation != nil {
+		mmSaveJetDropData.mock.t.Fatalf("Default expectation is already set for the Storage.SaveJetDropData method")
+	}
+
+	if len(mmSaveJetDropData.expectations) > 0 {
+		mmSaveJetDropData.mock.t.Fatalf("Some expectations are already set for the Storage.SaveJetDropData method")
+	}
+
+	mmSaveJetDropData.mock.funcSaveJetDropData = f
+	return mmSaveJetDropData.mock
+}
+
+// When sets expectation for the Storage.SaveJetDropData which will trigger the result defined by the following
+// Then helper
+func (mmSaveJetDropData *mStorageMockSaveJetDropData) When(jetDrop models.JetDrop, records []models.Record, pulseNumber int64) *StorageMockSaveJetDropDataExpectation {
+	if mmSaveJetDropData.mock.funcSaveJetDropData != nil {
+		mmSaveJetDropData.mock.t.Fatalf("StorageMock.SaveJetDropData mock is already set by Set")
+	}
+
+	expectation := &StorageMockSaveJetDropDataExpectation{
+		mock:   mmSaveJetDropData.mock,
+		params: &StorageMockSaveJetDropDataParams{jetDrop, records, pulseNumber},
+	}
+	mmSaveJetDropData.expectations = append(mmSaveJetDropData.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.SaveJetDropData return parameters for the expectation previously defined by the When method
+func (e *StorageMockSaveJetDropDataExpectation) Then(err error) *StorageMock {
+	e.results = &StorageMockSaveJetDropDataResults{err}
+	return e.mock
+}
+
+// SaveJetDropData implements interfaces.Storage
+func (mmSaveJetDropData *StorageMock) SaveJetDropData(jetDrop models.JetDrop, records []models.Record, pulseNumber int64) (err error) {
+	mm_atomic.AddUint64(&mmSaveJetDropData.beforeSaveJetDropDataCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveJetDropData.afterSaveJetDropDataCounter, 1)
+
+	if mmSaveJetDropData.inspectFuncSaveJetDropData != nil {
+		mmSaveJetDropData.inspectFuncSaveJetDropData(jetDrop, records, pulseNumber)
+	}
 
-	callArgs []*StorageMockGetNextSavedPulseParams
+	mm_params := &StorageMockSaveJetDropDataParams{jetDrop, records, pulseNumber}
+
+	// Record call args
+	mmSaveJetDropData.SaveJetDropDataMock.mutex.Lock()
+	mmSaveJetDropData.SaveJetDropDataMock.callArgs = append(mmSaveJetDropData.SaveJetDropDataMock.callArgs, mm_params)
+	mmSaveJetDropData.SaveJetDropDataMock.mutex.Unlock()
+
+	for _, e := range mmSaveJetDropData.SaveJetDropDataMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveJetDropData.SaveJetDropDataMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveJetDropData.SaveJetDropDataMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveJetDropData.SaveJetDropDataMock.defaultExpectation.params
+		mm_got := StorageMockSaveJetDropDataParams{jetDrop, records, pulseNumber}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveJetDropData.t.Errorf("StorageMock.SaveJetDropData got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveJetDropData.SaveJetDropDataMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveJetDropData.t.Fatal("No results are set for the StorageMock.SaveJetDropData")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveJetDropData.funcSaveJetDropData != nil {
+		return mmSaveJetDropData.funcSaveJetDropData(jetDrop, records, pulseNumber)
+	}
+	mmSaveJetDropData.t.Fatalf("Unexpected call to StorageMock.SaveJetDropData. %v %v %v", jetDrop, records, pulseNumber)
+	return
+}
+
+// SaveJetDropDataAfterCounter returns a count of finished StorageMock.SaveJetDropData invocations
+func (mmSaveJetDropData *StorageMock) SaveJetDropDataAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveJetDropData.afterSaveJetDropDataCounter)
+}
+
+// SaveJetDropDataBeforeCounter returns a count of StorageMock.SaveJetDropData invocations
+func (mmSaveJetDropData *StorageMock) SaveJetDropDataBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveJetDropData.beforeSaveJetDropDataCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.SaveJetDropData.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveJetDropData *mStorageMockSaveJetDropData) Calls() []*StorageMockSaveJetDropDataParams {
+	mmSaveJetDropData.mutex.RLock()
+
+	argCopy := make([]*StorageMockSaveJetDropDataParams, len(mmSaveJetDropData.callArgs))
+	copy(argCopy, mmSaveJetDropData.callArgs)
+
+	mmSaveJetDropData.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveJetDropDataDone returns true if the count of the SaveJetDropData invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockSaveJetDropDataDone() bool {
+	for _, e := range m.SaveJetDropDataMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveJetDropDataMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveJetDropDataCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveJetDropData != nil && mm_atomic.LoadUint64(&m.afterSaveJetDropDataCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSaveJetDropDataInspect logs each unmet expectation
+func (m *StorageMock) MinimockSaveJetDropDataInspect() {
+	for _, e := range m.SaveJetDropDataMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.SaveJetDropData with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveJetDropDataMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveJetDropDataCounter) < 1 {
+		if m.SaveJetDropDataMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StorageMock.SaveJetDropData")
+		} else {
+			m.t.Errorf("Expected call to StorageMock.SaveJetDropData with params: %#v", *m.SaveJetDropDataMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveJetDropData != nil && mm_atomic.LoadUint64(&m.afterSaveJetDropDataCounter) < 1 {
+		m.t.Error("Expected call to StorageMock.SaveJetDropData")
+	}
+}
+
+type mStorageMockSaveJetDropsBatch struct {
+	mock               *StorageMock
+	defaultExpectation *StorageMockSaveJetDropsBatchExpectation
+	expectations       []*StorageMockSaveJetDropsBatchExpectation
+
+	callArgs []*StorageMockSaveJetDropsBatchParams
 	mutex    sync.RWMutex
 }
 
-// StorageMockGetNextSavedPulseExpectation specifies expectation struct of the Storage.GetNextSavedPulse
-type StorageMockGetNextSavedPulseExpectation struct {
+// StorageMockSaveJetDropsBatchExpectation specifies expectation struct of the Storage.SaveJetDropsBatch
+type StorageMockSaveJetDropsBatchExpectation struct {
 	mock    *StorageMock
-	params  *StorageMockGetNextSavedPulseParams
-	results *StorageMockGetNextSavedPulseResults
+	params  *StorageMockSaveJetDropsBatchParams
+	results *StorageMockSaveJetDropsBatchResults
 	Counter uint64
 }
 
-// StorageMockGetNextSavedPulseParams contains parameters of the Storage.GetNextSavedPulse
-type StorageMockGetNextSavedPulseParams struct {
-	fromPulseNumber models.Pulse
-	completedOnly   bool
+// StorageMockSaveJetDropsBatchParams contains parameters of the Storage.SaveJetDropsBatch
+type StorageMockSaveJetDropsBatchParams struct {
+	pulse       models.Pulse
+	pulseJetIDs []string
+	jetDrops    []models.JetDropData
 }
 
-// StorageMockGetNextSavedPulseResults contains results of the Storage.GetNextSavedPulse
-type StorageMockGetNextSavedPulseResults struct {
-	p1  models.Pulse
+// StorageMockSaveJetDropsBatchResults contains results of the Storage.SaveJetDropsBatch
+type StorageMockSaveJetDropsBatchResults struct {
 	err error
 }
 
-// Expect sets up expected params for Storage.GetNextSavedPulse
-func (mmGetNextSavedPulse *mStorageMockGetNextSavedPulse) Expect(fromPulseNumber models.Pulse, completedOnly bool) *mStorageMockGetNextSavedPulse {
-	if mmGetNextSavedPulse.mock.funcGetNextSavedPulse != nil {
-		mmGetNextSavedPulse.mock.t.Fatalf("StorageMock.GetNextSavedPulse mock is already set by Set")
+// Expect sets up expected params for Storage.SaveJetDropsBatch
+func (mmSaveJetDropsBatch *mStorageMockSaveJetDropsBatch) Expect(pulse models.Pulse, pulseJetIDs []string, jetDrops []models.JetDropData) *mStorageMockSaveJetDropsBatch {
+	if mmSaveJetDropsBatch.mock.funcSaveJetDropsBatch != nil {
+		mmSaveJetDropsBatch.mock.t.Fatalf("StorageMock.SaveJetDropsBatch mock is already set by Set")
 	}
 
-	if mmGetNextSavedPulse.defaultExpectation == nil {
-		mmGetNextSavedPulse.defaultExpectation = &StorageMockGetNextSavedPulseExpectation{}
+	if mmSaveJetDropsBatch.defaultExpectation == nil {
+		mmSaveJetDropsBatch.defaultExpectation = &StorageMockSaveJetDropsBatchExpectation{}
 	}
 
-	mmGetNextSavedPulse.defaultExpectation.params = &StorageMockGetNextSavedPulseParams{fromPulseNumber, completedOnly}
-	for _, e := range mmGetNextSavedPulse.expectations {
-		if minimock.Equal(e.params, mmGetNextSavedPulse.defaultExpectation.params) {
-			mmGetNextSavedPulse.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetNextSavedPulse.defaultExpectation.params)
+	mmSaveJetDropsBatch.defaultExpectation.params = &StorageMockSaveJetDropsBatchParams{pulse, pulseJetIDs, jetDrops}
+	for _, e := range mmSaveJetDropsBatch.expectations {
+		if minimock.Equal(e.params, mmSaveJetDropsBatch.defaultExpectation.params) {
+			mmSaveJetDropsBatch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveJetDropsBatch.defaultExpectation.params)
 		}
 	}
 
-	return mmGetNextSavedPulse
+	return mmSaveJetDropsBatch
 }
 
-// Inspect accepts an inspector function that has same arguments as the Storage.GetNextSavedPulse
-func (mmGetNextSavedPulse *mStorageMockGetNextSavedPulse) Inspect(f func(fromPulseNumber models.Pulse, completedOnly bool)) *mStorageMockGetNextSavedPulse {
-	if mmGetNextSavedPulse.mock.inspectFuncGetNextSavedPulse != nil {
-		mmGetNextSavedPulse.mock.t.Fatalf("Inspect function is already set for StorageMock.GetNextSavedPulse")
+// Inspect accepts an inspector function that has same arguments as the Storage.SaveJetDropsBatch
+func (mmSaveJetDropsBatch *mStorageMockSaveJetDropsBatch) Inspect(f func(pulse models.Pulse, pulseJetIDs []string, jetDrops []models.JetDropData)) *mStorageMockSaveJetDropsBatch {
+	if mmSaveJetDropsBatch.mock.inspectFuncSaveJetDropsBatch != nil {
+		mmSaveJetDropsBatch.mock.t.Fatalf("Inspect function is already set for StorageMock.SaveJetDropsBatch")
 	}
 
-	mmGetNextSavedPulse.mock.inspectFuncGetNextSavedPulse = f
+	mmSaveJetDropsBatch.mock.inspectFuncSaveJetDropsBatch = f
 
-	return mmGetNextSavedPulse
+	return mmSaveJetDropsBatch
 }
 
-// Return sets up results that will be returned by Storage.GetNextSavedPulse
-func (mmGetNextSavedPulse *mStorageMockGetNextSavedPulse) Return(p1 models.Pulse, err error) *StorageMock {
-	if mmGetNextSavedPulse.mock.funcGetNextSavedPulse != nil {
-		mmGetNextSavedPulse.mock.t.Fatalf("StorageMock.GetNextSavedPulse mock is already set by Set")
+// Return sets up results that will be returned by Storage.SaveJetDropsBatch
+func (mmSaveJetDropsBatch *mStorageMockSaveJetDropsBatch) Return(err error) *StorageMock {
+	if mmSaveJetDropsBatch.mock.funcSaveJetDropsBatch != nil {
+		mmSaveJetDropsBatch.mock.t.Fatalf("StorageMock.SaveJetDropsBatch mock is already set by Set")
 	}
 
-	if mmGetNextSavedPulse.defaultExpectation == nil {
-		mmGetNextSavedPulse.defaultExpectation = &StorageMockGetNextSavedPulseExpectation{mock: mmGetNextSavedPulse.mock}
+	if mmSaveJetDropsBatch.defaultExpectation == nil {
+		mmSaveJetDropsBatch.defaultExpectation = &StorageMockSaveJetDropsBatchExpectation{mock: mmSaveJetDropsBatch.mock}
 	}
-	mmGetNextSavedPulse.defaultExpectation.results = &StorageMockGetNextSavedPulseResults{p1, err}
-	return mmGetNextSavedPulse.mock
+	mmSaveJetDropsBatch.defaultExpectation.results = &StorageMockSaveJetDropsBatchResults{err}
+	return mmSaveJetDropsBatch.mock
 }
 
-//Set uses given function f to mock the Storage.GetNextSavedPulse method
-func (mmGetNextSavedPulse *mStorageMockGetNextSavedPulse) Set(f func(fromPulseNumber models.Pulse, completedOnly bool) (p1 models.Pulse, err error)) *StorageMock {
-	if mmGetNextSavedPulse.defaultExpectation != nil {
-		mmGetNextSavedPulse.mock.t.Fatalf("Default expectation is already set for the Storage.GetNextSavedPulse method")
+//Set uses given function f to mock the Storage.SaveJetDropsBatch method
+func (mmSaveJetDropsBatch *mStorageMockSaveJetDropsBatch) Set(f func(pulse models.Pulse, pulseJetIDs []string, jetDrops []models.JetDropData) (err error)) *StorageMock {
+	if mmSaveJetDropsBatch.defaultExpectation != nil {
+		mmSaveJetDropsBatch.mock.t.Fatalf("Default expectation is already set for the Storage.SaveJetDropsBatch method")
 	}
 
-	if len(mmGetNextSavedPulse.expectations) > 0 {
-		mmGetNextSavedPulse.mock.t.Fatalf("Some expectations are already set for the Storage.GetNextSavedPulse method")
+	if len(mmSaveJetDropsBatch.expectations) > 0 {
+		mmSaveJetDropsBatch.mock.t.Fatalf("Some expectations are already set for the Storage.SaveJetDropsBatch method")
 	}
 
-	mmGetNextSavedPulse.mock.funcGetNextSavedPulse = f
-	return mmGetNextSavedPulse.mock
+	mmSaveJetDropsBatch.mock.funcSaveJetDropsBatch = f
+	return mmSaveJetDropsBatch.mock
 }
 
-// When sets expectation for the Storage.GetNextSavedPulse which will trigger the result defined by the following
+// When sets expectation for the Storage.SaveJetDropsBatch which will trigger the result defined by the following
 // Then helper
-func (mmGetNextSavedPulse *mStorageMockGetNextSavedPulse) When(fromPulseNumber models.Pulse, completedOnly bool) *StorageMockGetNextSavedPulseExpectation {
-	if mmGetNextSavedPulse.mock.funcGetNextSavedPulse != nil {
-		mmGetNextSavedPulse.mock.t.Fatalf("StorageMock.GetNextSavedPulse mock is already set by Set")
+func (mmSaveJetDropsBatch *mStorageMockSaveJetDropsBatch) When(pulse models.Pulse, pulseJetIDs []string, jetDrops []models.JetDropData) *StorageMockSaveJetDropsBatchExpectation {
+	if mmSaveJetDropsBatch.mock.funcSaveJetDropsBatch != nil {
+		mmSaveJetDropsBatch.mock.t.Fatalf("StorageMock.SaveJetDropsBatch mock is already set by Set")
 	}
 
-	expectation := &StorageMockGetNextSavedPulseExpectation{
-		mock:   mmGetNextSavedPulse.mock,
-		params: &StorageMockGetNextSavedPulseParams{fromPulseNumber, completedOnly},
+	expectation := &StorageMockSaveJetDropsBatchExpectation{
+		mock:   mmSaveJetDropsBatch.mock,
+		params: &StorageMockSaveJetDropsBatchParams{pulse, pulseJetIDs, jetDrops},
 	}
-	mmGetNextSavedPulse.expectations = append(mmGetNextSavedPulse.expectations, expectation)
+	mmSaveJetDropsBatch.expectations = append(mmSaveJetDropsBatch.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Storage.GetNextSavedPulse return parameters for the expectation previously defined by the When method
-func (e *StorageMockGetNextSavedPulseExpectation) Then(p1 models.Pulse, err error) *StorageMock {
-	e.results = &StorageMockGetNextSavedPulseResults{p1, err}
+// Then sets up Storage.SaveJetDropsBatch return parameters for the expectation previously defined by the When method
+func (e *StorageMockSaveJetDropsBatchExpectation) Then(err error) *StorageMock {
+	e.results = &StorageMockSaveJetDropsBatchResults{err}
 	return e.mock
 }
 
-// GetNextSavedPulse implements interfaces.Storage
-func (mmGetNextSavedPulse *StorageMock) GetNextSavedPulse(fromPulseNumber models.Pulse, completedOnly bool) (p1 models.Pulse, err error) {
-	mm_atomic.AddUint64(&mmGetNextSavedPulse.beforeGetNextSavedPulseCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetNextSavedPulse.afterGetNextSavedPulseCounter, 1)
+// SaveJetDropsBatch implements interfaces.Storage
+func (mmSaveJetDropsBatch *StorageMock) SaveJetDropsBatch(pulse models.Pulse, pulseJetIDs []string, jetDrops []models.JetDropData) (err error) {
+	mm_atomic.AddUint64(&mmSaveJetDropsBatch.beforeSaveJetDropsBatchCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveJetDropsBatch.afterSaveJetDropsBatchCounter, 1)
 
-	if mmGetNextSavedPulse.inspectFuncGetNextSavedPulse != nil {
-		mmGetNextSavedPulse.inspectFuncGetNextSavedPulse(fromPulseNumber, completedOnly)
+	if mmSaveJetDropsBatch.inspectFuncSaveJetDropsBatch != nil {
+		mmSaveJetDropsBatch.inspectFuncSaveJetDropsBatch(pulse, pulseJetIDs, jetDrops)
 	}
 
-	mm_params := &StorageMockGetNextSavedPulseParams{fromPulseNumber, completedOnly}
+	mm_params := &StorageMockSaveJetDropsBatchParams{pulse, pulseJetIDs, jetDrops}
 
 	// Record call args
-	mmGetNextSavedPulse.GetNextSavedPulseMock.mutex.Lock()
-	mmGetNextSavedPulse.GetNextSavedPulseMock.callArgs = append(mmGetNextSavedPulse.GetNextSavedPulseMock.callArgs, mm_params)
-	mmGetNextSavedPulse.GetNextSavedPulseMock.mutex.Unlock()
+	mmSaveJetDropsBatch.SaveJetDropsBatchMock.mutex.Lock()
+	mmSaveJetDropsBatch.SaveJetDropsBatchMock.callArgs = append(mmSaveJetDropsBatch.SaveJetDropsBatchMock.callArgs, mm_params)
+	mmSaveJetDropsBatch.SaveJetDropsBatchMock.mutex.Unlock()
 
-	for _, e := range mmGetNextSavedPulse.GetNextSavedPulseMock.expectations {
+	for _, e := range mmSaveJetDropsBatch.SaveJetDropsBatchMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.p1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetNextSavedPulse.GetNextSavedPulseMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetNextSavedPulse.GetNextSavedPulseMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetNextSavedPulse.GetNextSavedPulseMock.defaultExpectation.params
-		mm_got := StorageMockGetNextSavedPulseParams{fromPulseNumber, completedOnly}
+	if mmSaveJetDropsBatch.SaveJetDropsBatchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveJetDropsBatch.SaveJetDropsBatchMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveJetDropsBatch.SaveJetDropsBatchMock.defaultExpectation.params
+		mm_got := StorageMockSaveJetDropsBatchParams{pulse, pulseJetIDs, jetDrops}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetNextSavedPulse.t.Errorf("StorageMock.GetNextSavedPulse got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSaveJetDropsBatch.t.Errorf("StorageMock.SaveJetDropsBatch got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetNextSavedPulse.GetNextSavedPulseMock.defaultExpectation.results
+		mm_results := mmSaveJetDropsBatch.SaveJetDropsBatchMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetNextSavedPulse.t.Fatal("No results are set for the StorageMock.GetNextSavedPulse")
+			mmSaveJetDropsBatch.t.Fatal("No results are set for the StorageMock.SaveJetDropsBatch")
 		}
-		return (*mm_results).p1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetNextSavedPulse.funcGetNextSavedPulse != nil {
-		return mmGetNextSavedPulse.funcGetNextSavedPulse(fromPulseNumber, completedOnly)
+	if mmSaveJetDropsBatch.funcSaveJetDropsBatch != nil {
+		return mmSaveJetDropsBatch.funcSaveJetDropsBatch(pulse, pulseJetIDs, jetDrops)
 	}
-	mmGetNextSavedPulse.t.Fatalf("Unexpected call to StorageMock.GetNextSavedPulse. %v %v", fromPulseNumber, completedOnly)
+	mmSaveJetDropsBatch.t.Fatalf("Unexpected call to StorageMock.SaveJetDropsBatch. %v %v %v", pulse, pulseJetIDs, jetDrops)
 	return
 }
 
-// GetNextSavedPulseAfterCounter returns a count of finished StorageMock.GetNextSavedPulse invocations
-func (mmGetNextSavedPulse *StorageMock) GetNextSavedPulseAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetNextSavedPulse.afterGetNextSavedPulseCounter)
+// SaveJetDropsBatchAfterCounter returns a count of finished StorageMock.SaveJetDropsBatch invocations
+func (mmSaveJetDropsBatch *StorageMock) SaveJetDropsBatchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveJetDropsBatch.afterSaveJetDropsBatchCounter)
 }
 
-// GetNextSavedPulseBeforeCounter returns a count of StorageMock.GetNextSavedPulse invocations
-func (mmGetNextSavedPulse *StorageMock) GetNextSavedPulseBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetNextSavedPulse.beforeGetNextSavedPulseCounter)
+// SaveJetDropsBatchBeforeCounter returns a count of StorageMock.SaveJetDropsBatch invocations
+func (mmSaveJetDropsBatch *StorageMock) SaveJetDropsBatchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveJetDropsBatch.beforeSaveJetDropsBatchCounter)
 }
 
-// Calls returns a list of arguments used in each call to StorageMock.GetNextSavedPulse.
+// Calls returns a list of arguments used in each call to StorageMock.SaveJetDropsBatch.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetNextSavedPulse *mStorageMockGetNextSavedPulse) Calls() []*StorageMockGetNextSavedPulseParams {
-	mmGetNextSavedPulse.mutex.RLock()
+func (mmSaveJetDropsBatch *mStorageMockSaveJetDropsBatch) Calls() []*StorageMockSaveJetDropsBatchParams {
+	mmSaveJetDropsBatch.mutex.RLock()
 
-	argCopy := make([]*StorageMockGetNextSavedPulseParams, len(mmGetNextSavedPulse.callArgs))
-	copy(argCopy, mmGetNextSavedPulse.callArgs)
+	argCopy := make([]*StorageMockSaveJetDropsBatchParams, len(mmSaveJetDropsBatch.callArgs))
+	copy(argCopy, mmSaveJetDropsBatch.callArgs)
 
-	mmGetNextSavedPulse.mutex.RUnlock()
+	mmSaveJetDropsBatch.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetNextSavedPulseDone returns true if the count of the GetNextSavedPulse invocations corresponds
+// MinimockSaveJetDropsBatchDone returns true if the count of the SaveJetDropsBatch invocations corresponds
 // the number of defined expectations
-func (m *StorageMock) MinimockGetNextSavedPulseDone() bool {
-	for _, e := range m.GetNextSavedPulseMock.expectations {
+func (m *StorageMock) MinimockSaveJetDropsBatchDone() bool {
+	for _, e := range m.SaveJetDropsBatchMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetNextSavedPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetNextSavedPulseCounter) < 1 {
+	if m.SaveJetDropsBatchMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveJetDropsBatchCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetNextSavedPulse != nil && mm_atomic.LoadUint64(&m.afterGetNextSavedPulseCounter) < 1 {
+	if m.funcSaveJetDropsBatch != nil && mm_atomic.LoadUint64(&m.afterSaveJetDropsBatchCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockGetNextSavedPulseInspect logs each unmet expectation
-func (m *StorageMock) MinimockGetNextSavedPulseInspect() {
-	for _, e := range m.GetNextSavedPulseMock.expectations {
+// MinimockSaveJetDropsBatchInspect logs each unmet expectation
+func (m *StorageMock) MinimockSaveJetDropsBatchInspect() {
+	for _, e := range m.SaveJetDropsBatchMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StorageMock.GetNextSavedPulse with params: %#v", *e.params)
+			m.t.Errorf("Expected call to StorageMock.SaveJetDropsBatch with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetNextSavedPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetNextSavedPulseCounter) < 1 {
-		if m.GetNextSavedPulseMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to StorageMock.GetNextSavedPulse")
+	if m.SaveJetDropsBatchMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveJetDropsBatchCounter) < 1 {
+		if m.SaveJetDropsBatchMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StorageMock.SaveJetDropsBatch")
 		} else {
-			m.t.Errorf("Expected call to StorageMock.GetNextSavedPulse with params: %#v", *m.GetNextSavedPulseMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StorageMock.SaveJetDropsBatch with params: %#v", *m.SaveJetDropsBatchMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetNextSavedPulse != nil && mm_atomic.LoadUint64(&m.afterGetNextSavedPulseCounter) < 1 {
-		m.t.Error("Expected call to StorageMock.GetNextSavedPulse")
+	if m.funcSaveJetDropsBatch != nil && mm_atomic.LoadUint64(&m.afterSaveJetDropsBatchCounter) < 1 {
+		m.t.Error("Expected call to StorageMock.SaveJetDropsBatch")
 	}
 }
 
-type mStorageMockGetPulseByPrev struct {
+type mStorageMockSavePulse struct {
 	mock               *StorageMock
-	defaultExpectation *StorageMockGetPulseByPrevExpectation
-	expectations       []*StorageMockGetPulseByPrevExpectation
+	defaultExpectation *StorageMockSavePulseExpectation
+	expectations       []*StorageMockSavePulseExpectation
 
-	callArgs []*StorageMockGetPulseByPrevParams
+	callArgs []*StorageMockSavePulseParams
 	mutex    sync.RWMutex
 }
 
-// StorageMockGetPulseByPrevExpectation specifies expectation struct of the Storage.GetPulseByPrev
-type StorageMockGetPulseByPrevExpectation struct {
+// StorageMockSavePulseExpectation specifies expectation struct of the Storage.SavePulse
+type StorageMockSavePulseExpectation struct {
 	mock    *StorageMock
-	params  *StorageMockGetPulseByPrevParams
-	results *StorageMockGetPulseByPrevResults
+	params  *StorageMockSavePulseParams
+	results *StorageMockSavePulseResults
 	Counter uint64
 }
 
-// StorageMockGetPulseByPrevParams contains parameters of the Storage.GetPulseByPrev
-type StorageMockGetPulseByPrevParams struct {
-	prevPulse models.Pulse
+// StorageMockSavePulseParams contains parameters of the Storage.SavePulse
+type StorageMockSavePulseParams struct {
+	pulse models.Pulse
 }
 
-// StorageMockGetPulseByPrevResults contains results of the Storage.GetPulseByPrev
-type StorageMockGetPulseByPrevResults struct {
-	p1  models.Pulse
+// StorageMockSavePulseResults contains results of the Storage.SavePulse
+type StorageMockSavePulseResults struct {
 	err error
 }
 
-// Expect sets up expected params for Storage.GetPulseByPrev
-func (mmGetPulseByPrev *mStorageMockGetPulseByPrev) Expect(prevPulse models.Pulse) *mStorageMockGetPulseByPrev {
-	if mmGetPulseByPrev.mock.funcGetPulseByPrev != nil {
-		mmGetPulseByPrev.mock.t.Fatalf("StorageMock.GetPulseByPrev mock is already set by Set")
+// Expect sets up expected params for Storage.SavePulse
+func (mmSavePulse *mStorageMockSavePulse) Expect(pulse models.Pulse) *mStorageMockSavePulse {
+	if mmSavePulse.mock.funcSavePulse != nil {
+		mmSavePulse.mock.t.Fatalf("StorageMock.SavePulse mock is already set by Set")
 	}
 
-	if mmGetPulseByPrev.defaultExpectation == nil {
-		mmGetPulseByPrev.defaultExpectation = &StorageMockGetPulseByPrevExpectation{}
+	if mmSavePulse.defaultExpectation == nil {
+		mmSavePulse.defaultExpectation = &StorageMockSavePulseExpectation{}
 	}
 
-	mmGetPulseByPrev.defaultExpectation.params = &StorageMockGetPulseByPrevParams{prevPulse}
-	for _, e := range mmGetPulseByPrev.expectations {
-		if minimock.Equal(e.params, mmGetPulseByPrev.defaultExpectation.params) {
-			mmGetPulseByPrev.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPulseByPrev.defaultExpectation.params)
+	mmSavePulse.defaultExpectation.params = &StorageMockSavePulseParams{pulse}
+	for _, e := range mmSavePulse.expectations {
+		if minimock.Equal(e.params, mmSavePulse.defaultExpectation.params) {
+			mmSavePulse.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSavePulse.defaultExpectation.params)
 		}
 	}
 
-	return mmGetPulseByPrev
+	return mmSavePulse
 }
 
-// Inspect accepts an inspector function that has same arguments as the Storage.GetPulseByPrev
-func (mmGetPulseByPrev *mStorageMockGetPulseByPrev) Inspect(f func(prevPulse models.Pulse)) *mStorageMockGetPulseByPrev {
-	if mmGetPulseByPrev.mock.inspectFuncGetPulseByPrev != nil {
-		mmGetPulseByPrev.mock.t.Fatalf("Inspect function is already set for StorageMock.GetPulseByPrev")
+// Inspect accepts an inspector function that has same arguments as the Storage.SavePulse
+func (mmSavePulse *mStorageMockSavePulse) Inspect(f func(pulse models.Pulse)) *mStorageMockSavePulse {
+	if mmSavePulse.mock.inspectFuncSavePulse != nil {
+		mmSavePulse.mock.t.Fatalf("Inspect function is already set for StorageMock.SavePulse")
 	}
 
-	mmGetPulseByPrev.mock.inspectFuncGetPulseByPrev = f
+	mmSavePulse.mock.inspectFuncSavePulse = f
 
-	return mmGetPulseByPrev
+	return mmSavePulse
 }
 
-// Return sets up results that will be returned by Storage.GetPulseByPrev
-func (mmGetPulseByPrev *mStorageMockGetPulseByPrev) Return(p1 models.Pulse, err error) *StorageMock {
-	if mmGetPulseByPrev.mock.funcGetPulseByPrev != nil {
-		mmGetPulseByPrev.mock.t.Fatalf("StorageMock.GetPulseByPrev mock is already set by Set")
+// Return sets up results that will be returned by Storage.SavePulse
+func (mmSavePulse *mStorageMockSavePulse) Return(err error) *StorageMock {
+	if mmSavePulse.mock.funcSavePulse != nil {
+		mmSavePulse.mock.t.Fatalf("StorageMock.SavePulse mock is already set by Set")
 	}
 
-	if mmGetPulseByPrev.defaultExpectation == nil {
-		mmGetPulseByPrev.defaultExpectation = &StorageMockGetPulseByPrevExpectation{mock: mmGetPulseByPrev.mock}
+	if mmSavePulse.defaultExpectation == nil {
+		mmSavePulse.defaultExpectation = &StorageMockSavePulseExpectation{mock: mmSavePulse.mock}
 	}
-	mmGetPulseByPrev.defaultExpectation.results = &StorageMockGetPulseByPrevResults{p1, err}
-	return mmGetPulseByPrev.mock
+	mmSavePulse.defaultExpectation.results = &StorageMockSavePulseResults{err}
+	return mmSavePulse.mock
 }
 
-//Set uses given function f to mock the Storage.GetPulseByPrev method
-func (mmGetPulseByPrev *mStorageMockGetPulseByPrev) Set(f func(prevPulse models.Pulse) (p1 models.Pulse, err error)) *StorageMock {
-	if mmGetPulseByPrev.defaultExpectation != nil {
-		mmGetPulseByPrev.mock.t.Fatalf("Default expectation is already set for the Storage.GetPulseByPrev method")
+//Set uses given function f to mock the Storage.SavePulse method
+func (mmSavePulse *mStorageMockSavePulse) Set(f func(pulse models.Pulse) (err error)) *StorageMock {
+	if mmSavePulse.defaultExpectation != nil {
+		mmSavePulse.mock.t.Fatalf("Default expectation is already set for the Storage.SavePulse method")
 	}
 
-	if len(mmGetPulseByPrev.expectations) > 0 {
-		mmGetPulseByPrev.mock.t.Fatalf("Some expectations are already set for the Storage.GetPulseByPrev method")
+	if len(mmSavePulse.expectations) > 0 {
+		mmSavePulse.mock.t.Fatalf("Some expectations are already set for the Storage.SavePulse method")
 	}
 
-	mmGetPulseByPrev.mock.funcGetPulseByPrev = f
-	return mmGetPulseByPrev.mock
+	mmSavePulse.mock.funcSavePulse = f
+	return mmSavePulse.mock
 }
 
-// When sets expectation for the Storage.GetPulseByPrev which will trigger the result defined by the following
+// When sets expectation for the Storage.SavePulse which will trigger the result defined by the following
 // Then helper
-func (mmGetPulseByPrev *mStorageMockGetPulseByPrev) When(prevPulse models.Pulse) *StorageMockGetPulseByPrevExpectation {
-	if mmGetPulseByPrev.mock.funcGetPulseByPrev != nil {
-		mmGetPulseByPrev.mock.t.Fatalf("StorageMock.GetPulseByPrev mock is already set by Set")
+func (mmSavePulse *mStorageMockSavePulse) When(pulse models.Pulse) *StorageMockSavePulseExpectation {
+	if mmSavePulse.mock.funcSavePulse != nil {
+		mmSavePulse.mock.t.Fatalf("StorageMock.SavePulse mock is already set by Set")
 	}
 
-	expectation := &StorageMockGetPulseByPrevExpectation{
-		mock:   mmGetPulseByPrev.mock,
-		params: &StorageMockGetPulseByPrevParams{prevPulse},
+	expectation := &StorageMockSavePulseExpectation{
+		mock:   mmSavePulse.mock,
+		params: &StorageMockSavePulseParams{pulse},
 	}
-	mmGetPulseByPrev.expectations = append(mmGetPulseByPrev.expectations, expectation)
+	mmSavePulse.expectations = append(mmSavePulse.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Storage.GetPulseByPrev return parameters for the expectation previously defined by the When method
-func (e *StorageMockGetPulseByPrevExpectation) Then(p1 models.Pulse, err error) *StorageMock {
-	e.results = &StorageMockGetPulseByPrevResults{p1, err}
+// Then sets up Storage.SavePulse return parameters for the expectation previously defined by the When method
+func (e *StorageMockSavePulseExpectation) Then(err error) *StorageMock {
+	e.results = &StorageMockSavePulseResults{err}
 	return e.mock
 }
 
-// GetPulseByPrev implements interfaces.Storage
-func (mmGetPulseByPrev *StorageMock) GetPulseByPrev(prevPulse models.Pulse) (p1 models.Pulse, err error) {
-	mm_atomic.AddUint64(&mmGetPulseByPrev.beforeGetPulseByPrevCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetPulseByPrev.afterGetPulseByPrevCounter, 1)
+// SavePulse implements interfaces.Storage
+func (mmSavePulse *StorageMock) SavePulse(pulse models.Pulse) (err error) {
+	mm_atomic.AddUint64(&mmSavePulse.beforeSavePulseCounter, 1)
+	defer mm_atomic.AddUint64(&mmSavePulse.afterSavePulseCounter, 1)
 
-	if mmGetPulseByPrev.inspectFuncGetPulseByPrev != nil {
-		mmGetPulseByPrev.inspectFuncGetPulseByPrev(prevPulse)
+	if mmSavePulse.inspectFuncSavePulse != nil {
+		mmSavePulse.inspectFuncSavePulse(pulse)
 	}
 
-	mm_params := &StorageMockGetPulseByPrevParams{prevPulse}
+	mm_params := &StorageMockSavePulseParams{pulse}
 
 	// Record call args
-	mmGetPulseByPrev.GetPulseByPrevMock.mutex.Lock()
-	mmGetPulseByPrev.GetPulseByPrevMock.callArgs = append(mmGetPulseByPrev.GetPulseByPrevMock.callArgs, mm_params)
-	mmGetPulseByPrev.GetPulseByPrevMock.mutex.Unlock()
+	mmSavePulse.SavePulseMock.mutex.Lock()
+	mmSavePulse.SavePulseMock.callArgs = append(mmSavePulse.SavePulseMock.callArgs, mm_params)
+	mmSavePulse.SavePulseMock.mutex.Unlock()
 
-	for _, e := range mmGetPulseByPrev.GetPulseByPrevMock.expectations {
+	for _, e := range mmSavePulse.SavePulseMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.p1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetPulseByPrev.GetPulseByPrevMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetPulseByPrev.GetPulseByPrevMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetPulseByPrev.GetPulseByPrevMock.defaultExpectation.params
-		mm_got := StorageMockGetPulseByPrevParams{prevPulse}
+	if mmSavePulse.SavePulseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSavePulse.SavePulseMock.defaultExpectation.Counter, 1)
+		mm_want := mmSavePulse.SavePulseMock.defaultExpectation.params
+		mm_got := StorageMockSavePulseParams{pulse}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetPulseByPrev.t.Errorf("StorageMock.GetPulseByPrev got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSavePulse.t.Errorf("StorageMock.SavePulse got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetPulseByPrev.GetPulseByPrevMock.defaultExpectation.results
+		mm_results := mmSavePulse.SavePulseMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetPulseByPrev.t.Fatal("No results are set for the StorageMock.GetPulseByPrev")
+			mmSavePulse.t.Fatal("No results are set for the StorageMock.SavePulse")
 		}
-		return (*mm_results).p1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetPulseByPrev.funcGetPulseByPrev != nil {
-		return mmGetPulseByPrev.funcGetPulseByPrev(prevPulse)
+	if mmSavePulse.funcSavePulse != nil {
+		return mmSavePulse.funcSavePulse(pulse)
 	}
-	mmGetPulseByPrev.t.Fatalf("Unexpected call to StorageMock.GetPulseByPrev. %v", prevPulse)
+	mmSavePulse.t.Fatalf("Unexpected call to StorageMock.SavePulse. %v", pulse)
 	return
 }
 
-// GetPulseByPrevAfterCounter returns a count of finished StorageMock.GetPulseByPrev invocations
-func (mmGetPulseByPrev *StorageMock) GetPulseByPrevAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetPulseByPrev.afterGetPulseByPrevCounter)
+// SavePulseAfterCounter returns a count of finished StorageMock.SavePulse invocations
+func (mmSavePulse *StorageMock) SavePulseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSavePulse.afterSavePulseCounter)
 }
 
-// GetPulseByPrevBeforeCounter returns a count of StorageMock.GetPulseByPrev invocations
-func (mmGetPulseByPrev *StorageMock) GetPulseByPrevBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetPulseByPrev.beforeGetPulseByPrevCounter)
+// SavePulseBeforeCounter returns a count of StorageMock.SavePulse invocations
+func (mmSavePulse *StorageMock) SavePulseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSavePulse.beforeSavePulseCounter)
 }
 
-// Calls returns a list of arguments used in each call to StorageMock.GetPulseByPrev.
+// Calls returns a list of arguments used in each call to StorageMock.SavePulse.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetPulseByPrev *mStorageMockGetPulseByPrev) Calls() []*StorageMockGetPulseByPrevParams {
-	mmGetPulseByPrev.mutex.RLock()
+func (mmSavePulse *mStorageMockSavePulse) Calls() []*StorageMockSavePulseParams {
+	mmSavePulse.mutex.RLock()
 
-	argCopy := make([]*StorageMockGetPulseByPrevParams, len(mmGetPulseByPrev.callArgs))
-	copy(argCopy, mmGetPulseByPrev.callArgs)
+	argCopy := make([]*StorageMockSavePulseParams, len(mmSavePulse.callArgs))
+	copy(argCopy, mmSavePulse.callArgs)
 
-	mmGetPulseByPrev.mutex.RUnlock()
+	mmSavePulse.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetPulseByPrevDone returns true if the count of the GetPulseByPrev invocations corresponds
+// MinimockSavePulseDone returns true if the count of the SavePulse invocations corresponds
 // the number of defined expectations
-func (m *StorageMock) MinimockGetPulseByPrevDone() bool {
-	for _, e := range m.GetPulseByPrevMock.expectations {
+func (m *StorageMock) MinimockSavePulseDone() bool {
+	for _, e := range m.SavePulseMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetPulseByPrevMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetPulseByPrevCounter) < 1 {
+	if m.SavePulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSavePulseCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetPulseByPrev != nil && mm_atomic.LoadUint64(&m.afterGetPulseByPrevCounter) < 1 {
+	if m.funcSavePulse != nil && mm_atomic.LoadUint64(&m.afterSavePulseCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockGetPulseByPrevInspect logs each unmet expectation
-func (m *StorageMock) MinimockGetPulseByPrevInspect() {
-	for _, e := range m.GetPulseByPrevMock.expectations {
+// MinimockSavePulseInspect logs each unmet expectation
+func (m *StorageMock) MinimockSavePulseInspect() {
+	for _, e := range m.SavePulseMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StorageMock.GetPulseByPrev with params: %#v", *e.params)
+			m.t.Errorf("Expected call to StorageMock.SavePulse with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetPulseByPrevMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetPulseByPrevCounter) < 1 {
-		if m.GetPulseByPrevMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to StorageMock.GetPulseByPrev")
+	if m.SavePulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSavePulseCounter) < 1 {
+		if m.SavePulseMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StorageMock.SavePulse")
 		} else {
-			m.t.Errorf("Expected call to StorageMock.GetPulseByPrev with params: %#v", *m.GetPulseByPrevMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StorageMock.SavePulse with params: %#v", *m.SavePulseMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetPulseByPrev != nil && mm_atomic.LoadUint64(&m.afterGetPulseByPrevCounter) < 1 {
-		m.t.Error("Expected call to StorageMock.GetPulseByPrev")
+	if m.funcSavePulse != nil && mm_atomic.LoadUint64(&m.afterSavePulseCounter) < 1 {
+		m.t.Error("Expected call to StorageMock.SavePulse")
 	}
 }
 
-type mStorageMockGetSequentialPulse struct {
+type mStorageMockSavePulseJets struct {
 	mock               *StorageMock
-	defaultExpectation *StorageMockGetSequentialPulseExpectation
-	expectations       []*StorageMockGetSequentialPulseExpectation
-}
+	defaultExpectation *StorageMockSavePulseJetsExpectation
+	expectations       []*StorageMockSavePulseJetsExpectation
 
-// StorageMockGetSequentialPulseExpectation specifies expectation struct of the Storage.GetSequentialPulse
-type StorageMockGetSequentialPulseExpectation struct {
-	mock *StorageMock
+	callArgs []*StorageMockSavePulseJetsParams
+	mutex    sync.RWMutex
+}
 
-	results *StorageMockGetSequentialPulseResults
+// StorageMockSavePulseJetsExpectation specifies expectation struct of the Storage.SavePulseJets
+type StorageMockSavePulseJetsExpectation struct {
+	mock    *StorageMock
+	params  *StorageMockSavePulseJetsParams
+	results *StorageMockSavePulseJetsResults
 	Counter uint64
 }
 
-// StorageMockGetSequentialPulseResults contains results of the Storage.GetSequentialPulse
-type StorageMockGetSequentialPulseResults struct {
-	p1  models.Pulse
+// StorageMockSavePulseJetsParams contains parameters of the Storage.SavePulseJets
+type StorageMockSavePulseJetsParams struct {
+	pulseNumber int64
+	jetIDs      []string
+}
+
+// StorageMockSavePulseJetsResults contains results of the Storage.SavePulseJets
+type StorageMockSavePulseJetsResults struct {
 	err error
 }
 
-// Expect sets up expected params for Storage.GetSequentialPulse
-func (mmGetSequentialPulse *mStorageMockGetSequentialPulse) Expect() *mStorageMockGetSequentialPulse {
-	if mmGetSequentialPulse.mock.funcGetSequentialPulse != nil {
-		mmGetSequentialPulse.mock.t.Fatalf("StorageMock.GetSequentialPulse mock is already set by Set")
+// Expect sets up expected params for Storage.SavePulseJets
+func (mmSavePulseJets *mStorageMockSavePulseJets) Expect(pulseNumber int64, jetIDs []string) *mStorageMockSavePulseJets {
+	if mmSavePulseJets.mock.funcSavePulseJets != nil {
+		mmSavePulseJets.mock.t.Fatalf("StorageMock.SavePulseJets mock is already set by Set")
 	}
 
-	if mmGetSequentialPulse.defaultExpectation == nil {
-		mmGetSequentialPulse.defaultExpectation = &StorageMockGetSequentialPulseExpectation{}
+	if mmSavePulseJets.defaultExpectation == nil {
+		mmSavePulseJets.defaultExpectation = &StorageMockSavePulseJetsExpectation{}
 	}
 
-	return mmGetSequentialPulse
+	mmSavePulseJets.defaultExpectation.params = &StorageMockSavePulseJetsParams{pulseNumber, jetIDs}
+	for _, e := range mmSavePulseJets.expectations {
+		if minimock.Equal(e.params, mmSavePulseJets.defaultExpectation.params) {
+			mmSavePulseJets.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSavePulseJets.defaultExpectation.params)
+		}
+	}
+
+	return mmSavePulseJets
 }
 
-// Inspect accepts an inspector function that has same arguments as the Storage.GetSequentialPulse
-func (mmGetSequentialPulse *mStorageMockGetSequentialPulse) Inspect(f func()) *mStorageMockGetSequentialPulse {
-	if mmGetSequentialPulse.mock.inspectFuncGetSequentialPulse != nil {
-		mmGetSequentialPulse.mock.t.Fatalf("Inspect function is already set for StorageMock.GetSequentialPulse")
+// Inspect accepts an inspector function that has same arguments as the Storage.SavePulseJets
+func (mmSavePulseJets *mStorageMockSavePulseJets) Inspect(f func(pulseNumber int64, jetIDs []string)) *mStorageMockSavePulseJets {
+	if mmSavePulseJets.mock.inspectFuncSavePulseJets != nil {
+		mmSavePulseJets.mock.t.Fatalf("Inspect function is already set for StorageMock.SavePulseJets")
 	}
 
-	mmGetSequentialPulse.mock.inspectFuncGetSequentialPulse = f
+	mmSavePulseJets.mock.inspectFuncSavePulseJets = f
 
-	return mmGetSequentialPulse
+	return mmSavePulseJets
 }
 
-// Return sets up results that will be returned by Storage.GetSequentialPulse
-func (mmGetSequentialPulse *mStorageMockGetSequentialPulse) Return(p1 models.Pulse, err error) *StorageMock {
-	if mmGetSequentialPulse.mock.funcGetSequentialPulse != nil {
-		mmGetSequentialPulse.mock.t.Fatalf("StorageMock.GetSequentialPulse mock is already set by Set")
+// Return sets up results that will be returned by Storage.SavePulseJets
+func (mmSavePulseJets *mStorageMockSavePulseJets) Return(err error) *StorageMock {
+	if mmSavePulseJets.mock.funcSavePulseJets != nil {
+		mmSavePulseJets.mock.t.Fatalf("StorageMock.SavePulseJets mock is already set by Set")
 	}
 
-	if mmGetSequentialPulse.defaultExpectation == nil {
-		mmGetSequentialPulse.defaultExpectation = &StorageMockGetSequentialPulseExpectation{mock: mmGetSequentialPulse.mock}
+	if mmSavePulseJets.defaultExpectation == nil {
+		mmSavePulseJets.defaultExpectation = &StorageMockSavePulseJetsExpectation{mock: mmSavePulseJets.mock}
 	}
-	mmGetSequentialPulse.defaultExpectation.results = &StorageMockGetSequentialPulseResults{p1, err}
-	return mmGetSequentialPulse.mock
+	mmSavePulseJets.defaultExpectation.results = &StorageMockSavePulseJetsResults{err}
+	return mmSavePulseJets.mock
 }
 
-//Set uses given function f to mock the Storage.GetSequentialPulse method
-func (mmGetSequentialPulse *mStorageMockGetSequentialPulse) Set(f func() (p1 models.Pulse, err error)) *StorageMock {
-	if mmGetSequentialPulse.defaultExpectation != nil {
-		mmGetSequentialPulse.mock.t.Fatalf("Default expectation is already set for the Storage.GetSequentialPulse method")
+//Set uses given function f to mock the Storage.SavePulseJets method
+func (mmSavePulseJets *mStorageMockSavePulseJets) Set(f func(pulseNumber int64, jetIDs []string) (err error)) *StorageMock {
+	if mmSavePulseJets.defaultExpectation != nil {
+		mmSavePulseJets.mock.t.Fatalf("Default expectation is already set for the Storage.SavePulseJets method")
 	}
 
-	if len(mmGetSequentialPulse.expectations) > 0 {
-		mmGetSequentialPulse.mock.t.Fatalf("Some expectations are already set for the Storage.GetSequentialPulse method")
+	if len(mmSavePulseJets.expectations) > 0 {
+		mmSavePulseJets.mock.t.Fatalf("Some expectations are already set for the Storage.SavePulseJets method")
 	}
 
-	mmGetSequentialPulse.mock.funcGetSequentialPulse = f
-	return mmGetSequentialPulse.mock
+	mmSavePulseJets.mock.funcSavePulseJets = f
+	return mmSavePulseJets.mock
 }
 
-// GetSequentialPulse implements interfaces.Storage
-func (mmGetSequentialPulse *StorageMock) GetSequentialPulse() (p1 models.Pulse, err error) {
-	mm_atomic.AddUint64(&mmGetSequentialPulse.beforeGetSequentialPulseCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetSequentialPulse.afterGetSequentialPulseCounter, 1)
+// When sets expectation for the Storage.SavePulseJets which will trigger the result defined by the following
+// Then helper
+func (mmSavePulseJets *mStorageMockSavePulseJets) When(pulseNumber int64, jetIDs []string) *StorageMockSavePulseJetsExpectation {
+	if mmSavePulseJets.mock.funcSavePulseJets != nil {
+		mmSavePulseJets.mock.t.Fatalf("StorageMock.SavePulseJets mock is already set by Set")
+	}
 
-	if mmGetSequentialPulse.inspectFuncGetSequentialPulse != nil {
-		mmGetSequentialPulse.inspectFuncGetSequentialPulse()
+	expectation := &StorageMockSavePulseJetsExpectation{
+		mock:   mmSavePulseJets.mock,
+		params: &StorageMockSavePulseJetsParams{pulseNumber, jetIDs},
 	}
+	mmSavePulseJets.expectations = append(mmSavePulseJets.expectations, expectation)
+	return expectation
+}
 
-	if mmGetSequentialPulse.GetSequentialPulseMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetSequentialPulse.GetSequentialPulseMock.defaultExpectation.Counter, 1)
+// Then sets up Storage.SavePulseJets return parameters for the expectation previously defined by the When method
+func (e *StorageMockSavePulseJetsExpectation) Then(err error) *StorageMock {
+	e.results = &StorageMockSavePulseJetsResults{err}
+	return e.mock
+}
 
-		mm_results := mmGetSequentialPulse.GetSequentialPulseMock.defaultExpectation.results
+// SavePulseJets implements interfaces.Storage
+func (mmSavePulseJets *StorageMock) SavePulseJets(pulseNumber int64, jetIDs []string) (err error) {
+	mm_atomic.AddUint64(&mmSavePulseJets.beforeSavePulseJetsCounter, 1)
+	defer mm_atomic.AddUint64(&mmSavePulseJets.afterSavePulseJetsCounter, 1)
+
+	if mmSavePulseJets.inspectFuncSavePulseJets != nil {
+		mmSavePulseJets.inspectFuncSavePulseJets(pulseNumber, jetIDs)
+	}
+
+	mm_params := &StorageMockSavePulseJetsParams{pulseNumber, jetIDs}
+
+	// Record call args
+	mmSavePulseJets.SavePulseJetsMock.mutex.Lock()
+	mmSavePulseJets.SavePulseJetsMock.callArgs = append(mmSavePulseJets.SavePulseJetsMock.callArgs, mm_params)
+	mmSavePulseJets.SavePulseJetsMock.mutex.Unlock()
+
+	for _, e := range mmSavePulseJets.SavePulseJetsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSavePulseJets.SavePulseJetsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSavePulseJets.SavePulseJetsMock.defaultExpectation.Counter, 1)
+		mm_want := mmSavePulseJets.SavePulseJetsMock.defaultExpectation.params
+		mm_got := StorageMockSavePulseJetsParams{pulseNumber, jetIDs}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSavePulseJets.t.Errorf("StorageMock.SavePulseJets got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSavePulseJets.SavePulseJetsMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetSequentialPulse.t.Fatal("No results are set for the StorageMock.GetSequentialPulse")
+			mmSavePulseJets.t.Fatal("No results are set for the StorageMock.SavePulseJets")
 		}
-		return (*mm_results).p1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetSequentialPulse.funcGetSequentialPulse != nil {
-		return mmGetSequentialPulse.funcGetSequentialPulse()
+	if mmSavePulseJets.funcSavePulseJets != nil {
+		return mmSavePulseJets.funcSavePulseJets(pulseNumber, jetIDs)
 	}
-	mmGetSequentialPulse.t.Fatalf("Unexpected call to StorageMock.GetSequentialPulse.")
+	mmSavePulseJets.t.Fatalf("Unexpected call to StorageMock.SavePulseJets. %v %v", pulseNumber, jetIDs)
 	return
 }
 
-// GetSequentialPulseAfterCounter returns a count of finished StorageMock.GetSequentialPulse invocations
-func (mmGetSequentialPulse *StorageMock) GetSequentialPulseAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetSequentialPulse.afterGetSequentialPulseCounter)
+// SavePulseJetsAfterCounter returns a count of finished StorageMock.SavePulseJets invocations
+func (mmSavePulseJets *StorageMock) SavePulseJetsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSavePulseJets.afterSavePulseJetsCounter)
+}
+
+// SavePulseJetsBeforeCounter returns a count of StorageMock.SavePulseJets invocations
+func (mmSavePulseJets *StorageMock) SavePulseJetsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSavePulseJets.beforeSavePulseJetsCounter)
 }
 
-// GetSequentialPulseBeforeCounter returns a count of StorageMock.GetSequentialPulse invocations
-func (mmGetSequentialPulse *StorageMock) GetSequentialPulseBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetSequentialPulse.beforeGetSequentialPulseCounter)
+// Calls returns a list of arguments used in each call to StorageMock.SavePulseJets.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSavePulseJets *mStorageMockSavePulseJets) Calls() []*StorageMockSavePulseJetsParams {
+	mmSavePulseJets.mutex.RLock()
+
+	argCopy := make([]*StorageMockSavePulseJetsParams, len(mmSavePulseJets.callArgs))
+	copy(argCopy, mmSavePulseJets.callArgs)
+
+	mmSavePulseJets.mutex.RUnlock()
+
+	return argCopy
 }
 
-// MinimockGetSequentialPulseDone returns true if the count of the GetSequentialPulse invocations corresponds
+// MinimockSavePulseJetsDone returns true if the count of the SavePulseJets invocations corresponds
 // the number of defined expectations
-func (m *StorageMock) MinimockGetSequentialPulseDone() bool {
-	for _, e := range m.GetSequentialPulseMock.expectations {
+func (m *StorageMock) MinimockSavePulseJetsDone() bool {
+	for _, e := range m.SavePulseJetsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetSequentialPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetSequentialPulseCounter) < 1 {
+	if m.SavePulseJetsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSavePulseJetsCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetSequentialPulse != nil && mm_atomic.LoadUint64(&m.afterGetSequentialPulseCounter) < 1 {
+	if m.funcSavePulseJets != nil && mm_atomic.LoadUint64(&m.afterSavePulseJetsCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockGetSequentialPulseInspect logs each unmet expectation
-func (m *StorageMock) MinimockGetSequentialPulseInspect() {
-	for _, e := range m.GetSequentialPulseMock.expectations {
+// MinimockSavePulseJetsInspect logs each unmet expectation
+func (m *StorageMock) MinimockSavePulseJetsInspect() {
+	for _, e := range m.SavePulseJetsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Error("Expected call to StorageMock.GetSequentialPulse")
+			m.t.Errorf("Expected call to StorageMock.SavePulseJets with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetSequentialPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetSequentialPulseCounter) < 1 {
-		m.t.Error("Expected call to StorageMock.GetSequentialPulse")
+	if m.SavePulseJetsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSavePulseJetsCounter) < 1 {
+		if m.SavePulseJetsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StorageMock.SavePulseJets")
+		} else {
+			m.t.Errorf("Expected call to StorageMock.SavePulseJets with params: %#v", *m.SavePulseJetsMock.defaultExpectation.params)
+		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetSequentialPulse != nil && mm_atomic.LoadUint64(&m.afterGetSequentialPulseCounter) < 1 {
-		m.t.Error("Expected call to StorageMock.GetSequentialPulse")
+	if m.funcSavePulseJets != nil && mm_atomic.LoadUint64(&m.afterSavePulseJetsCounter) < 1 {
+		m.t.Error("Expected call to StorageMock.SavePulseJets")
 	}
 }
 
-type mStorageMockSaveJetDropData struct {
+type mStorageMockSaveRecordExtensions struct {
 	mock               *StorageMock
-	defaultExpectation *StorageMockSaveJetDropDataExpectation
-	expectations       []*StorageMockSaveJetDropDataExpectation
+	defaultExpectation *StorageMockSaveRecordExtensionsExpectation
+	expectations       []*StorageMockSaveRecordExtensionsExpectation
 
-	callArgs []*StorageMockSaveJetDropDataParams
+	callArgs []*StorageMockSaveRecordExtensionsParams
 	mutex    sync.RWMutex
 }
 
-// StorageMockSaveJetDropDataExpectation specifies expectation struct of the Storage.SaveJetDropData
-type StorageMockSaveJetDropDataExpectation struct {
+// StorageMockSaveRecordExtensionsExpectation specifies expectation struct of the Storage.SaveRecordExtensions
+type StorageMockSaveRecordExtensionsExpectation struct {
 	mock    *StorageMock
-	params  *StorageMockSaveJetDropDataParams
-	results *StorageMockSaveJetDropDataResults
+	params  *StorageMockSaveRecordExtensionsParams
+	results *StorageMockSaveRecordExtensionsResults
 	Counter uint64
 }
 
-// StorageMockSaveJetDropDataParams contains parameters of the Storage.SaveJetDropData
-type StorageMockSaveJetDropDataParams struct {
-	jetDrop     models.JetDrop
-	records     []models.Record
-	pulseNumber int64
+// StorageMockSaveRecordExtensionsParams contains parameters of the Storage.SaveRecordExtensions
+type StorageMockSaveRecordExtensionsParams struct {
+	extensions []models.RecordExtension
 }
 
-// StorageMockSaveJetDropDataResults contains results of the Storage.SaveJetDropData
-type StorageMockSaveJetDropDataResults struct {
+// StorageMockSaveRecordExtensionsResults contains results of the Storage.SaveRecordExtensions
+type StorageMockSaveRecordExtensionsResults struct {
 	err error
 }
 
-// Expect sets up expected params for Storage.SaveJetDropData
-func (mmSaveJetDropData *mStorageMockSaveJetDropData) Expect(jetDrop models.JetDrop, records []models.Record, pulseNumber int64) *mStorageMockSaveJetDropData {
-	if mmSaveJetDropData.mock.funcSaveJetDropData != nil {
-		mmSaveJetDropData.mock.t.Fatalf("StorageMock.SaveJetDropData mock is already set by Set")
+// Expect sets up expected params for Storage.SaveRecordExtensions
+func (mmSaveRecordExtensions *mStorageMockSaveRecordExtensions) Expect(extensions []models.RecordExtension) *mStorageMockSaveRecordExtensions {
+	if mmSaveRecordExtensions.mock.funcSaveRecordExtensions != nil {
+		mmSaveRecordExtensions.mock.t.Fatalf("StorageMock.SaveRecordExtensions mock is already set by Set")
 	}
 
-	if mmSaveJetDropData.defaultExpectation == nil {
-		mmSaveJetDropData.defaultExpectation = &StorageMockSaveJetDropDataExpectation{}
+	if mmSaveRecordExtensions.defaultExpectation == nil {
+		mmSaveRecordExtensions.defaultExpectation = &StorageMockSaveRecordExtensionsExpectation{}
 	}
 
-	mmSaveJetDropData.defaultExpectation.params = &StorageMockSaveJetDropDataParams{jetDrop, records, pulseNumber}
-	for _, e := range mmSaveJetDropData.expectations {
-		if minimock.Equal(e.params, mmSaveJetDropData.defaultExpectation.params) {
-			mmSaveJetDropData.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveJetDropData.defaultExpectation.params)
+	mmSaveRecordExtensions.defaultExpectation.params = &StorageMockSaveRecordExtensionsParams{extensions}
+	for _, e := range mmSaveRecordExtensions.expectations {
+		if minimock.Equal(e.params, mmSaveRecordExtensions.defaultExpectation.params) {
+			mmSaveRecordExtensions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveRecordExtensions.defaultExpectation.params)
 		}
 	}
 
-	return mmSaveJetDropData
+	return mmSaveRecordExtensions
 }
 
-// Inspect accepts an inspector function that has same arguments as the Storage.SaveJetDropData
-func (mmSaveJetDropData *mStorageMockSaveJetDropData) Inspect(f func(jetDrop models.JetDrop, records []models.Record, pulseNumber int64)) *mStorageMockSaveJetDropData {
-	if mmSaveJetDropData.mock.inspectFuncSaveJetDropData != nil {
-		mmSaveJetDropData.mock.t.Fatalf("Inspect function is already set for StorageMock.SaveJetDropData")
+// Inspect accepts an inspector function that has same arguments as the Storage.SaveRecordExtensions
+func (mmSaveRecordExtensions *mStorageMockSaveRecordExtensions) Inspect(f func(extensions []models.RecordExtension)) *mStorageMockSaveRecordExtensions {
+	if mmSaveRecordExtensions.mock.inspectFuncSaveRecordExtensions != nil {
+		mmSaveRecordExtensions.mock.t.Fatalf("Inspect function is already set for StorageMock.SaveRecordExtensions")
 	}
 
-	mmSaveJetDropData.mock.inspectFuncSaveJetDropData = f
+	mmSaveRecordExtensions.mock.inspectFuncSaveRecordExtensions = f
 
-	return mmSaveJetDropData
+	return mmSaveRecordExtensions
 }
 
-// Return sets up results that will be returned by Storage.SaveJetDropData
-func (mmSaveJetDropData *mStorageMockSaveJetDropData) Return(err error) *StorageMock {
-	if mmSaveJetDropData.mock.funcSaveJetDropData != nil {
-		mmSaveJetDropData.mock.t.Fatalf("StorageMock.SaveJetDropData mock is already set by Set")
+// Return sets up results that will be returned by Storage.SaveRecordExtensions
+func (mmSaveRecordExtensions *mStorageMockSaveRecordExtensions) Return(err error) *StorageMock {
+	if mmSaveRecordExtensions.mock.funcSaveRecordExtensions != nil {
+		mmSaveRecordExtensions.mock.t.Fatalf("StorageMock.SaveRecordExtensions mock is already set by Set")
 	}
 
-	if mmSaveJetDropData.defaultExpectation == nil {
-		mmSaveJetDropData.defaultExpectation = &StorageMockSaveJetDropDataExpectation{mock: mmSaveJetDropData.mock}
+	if mmSaveRecordExtensions.defaultExpectation == nil {
+		mmSaveRecordExtensions.defaultExpectation = &StorageMockSaveRecordExtensionsExpectation{mock: mmSaveRecordExtensions.mock}
 	}
-	mmSaveJetDropData.defaultExpectation.results = &StorageMockSaveJetDropDataResults{err}
-	return mmSaveJetDropData.mock
+	mmSaveRecordExtensions.defaultExpectation.results = &StorageMockSaveRecordExtensionsResults{err}
+	return mmSaveRecordExtensions.mock
 }
 
-//Set uses given function f to mock the Storage.SaveJetDropData method
-func (mmSaveJetDropData *mStorageMockSaveJetDropData) Set(f func(jetDrop models.JetDrop, records []models.Record, pulseNumber int64) (err error)) *StorageMock {
-	if mmSaveJetDropData.defaultExpectation != nil {
-		mmSaveJetDropData.mock.t.Fatalf("Default expectation is already set for the Storage.SaveJetDropData method")
+//Set uses given function f to mock the Storage.SaveRecordExtensions method
+func (mmSaveRecordExtensions *mStorageMockSaveRecordExtensions) Set(f func(extensions []models.RecordExtension) (err error)) *StorageMock {
+	if mmSaveRecordExtensions.defaultExpectation != nil {
+		mmSaveRecordExtensions.mock.t.Fatalf("Default expectation is already set for the Storage.SaveRecordExtensions method")
 	}
 
-	if len(mmSaveJetDropData.expectations) > 0 {
-		mmSaveJetDropData.mock.t.Fatalf("Some expectations are already set for the Storage.SaveJetDropData method")
+	if len(mmSaveRecordExtensions.expectations) > 0 {
+		mmSaveRecordExtensions.mock.t.Fatalf("Some expectations are already set for the Storage.SaveRecordExtensions method")
 	}
 
-	mmSaveJetDropData.mock.funcSaveJetDropData = f
-	return mmSaveJetDropData.mock
+	mmSaveRecordExtensions.mock.funcSaveRecordExtensions = f
+	return mmSaveRecordExtensions.mock
 }
 
-// When sets expectation for the Storage.SaveJetDropData which will trigger the result defined by the following
+// When sets expectation for the Storage.SaveRecordExtensions which will trigger the result defined by the following
 // Then helper
-func (mmSaveJetDropData *mStorageMockSaveJetDropData) When(jetDrop models.JetDrop, records []models.Record, pulseNumber int64) *StorageMockSaveJetDropDataExpectation {
-	if mmSaveJetDropData.mock.funcSaveJetDropData != nil {
-		mmSaveJetDropData.mock.t.Fatalf("StorageMock.SaveJetDropData mock is already set by Set")
+func (mmSaveRecordExtensions *mStorageMockSaveRecordExtensions) When(extensions []models.RecordExtension) *StorageMockSaveRecordExtensionsExpectation {
+	if mmSaveRecordExtensions.mock.funcSaveRecordExtensions != nil {
+		mmSaveRecordExtensions.mock.t.Fatalf("StorageMock.SaveRecordExtensions mock is already set by Set")
 	}
 
-	expectation := &StorageMockSaveJetDropDataExpectation{
-		mock:   mmSaveJetDropData.mock,
-		params: &StorageMockSaveJetDropDataParams{jetDrop, records, pulseNumber},
+	expectation := &StorageMockSaveRecordExtensionsExpectation{
+		mock:   mmSaveRecordExtensions.mock,
+		params: &StorageMockSaveRecordExtensionsParams{extensions},
 	}
-	mmSaveJetDropData.expectations = append(mmSaveJetDropData.expectations, expectation)
+	mmSaveRecordExtensions.expectations = append(mmSaveRecordExtensions.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Storage.SaveJetDropData return parameters for the expectation previously defined by the When method
-func (e *StorageMockSaveJetDropDataExpectation) Then(err error) *StorageMock {
-	e.results = &StorageMockSaveJetDropDataResults{err}
+// Then sets up Storage.SaveRecordExtensions return parameters for the expectation previously defined by the When method
+func (e *StorageMockSaveRecordExtensionsExpectation) Then(err error) *StorageMock {
+	e.results = &StorageMockSaveRecordExtensionsResults{err}
 	return e.mock
 }
 
-// SaveJetDropData implements interfaces.Storage
-func (mmSaveJetDropData *StorageMock) SaveJetDropData(jetDrop models.JetDrop, records []models.Record, pulseNumber int64) (err error) {
-	mm_atomic.AddUint64(&mmSaveJetDropData.beforeSaveJetDropDataCounter, 1)
-	defer mm_atomic.AddUint64(&mmSaveJetDropData.afterSaveJetDropDataCounter, 1)
+// SaveRecordExtensions implements interfaces.Storage
+func (mmSaveRecordExtensions *StorageMock) SaveRecordExtensions(extensions []models.RecordExtension) (err error) {
+	mm_atomic.AddUint64(&mmSaveRecordExtensions.beforeSaveRecordExtensionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveRecordExtensions.afterSaveRecordExtensionsCounter, 1)
 
-	if mmSaveJetDropData.inspectFuncSaveJetDropData != nil {
-		mmSaveJetDropData.inspectFuncSaveJetDropData(jetDrop, records, pulseNumber)
+	if mmSaveRecordExtensions.inspectFuncSaveRecordExtensions != nil {
+		mmSaveRecordExtensions.inspectFuncSaveRecordExtensions(extensions)
 	}
 
-	mm_params := &StorageMockSaveJetDropDataParams{jetDrop, records, pulseNumber}
+	mm_params := &StorageMockSaveRecordExtensionsParams{extensions}
 
 	// Record call args
-	mmSaveJetDropData.SaveJetDropDataMock.mutex.Lock()
-	mmSaveJetDropData.SaveJetDropDataMock.callArgs = append(mmSaveJetDropData.SaveJetDropDataMock.callArgs, mm_params)
-	mmSaveJetDropData.SaveJetDropDataMock.mutex.Unlock()
+	mmSaveRecordExtensions.SaveRecordExtensionsMock.mutex.Lock()
+	mmSaveRecordExtensions.SaveRecordExtensionsMock.callArgs = append(mmSaveRecordExtensions.SaveRecordExtensionsMock.callArgs, mm_params)
+	mmSaveRecordExtensions.SaveRecordExtensionsMock.mutex.Unlock()
 
-	for _, e := range mmSaveJetDropData.SaveJetDropDataMock.expectations {
+	for _, e := range mmSaveRecordExtensions.SaveRecordExtensionsMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmSaveJetDropData.SaveJetDropDataMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmSaveJetDropData.SaveJetDropDataMock.defaultExpectation.Counter, 1)
-		mm_want := mmSaveJetDropData.SaveJetDropDataMock.defaultExpectation.params
-		mm_got := StorageMockSaveJetDropDataParams{jetDrop, records, pulseNumber}
+	if mmSaveRecordExtensions.SaveRecordExtensionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveRecordExtensions.SaveRecordExtensionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveRecordExtensions.SaveRecordExtensionsMock.defaultExpectation.params
+		mm_got := StorageMockSaveRecordExtensionsParams{extensions}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmSaveJetDropData.t.Errorf("StorageMock.SaveJetDropData got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSaveRecordExtensions.t.Errorf("StorageMock.SaveRecordExtensions got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmSaveJetDropData.SaveJetDropDataMock.defaultExpectation.results
+		mm_results := mmSaveRecordExtensions.SaveRecordExtensionsMock.defaultExpectation.results
 		if mm_results == nil {
-			mmSaveJetDropData.t.Fatal("No results are set for the StorageMock.SaveJetDropData")
+			mmSaveRecordExtensions.t.Fatal("No results are set for the StorageMock.SaveRecordExtensions")
 		}
 		return (*mm_results).err
 	}
-	if mmSaveJetDropData.funcSaveJetDropData != nil {
-		return mmSaveJetDropData.funcSaveJetDropData(jetDrop, records, pulseNumber)
+	if mmSaveRecordExtensions.funcSaveRecordExtensions != nil {
+		return mmSaveRecordExtensions.funcSaveRecordExtensions(extensions)
 	}
-	mmSaveJetDropData.t.Fatalf("Unexpected call to StorageMock.SaveJetDropData. %v %v %v", jetDrop, records, pulseNumber)
+	mmSaveRecordExtensions.t.Fatalf("Unexpected call to StorageMock.SaveRecordExtensions. %v", extensions)
 	return
 }
 
-// SaveJetDropDataAfterCounter returns a count of finished StorageMock.SaveJetDropData invocations
-func (mmSaveJetDropData *StorageMock) SaveJetDropDataAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSaveJetDropData.afterSaveJetDropDataCounter)
+// SaveRecordExtensionsAfterCounter returns a count of finished StorageMock.SaveRecordExtensions invocations
+func (mmSaveRecordExtensions *StorageMock) SaveRecordExtensionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveRecordExtensions.afterSaveRecordExtensionsCounter)
 }
 
-// SaveJetDropDataBeforeCounter returns a count of StorageMock.SaveJetDropData invocations
-func (mmSaveJetDropData *StorageMock) SaveJetDropDataBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSaveJetDropData.beforeSaveJetDropDataCounter)
+// SaveRecordExtensionsBeforeCounter returns a count of StorageMock.SaveRecordExtensions invocations
+func (mmSaveRecordExtensions *StorageMock) SaveRecordExtensionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveRecordExtensions.beforeSaveRecordExtensionsCounter)
 }
 
-// Calls returns a list of arguments used in each call to StorageMock.SaveJetDropData.
+// Calls returns a list of arguments used in each call to StorageMock.SaveRecordExtensions.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmSaveJetDropData *mStorageMockSaveJetDropData) Calls() []*StorageMockSaveJetDropDataParams {
-	mmSaveJetDropData.mutex.RLock()
+func (mmSaveRecordExtensions *mStorageMockSaveRecordExtensions) Calls() []*StorageMockSaveRecordExtensionsParams {
+	mmSaveRecordExtensions.mutex.RLock()
 
-	argCopy := make([]*StorageMockSaveJetDropDataParams, len(mmSaveJetDropData.callArgs))
-	copy(argCopy, mmSaveJetDropData.callArgs)
+	argCopy := make([]*StorageMockSaveRecordExtensionsParams, len(mmSaveRecordExtensions.callArgs))
+	copy(argCopy, mmSaveRecordExtensions.callArgs)
 
-	mmSaveJetDropData.mutex.RUnlock()
+	mmSaveRecordExtensions.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockSaveJetDropDataDone returns true if the count of the SaveJetDropData invocations corresponds
+// MinimockSaveRecordExtensionsDone returns true if the count of the SaveRecordExtensions invocations corresponds
 // the number of defined expectations
-func (m *StorageMock) MinimockSaveJetDropDataDone() bool {
-	for _, e := range m.SaveJetDropDataMock.expectations {
+func (m *StorageMock) MinimockSaveRecordExtensionsDone() bool {
+	for _, e := range m.SaveRecordExtensionsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SaveJetDropDataMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveJetDropDataCounter) < 1 {
+	if m.SaveRecordExtensionsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveRecordExtensionsCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSaveJetDropData != nil && mm_atomic.LoadUint64(&m.afterSaveJetDropDataCounter) < 1 {
+	if m.funcSaveRecordExtensions != nil && mm_atomic.LoadUint64(&m.afterSaveRecordExtensionsCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockSaveJetDropDataInspect logs each unmet expectation
-func (m *StorageMock) MinimockSaveJetDropDataInspect() {
-	for _, e := range m.SaveJetDropDataMock.expectations {
+// MinimockSaveRecordExtensionsInspect logs each unmet expectation
+func (m *StorageMock) MinimockSaveRecordExtensionsInspect() {
+	for _, e := range m.SaveRecordExtensionsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StorageMock.SaveJetDropData with params: %#v", *e.params)
+			m.t.Errorf("Expected call to StorageMock.SaveRecordExtensions with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SaveJetDropDataMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveJetDropDataCounter) < 1 {
-		if m.SaveJetDropDataMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to StorageMock.SaveJetDropData")
+	if m.SaveRecordExtensionsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveRecordExtensionsCounter) < 1 {
+		if m.SaveRecordExtensionsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StorageMock.SaveRecordExtensions")
 		} else {
-			m.t.Errorf("Expected call to StorageMock.SaveJetDropData with params: %#v", *m.SaveJetDropDataMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StorageMock.SaveRecordExtensions with params: %#v", *m.SaveRecordExtensionsMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSaveJetDropData != nil && mm_atomic.LoadUint64(&m.afterSaveJetDropDataCounter) < 1 {
-		m.t.Error("Expected call to StorageMock.SaveJetDropData")
+	if m.funcSaveRecordExtensions != nil && mm_atomic.LoadUint64(&m.afterSaveRecordExtensionsCounter) < 1 {
+		m.t.Error("Expected call to StorageMock.SaveRecordExtensions")
 	}
 }
 
-type mStorageMockSavePulse struct {
+type mStorageMockSaveReloadAttempt struct {
 	mock               *StorageMock
-	defaultExpectation *StorageMockSavePulseExpectation
-	expectations       []*StorageMockSavePulseExpectation
+	defaultExpectation *StorageMockSaveReloadAttemptExpectation
+	expectations       []*StorageMockSaveReloadAttemptExpectation
 
-	callArgs []*StorageMockSavePulseParams
+	callArgs []*StorageMockSaveReloadAttemptParams
 	mutex    sync.RWMutex
 }
 
-// StorageMockSavePulseExpectation specifies expectation struct of the Storage.SavePulse
-type StorageMockSavePulseExpectation struct {
+// StorageMockSaveReloadAttemptExpectation specifies expectation struct of the Storage.SaveReloadAttempt
+type StorageMockSaveReloadAttemptExpectation struct {
 	mock    *StorageMock
-	params  *StorageMockSavePulseParams
-	results *StorageMockSavePulseResults
+	params  *StorageMockSaveReloadAttemptParams
+	results *StorageMockSaveReloadAttemptResults
 	Counter uint64
 }
 
-// StorageMockSavePulseParams contains parameters of the Storage.SavePulse
-type StorageMockSavePulseParams struct {
-	pulse models.Pulse
+// StorageMockSaveReloadAttemptParams contains parameters of the Storage.SaveReloadAttempt
+type StorageMockSaveReloadAttemptParams struct {
+	fromPulseNumber int64
+	toPulseNumber   int64
 }
 
-// StorageMockSavePulseResults contains results of the Storage.SavePulse
-type StorageMockSavePulseResults struct {
+// StorageMockSaveReloadAttemptResults contains results of the Storage.SaveReloadAttempt
+type StorageMockSaveReloadAttemptResults struct {
+	r1  models.ReloadRange
 	err error
 }
 
-// Expect sets up expected params for Storage.SavePulse
-func (mmSavePulse *mStorageMockSavePulse) Expect(pulse models.Pulse) *mStorageMockSavePulse {
-	if mmSavePulse.mock.funcSavePulse != nil {
-		mmSavePulse.mock.t.Fatalf("StorageMock.SavePulse mock is already set by Set")
+// Expect sets up expected params for Storage.SaveReloadAttempt
+func (mmSaveReloadAttempt *mStorageMockSaveReloadAttempt) Expect(fromPulseNumber int64, toPulseNumber int64) *mStorageMockSaveReloadAttempt {
+	if mmSaveReloadAttempt.mock.funcSaveReloadAttempt != nil {
+		mmSaveReloadAttempt.mock.t.Fatalf("StorageMock.SaveReloadAttempt mock is already set by Set")
 	}
 
-	if mmSavePulse.defaultExpectation == nil {
-		mmSavePulse.defaultExpectation = &StorageMockSavePulseExpectation{}
+	if mmSaveReloadAttempt.defaultExpectation == nil {
+		mmSaveReloadAttempt.defaultExpectation = &StorageMockSaveReloadAttemptExpectation{}
 	}
 
-	mmSavePulse.defaultExpectation.params = &StorageMockSavePulseParams{pulse}
-	for _, e := range mmSavePulse.expectations {
-		if minimock.Equal(e.params, mmSavePulse.defaultExpectation.params) {
-			mmSavePulse.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSavePulse.defaultExpectation.params)
+	mmSaveReloadAttempt.defaultExpectation.params = &StorageMockSaveReloadAttemptParams{fromPulseNumber, toPulseNumber}
+	for _, e := range mmSaveReloadAttempt.expectations {
+		if minimock.Equal(e.params, mmSaveReloadAttempt.defaultExpectation.params) {
+			mmSaveReloadAttempt.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveReloadAttempt.defaultExpectation.params)
 		}
 	}
 
-	return mmSavePulse
+	return mmSaveReloadAttempt
 }
 
-// Inspect accepts an inspector function that has same arguments as the Storage.SavePulse
-func (mmSavePulse *mStorageMockSavePulse) Inspect(f func(pulse models.Pulse)) *mStorageMockSavePulse {
-	if mmSavePulse.mock.inspectFuncSavePulse != nil {
-		mmSavePulse.mock.t.Fatalf("Inspect function is already set for StorageMock.SavePulse")
+// Inspect accepts an inspector function that has same arguments as the Storage.SaveReloadAttempt
+func (mmSaveReloadAttempt *mStorageMockSaveReloadAttempt) Inspect(f func(fromPulseNumber int64, toPulseNumber int64)) *mStorageMockSaveReloadAttempt {
+	if mmSaveReloadAttempt.mock.inspectFuncSaveReloadAttempt != nil {
+		mmSaveReloadAttempt.mock.t.Fatalf("Inspect function is already set for StorageMock.SaveReloadAttempt")
 	}
 
-	mmSavePulse.mock.inspectFuncSavePulse = f
+	mmSaveReloadAttempt.mock.inspectFuncSaveReloadAttempt = f
 
-	return mmSavePulse
+	return mmSaveReloadAttempt
 }
 
-// Return sets up results that will be returned by Storage.SavePulse
-func (mmSavePulse *mStorageMockSavePulse) Return(err error) *StorageMock {
-	if mmSavePulse.mock.funcSavePulse != nil {
-		mmSavePulse.mock.t.Fatalf("StorageMock.SavePulse mock is already set by Set")
+// Return sets up results that will be returned by Storage.SaveReloadAttempt
+func (mmSaveReloadAttempt *mStorageMockSaveReloadAttempt) Return(r1 models.ReloadRange, err error) *StorageMock {
+	if mmSaveReloadAttempt.mock.funcSaveReloadAttempt != nil {
+		mmSaveReloadAttempt.mock.t.Fatalf("StorageMock.SaveReloadAttempt mock is already set by Set")
 	}
 
-	if mmSavePulse.defaultExpectation == nil {
-		mmSavePulse.defaultExpectation = &StorageMockSavePulseExpectation{mock: mmSavePulse.mock}
+	if mmSaveReloadAttempt.defaultExpectation == nil {
+		mmSaveReloadAttempt.defaultExpectation = &StorageMockSaveReloadAttemptExpectation{mock: mmSaveReloadAttempt.mock}
 	}
-	mmSavePulse.defaultExpectation.results = &StorageMockSavePulseResults{err}
-	return mmSavePulse.mock
+	mmSaveReloadAttempt.defaultExpectation.results = &StorageMockSaveReloadAttemptResults{r1, err}
+	return mmSaveReloadAttempt.mock
 }
 
-//Set uses given function f to mock the Storage.SavePulse method
-func (mmSavePulse *mStorageMockSavePulse) Set(f func(pulse models.Pulse) (err error)) *StorageMock {
-	if mmSavePulse.defaultExpectation != nil {
-		mmSavePulse.mock.t.Fatalf("Default expectation is already set for the Storage.SavePulse method")
+//Set uses given function f to mock the Storage.SaveReloadAttempt method
+func (mmSaveReloadAttempt *mStorageMockSaveReloadAttempt) Set(f func(fromPulseNumber int64, toPulseNumber int64) (r1 models.ReloadRange, err error)) *StorageMock {
+	if mmSaveReloadAttempt.defaultExpectation != nil {
+		mmSaveReloadAttempt.mock.t.Fatalf("Default expectation is already set for the Storage.SaveReloadAttempt method")
 	}
 
-	if len(mmSavePulse.expectations) > 0 {
-		mmSavePulse.mock.t.Fatalf("Some expectations are already set for the Storage.SavePulse method")
+	if len(mmSaveReloadAttempt.expectations) > 0 {
+		mmSaveReloadAttempt.mock.t.Fatalf("Some expectations are already set for the Storage.SaveReloadAttempt method")
 	}
 
-	mmSavePulse.mock.funcSavePulse = f
-	return mmSavePulse.mock
+	mmSaveReloadAttempt.mock.funcSaveReloadAttempt = f
+	return mmSaveReloadAttempt.mock
 }
 
-// When sets expectation for the Storage.SavePulse which will trigger the result defined by the following
+// When sets expectation for the Storage.SaveReloadAttempt which will trigger the result defined by the following
 // Then helper
-func (mmSavePulse *mStorageMockSavePulse) When(pulse models.Pulse) *StorageMockSavePulseExpectation {
-	if mmSavePulse.mock.funcSavePulse != nil {
-		mmSavePulse.mock.t.Fatalf("StorageMock.SavePulse mock is already set by Set")
+func (mmSaveReloadAttempt *mStorageMockSaveReloadAttempt) When(fromPulseNumber int64, toPulseNumber int64) *StorageMockSaveReloadAttemptExpectation {
+	if mmSaveReloadAttempt.mock.funcSaveReloadAttempt != nil {
+		mmSaveReloadAttempt.mock.t.Fatalf("StorageMock.SaveReloadAttempt mock is already set by Set")
 	}
 
-	expectation := &StorageMockSavePulseExpectation{
-		mock:   mmSavePulse.mock,
-		params: &StorageMockSavePulseParams{pulse},
+	expectation := &StorageMockSaveReloadAttemptExpectation{
+		mock:   mmSaveReloadAttempt.mock,
+		params: &StorageMockSaveReloadAttemptParams{fromPulseNumber, toPulseNumber},
 	}
-	mmSavePulse.expectations = append(mmSavePulse.expectations, expectation)
+	mmSaveReloadAttempt.expectations = append(mmSaveReloadAttempt.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Storage.SavePulse return parameters for the expectation previously defined by the When method
-func (e *StorageMockSavePulseExpectation) Then(err error) *StorageMock {
-	e.results = &StorageMockSavePulseResults{err}
+// Then sets up Storage.SaveReloadAttempt return parameters for the expectation previously defined by the When method
+func (e *StorageMockSaveReloadAttemptExpectation) Then(r1 models.ReloadRange, err error) *StorageMock {
+	e.results = &StorageMockSaveReloadAttemptResults{r1, err}
 	return e.mock
 }
 
-// SavePulse implements interfaces.Storage
-func (mmSavePulse *StorageMock) SavePulse(pulse models.Pulse) (err error) {
-	mm_atomic.AddUint64(&mmSavePulse.beforeSavePulseCounter, 1)
-	defer mm_atomic.AddUint64(&mmSavePulse.afterSavePulseCounter, 1)
+// SaveReloadAttempt implements interfaces.Storage
+func (mmSaveReloadAttempt *StorageMock) SaveReloadAttempt(fromPulseNumber int64, toPulseNumber int64) (r1 models.ReloadRange, err error) {
+	mm_atomic.AddUint64(&mmSaveReloadAttempt.beforeSaveReloadAttemptCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveReloadAttempt.afterSaveReloadAttemptCounter, 1)
 
-	if mmSavePulse.inspectFuncSavePulse != nil {
-		mmSavePulse.inspectFuncSavePulse(pulse)
+	if mmSaveReloadAttempt.inspectFuncSaveReloadAttempt != nil {
+		mmSaveReloadAttempt.inspectFuncSaveReloadAttempt(fromPulseNumber, toPulseNumber)
 	}
 
-	mm_params := &StorageMockSavePulseParams{pulse}
+	mm_params := &StorageMockSaveReloadAttemptParams{fromPulseNumber, toPulseNumber}
 
 	// Record call args
-	mmSavePulse.SavePulseMock.mutex.Lock()
-	mmSavePulse.SavePulseMock.callArgs = append(mmSavePulse.SavePulseMock.callArgs, mm_params)
-	mmSavePulse.SavePulseMock.mutex.Unlock()
+	mmSaveReloadAttempt.SaveReloadAttemptMock.mutex.Lock()
+	mmSaveReloadAttempt.SaveReloadAttemptMock.callArgs = append(mmSaveReloadAttempt.SaveReloadAttemptMock.callArgs, mm_params)
+	mmSaveReloadAttempt.SaveReloadAttemptMock.mutex.Unlock()
 
-	for _, e := range mmSavePulse.SavePulseMock.expectations {
+	for _, e := range mmSaveReloadAttempt.SaveReloadAttemptMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.r1, e.results.err
 		}
 	}
 
-	if mmSavePulse.SavePulseMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmSavePulse.SavePulseMock.defaultExpectation.Counter, 1)
-		mm_want := mmSavePulse.SavePulseMock.defaultExpectation.params
-		mm_got := StorageMockSavePulseParams{pulse}
+	if mmSaveReloadAttempt.SaveReloadAttemptMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveReloadAttempt.SaveReloadAttemptMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveReloadAttempt.SaveReloadAttemptMock.defaultExpectation.params
+		mm_got := StorageMockSaveReloadAttemptParams{fromPulseNumber, toPulseNumber}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmSavePulse.t.Errorf("StorageMock.SavePulse got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSaveReloadAttempt.t.Errorf("StorageMock.SaveReloadAttempt got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmSavePulse.SavePulseMock.defaultExpectation.results
+		mm_results := mmSaveReloadAttempt.SaveReloadAttemptMock.defaultExpectation.results
 		if mm_results == nil {
-			mmSavePulse.t.Fatal("No results are set for the StorageMock.SavePulse")
+			mmSaveReloadAttempt.t.Fatal("No results are set for the StorageMock.SaveReloadAttempt")
 		}
-		return (*mm_results).err
+		return (*mm_results).r1, (*mm_results).err
 	}
-	if mmSavePulse.funcSavePulse != nil {
-		return mmSavePulse.funcSavePulse(pulse)
+	if mmSaveReloadAttempt.funcSaveReloadAttempt != nil {
+		return mmSaveReloadAttempt.funcSaveReloadAttempt(fromPulseNumber, toPulseNumber)
 	}
-	mmSavePulse.t.Fatalf("Unexpected call to StorageMock.SavePulse. %v", pulse)
+	mmSaveReloadAttempt.t.Fatalf("Unexpected call to StorageMock.SaveReloadAttempt. %v %v", fromPulseNumber, toPulseNumber)
 	return
 }
 
-// SavePulseAfterCounter returns a count of finished StorageMock.SavePulse invocations
-func (mmSavePulse *StorageMock) SavePulseAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSavePulse.afterSavePulseCounter)
+// SaveReloadAttemptAfterCounter returns a count of finished StorageMock.SaveReloadAttempt invocations
+func (mmSaveReloadAttempt *StorageMock) SaveReloadAttemptAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveReloadAttempt.afterSaveReloadAttemptCounter)
 }
 
-// SavePulseBeforeCounter returns a count of StorageMock.SavePulse invocations
-func (mmSavePulse *StorageMock) SavePulseBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSavePulse.beforeSavePulseCounter)
+// SaveReloadAttemptBeforeCounter returns a count of StorageMock.SaveReloadAttempt invocations
+func (mmSaveReloadAttempt *StorageMock) SaveReloadAttemptBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveReloadAttempt.beforeSaveReloadAttemptCounter)
 }
 
-// Calls returns a list of arguments used in each call to StorageMock.SavePulse.
+// Calls returns a list of arguments used in each call to StorageMock.SaveReloadAttempt.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmSavePulse *mStorageMockSavePulse) Calls() []*StorageMockSavePulseParams {
-	mmSavePulse.mutex.RLock()
+func (mmSaveReloadAttempt *mStorageMockSaveReloadAttempt) Calls() []*StorageMockSaveReloadAttemptParams {
+	mmSaveReloadAttempt.mutex.RLock()
 
-	argCopy := make([]*StorageMockSavePulseParams, len(mmSavePulse.callArgs))
-	copy(argCopy, mmSavePulse.callArgs)
+	argCopy := make([]*StorageMockSaveReloadAttemptParams, len(mmSaveReloadAttempt.callArgs))
+	copy(argCopy, mmSaveReloadAttempt.callArgs)
 
-	mmSavePulse.mutex.RUnlock()
+	mmSaveReloadAttempt.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockSavePulseDone returns true if the count of the SavePulse invocations corresponds
+// MinimockSaveReloadAttemptDone returns true if the count of the SaveReloadAttempt invocations corresponds
 // the number of defined expectations
-func (m *StorageMock) MinimockSavePulseDone() bool {
-	for _, e := range m.SavePulseMock.expectations {
+func (m *StorageMock) MinimockSaveReloadAttemptDone() bool {
+	for _, e := range m.SaveReloadAttemptMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SavePulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSavePulseCounter) < 1 {
+	if m.SaveReloadAttemptMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveReloadAttemptCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSavePulse != nil && mm_atomic.LoadUint64(&m.afterSavePulseCounter) < 1 {
+	if m.funcSaveReloadAttempt != nil && mm_atomic.LoadUint64(&m.afterSaveReloadAttemptCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockSavePulseInspect logs each unmet expectation
-func (m *StorageMock) MinimockSavePulseInspect() {
-	for _, e := range m.SavePulseMock.expectations {
+// MinimockSaveReloadAttemptInspect logs each unmet expectation
+func (m *StorageMock) MinimockSaveReloadAttemptInspect() {
+	for _, e := range m.SaveReloadAttemptMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StorageMock.SavePulse with params: %#v", *e.params)
+			m.t.Errorf("Expected call to StorageMock.SaveReloadAttempt with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SavePulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSavePulseCounter) < 1 {
-		if m.SavePulseMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to StorageMock.SavePulse")
+	if m.SaveReloadAttemptMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveReloadAttemptCounter) < 1 {
+		if m.SaveReloadAttemptMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StorageMock.SaveReloadAttempt")
 		} else {
-			m.t.Errorf("Expected call to StorageMock.SavePulse with params: %#v", *m.SavePulseMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StorageMock.SaveReloadAttempt with params: %#v", *m.SaveReloadAttemptMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSavePulse != nil && mm_atomic.LoadUint64(&m.afterSavePulseCounter) < 1 {
-		m.t.Error("Expected call to StorageMock.SavePulse")
+	if m.funcSaveReloadAttempt != nil && mm_atomic.LoadUint64(&m.afterSaveReloadAttemptCounter) < 1 {
+		m.t.Error("Expected call to StorageMock.SaveReloadAttempt")
 	}
 }
 
@@ -2135,22 +5222,50 @@ func (m *StorageMock) MinimockFinish() {
 	if !m.minimockDone() {
 		m.MinimockCompletePulseInspect()
 
+		m.MinimockDeletePulsesInspect()
+
+		m.MinimockDeleteReloadRangesInspect()
+
+		m.MinimockGetCheckpointInspect()
+
 		m.MinimockGetIncompletePulsesInspect()
 
 		m.MinimockGetJetDropsInspect()
 
-		m.MinimockGetNextCompletePulseFilterByPrototypeReferenceInspect()
-
 		m.MinimockGetNextSavedPulseInspect()
 
+		m.MinimockGetNextSequentialPulseInspect()
+
+		m.MinimockGetNextSequentialPulseFilterByRecordsInspect()
+
+		m.MinimockGetPulseInspect()
+
 		m.MinimockGetPulseByPrevInspect()
 
+		m.MinimockGetPulseJetsInspect()
+
+		m.MinimockGetRecordAmountsByPulseInspect()
+
+		m.MinimockGetRecordsByPulseInspect()
+
+		m.MinimockGetReloadRangesInspect()
+
 		m.MinimockGetSequentialPulseInspect()
 
+		m.MinimockSaveCheckpointInspect()
+
 		m.MinimockSaveJetDropDataInspect()
 
+		m.MinimockSaveJetDropsBatchInspect()
+
 		m.MinimockSavePulseInspect()
 
+		m.MinimockSavePulseJetsInspect()
+
+		m.MinimockSaveRecordExtensionsInspect()
+
+		m.MinimockSaveReloadAttemptInspect()
+
 		m.MinimockSequencePulseInspect()
 		m.t.FailNow()
 	}
@@ -2176,13 +5291,27 @@ func (m *StorageMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockCompletePulseDone() &&
+		m.MinimockDeletePulsesDone() &&
+		m.MinimockDeleteReloadRangesDone() &&
+		m.MinimockGetCheckpointDone() &&
 		m.MinimockGetIncompletePulsesDone() &&
 		m.MinimockGetJetDropsDone() &&
-		m.MinimockGetNextCompletePulseFilterByPrototypeReferenceDone() &&
 		m.MinimockGetNextSavedPulseDone() &&
+		m.MinimockGetNextSequentialPulseDone() &&
+		m.MinimockGetNextSequentialPulseFilterByRecordsDone() &&
+		m.MinimockGetPulseDone() &&
 		m.MinimockGetPulseByPrevDone() &&
+		m.MinimockGetPulseJetsDone() &&
+		m.MinimockGetRecordAmountsByPulseDone() &&
+		m.MinimockGetRecordsByPulseDone() &&
+		m.MinimockGetReloadRangesDone() &&
 		m.MinimockGetSequentialPulseDone() &&
+		m.MinimockSaveCheckpointDone() &&
 		m.MinimockSaveJetDropDataDone() &&
+		m.MinimockSaveJetDropsBatchDone() &&
 		m.MinimockSavePulseDone() &&
+		m.MinimockSavePulseJetsDone() &&
+		m.MinimockSaveRecordExtensionsDone() &&
+		m.MinimockSaveReloadAttemptDone() &&
 		m.MinimockSequencePulseDone()
 }
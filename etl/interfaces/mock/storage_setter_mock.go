@@ -21,18 +21,42 @@ type StorageSetterMock struct {
 	beforeCompletePulseCounter uint64
 	CompletePulseMock          mStorageSetterMockCompletePulse
 
+	funcDeletePulses          func(fromPulseNumber int64, toPulseNumber int64) (err error)
+	inspectFuncDeletePulses   func(fromPulseNumber int64, toPulseNumber int64)
+	afterDeletePulsesCounter  uint64
+	beforeDeletePulsesCounter uint64
+	DeletePulsesMock          mStorageSetterMockDeletePulses
+
 	funcSaveJetDropData          func(jetDrop models.JetDrop, records []models.Record, pulseNumber int64) (err error)
 	inspectFuncSaveJetDropData   func(jetDrop models.JetDrop, records []models.Record, pulseNumber int64)
 	afterSaveJetDropDataCounter  uint64
 	beforeSaveJetDropDataCounter uint64
 	SaveJetDropDataMock          mStorageSetterMockSaveJetDropData
 
+	funcSaveJetDropsBatch          func(pulse models.Pulse, pulseJetIDs []string, jetDrops []models.JetDropData) (err error)
+	inspectFuncSaveJetDropsBatch   func(pulse models.Pulse, pulseJetIDs []string, jetDrops []models.JetDropData)
+	afterSaveJetDropsBatchCounter  uint64
+	beforeSaveJetDropsBatchCounter uint64
+	SaveJetDropsBatchMock          mStorageSetterMockSaveJetDropsBatch
+
 	funcSavePulse          func(pulse models.Pulse) (err error)
 	inspectFuncSavePulse   func(pulse models.Pulse)
 	afterSavePulseCounter  uint64
 	beforeSavePulseCounter uint64
 	SavePulseMock          mStorageSetterMockSavePulse
 
+	funcSavePulseJets          func(pulseNumber int64, jetIDs []string) (err error)
+	inspectFuncSavePulseJets   func(pulseNumber int64, jetIDs []string)
+	afterSavePulseJetsCounter  uint64
+	beforeSavePulseJetsCounter uint64
+	SavePulseJetsMock          mStorageSetterMockSavePulseJets
+
+	funcSaveRecordExtensions          func(extensions []models.RecordExtension) (err error)
+	inspectFuncSaveRecordExtensions   func(extensions []models.RecordExtension)
+	afterSaveRecordExtensionsCounter  uint64
+	beforeSaveRecordExtensionsCounter uint64
+	SaveRecordExtensionsMock          mStorageSetterMockSaveRecordExtensions
+
 	funcSequencePulse          func(pulseNumber int64) (err error)
 	inspectFuncSequencePulse   func(pulseNumber int64)
 	afterSequencePulseCounter  uint64
@@ -50,12 +74,24 @@ func NewStorageSetterMock(t minimock.Tester) *StorageSetterMock {
 	m.CompletePulseMock = mStorageSetterMockCompletePulse{mock: m}
 	m.CompletePulseMock.callArgs = []*StorageSetterMockCompletePulseParams{}
 
+	m.DeletePulsesMock = mStorageSetterMockDeletePulses{mock: m}
+	m.DeletePulsesMock.callArgs = []*StorageSetterMockDeletePulsesParams{}
+
 	m.SaveJetDropDataMock = mStorageSetterMockSaveJetDropData{mock: m}
 	m.SaveJetDropDataMock.callArgs = []*StorageSetterMockSaveJetDropDataParams{}
 
+	m.SaveJetDropsBatchMock = mStorageSetterMockSaveJetDropsBatch{mock: m}
+	m.SaveJetDropsBatchMock.callArgs = []*StorageSetterMockSaveJetDropsBatchParams{}
+
 	m.SavePulseMock = mStorageSetterMockSavePulse{mock: m}
 	m.SavePulseMock.callArgs = []*StorageSetterMockSavePulseParams{}
 
+	m.SavePulseJetsMock = mStorageSetterMockSavePulseJets{mock: m}
+	m.SavePulseJetsMock.callArgs = []*StorageSetterMockSavePulseJetsParams{}
+
+	m.SaveRecordExtensionsMock = mStorageSetterMockSaveRecordExtensions{mock: m}
+	m.SaveRecordExtensionsMock.callArgs = []*StorageSetterMockSaveRecordExtensionsParams{}
+
 	m.SequencePulseMock = mStorageSetterMockSequencePulse{mock: m}
 	m.SequencePulseMock.callArgs = []*StorageSetterMockSequencePulseParams{}
 
@@ -277,6 +313,222 @@ func (m *StorageSetterMock) MinimockCompletePulseInspect() {
 	}
 }
 
+type mStorageSetterMockDeletePulses struct {
+	mock               *StorageSetterMock
+	defaultExpectation *StorageSetterMockDeletePulsesExpectation
+	expectations       []*StorageSetterMockDeletePulsesExpectation
+
+	callArgs []*StorageSetterMockDeletePulsesParams
+	mutex    sync.RWMutex
+}
+
+// StorageSetterMockDeletePulsesExpectation specifies expectation struct of the StorageSetter.DeletePulses
+type StorageSetterMockDeletePulsesExpectation struct {
+	mock    *StorageSetterMock
+	params  *StorageSetterMockDeletePulsesParams
+	results *StorageSetterMockDeletePulsesResults
+	Counter uint64
+}
+
+// StorageSetterMockDeletePulsesParams contains parameters of the StorageSetter.DeletePulses
+type StorageSetterMockDeletePulsesParams struct {
+	fromPulseNumber int64
+	toPulseNumber   int64
+}
+
+// StorageSetterMockDeletePulsesResults contains results of the StorageSetter.DeletePulses
+type StorageSetterMockDeletePulsesResults struct {
+	err error
+}
+
+// Expect sets up expected params for StorageSetter.DeletePulses
+func (mmDeletePulses *mStorageSetterMockDeletePulses) Expect(fromPulseNumber int64, toPulseNumber int64) *mStorageSetterMockDeletePulses {
+	if mmDeletePulses.mock.funcDeletePulses != nil {
+		mmDeletePulses.mock.t.Fatalf("StorageSetterMock.DeletePulses mock is already set by Set")
+	}
+
+	if mmDeletePulses.defaultExpectation == nil {
+		mmDeletePulses.defaultExpectation = &StorageSetterMockDeletePulsesExpectation{}
+	}
+
+	mmDeletePulses.defaultExpectation.params = &StorageSetterMockDeletePulsesParams{fromPulseNumber, toPulseNumber}
+	for _, e := range mmDeletePulses.expectations {
+		if minimock.Equal(e.params, mmDeletePulses.defaultExpectation.params) {
+			mmDeletePulses.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeletePulses.defaultExpectation.params)
+		}
+	}
+
+	return mmDeletePulses
+}
+
+// Inspect accepts an inspector function that has same arguments as the StorageSetter.DeletePulses
+func (mmDeletePulses *mStorageSetterMockDeletePulses) Inspect(f func(fromPulseNumber int64, toPulseNumber int64)) *mStorageSetterMockDeletePulses {
+	if mmDeletePulses.mock.inspectFuncDeletePulses != nil {
+		mmDeletePulses.mock.t.Fatalf("Inspect function is already set for StorageSetterMock.DeletePulses")
+	}
+
+	mmDeletePulses.mock.inspectFuncDeletePulses = f
+
+	return mmDeletePulses
+}
+
+// Return sets up results that will be returned by StorageSetter.DeletePulses
+func (mmDeletePulses *mStorageSetterMockDeletePulses) Return(err error) *StorageSetterMock {
+	if mmDeletePulses.mock.funcDeletePulses != nil {
+		mmDeletePulses.mock.t.Fatalf("StorageSetterMock.DeletePulses mock is already set by Set")
+	}
+
+	if mmDeletePulses.defaultExpectation == nil {
+		mmDeletePulses.defaultExpectation = &StorageSetterMockDeletePulsesExpectation{mock: mmDeletePulses.mock}
+	}
+	mmDeletePulses.defaultExpectation.results = &StorageSetterMockDeletePulsesResults{err}
+	return mmDeletePulses.mock
+}
+
+//Set uses given function f to mock the StorageSetter.DeletePulses method
+func (mmDeletePulses *mStorageSetterMockDeletePulses) Set(f func(fromPulseNumber int64, toPulseNumber int64) (err error)) *StorageSetterMock {
+	if mmDeletePulses.defaultExpectation != nil {
+		mmDeletePulses.mock.t.Fatalf("Default expectation is already set for the StorageSetter.DeletePulses method")
+	}
+
+	if len(mmDeletePulses.expectations) > 0 {
+		mmDeletePulses.mock.t.Fatalf("Some expectations are already set for the StorageSetter.DeletePulses method")
+	}
+
+	mmDeletePulses.mock.funcDeletePulses = f
+	return mmDeletePulses.mock
+}
+
+// When sets expectation for the StorageSetter.DeletePulses which will trigger the result defined by the following
+// Then helper
+func (mmDeletePulses *mStorageSetterMockDeletePulses) When(fromPulseNumber int64, toPulseNumber int64) *StorageSetterMockDeletePulsesExpectation {
+	if mmDeletePulses.mock.funcDeletePulses != nil {
+		mmDeletePulses.mock.t.Fatalf("StorageSetterMock.DeletePulses mock is already set by Set")
+	}
+
+	expectation := &StorageSetterMockDeletePulsesExpectation{
+		mock:   mmDeletePulses.mock,
+		params: &StorageSetterMockDeletePulsesParams{fromPulseNumber, toPulseNumber},
+	}
+	mmDeletePulses.expectations = append(mmDeletePulses.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StorageSetter.DeletePulses return parameters for the expectation previously defined by the When method
+func (e *StorageSetterMockDeletePulsesExpectation) Then(err error) *StorageSetterMock {
+	e.results = &StorageSetterMockDeletePulsesResults{err}
+	return e.mock
+}
+
+// DeletePulses implements interfaces.StorageSetter
+func (mmDeletePulses *StorageSetterMock) DeletePulses(fromPulseNumber int64, toPulseNumber int64) (err error) {
+	mm_atomic.AddUint64(&mmDeletePulses.beforeDeletePulsesCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeletePulses.afterDeletePulsesCounter, 1)
+
+	if mmDeletePulses.inspectFuncDeletePulses != nil {
+		mmDeletePulses.inspectFuncDeletePulses(fromPulseNumber, toPulseNumber)
+	}
+
+	mm_params := &StorageSetterMockDeletePulsesParams{fromPulseNumber, toPulseNumber}
+
+	// Record call args
+	mmDeletePulses.DeletePulsesMock.mutex.Lock()
+	mmDeletePulses.DeletePulsesMock.callArgs = append(mmDeletePulses.DeletePulsesMock.callArgs, mm_params)
+	mmDeletePulses.DeletePulsesMock.mutex.Unlock()
+
+	for _, e := range mmDeletePulses.DeletePulsesMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeletePulses.DeletePulsesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeletePulses.DeletePulsesMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeletePulses.DeletePulsesMock.defaultExpectation.params
+		mm_got := StorageSetterMockDeletePulsesParams{fromPulseNumber, toPulseNumber}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeletePulses.t.Errorf("StorageSetterMock.DeletePulses got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeletePulses.DeletePulsesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeletePulses.t.Fatal("No results are set for the StorageSetterMock.DeletePulses")
+		}
+		return (*mm_results).err
+	}
+	if mmDeletePulses.funcDeletePulses != nil {
+		return mmDeletePulses.funcDeletePulses(fromPulseNumber, toPulseNumber)
+	}
+	mmDeletePulses.t.Fatalf("Unexpected call to StorageSetterMock.DeletePulses. %v %v", fromPulseNumber, toPulseNumber)
+	return
+}
+
+// DeletePulsesAfterCounter returns a count of finished StorageSetterMock.DeletePulses invocations
+func (mmDeletePulses *StorageSetterMock) DeletePulsesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePulses.afterDeletePulsesCounter)
+}
+
+// DeletePulsesBeforeCounter returns a count of StorageSetterMock.DeletePulses invocations
+func (mmDeletePulses *StorageSetterMock) DeletePulsesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePulses.beforeDeletePulsesCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageSetterMock.DeletePulses.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeletePulses *mStorageSetterMockDeletePulses) Calls() []*StorageSetterMockDeletePulsesParams {
+	mmDeletePulses.mutex.RLock()
+
+	argCopy := make([]*StorageSetterMockDeletePulsesParams, len(mmDeletePulses.callArgs))
+	copy(argCopy, mmDeletePulses.callArgs)
+
+	mmDeletePulses.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeletePulsesDone returns true if the count of the DeletePulses invocations corresponds
+// the number of defined expectations
+func (m *StorageSetterMock) MinimockDeletePulsesDone() bool {
+	for _, e := range m.DeletePulsesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeletePulsesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeletePulsesCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeletePulses != nil && mm_atomic.LoadUint64(&m.afterDeletePulsesCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDeletePulsesInspect logs each unmet expectation
+func (m *StorageSetterMock) MinimockDeletePulsesInspect() {
+	for _, e := range m.DeletePulsesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageSetterMock.DeletePulses with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeletePulsesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeletePulsesCounter) < 1 {
+		if m.DeletePulsesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StorageSetterMock.DeletePulses")
+		} else {
+			m.t.Errorf("Expected call to StorageSetterMock.DeletePulses with params: %#v", *m.DeletePulsesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeletePulses != nil && mm_atomic.LoadUint64(&m.afterDeletePulsesCounter) < 1 {
+		m.t.Error("Expected call to StorageSetterMock.DeletePulses")
+	}
+}
+
 type mStorageSetterMockSaveJetDropData struct {
 	mock               *StorageSetterMock
 	defaultExpectation *StorageSetterMockSaveJetDropDataExpectation
@@ -494,6 +746,223 @@ func (m *StorageSetterMock) MinimockSaveJetDropDataInspect() {
 	}
 }
 
+type mStorageSetterMockSaveJetDropsBatch struct {
+	mock               *StorageSetterMock
+	defaultExpectation *StorageSetterMockSaveJetDropsBatchExpectation
+	expectations       []*StorageSetterMockSaveJetDropsBatchExpectation
+
+	callArgs []*StorageSetterMockSaveJetDropsBatchParams
+	mutex    sync.RWMutex
+}
+
+// StorageSetterMockSaveJetDropsBatchExpectation specifies expectation struct of the StorageSetter.SaveJetDropsBatch
+type StorageSetterMockSaveJetDropsBatchExpectation struct {
+	mock    *StorageSetterMock
+	params  *StorageSetterMockSaveJetDropsBatchParams
+	results *StorageSetterMockSaveJetDropsBatchResults
+	Counter uint64
+}
+
+// StorageSetterMockSaveJetDropsBatchParams contains parameters of the StorageSetter.SaveJetDropsBatch
+type StorageSetterMockSaveJetDropsBatchParams struct {
+	pulse       models.Pulse
+	pulseJetIDs []string
+	jetDrops    []models.JetDropData
+}
+
+// StorageSetterMockSaveJetDropsBatchResults contains results of the StorageSetter.SaveJetDropsBatch
+type StorageSetterMockSaveJetDropsBatchResults struct {
+	err error
+}
+
+// Expect sets up expected params for StorageSetter.SaveJetDropsBatch
+func (mmSaveJetDropsBatch *mStorageSetterMockSaveJetDropsBatch) Expect(pulse models.Pulse, pulseJetIDs []string, jetDrops []models.JetDropData) *mStorageSetterMockSaveJetDropsBatch {
+	if mmSaveJetDropsBatch.mock.funcSaveJetDropsBatch != nil {
+		mmSaveJetDropsBatch.mock.t.Fatalf("StorageSetterMock.SaveJetDropsBatch mock is already set by Set")
+	}
+
+	if mmSaveJetDropsBatch.defaultExpectation == nil {
+		mmSaveJetDropsBatch.defaultExpectation = &StorageSetterMockSaveJetDropsBatchExpectation{}
+	}
+
+	mmSaveJetDropsBatch.defaultExpectation.params = &StorageSetterMockSaveJetDropsBatchParams{pulse, pulseJetIDs, jetDrops}
+	for _, e := range mmSaveJetDropsBatch.expectations {
+		if minimock.Equal(e.params, mmSaveJetDropsBatch.defaultExpectation.params) {
+			mmSaveJetDropsBatch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveJetDropsBatch.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveJetDropsBatch
+}
+
+// Inspect accepts an inspector function that has same arguments as the StorageSetter.SaveJetDropsBatch
+func (mmSaveJetDropsBatch *mStorageSetterMockSaveJetDropsBatch) Inspect(f func(pulse models.Pulse, pulseJetIDs []string, jetDrops []models.JetDropData)) *mStorageSetterMockSaveJetDropsBatch {
+	if mmSaveJetDropsBatch.mock.inspectFuncSaveJetDropsBatch != nil {
+		mmSaveJetDropsBatch.mock.t.Fatalf("Inspect function is already set for StorageSetterMock.SaveJetDropsBatch")
+	}
+
+	mmSaveJetDropsBatch.mock.inspectFuncSaveJetDropsBatch = f
+
+	return mmSaveJetDropsBatch
+}
+
+// Return sets up results that will be returned by StorageSetter.SaveJetDropsBatch
+func (mmSaveJetDropsBatch *mStorageSetterMockSaveJetDropsBatch) Return(err error) *StorageSetterMock {
+	if mmSaveJetDropsBatch.mock.funcSaveJetDropsBatch != nil {
+		mmSaveJetDropsBatch.mock.t.Fatalf("StorageSetterMock.SaveJetDropsBatch mock is already set by Set")
+	}
+
+	if mmSaveJetDropsBatch.defaultExpectation == nil {
+		mmSaveJetDropsBatch.defaultExpectation = &StorageSetterMockSaveJetDropsBatchExpectation{mock: mmSaveJetDropsBatch.mock}
+	}
+	mmSaveJetDropsBatch.defaultExpectation.results = &StorageSetterMockSaveJetDropsBatchResults{err}
+	return mmSaveJetDropsBatch.mock
+}
+
+//Set uses given function f to mock the StorageSetter.SaveJetDropsBatch method
+func (mmSaveJetDropsBatch *mStorageSetterMockSaveJetDropsBatch) Set(f func(pulse models.Pulse, pulseJetIDs []string, jetDrops []models.JetDropData) (err error)) *StorageSetterMock {
+	if mmSaveJetDropsBatch.defaultExpectation != nil {
+		mmSaveJetDropsBatch.mock.t.Fatalf("Default expectation is already set for the StorageSetter.SaveJetDropsBatch method")
+	}
+
+	if len(mmSaveJetDropsBatch.expectations) > 0 {
+		mmSaveJetDropsBatch.mock.t.Fatalf("Some expectations are already set for the StorageSetter.SaveJetDropsBatch method")
+	}
+
+	mmSaveJetDropsBatch.mock.funcSaveJetDropsBatch = f
+	return mmSaveJetDropsBatch.mock
+}
+
+// When sets expectation for the StorageSetter.SaveJetDropsBatch which will trigger the result defined by the following
+// Then helper
+func (mmSaveJetDropsBatch *mStorageSetterMockSaveJetDropsBatch) When(pulse models.Pulse, pulseJetIDs []string, jetDrops []models.JetDropData) *StorageSetterMockSaveJetDropsBatchExpectation {
+	if mmSaveJetDropsBatch.mock.funcSaveJetDropsBatch != nil {
+		mmSaveJetDropsBatch.mock.t.Fatalf("StorageSetterMock.SaveJetDropsBatch mock is already set by Set")
+	}
+
+	expectation := &StorageSetterMockSaveJetDropsBatchExpectation{
+		mock:   mmSaveJetDropsBatch.mock,
+		params: &StorageSetterMockSaveJetDropsBatchParams{pulse, pulseJetIDs, jetDrops},
+	}
+	mmSaveJetDropsBatch.expectations = append(mmSaveJetDropsBatch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StorageSetter.SaveJetDropsBatch return parameters for the expectation previously defined by the When method
+func (e *StorageSetterMockSaveJetDropsBatchExpectation) Then(err error) *StorageSetterMock {
+	e.results = &StorageSetterMockSaveJetDropsBatchResults{err}
+	return e.mock
+}
+
+// SaveJetDropsBatch implements interfaces.StorageSetter
+func (mmSaveJetDropsBatch *StorageSetterMock) SaveJetDropsBatch(pulse models.Pulse, pulseJetIDs []string, jetDrops []models.JetDropData) (err error) {
+	mm_atomic.AddUint64(&mmSaveJetDropsBatch.beforeSaveJetDropsBatchCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveJetDropsBatch.afterSaveJetDropsBatchCounter, 1)
+
+	if mmSaveJetDropsBatch.inspectFuncSaveJetDropsBatch != nil {
+		mmSaveJetDropsBatch.inspectFuncSaveJetDropsBatch(pulse, pulseJetIDs, jetDrops)
+	}
+
+	mm_params := &StorageSetterMockSaveJetDropsBatchParams{pulse, pulseJetIDs, jetDrops}
+
+	// Record call args
+	mmSaveJetDropsBatch.SaveJetDropsBatchMock.mutex.Lock()
+	mmSaveJetDropsBatch.SaveJetDropsBatchMock.callArgs = append(mmSaveJetDropsBatch.SaveJetDropsBatchMock.callArgs, mm_params)
+	mmSaveJetDropsBatch.SaveJetDropsBatchMock.mutex.Unlock()
+
+	for _, e := range mmSaveJetDropsBatch.SaveJetDropsBatchMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveJetDropsBatch.SaveJetDropsBatchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveJetDropsBatch.SaveJetDropsBatchMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveJetDropsBatch.SaveJetDropsBatchMock.defaultExpectation.params
+		mm_got := StorageSetterMockSaveJetDropsBatchParams{pulse, pulseJetIDs, jetDrops}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveJetDropsBatch.t.Errorf("StorageSetterMock.SaveJetDropsBatch got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveJetDropsBatch.SaveJetDropsBatchMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveJetDropsBatch.t.Fatal("No results are set for the StorageSetterMock.SaveJetDropsBatch")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveJetDropsBatch.funcSaveJetDropsBatch != nil {
+		return mmSaveJetDropsBatch.funcSaveJetDropsBatch(pulse, pulseJetIDs, jetDrops)
+	}
+	mmSaveJetDropsBatch.t.Fatalf("Unexpected call to StorageSetterMock.SaveJetDropsBatch. %v %v %v", pulse, pulseJetIDs, jetDrops)
+	return
+}
+
+// SaveJetDropsBatchAfterCounter returns a count of finished StorageSetterMock.SaveJetDropsBatch invocations
+func (mmSaveJetDropsBatch *StorageSetterMock) SaveJetDropsBatchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveJetDropsBatch.afterSaveJetDropsBatchCounter)
+}
+
+// SaveJetDropsBatchBeforeCounter returns a count of StorageSetterMock.SaveJetDropsBatch invocations
+func (mmSaveJetDropsBatch *StorageSetterMock) SaveJetDropsBatchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveJetDropsBatch.beforeSaveJetDropsBatchCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageSetterMock.SaveJetDropsBatch.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveJetDropsBatch *mStorageSetterMockSaveJetDropsBatch) Calls() []*StorageSetterMockSaveJetDropsBatchParams {
+	mmSaveJetDropsBatch.mutex.RLock()
+
+	argCopy := make([]*StorageSetterMockSaveJetDropsBatchParams, len(mmSaveJetDropsBatch.callArgs))
+	copy(argCopy, mmSaveJetDropsBatch.callArgs)
+
+	mmSaveJetDropsBatch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveJetDropsBatchDone returns true if the count of the SaveJetDropsBatch invocations corresponds
+// the number of defined expectations
+func (m *StorageSetterMock) MinimockSaveJetDropsBatchDone() bool {
+	for _, e := range m.SaveJetDropsBatchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveJetDropsBatchMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveJetDropsBatchCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveJetDropsBatch != nil && mm_atomic.LoadUint64(&m.afterSaveJetDropsBatchCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSaveJetDropsBatchInspect logs each unmet expectation
+func (m *StorageSetterMock) MinimockSaveJetDropsBatchInspect() {
+	for _, e := range m.SaveJetDropsBatchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageSetterMock.SaveJetDropsBatch with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveJetDropsBatchMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveJetDropsBatchCounter) < 1 {
+		if m.SaveJetDropsBatchMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StorageSetterMock.SaveJetDropsBatch")
+		} else {
+			m.t.Errorf("Expected call to StorageSetterMock.SaveJetDropsBatch with params: %#v", *m.SaveJetDropsBatchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveJetDropsBatch != nil && mm_atomic.LoadUint64(&m.afterSaveJetDropsBatchCounter) < 1 {
+		m.t.Error("Expected call to StorageSetterMock.SaveJetDropsBatch")
+	}
+}
+
 type mStorageSetterMockSavePulse struct {
 	mock               *StorageSetterMock
 	defaultExpectation *StorageSetterMockSavePulseExpectation
@@ -709,6 +1178,437 @@ func (m *StorageSetterMock) MinimockSavePulseInspect() {
 	}
 }
 
+type mStorageSetterMockSavePulseJets struct {
+	mock               *StorageSetterMock
+	defaultExpectation *StorageSetterMockSavePulseJetsExpectation
+	expectations       []*StorageSetterMockSavePulseJetsExpectation
+
+	callArgs []*StorageSetterMockSavePulseJetsParams
+	mutex    sync.RWMutex
+}
+
+// StorageSetterMockSavePulseJetsExpectation specifies expectation struct of the StorageSetter.SavePulseJets
+type StorageSetterMockSavePulseJetsExpectation struct {
+	mock    *StorageSetterMock
+	params  *StorageSetterMockSavePulseJetsParams
+	results *StorageSetterMockSavePulseJetsResults
+	Counter uint64
+}
+
+// StorageSetterMockSavePulseJetsParams contains parameters of the StorageSetter.SavePulseJets
+type StorageSetterMockSavePulseJetsParams struct {
+	pulseNumber int64
+	jetIDs      []string
+}
+
+// StorageSetterMockSavePulseJetsResults contains results of the StorageSetter.SavePulseJets
+type StorageSetterMockSavePulseJetsResults struct {
+	err error
+}
+
+// Expect sets up expected params for StorageSetter.SavePulseJets
+func (mmSavePulseJets *mStorageSetterMockSavePulseJets) Expect(pulseNumber int64, jetIDs []string) *mStorageSetterMockSavePulseJets {
+	if mmSavePulseJets.mock.funcSavePulseJets != nil {
+		mmSavePulseJets.mock.t.Fatalf("StorageSetterMock.SavePulseJets mock is already set by Set")
+	}
+
+	if mmSavePulseJets.defaultExpectation == nil {
+		mmSavePulseJets.defaultExpectation = &StorageSetterMockSavePulseJetsExpectation{}
+	}
+
+	mmSavePulseJets.defaultExpectation.params = &StorageSetterMockSavePulseJetsParams{pulseNumber, jetIDs}
+	for _, e := range mmSavePulseJets.expectations {
+		if minimock.Equal(e.params, mmSavePulseJets.defaultExpectation.params) {
+			mmSavePulseJets.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSavePulseJets.defaultExpectation.params)
+		}
+	}
+
+	return mmSavePulseJets
+}
+
+// Inspect accepts an inspector function that has same arguments as the StorageSetter.SavePulseJets
+func (mmSavePulseJets *mStorageSetterMockSavePulseJets) Inspect(f func(pulseNumber int64, jetIDs []string)) *mStorageSetterMockSavePulseJets {
+	if mmSavePulseJets.mock.inspectFuncSavePulseJets != nil {
+		mmSavePulseJets.mock.t.Fatalf("Inspect function is already set for StorageSetterMock.SavePulseJets")
+	}
+
+	mmSavePulseJets.mock.inspectFuncSavePulseJets = f
+
+	return mmSavePulseJets
+}
+
+// Return sets up results that will be returned by StorageSetter.SavePulseJets
+func (mmSavePulseJets *mStorageSetterMockSavePulseJets) Return(err error) *StorageSetterMock {
+	if mmSavePulseJets.mock.funcSavePulseJets != nil {
+		mmSavePulseJets.mock.t.Fatalf("StorageSetterMock.SavePulseJets mock is already set by Set")
+	}
+
+	if mmSavePulseJets.defaultExpectation == nil {
+		mmSavePulseJets.defaultExpectation = &StorageSetterMockSavePulseJetsExpectation{mock: mmSavePulseJets.mock}
+	}
+	mmSavePulseJets.defaultExpectation.results = &StorageSetterMockSavePulseJetsResults{err}
+	return mmSavePulseJets.mock
+}
+
+//Set uses given function f to mock the StorageSetter.SavePulseJets method
+func (mmSavePulseJets *mStorageSetterMockSavePulseJets) Set(f func(pulseNumber int64, jetIDs []string) (err error)) *StorageSetterMock {
+	if mmSavePulseJets.defaultExpectation != nil {
+		mmSavePulseJets.mock.t.Fatalf("Default expectation is already set for the StorageSetter.SavePulseJets method")
+	}
+
+	if len(mmSavePulseJets.expectations) > 0 {
+		mmSavePulseJets.mock.t.Fatalf("Some expectations are already set for the StorageSetter.SavePulseJets method")
+	}
+
+	mmSavePulseJets.mock.funcSavePulseJets = f
+	return mmSavePulseJets.mock
+}
+
+// When sets expectation for the StorageSetter.SavePulseJets which will trigger the result defined by the following
+// Then helper
+func (mmSavePulseJets *mStorageSetterMockSavePulseJets) When(pulseNumber int64, jetIDs []string) *StorageSetterMockSavePulseJetsExpectation {
+	if mmSavePulseJets.mock.funcSavePulseJets != nil {
+		mmSavePulseJets.mock.t.Fatalf("StorageSetterMock.SavePulseJets mock is already set by Set")
+	}
+
+	expectation := &StorageSetterMockSavePulseJetsExpectation{
+		mock:   mmSavePulseJets.mock,
+		params: &StorageSetterMockSavePulseJetsParams{pulseNumber, jetIDs},
+	}
+	mmSavePulseJets.expectations = append(mmSavePulseJets.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StorageSetter.SavePulseJets return parameters for the expectation previously defined by the When method
+func (e *StorageSetterMockSavePulseJetsExpectation) Then(err error) *StorageSetterMock {
+	e.results = &StorageSetterMockSavePulseJetsResults{err}
+	return e.mock
+}
+
+// SavePulseJets implements interfaces.StorageSetter
+func (mmSavePulseJets *StorageSetterMock) SavePulseJets(pulseNumber int64, jetIDs []string) (err error) {
+	mm_atomic.AddUint64(&mmSavePulseJets.beforeSavePulseJetsCounter, 1)
+	defer mm_atomic.AddUint64(&mmSavePulseJets.afterSavePulseJetsCounter, 1)
+
+	if mmSavePulseJets.inspectFuncSavePulseJets != nil {
+		mmSavePulseJets.inspectFuncSavePulseJets(pulseNumber, jetIDs)
+	}
+
+	mm_params := &StorageSetterMockSavePulseJetsParams{pulseNumber, jetIDs}
+
+	// Record call args
+	mmSavePulseJets.SavePulseJetsMock.mutex.Lock()
+	mmSavePulseJets.SavePulseJetsMock.callArgs = append(mmSavePulseJets.SavePulseJetsMock.callArgs, mm_params)
+	mmSavePulseJets.SavePulseJetsMock.mutex.Unlock()
+
+	for _, e := range mmSavePulseJets.SavePulseJetsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSavePulseJets.SavePulseJetsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSavePulseJets.SavePulseJetsMock.defaultExpectation.Counter, 1)
+		mm_want := mmSavePulseJets.SavePulseJetsMock.defaultExpectation.params
+		mm_got := StorageSetterMockSavePulseJetsParams{pulseNumber, jetIDs}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSavePulseJets.t.Errorf("StorageSetterMock.SavePulseJets got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSavePulseJets.SavePulseJetsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSavePulseJets.t.Fatal("No results are set for the StorageSetterMock.SavePulseJets")
+		}
+		return (*mm_results).err
+	}
+	if mmSavePulseJets.funcSavePulseJets != nil {
+		return mmSavePulseJets.funcSavePulseJets(pulseNumber, jetIDs)
+	}
+	mmSavePulseJets.t.Fatalf("Unexpected call to StorageSetterMock.SavePulseJets. %v %v", pulseNumber, jetIDs)
+	return
+}
+
+// SavePulseJetsAfterCounter returns a count of finished StorageSetterMock.SavePulseJets invocations
+func (mmSavePulseJets *StorageSetterMock) SavePulseJetsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSavePulseJets.afterSavePulseJetsCounter)
+}
+
+// SavePulseJetsBeforeCounter returns a count of StorageSetterMock.SavePulseJets invocations
+func (mmSavePulseJets *StorageSetterMock) SavePulseJetsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSavePulseJets.beforeSavePulseJetsCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageSetterMock.SavePulseJets.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSavePulseJets *mStorageSetterMockSavePulseJets) Calls() []*StorageSetterMockSavePulseJetsParams {
+	mmSavePulseJets.mutex.RLock()
+
+	argCopy := make([]*StorageSetterMockSavePulseJetsParams, len(mmSavePulseJets.callArgs))
+	copy(argCopy, mmSavePulseJets.callArgs)
+
+	mmSavePulseJets.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSavePulseJetsDone returns true if the count of the SavePulseJets invocations corresponds
+// the number of defined expectations
+func (m *StorageSetterMock) MinimockSavePulseJetsDone() bool {
+	for _, e := range m.SavePulseJetsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SavePulseJetsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSavePulseJetsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSavePulseJets != nil && mm_atomic.LoadUint64(&m.afterSavePulseJetsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSavePulseJetsInspect logs each unmet expectation
+func (m *StorageSetterMock) MinimockSavePulseJetsInspect() {
+	for _, e := range m.SavePulseJetsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageSetterMock.SavePulseJets with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SavePulseJetsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSavePulseJetsCounter) < 1 {
+		if m.SavePulseJetsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StorageSetterMock.SavePulseJets")
+		} else {
+			m.t.Errorf("Expected call to StorageSetterMock.SavePulseJets with params: %#v", *m.SavePulseJetsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSavePulseJets != nil && mm_atomic.LoadUint64(&m.afterSavePulseJetsCounter) < 1 {
+		m.t.Error("Expected call to StorageSetterMock.SavePulseJets")
+	}
+}
+
+type mStorageSetterMockSaveRecordExtensions struct {
+	mock               *StorageSetterMock
+	defaultExpectation *StorageSetterMockSaveRecordExtensionsExpectation
+	expectations       []*StorageSetterMockSaveRecordExtensionsExpectation
+
+	callArgs []*StorageSetterMockSaveRecordExtensionsParams
+	mutex    sync.RWMutex
+}
+
+// StorageSetterMockSaveRecordExtensionsExpectation specifies expectation struct of the StorageSetter.SaveRecordExtensions
+type StorageSetterMockSaveRecordExtensionsExpectation struct {
+	mock    *StorageSetterMock
+	params  *StorageSetterMockSaveRecordExtensionsParams
+	results *StorageSetterMockSaveRecordExtensionsResults
+	Counter uint64
+}
+
+// StorageSetterMockSaveRecordExtensionsParams contains parameters of the StorageSetter.SaveRecordExtensions
+type StorageSetterMockSaveRecordExtensionsParams struct {
+	extensions []models.RecordExtension
+}
+
+// StorageSetterMockSaveRecordExtensionsResults contains results of the StorageSetter.SaveRecordExtensions
+type StorageSetterMockSaveRecordExtensionsResults struct {
+	err error
+}
+
+// Expect sets up expected params for StorageSetter.SaveRecordExtensions
+func (mmSaveRecordExtensions *mStorageSetterMockSaveRecordExtensions) Expect(extensions []models.RecordExtension) *mStorageSetterMockSaveRecordExtensions {
+	if mmSaveRecordExtensions.mock.funcSaveRecordExtensions != nil {
+		mmSaveRecordExtensions.mock.t.Fatalf("StorageSetterMock.SaveRecordExtensions mock is already set by Set")
+	}
+
+	if mmSaveRecordExtensions.defaultExpectation == nil {
+		mmSaveRecordExtensions.defaultExpectation = &StorageSetterMockSaveRecordExtensionsExpectation{}
+	}
+
+	mmSaveRecordExtensions.defaultExpectation.params = &StorageSetterMockSaveRecordExtensionsParams{extensions}
+	for _, e := range mmSaveRecordExtensions.expectations {
+		if minimock.Equal(e.params, mmSaveRecordExtensions.defaultExpectation.params) {
+			mmSaveRecordExtensions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveRecordExtensions.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveRecordExtensions
+}
+
+// Inspect accepts an inspector function that has same arguments as the StorageSetter.SaveRecordExtensions
+func (mmSaveRecordExtensions *mStorageSetterMockSaveRecordExtensions) Inspect(f func(extensions []models.RecordExtension)) *mStorageSetterMockSaveRecordExtensions {
+	if mmSaveRecordExtensions.mock.inspectFuncSaveRecordExtensions != nil {
+		mmSaveRecordExtensions.mock.t.Fatalf("Inspect function is already set for StorageSetterMock.SaveRecordExtensions")
+	}
+
+	mmSaveRecordExtensions.mock.inspectFuncSaveRecordExtensions = f
+
+	return mmSaveRecordExtensions
+}
+
+// Return sets up results that will be returned by StorageSetter.SaveRecordExtensions
+func (mmSaveRecordExtensions *mStorageSetterMockSaveRecordExtensions) Return(err error) *StorageSetterMock {
+	if mmSaveRecordExtensions.mock.funcSaveRecordExtensions != nil {
+		mmSaveRecordExtensions.mock.t.Fatalf("StorageSetterMock.SaveRecordExtensions mock is already set by Set")
+	}
+
+	if mmSaveRecordExtensions.defaultExpectation == nil {
+		mmSaveRecordExtensions.defaultExpectation = &StorageSetterMockSaveRecordExtensionsExpectation{mock: mmSaveRecordExtensions.mock}
+	}
+	mmSaveRecordExtensions.defaultExpectation.results = &StorageSetterMockSaveRecordExtensionsResults{err}
+	return mmSaveRecordExtensions.mock
+}
+
+//Set uses given function f to mock the StorageSetter.SaveRecordExtensions method
+func (mmSaveRecordExtensions *mStorageSetterMockSaveRecordExtensions) Set(f func(extensions []models.RecordExtension) (err error)) *StorageSetterMock {
+	if mmSaveRecordExtensions.defaultExpectation != nil {
+		mmSaveRecordExtensions.mock.t.Fatalf("Default expectation is already set for the StorageSetter.SaveRecordExtensions method")
+	}
+
+	if len(mmSaveRecordExtensions.expectations) > 0 {
+		mmSaveRecordExtensions.mock.t.Fatalf("Some expectations are already set for the StorageSetter.SaveRecordExtensions method")
+	}
+
+	mmSaveRecordExtensions.mock.funcSaveRecordExtensions = f
+	return mmSaveRecordExtensions.mock
+}
+
+// When sets expectation for the StorageSetter.SaveRecordExtensions which will trigger the result defined by the following
+// Then helper
+func (mmSaveRecordExtensions *mStorageSetterMockSaveRecordExtensions) When(extensions []models.RecordExtension) *StorageSetterMockSaveRecordExtensionsExpectation {
+	if mmSaveRecordExtensions.mock.funcSaveRecordExtensions != nil {
+		mmSaveRecordExtensions.mock.t.Fatalf("StorageSetterMock.SaveRecordExtensions mock is already set by Set")
+	}
+
+	expectation := &StorageSetterMockSaveRecordExtensionsExpectation{
+		mock:   mmSaveRecordExtensions.mock,
+		params: &StorageSetterMockSaveRecordExtensionsParams{extensions},
+	}
+	mmSaveRecordExtensions.expectations = append(mmSaveRecordExtensions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StorageSetter.SaveRecordExtensions return parameters for the expectation previously defined by the When method
+func (e *StorageSetterMockSaveRecordExtensionsExpectation) Then(err error) *StorageSetterMock {
+	e.results = &StorageSetterMockSaveRecordExtensionsResults{err}
+	return e.mock
+}
+
+// SaveRecordExtensions implements interfaces.StorageSetter
+func (mmSaveRecordExtensions *StorageSetterMock) SaveRecordExtensions(extensions []models.RecordExtension) (err error) {
+	mm_atomic.AddUint64(&mmSaveRecordExtensions.beforeSaveRecordExtensionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveRecordExtensions.afterSaveRecordExtensionsCounter, 1)
+
+	if mmSaveRecordExtensions.inspectFuncSaveRecordExtensions != nil {
+		mmSaveRecordExtensions.inspectFuncSaveRecordExtensions(extensions)
+	}
+
+	mm_params := &StorageSetterMockSaveRecordExtensionsParams{extensions}
+
+	// Record call args
+	mmSaveRecordExtensions.SaveRecordExtensionsMock.mutex.Lock()
+	mmSaveRecordExtensions.SaveRecordExtensionsMock.callArgs = append(mmSaveRecordExtensions.SaveRecordExtensionsMock.callArgs, mm_params)
+	mmSaveRecordExtensions.SaveRecordExtensionsMock.mutex.Unlock()
+
+	for _, e := range mmSaveRecordExtensions.SaveRecordExtensionsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveRecordExtensions.SaveRecordExtensionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveRecordExtensions.SaveRecordExtensionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveRecordExtensions.SaveRecordExtensionsMock.defaultExpectation.params
+		mm_got := StorageSetterMockSaveRecordExtensionsParams{extensions}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveRecordExtensions.t.Errorf("StorageSetterMock.SaveRecordExtensions got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveRecordExtensions.SaveRecordExtensionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveRecordExtensions.t.Fatal("No results are set for the StorageSetterMock.SaveRecordExtensions")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveRecordExtensions.funcSaveRecordExtensions != nil {
+		return mmSaveRecordExtensions.funcSaveRecordExtensions(extensions)
+	}
+	mmSaveRecordExtensions.t.Fatalf("Unexpected call to StorageSetterMock.SaveRecordExtensions. %v", extensions)
+	return
+}
+
+// SaveRecordExtensionsAfterCounter returns a count of finished StorageSetterMock.SaveRecordExtensions invocations
+func (mmSaveRecordExtensions *StorageSetterMock) SaveRecordExtensionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveRecordExtensions.afterSaveRecordExtensionsCounter)
+}
+
+// SaveRecordExtensionsBeforeCounter returns a count of StorageSetterMock.SaveRecordExtensions invocations
+func (mmSaveRecordExtensions *StorageSetterMock) SaveRecordExtensionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveRecordExtensions.beforeSaveRecordExtensionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageSetterMock.SaveRecordExtensions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveRecordExtensions *mStorageSetterMockSaveRecordExtensions) Calls() []*StorageSetterMockSaveRecordExtensionsParams {
+	mmSaveRecordExtensions.mutex.RLock()
+
+	argCopy := make([]*StorageSetterMockSaveRecordExtensionsParams, len(mmSaveRecordExtensions.callArgs))
+	copy(argCopy, mmSaveRecordExtensions.callArgs)
+
+	mmSaveRecordExtensions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveRecordExtensionsDone returns true if the count of the SaveRecordExtensions invocations corresponds
+// the number of defined expectations
+func (m *StorageSetterMock) MinimockSaveRecordExtensionsDone() bool {
+	for _, e := range m.SaveRecordExtensionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveRecordExtensionsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveRecordExtensionsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveRecordExtensions != nil && mm_atomic.LoadUint64(&m.afterSaveRecordExtensionsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSaveRecordExtensionsInspect logs each unmet expectation
+func (m *StorageSetterMock) MinimockSaveRecordExtensionsInspect() {
+	for _, e := range m.SaveRecordExtensionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageSetterMock.SaveRecordExtensions with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveRecordExtensionsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveRecordExtensionsCounter) < 1 {
+		if m.SaveRecordExtensionsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StorageSetterMock.SaveRecordExtensions")
+		} else {
+			m.t.Errorf("Expected call to StorageSetterMock.SaveRecordExtensions with params: %#v", *m.SaveRecordExtensionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveRecordExtensions != nil && mm_atomic.LoadUint64(&m.afterSaveRecordExtensionsCounter) < 1 {
+		m.t.Error("Expected call to StorageSetterMock.SaveRecordExtensions")
+	}
+}
+
 type mStorageSetterMockSequencePulse struct {
 	mock               *StorageSetterMock
 	defaultExpectation *StorageSetterMockSequencePulseExpectation
@@ -929,10 +1829,18 @@ func (m *StorageSetterMock) MinimockFinish() {
 	if !m.minimockDone() {
 		m.MinimockCompletePulseInspect()
 
+		m.MinimockDeletePulsesInspect()
+
 		m.MinimockSaveJetDropDataInspect()
 
+		m.MinimockSaveJetDropsBatchInspect()
+
 		m.MinimockSavePulseInspect()
 
+		m.MinimockSavePulseJetsInspect()
+
+		m.MinimockSaveRecordExtensionsInspect()
+
 		m.MinimockSequencePulseInspect()
 		m.t.FailNow()
 	}
@@ -958,7 +1866,11 @@ func (m *StorageSetterMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockCompletePulseDone() &&
+		m.MinimockDeletePulsesDone() &&
 		m.MinimockSaveJetDropDataDone() &&
+		m.MinimockSaveJetDropsBatchDone() &&
 		m.MinimockSavePulseDone() &&
+		m.MinimockSavePulseJetsDone() &&
+		m.MinimockSaveRecordExtensionsDone() &&
 		m.MinimockSequencePulseDone()
 }
@@ -0,0 +1,250 @@
+package leader
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/block-explorer/configuration"
+	"github.com/insolar/block-explorer/instrumentation/belogger"
+)
+
+const (
+	RoleLeader  = "leader"
+	RoleStandby = "standby"
+)
+
+// Elector elects the leader among the block-explorer instances sharing the database.
+// The leader holds the Postgres session advisory lock on a dedicated connection, so the lock is released
+// by the database as soon as the leader dies, and one of the standby instances takes it on the next attempt.
+// The lock session has short TCP keepalives, so the database also releases the lock if the leader host dies
+// or the link breaks without closing the connection. The leader gives up the leadership as soon as a check
+// of the lock connection doesn't complete within the period, that is before the database drops its session.
+type Elector struct {
+	db  *sql.DB
+	cfg configuration.LeaderElection
+
+	leader  int32
+	elected chan struct{}
+	lost    chan struct{}
+
+	cancel context.CancelFunc
+	done   chan struct{}
+	mu     sync.Mutex
+}
+
+func NewElector(db *sql.DB, cfg configuration.LeaderElection) *Elector {
+	return &Elector{
+		db:      db,
+		cfg:     cfg,
+		elected: make(chan struct{}),
+		lost:    make(chan struct{}),
+	}
+}
+
+// Start campaigns for the leadership in the background
+func (e *Elector) Start(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.cancel != nil {
+		return nil
+	}
+	if e.cfg.SessionTimeout <= e.cfg.Period {
+		return errors.New("session timeout must be greater than the period, otherwise the lock may be taken while the leader runs")
+	}
+
+	IsLeader.Set(0)
+	ctx, e.cancel = context.WithCancel(ctx)
+	e.done = make(chan struct{})
+	go e.run(ctx)
+	return nil
+}
+
+// Stop stops the campaign and releases the leadership if it's held
+func (e *Elector) Stop(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.cancel == nil {
+		return nil
+	}
+
+	e.cancel()
+	<-e.done
+	e.cancel = nil
+	return nil
+}
+
+// Elected returns the channel closed when the instance is elected as the leader
+func (e *Elector) Elected() <-chan struct{} {
+	return e.elected
+}
+
+// IsLeader returns true if the instance holds the leadership
+func (e *Elector) IsLeader() bool {
+	return atomic.LoadInt32(&e.leader) == 1
+}
+
+// Role returns the current role of the instance
+func (e *Elector) Role() string {
+	if e.IsLeader() {
+		return RoleLeader
+	}
+	return RoleStandby
+}
+
+// NotifyShutdown sends a notification to the channel when the leadership is lost,
+// so the instance stops before another one takes over the ETL
+func (e *Elector) NotifyShutdown(ctx context.Context, stopChannel chan<- struct{}) {
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-e.lost:
+			stopChannel <- struct{}{}
+		}
+	}()
+}
+
+func (e *Elector) run(ctx context.Context) {
+	defer close(e.done)
+	log := belogger.FromContext(ctx)
+
+	var conn *sql.Conn
+	defer func() {
+		if conn != nil {
+			e.release(ctx, conn)
+		}
+	}()
+
+	for {
+		if e.IsLeader() {
+			if err := e.check(ctx, conn); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				log.Errorf("Leadership is lost: %s", err)
+				// closing waits for the check that may hang on the half-open connection
+				go conn.Close()
+				conn = nil
+				e.setLeader(false)
+				Losses.Inc()
+				closeOnce(e.lost)
+				return
+			}
+		} else {
+			var err error
+			conn, err = e.tryLock(ctx, conn)
+			if err != nil && ctx.Err() == nil {
+				log.Warnf("Failed to take the leadership: %s", err)
+			}
+			if e.IsLeader() {
+				log.Infof("The instance is elected as the leader by advisory lock %d", e.cfg.LockID)
+				Elections.Inc()
+				closeOnce(e.elected)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(e.cfg.Period):
+		}
+	}
+}
+
+// tryLock tries to take the advisory lock on the dedicated connection, the connection is reopened if it's broken
+func (e *Elector) tryLock(ctx context.Context, conn *sql.Conn) (*sql.Conn, error) {
+	ctx, cancel := context.WithTimeout(ctx, e.cfg.Period)
+	defer cancel()
+
+	if conn == nil {
+		var err error
+		conn, err = e.db.Conn(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to open connection for the advisory lock")
+		}
+		if err := e.setKeepalives(ctx, conn); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+
+	var locked bool
+	err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", e.cfg.LockID).Scan(&locked)
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "failed to take the advisory lock")
+	}
+	e.setLeader(locked)
+	return conn, nil
+}
+
+// setKeepalives sets the TCP keepalives of the lock session, so the database closes the session
+// of a dead leader and releases its lock within the session timeout
+func (e *Elector) setKeepalives(ctx context.Context, conn *sql.Conn) error {
+	// the session is dropped after the idle time and two unanswered probes
+	interval := int64(e.cfg.SessionTimeout / time.Second / 3)
+	if interval < 1 {
+		interval = 1
+	}
+	_, err := conn.ExecContext(ctx,
+		"SELECT set_config('tcp_keepalives_idle', $1, false), set_config('tcp_keepalives_interval', $1, false), set_config('tcp_keepalives_count', '2', false)",
+		strconv.FormatInt(interval, 10))
+	return errors.Wrap(err, "failed to set keepalives of the advisory lock connection")
+}
+
+// check pings the connection holding the advisory lock, the lock is held while the session is alive
+func (e *Elector) check(ctx context.Context, conn *sql.Conn) error {
+	ctx, cancel := context.WithTimeout(ctx, e.cfg.Period)
+	defer cancel()
+
+	// the driver waits for the response of the half-open connection much longer than the deadline
+	result := make(chan error, 1)
+	go func() {
+		result <- conn.PingContext(ctx)
+	}()
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "lock connection doesn't respond")
+	}
+}
+
+// release unlocks the advisory lock if it's held and returns the connection to the pool
+func (e *Elector) release(ctx context.Context, conn *sql.Conn) {
+	if e.IsLeader() {
+		// ctx is already cancelled by Stop
+		unlockCtx, cancel := context.WithTimeout(context.Background(), e.cfg.Period)
+		defer cancel()
+		_, err := conn.ExecContext(unlockCtx, "SELECT pg_advisory_unlock($1)", e.cfg.LockID)
+		if err != nil {
+			belogger.FromContext(ctx).Errorf("Failed to release the advisory lock: %s", err)
+		}
+		e.setLeader(false)
+	}
+	conn.Close()
+}
+
+func (e *Elector) setLeader(leader bool) {
+	if leader {
+		atomic.StoreInt32(&e.leader, 1)
+		IsLeader.Set(1)
+		return
+	}
+	atomic.StoreInt32(&e.leader, 0)
+	IsLeader.Set(0)
+}
+
+// closeOnce closes the notification channel if it isn't closed by the previous campaign
+func closeOnce(ch chan struct{}) {
+	select {
+	case <-ch:
+	default:
+		close(ch)
+	}
+}
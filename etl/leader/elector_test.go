@@ -0,0 +1,137 @@
+// +build integration
+
+package leader
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/block-explorer/configuration"
+	"github.com/insolar/block-explorer/etl/dbconn"
+	"github.com/insolar/block-explorer/instrumentation/belogger"
+	"github.com/insolar/block-explorer/testutils"
+)
+
+var electionConfig = configuration.LeaderElection{
+	Enabled:        true,
+	LockID:         4242,
+	Period:         50 * time.Millisecond,
+	SessionTimeout: 3 * time.Second,
+}
+
+// connectInstances opens separate connection pools as the block-explorer instances do
+func connectInstances(t *testing.T, count int) []*sql.DB {
+	dbName := "test_db"
+	dbPassword := "secret"
+	pool, resource, poolCleaner := testutils.RunDBInDocker(dbName, dbPassword)
+	t.Cleanup(poolCleaner)
+
+	cfg := configuration.DB{
+		URL:          fmt.Sprintf("postgres://postgres:%s@localhost:%s/%s?sslmode=disable", dbPassword, resource.GetPort("5432/tcp"), dbName),
+		MaxOpenConns: 10,
+	}
+	var result []*sql.DB
+	for i := 0; i < count; i++ {
+		var db *gorm.DB
+		err := pool.Retry(func() error {
+			var err error
+			db, err = dbconn.Connect(cfg)
+			if err != nil {
+				return err
+			}
+			return db.DB().Ping()
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() { db.Close() })
+		result = append(result, db.DB())
+	}
+	return result
+}
+
+func waitElected(t *testing.T, e *Elector) {
+	select {
+	case <-e.Elected():
+	case <-time.After(10 * time.Second):
+		t.Fatal("instance isn't elected")
+	}
+}
+
+func TestElector_Failover(t *testing.T) {
+	ctx := belogger.TestContext(t)
+	dbs := connectInstances(t, 2)
+	first, second := NewElector(dbs[0], electionConfig), NewElector(dbs[1], electionConfig)
+
+	require.NoError(t, first.Start(ctx))
+	defer first.Stop(ctx)
+	waitElected(t, first)
+	require.Equal(t, RoleLeader, first.Role())
+
+	require.NoError(t, second.Start(ctx))
+	defer second.Stop(ctx)
+	time.Sleep(5 * electionConfig.Period)
+	require.Equal(t, RoleStandby, second.Role(), "the lock is held by the leader")
+
+	require.NoError(t, first.Stop(ctx))
+	require.Equal(t, RoleStandby, first.Role())
+	waitElected(t, second)
+	require.Equal(t, RoleLeader, second.Role())
+}
+
+func TestElector_SessionKeepalives(t *testing.T) {
+	ctx := belogger.TestContext(t)
+	dbs := connectInstances(t, 1)
+	e := NewElector(dbs[0], electionConfig)
+
+	conn, err := e.tryLock(ctx, nil)
+	require.NoError(t, err)
+	defer e.release(ctx, conn)
+	require.True(t, e.IsLeader())
+
+	for name, expected := range map[string]string{
+		"tcp_keepalives_idle":     "1",
+		"tcp_keepalives_interval": "1",
+		"tcp_keepalives_count":    "2",
+	} {
+		var value string
+		require.NoError(t, conn.QueryRowContext(ctx, "SELECT current_setting($1)", name).Scan(&value))
+		require.Equal(t, expected, value, name)
+	}
+}
+
+func TestElector_WrongSessionTimeout(t *testing.T) {
+	ctx := belogger.TestContext(t)
+	cfg := electionConfig
+	cfg.SessionTimeout = cfg.Period
+	require.Error(t, NewElector(nil, cfg).Start(ctx))
+}
+
+func TestElector_LostLeadership(t *testing.T) {
+	ctx := belogger.TestContext(t)
+	dbs := connectInstances(t, 2)
+	first, second := NewElector(dbs[0], electionConfig), NewElector(dbs[1], electionConfig)
+
+	require.NoError(t, first.Start(ctx))
+	defer first.Stop(ctx)
+	waitElected(t, first)
+	stopChannel := make(chan struct{}, 1)
+	first.NotifyShutdown(ctx, stopChannel)
+	require.NoError(t, second.Start(ctx))
+	defer second.Stop(ctx)
+
+	_, err := dbs[1].Exec("SELECT pg_terminate_backend(pid) FROM pg_locks WHERE locktype = 'advisory' AND objid = $1 AND granted",
+		electionConfig.LockID)
+	require.NoError(t, err)
+
+	select {
+	case <-stopChannel:
+	case <-time.After(10 * time.Second):
+		t.Fatal("shutdown isn't notified")
+	}
+	require.Equal(t, RoleStandby, first.Role())
+	waitElected(t, second)
+}
@@ -0,0 +1,36 @@
+package leader
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/insolar/block-explorer/instrumentation/metrics"
+)
+
+var (
+	IsLeader = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "gbe_leader_is_leader",
+		Help: "1 if the instance is the leader running the ETL, 0 if it's standby",
+	})
+	Elections = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "gbe_leader_elections",
+		Help: "The number of times the instance was elected as the leader",
+	})
+	Losses = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "gbe_leader_losses",
+		Help: "The number of times the instance lost the leadership",
+	})
+)
+
+type Metrics struct{}
+
+func (s Metrics) Refresh() {
+	// nothing to refresh
+}
+
+func (s Metrics) Metrics(p *metrics.Prometheus) []prometheus.Collector {
+	return []prometheus.Collector{
+		IsLeader,
+		Elections,
+		Losses,
+	}
+}
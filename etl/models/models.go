@@ -13,15 +13,48 @@ import (
 type RecordType string
 
 func RecordTypeFromTypes(rt types.RecordType) RecordType {
-	return []RecordType{"state", "request", "result"}[rt]
+	return []RecordType{"state", "request", "result", "code", "pending_filament", "genesis"}[rt]
 }
 
 const (
 	State   RecordType = "state"
 	Request RecordType = "request"
 	Result  RecordType = "result"
+	// records without lifeline semantics, they're available in jet drops only
+	Code            RecordType = "code"
+	PendingFilament RecordType = "pending_filament"
+	Genesis         RecordType = "genesis"
 )
 
+// RecordTypes are all known record types
+var RecordTypes = []RecordType{State, Request, Result, Code, PendingFilament, Genesis}
+
+type RecordSubtype string
+
+func RecordSubtypeFromTypes(rs types.RecordSubtype) RecordSubtype {
+	return []RecordSubtype{
+		"activate", "amend", "deactivate", "incoming_request", "outgoing_request", "result", "code", "pending_filament", "genesis",
+	}[rs]
+}
+
+const (
+	SubtypeActivate        RecordSubtype = "activate"
+	SubtypeAmend           RecordSubtype = "amend"
+	SubtypeDeactivate      RecordSubtype = "deactivate"
+	SubtypeIncomingRequest RecordSubtype = "incoming_request"
+	SubtypeOutgoingRequest RecordSubtype = "outgoing_request"
+	SubtypeResult          RecordSubtype = "result"
+	SubtypeCode            RecordSubtype = "code"
+	SubtypePendingFilament RecordSubtype = "pending_filament"
+	SubtypeGenesis         RecordSubtype = "genesis"
+)
+
+// RecordSubtypes are all known record subtypes
+var RecordSubtypes = []RecordSubtype{
+	SubtypeActivate, SubtypeAmend, SubtypeDeactivate, SubtypeIncomingRequest, SubtypeOutgoingRequest, SubtypeResult,
+	SubtypeCode, SubtypePendingFilament, SubtypeGenesis,
+}
+
 type Reference []byte
 
 func ReferenceFromTypes(r types.Reference) Reference {
@@ -31,10 +64,17 @@ func ReferenceFromTypes(r types.Reference) Reference {
 type Record struct {
 	Reference           Reference `gorm:"primary_key;auto_increment:false"`
 	Type                RecordType
+	Subtype             RecordSubtype
 	ObjectReference     Reference
 	PrototypeReference  Reference
 	Payload             []byte
 	PrevRecordReference Reference
+	ReasonReference     Reference
+	RequestReference    Reference
+	Caller              Reference
+	Method              string
+	Immutable           bool
+	APIRequestID        string
 	Hash                []byte
 	RawData             []byte
 	JetID               string
@@ -43,6 +83,33 @@ type Record struct {
 	Timestamp           int64
 }
 
+// RecordFilter is the set of optional conditions on the record subtype and the request fields
+type RecordFilter struct {
+	Subtype      *RecordSubtype
+	Caller       Reference
+	Method       *string
+	Immutable    *bool
+	APIRequestID *string
+}
+
+type RecordExtensionKind string
+
+func RecordExtensionKindFromTypes(rk types.RecordExtensionKind) RecordExtensionKind {
+	return []RecordExtensionKind{"code", "memory"}[rk]
+}
+
+const (
+	CodeExtension   RecordExtensionKind = "code"
+	MemoryExtension RecordExtensionKind = "memory"
+)
+
+// RecordExtension is the copy of the code or large memory of the record, the record keeps its payload
+type RecordExtension struct {
+	RecordReference Reference           `gorm:"primary_key;auto_increment:false"`
+	Kind            RecordExtensionKind `gorm:"primary_key;auto_increment:false"`
+	Data            []byte
+}
+
 type JetDrop struct {
 	PulseNumber    int64  `gorm:"primary_key;auto_increment:false"`
 	JetID          string `gorm:"primary_key;auto_increment:false;default:''"`
@@ -63,6 +130,12 @@ func (j *JetDrop) Siblings() []string {
 	return siblings
 }
 
+// PulseJet is the jet of the pulse jet tree reported by the platform
+type PulseJet struct {
+	PulseNumber int64  `gorm:"primary_key;auto_increment:false"`
+	JetID       string `gorm:"primary_key;auto_increment:false"`
+}
+
 type Pulse struct {
 	PulseNumber     int64 `gorm:"primary_key;auto_increment:false"`
 	PrevPulseNumber int64
@@ -74,6 +147,20 @@ type Pulse struct {
 	RecordAmount    int64
 }
 
+// JetDropData is the jet drop with its records and record extensions which are saved together
+type JetDropData struct {
+	JetDrop    JetDrop
+	Records    []Record
+	Extensions []RecordExtension
+}
+
+// JetDropRecordAmount is the amount of records with the same prototype in the jet drop
+type JetDropRecordAmount struct {
+	JetID              string
+	PrototypeReference Reference
+	RecordAmount       int64
+}
+
 type JetDropID struct {
 	JetID       string
 	PulseNumber int64
@@ -123,3 +210,56 @@ func (j *JetDropID) JetIDToString() string {
 	}
 	return tmp
 }
+
+// AuditFindingKind is a kind of discrepancy found by the audit
+type AuditFindingKind string
+
+const (
+	// MissingParent means there are no ancestors of the jet drop in the previous pulse
+	MissingParent AuditFindingKind = "missing_parent"
+	// PrevHashMismatch means prev hashes of the jet drop don't match hashes of its ancestors
+	PrevHashMismatch AuditFindingKind = "prev_hash_mismatch"
+)
+
+// DeadLetter is the jet drop which failed to persist after all retries
+type DeadLetter struct {
+	ID          int64 `gorm:"primary_key"`
+	PulseNumber int64
+	JetID       string
+	// JetDrop is the versioned JSON encoding of the jet drop with the raw data of its records
+	JetDrop   []byte
+	Error     string
+	Attempts  int
+	Timestamp int64
+}
+
+// AuditFinding is a discrepancy in the jet drops hash chain
+type AuditFinding struct {
+	ID          int64 `gorm:"primary_key"`
+	PulseNumber int64
+	JetID       string
+	Kind        AuditFindingKind
+	Details     string
+	Timestamp   int64
+}
+
+// Checkpoint is the named pulse number up to which the pipeline has got
+type Checkpoint struct {
+	Name        string `gorm:"primary_key;auto_increment:false"`
+	PulseNumber int64
+	Timestamp   int64
+}
+
+// LastSavedPulseCheckpoint is the greatest pulse with jet drops saved by the processor,
+// the main thread of the extractor resumes from it
+const LastSavedPulseCheckpoint = "last_saved_pulse"
+
+// ReloadRange is the range of pulses (FromPulseNumber, ToPulseNumber] requested from the platform again
+// until it becomes sequential
+type ReloadRange struct {
+	FromPulseNumber int64 `gorm:"primary_key;auto_increment:false"`
+	ToPulseNumber   int64 `gorm:"primary_key;auto_increment:false"`
+	// Attempts is the number of the requests of the range
+	Attempts  int
+	Timestamp int64
+}
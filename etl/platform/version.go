@@ -0,0 +1,28 @@
+// Package platform holds the version of the heavy node exporter API the block explorer is compatible with.
+// It doesn't depend on other etl packages, so both the extractor and the connections can use it.
+package platform
+
+import (
+	"context"
+	"strings"
+
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"google.golang.org/grpc/metadata"
+)
+
+// APIVersion is the version of the heavy node exporter API sent with every call
+const APIVersion = "2"
+
+// AppendVersionToContext adds the client type and version to the outgoing metadata of the exporter call
+func AppendVersionToContext(ctx context.Context) context.Context {
+	ctx = metadata.AppendToOutgoingContext(ctx, exporter.KeyClientType, exporter.ValidateHeavyVersion.String())
+	return metadata.AppendToOutgoingContext(ctx, exporter.KeyClientVersionHeavy, APIVersion)
+}
+
+// IsVersionError returns true if the heavy node rejected the version of the client
+func IsVersionError(err error) bool {
+	return strings.Contains(err.Error(), exporter.ErrDeprecatedClientVersion.Error()) ||
+		strings.Contains(err.Error(), "unknown heavy-version") ||
+		strings.Contains(err.Error(), "unknown type client") ||
+		strings.Contains(err.Error(), "incorrect format of the heavy-version")
+}
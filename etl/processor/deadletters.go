@@ -0,0 +1,193 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/insolar/block-explorer/etl/models"
+	"github.com/insolar/block-explorer/etl/transformer"
+	"github.com/insolar/block-explorer/etl/types"
+	"github.com/insolar/block-explorer/instrumentation/belogger"
+)
+
+// sendToDeadLetters saves the jet drop which failed to persist after all retries to the dead letters
+func (p *Processor) sendToDeadLetters(ctx context.Context, jd *types.JetDrop, processErr error, attempts int) {
+	log := belogger.FromContext(ctx)
+	start := jd.MainSection.Start
+	data, err := encodeJetDrop(jd)
+	if err != nil {
+		log.Errorf("cannot encode jet drop %s:%d for the dead letters: %s", start.JetDropPrefix, start.PulseData.PulseNo, err.Error())
+		return
+	}
+	err = p.deadLetters.SaveDeadLetter(models.DeadLetter{
+		PulseNumber: start.PulseData.PulseNo,
+		JetID:       start.JetDropPrefix,
+		JetDrop:     data,
+		Error:       processErr.Error(),
+		Attempts:    attempts,
+		Timestamp:   time.Now().Unix(),
+	})
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	DeadLettersCounter.Inc()
+	log.Warnf("Jet drop %s:%d is sent to the dead letters after %d attempts", start.JetDropPrefix, start.PulseData.PulseNo, attempts)
+}
+
+// DeadLetters returns dead letters without jet drops ordered by id.
+func (p *Processor) DeadLetters(limit, offset int) ([]types.DeadLetter, error) {
+	deadLetters, err := p.deadLetters.GetDeadLetters(limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]types.DeadLetter, 0, len(deadLetters))
+	for _, dl := range deadLetters {
+		result = append(result, deadLetterFromModel(dl))
+	}
+	return result, nil
+}
+
+// DeadLetter returns dead letter with provided id and its jet drop.
+func (p *Processor) DeadLetter(id int64) (types.DeadLetter, error) {
+	dl, err := p.deadLetters.GetDeadLetter(id)
+	if err != nil {
+		return types.DeadLetter{}, err
+	}
+	jd, err := decodeJetDrop(dl.JetDrop)
+	if err != nil {
+		return types.DeadLetter{}, fmt.Errorf("cannot decode jet drop of dead letter %d: %s", id, err.Error())
+	}
+	result := deadLetterFromModel(dl)
+	result.JetDrop = jd
+	return result, nil
+}
+
+// RetryDeadLetter processes the jet drop of dead letter with provided id again, the dead letter is removed on success.
+func (p *Processor) RetryDeadLetter(ctx context.Context, id int64) error {
+	dl, err := p.DeadLetter(id)
+	if err != nil {
+		return err
+	}
+	err = p.process(ctx, dl.JetDrop)
+	if err != nil {
+		return fmt.Errorf("cannot process jet drop of dead letter %d: %s", id, err.Error())
+	}
+	err = p.deadLetters.DeleteDeadLetter(id)
+	if err != nil {
+		return err
+	}
+	DeadLettersRetriedCounter.Inc()
+	belogger.FromContext(ctx).Infof("Dead letter %d of jet drop %s:%d is retried", id, dl.JetID, dl.PulseNumber)
+	return nil
+}
+
+// DiscardDeadLetter removes dead letter with provided id without processing.
+func (p *Processor) DiscardDeadLetter(id int64) error {
+	err := p.deadLetters.DeleteDeadLetter(id)
+	if err != nil {
+		return err
+	}
+	DeadLettersDiscardedCounter.Inc()
+	return nil
+}
+
+func deadLetterFromModel(dl models.DeadLetter) types.DeadLetter {
+	return types.DeadLetter{
+		ID:          dl.ID,
+		PulseNumber: dl.PulseNumber,
+		JetID:       dl.JetID,
+		Error:       dl.Error,
+		Attempts:    dl.Attempts,
+		Timestamp:   dl.Timestamp,
+	}
+}
+
+// deadLetterEncodingVersion is the version of the dead letter jet drop encoding
+const deadLetterEncodingVersion = 1
+
+// deadLetterJetDrop is the JSON encoding of the dead-lettered jet drop, it's persisted, so the fields are changed
+// only with the version increment. Records are kept as their raw platform data and are transformed again on decoding,
+// so the encoding doesn't depend on the layout of the canonical types.
+type deadLetterJetDrop struct {
+	Version         int      `json:"version"`
+	PulseNumber     int64    `json:"pulse_number"`
+	EpochPulseNo    int64    `json:"epoch_pulse_number"`
+	PulseTimestamp  int64    `json:"pulse_timestamp"`
+	NextPulseNumber int64    `json:"next_pulse_number"`
+	PrevPulseNumber int64    `json:"prev_pulse_number"`
+	PulseJetIDs     []string `json:"pulse_jet_ids"`
+	JetDropPrefix   string   `json:"jet_drop_prefix"`
+	PrevDropHashes  [][]byte `json:"prev_drop_hashes"`
+	Hash            []byte   `json:"hash"`
+	Records         [][]byte `json:"records"`
+}
+
+func encodeJetDrop(jd *types.JetDrop) ([]byte, error) {
+	ms := jd.MainSection
+	pd := ms.Start.PulseData
+	encoded := deadLetterJetDrop{
+		Version:         deadLetterEncodingVersion,
+		PulseNumber:     pd.PulseNo,
+		EpochPulseNo:    pd.EpochPulseNo,
+		PulseTimestamp:  pd.PulseTimestamp,
+		NextPulseNumber: pd.NextPulseNumber,
+		PrevPulseNumber: pd.PrevPulseNumber,
+		PulseJetIDs:     ms.Start.PulseJetIDs,
+		JetDropPrefix:   ms.Start.JetDropPrefix,
+		PrevDropHashes:  ms.DropContinue.PrevDropHash,
+		Hash:            jd.Hash,
+	}
+	for _, r := range ms.Records {
+		if len(r.RawData) == 0 {
+			return nil, fmt.Errorf("record %x has no raw data", r.Ref)
+		}
+		encoded.Records = append(encoded.Records, r.RawData)
+	}
+	return json.Marshal(encoded)
+}
+
+func decodeJetDrop(data []byte) (*types.JetDrop, error) {
+	var encoded deadLetterJetDrop
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return nil, err
+	}
+	if encoded.Version != deadLetterEncodingVersion {
+		return nil, fmt.Errorf("unsupported encoding version %d", encoded.Version)
+	}
+
+	records := make([]types.Record, 0, len(encoded.Records))
+	for _, raw := range encoded.Records {
+		r, err := transformer.RecordFromRawData(raw)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, r)
+	}
+	jd := &types.JetDrop{
+		MainSection: &types.MainSection{
+			Start: types.DropStart{
+				PulseData: types.Pulse{
+					PulseNo:         encoded.PulseNumber,
+					EpochPulseNo:    encoded.EpochPulseNo,
+					PulseTimestamp:  encoded.PulseTimestamp,
+					NextPulseNumber: encoded.NextPulseNumber,
+					PrevPulseNumber: encoded.PrevPulseNumber,
+				},
+				PulseJetIDs:         encoded.PulseJetIDs,
+				JetDropPrefix:       encoded.JetDropPrefix,
+				JetDropPrefixLength: uint(len(encoded.JetDropPrefix)),
+			},
+			DropContinue: types.DropContinue{PrevDropHash: encoded.PrevDropHashes},
+			Records:      records,
+		},
+		Sections: []types.Section{},
+		Hash:     encoded.Hash,
+	}
+	if extensions := transformer.RecordExtensions(records); len(extensions) > 0 {
+		jd.Sections = append(jd.Sections, types.AdditionalSection{RecordExtensions: extensions})
+	}
+	return jd, nil
+}
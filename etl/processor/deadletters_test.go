@@ -0,0 +1,239 @@
+// +build unit
+
+package processor
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	insrecord "github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/insolar/insolar/pulse"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/block-explorer/configuration"
+	"github.com/insolar/block-explorer/etl/interfaces/mock"
+	"github.com/insolar/block-explorer/etl/models"
+	"github.com/insolar/block-explorer/etl/transformer"
+	"github.com/insolar/block-explorer/etl/types"
+	"github.com/insolar/block-explorer/instrumentation/belogger"
+	"github.com/insolar/block-explorer/testutils"
+)
+
+// rawJetDrop returns the jet drop transformed from the platform records, the dead letters keep their raw data
+func rawJetDrop(t *testing.T) types.JetDrop {
+	pn := insolar.PulseNumber(pulse.MinTimePulse + 10)
+	code := testutils.GenerateRecordsSilence(1)[0]
+	code.Record.ID = gen.IDWithPulse(pn)
+	code.Record.JetID = insolar.ZeroJetID
+	code.Record.Virtual.Union = &insrecord.Virtual_Code{Code: &insrecord.Code{Code: testutils.GenerateRandBytes()}}
+	request := testutils.GenerateVirtualRequestRecord(pn, gen.ID())
+	request.Record.JetID = insolar.ZeroJetID
+
+	jetDrops, err := transformer.Transform(belogger.TestContext(t), &types.PlatformPulseData{
+		Pulse: &exporter.FullPulse{
+			PulseNumber:     pn,
+			PrevPulseNumber: pn - 10,
+			NextPulseNumber: pn + 10,
+			Jets: []exporter.JetDropContinue{{
+				JetID:          insolar.ZeroJetID,
+				Hash:           testutils.GenerateRandBytes(),
+				PrevDropHashes: [][]byte{testutils.GenerateRandBytes()},
+			}},
+		},
+		Records: []*exporter.Record{code, request},
+	})
+	require.NoError(t, err)
+	require.Len(t, jetDrops, 1)
+	return *jetDrops[0]
+}
+
+func TestEncodeJetDrop(t *testing.T) {
+	jd := rawJetDrop(t)
+	require.Len(t, jd.MainSection.Records, 2)
+	require.Len(t, jd.Sections, 1, "code record has the extension")
+
+	data, err := encodeJetDrop(&jd)
+	require.NoError(t, err)
+	decoded, err := decodeJetDrop(data)
+	require.NoError(t, err)
+	require.Equal(t, &jd, decoded)
+}
+
+func TestEncodeJetDrop_Errors(t *testing.T) {
+	record := testutils.CreateRecordCanonical()
+	record.RawData = nil
+	jd := testutils.CreateJetDropCanonical([]types.Record{record})
+	_, err := encodeJetDrop(&jd)
+	require.Error(t, err, "record without raw data can't be encoded")
+
+	_, err = decodeJetDrop([]byte(`{"version":2}`))
+	require.Error(t, err)
+	_, err = decodeJetDrop([]byte(`{"version":1,"records":["AQID"]}`))
+	require.Error(t, err)
+}
+
+func TestProcessor_processWithRetries_Success(t *testing.T) {
+	ctx := belogger.TestContext(t)
+	jd := testutils.CreateJetDropCanonical([]types.Record{testutils.CreateRecordCanonical()})
+
+	trm := mock.NewTransformerMock(t)
+	trm.GetJetDropsChannelMock.Return(nil)
+
+	sm := mock.NewStorageSetterMock(t)
+	sm.SavePulseMock.Return(nil)
+	sm.SaveJetDropDataMock.Set(func(jetDrop models.JetDrop, records []models.Record, pulseNumber int64) (err error) {
+		if sm.SaveJetDropDataBeforeCounter() < 3 {
+			return errors.New("test error")
+		}
+		return nil
+	})
+
+	contr := mock.NewControllerMock(t)
+	contr.SetJetDropDataMock.Return()
+
+	dls := mock.NewDeadLetterStorageMock(t)
+
+	p := NewProcessor(trm, sm, dls, nil, contr, configuration.Processor{Workers: 1, Retry: configuration.Retry{MaxAttempts: 5}})
+	p.active = 1
+	p.processWithRetries(ctx, &jd)
+
+	require.Equal(t, uint64(3), sm.SaveJetDropDataAfterCounter())
+	require.Equal(t, uint64(1), contr.SetJetDropDataAfterCounter())
+	require.Equal(t, uint64(0), dls.SaveDeadLetterAfterCounter())
+}
+
+func TestProcessor_processWithRetries_DeadLetter(t *testing.T) {
+	ctx := belogger.TestContext(t)
+	jd := rawJetDrop(t)
+
+	trm := mock.NewTransformerMock(t)
+	trm.GetJetDropsChannelMock.Return(nil)
+
+	sm := mock.NewStorageSetterMock(t)
+	sm.SavePulseMock.Return(errors.New("test error"))
+
+	contr := mock.NewControllerMock(t)
+
+	dls := mock.NewDeadLetterStorageMock(t)
+	dls.SaveDeadLetterMock.Set(func(deadLetter models.DeadLetter) (err error) {
+		require.Equal(t, jd.MainSection.Start.PulseData.PulseNo, deadLetter.PulseNumber)
+		require.Equal(t, jd.MainSection.Start.JetDropPrefix, deadLetter.JetID)
+		require.Equal(t, 3, deadLetter.Attempts)
+		require.Contains(t, deadLetter.Error, "test error")
+		decoded, err := decodeJetDrop(deadLetter.JetDrop)
+		require.NoError(t, err)
+		require.Equal(t, &jd, decoded)
+		return nil
+	})
+
+	p := NewProcessor(trm, sm, dls, nil, contr, configuration.Processor{Workers: 1, Retry: configuration.Retry{MaxAttempts: 3}})
+	p.active = 1
+	p.processWithRetries(ctx, &jd)
+
+	require.Equal(t, uint64(3), sm.SavePulseAfterCounter())
+	require.Equal(t, uint64(1), dls.SaveDeadLetterAfterCounter())
+}
+
+func TestProcessor_processWithRetries_ZeroInterval(t *testing.T) {
+	ctx := belogger.TestContext(t)
+	jd := rawJetDrop(t)
+
+	trm := mock.NewTransformerMock(t)
+	trm.GetJetDropsChannelMock.Return(nil)
+
+	sm := mock.NewStorageSetterMock(t)
+	sm.SavePulseMock.Return(errors.New("test error"))
+
+	dls := mock.NewDeadLetterStorageMock(t)
+	dls.SaveDeadLetterMock.Return(nil)
+
+	p := NewProcessor(trm, sm, dls, nil, mock.NewControllerMock(t), configuration.Processor{Workers: 1, Retry: configuration.Retry{MaxAttempts: 3}})
+	p.active = 1
+	start := time.Now()
+	p.processWithRetries(ctx, &jd)
+
+	require.GreaterOrEqual(t, int64(time.Since(start)), int64(3*configuration.MinRetryInterval), "retries wait at least the min interval")
+	require.Equal(t, uint64(3), sm.SavePulseAfterCounter())
+}
+
+func TestProcessor_RetryDeadLetter(t *testing.T) {
+	ctx := belogger.TestContext(t)
+	jd := rawJetDrop(t)
+	data, err := encodeJetDrop(&jd)
+	require.NoError(t, err)
+
+	trm := mock.NewTransformerMock(t)
+	trm.GetJetDropsChannelMock.Return(nil)
+
+	sm := mock.NewStorageSetterMock(t)
+	sm.SavePulseMock.Return(nil)
+	sm.SavePulseJetsMock.Return(nil)
+	sm.SaveJetDropDataMock.Return(nil)
+	sm.SaveRecordExtensionsMock.Return(nil)
+
+	contr := mock.NewControllerMock(t)
+	contr.SetJetDropDataMock.Return()
+
+	dls := mock.NewDeadLetterStorageMock(t)
+	dls.GetDeadLetterMock.Expect(7).Return(models.DeadLetter{ID: 7, JetDrop: data}, nil)
+	dls.DeleteDeadLetterMock.Expect(7).Return(nil)
+
+	p := NewProcessor(trm, sm, dls, nil, contr, configuration.Processor{Workers: 1})
+	require.NoError(t, p.RetryDeadLetter(ctx, 7))
+	require.Equal(t, uint64(1), sm.SaveJetDropDataAfterCounter())
+	require.Equal(t, uint64(1), dls.DeleteDeadLetterAfterCounter())
+}
+
+func TestProcessor_RetryDeadLetter_Errors(t *testing.T) {
+	ctx := belogger.TestContext(t)
+	jd := rawJetDrop(t)
+	data, err := encodeJetDrop(&jd)
+	require.NoError(t, err)
+
+	trm := mock.NewTransformerMock(t)
+	trm.GetJetDropsChannelMock.Return(nil)
+
+	sm := mock.NewStorageSetterMock(t)
+	sm.SavePulseMock.Return(errors.New("test error"))
+
+	contr := mock.NewControllerMock(t)
+
+	dls := mock.NewDeadLetterStorageMock(t)
+	dls.GetDeadLetterMock.When(7).Then(models.DeadLetter{ID: 7, JetDrop: data}, nil)
+	dls.GetDeadLetterMock.When(8).Then(models.DeadLetter{}, gorm.ErrRecordNotFound)
+
+	p := NewProcessor(trm, sm, dls, nil, contr, configuration.Processor{Workers: 1})
+	err = p.RetryDeadLetter(ctx, 7)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "test error")
+
+	err = p.RetryDeadLetter(ctx, 8)
+	require.True(t, gorm.IsRecordNotFoundError(err))
+	require.Equal(t, uint64(0), dls.DeleteDeadLetterAfterCounter())
+}
+
+func TestProcessor_DeadLetters(t *testing.T) {
+	trm := mock.NewTransformerMock(t)
+	trm.GetJetDropsChannelMock.Return(nil)
+
+	dls := mock.NewDeadLetterStorageMock(t)
+	dls.GetDeadLettersMock.Expect(10, 20).Return([]models.DeadLetter{
+		{ID: 1, PulseNumber: 1000, JetID: "0", Error: "test error", Attempts: 3, Timestamp: 100},
+	}, nil)
+	dls.DeleteDeadLetterMock.Expect(1).Return(nil)
+
+	p := NewProcessor(trm, mock.NewStorageSetterMock(t), dls, nil, mock.NewControllerMock(t), configuration.Processor{Workers: 1})
+	deadLetters, err := p.DeadLetters(10, 20)
+	require.NoError(t, err)
+	require.Equal(t, []types.DeadLetter{
+		{ID: 1, PulseNumber: 1000, JetID: "0", Error: "test error", Attempts: 3, Timestamp: 100},
+	}, deadLetters)
+
+	require.NoError(t, p.DiscardDeadLetter(1))
+	require.Equal(t, uint64(1), dls.DeleteDeadLetterAfterCounter())
+}
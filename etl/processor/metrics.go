@@ -0,0 +1,47 @@
+package processor
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/insolar/block-explorer/instrumentation/metrics"
+)
+
+var (
+	RetriesCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "gbe_processor_retries",
+		Help: "How many times jet drops failed to persist were retried",
+	})
+	DeadLettersCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "gbe_processor_dead_letters",
+		Help: "How many jet drops were sent to the dead letters after all retries",
+	})
+	DeadLettersRetriedCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "gbe_processor_dead_letters_retried",
+		Help: "How many dead letters were successfully retried by admin",
+	})
+	DeadLettersDiscardedCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "gbe_processor_dead_letters_discarded",
+		Help: "How many dead letters were discarded by admin",
+	})
+	BatchSize = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "gbe_processor_batch_size",
+		Help:    "The count of jet drops saved in one transaction",
+		Buckets: prometheus.ExponentialBuckets(1, 2, 10),
+	})
+)
+
+type Metrics struct{}
+
+func (s Metrics) Refresh() {
+	// nothing to refresh
+}
+
+func (s Metrics) Metrics(p *metrics.Prometheus) []prometheus.Collector {
+	return []prometheus.Collector{
+		RetriesCounter,
+		DeadLettersCounter,
+		DeadLettersRetriedCounter,
+		DeadLettersDiscardedCounter,
+		BatchSize,
+	}
+}
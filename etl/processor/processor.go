@@ -6,7 +6,11 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
+	"github.com/jinzhu/gorm"
+
+	"github.com/insolar/block-explorer/configuration"
 	"github.com/insolar/block-explorer/etl/interfaces"
 	"github.com/insolar/block-explorer/etl/models"
 	"github.com/insolar/block-explorer/etl/types"
@@ -18,20 +22,40 @@ type Processor struct {
 	taskC        chan Task
 	taskCCloseMu sync.Mutex
 	storage      interfaces.StorageSetter
+	deadLetters  interfaces.DeadLetterStorage
+	checkpoints  interfaces.CheckpointStorage
 	controller   interfaces.Controller
 	workers      int
+	retry        configuration.Retry
+	batchSize    int
+	batchPeriod  time.Duration
 	active       int32
+
+	// lastSavedPulse is the greatest pulse with saved jet drops, it's checkpointed,
+	// so the extractor resumes from the data which is already persisted
+	lastSavedPulse   int64
+	lastSavedPulseMu sync.Mutex
 }
 
-func NewProcessor(jb interfaces.Transformer, storage interfaces.StorageSetter, controller interfaces.Controller, workers int) *Processor {
+func NewProcessor(jb interfaces.Transformer, storage interfaces.StorageSetter, deadLetters interfaces.DeadLetterStorage, checkpoints interfaces.CheckpointStorage, controller interfaces.Controller, cfg configuration.Processor) *Processor {
+	workers := cfg.Workers
 	if workers < 1 {
 		workers = 1
 	}
+	batchSize := cfg.BatchSize
+	if batchSize < 1 {
+		batchSize = 1
+	}
 	return &Processor{
 		jdC:          jb.GetJetDropsChannel(),
 		workers:      workers,
+		retry:        cfg.Retry.Clamped(),
+		batchSize:    batchSize,
+		batchPeriod:  cfg.BatchPeriod,
 		taskCCloseMu: sync.Mutex{},
 		storage:      storage,
+		deadLetters:  deadLetters,
+		checkpoints:  checkpoints,
 		controller:   controller,
 	}
 
@@ -40,7 +64,6 @@ func NewProcessor(jb interfaces.Transformer, storage interfaces.StorageSetter, c
 var ErrorAlreadyStarted = errors.New("Already started")
 
 func (p *Processor) Start(ctx context.Context) error {
-	log := belogger.FromContext(ctx)
 	startOnce := func() error {
 		p.taskCCloseMu.Lock()
 		defer p.taskCCloseMu.Unlock()
@@ -55,6 +78,7 @@ func (p *Processor) Start(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	p.loadLastSavedPulse(ctx)
 
 	for i := 0; i < p.workers; i++ {
 		go func() {
@@ -63,34 +87,54 @@ func (p *Processor) Start(ctx context.Context) error {
 				if !ok {
 					return
 				}
-				err := p.process(ctx, t.JD)
-				// todo remove this in penv-667
-				if err != nil {
-					log.Error(err)
-					p.taskC <- t
-				}
+				p.processTask(ctx, t)
 			}
 		}()
 	}
 
 	go func() {
-		for {
-			jd, ok := <-p.jdC
-			if !ok {
-				p.taskCCloseMu.Lock()
-				if atomic.CompareAndSwapInt32(&p.active, 1, 0) {
-					close(p.taskC)
-				}
-				p.taskCCloseMu.Unlock()
+		// jet drops of one pulse come together, they're collected to the batch
+		// until the batch is full, the batch period is over or the next pulse begins
+		var batch []*types.JetDrop
+		var flushC <-chan time.Time
+		flush := func() {
+			flushC = nil
+			if len(batch) == 0 {
 				return
 			}
 			p.taskCCloseMu.Lock()
 			if atomic.LoadInt32(&p.active) == 1 {
-				p.taskC <- Task{jd}
+				p.taskC <- Task{batch}
 			}
 			p.taskCCloseMu.Unlock()
+			batch = nil
+		}
+		for {
+			select {
+			case jd, ok := <-p.jdC:
+				if !ok {
+					flush()
+					p.taskCCloseMu.Lock()
+					if atomic.CompareAndSwapInt32(&p.active, 1, 0) {
+						close(p.taskC)
+					}
+					p.taskCCloseMu.Unlock()
+					return
+				}
+				if len(batch) > 0 && batch[0].MainSection.Start.PulseData.PulseNo != jd.MainSection.Start.PulseData.PulseNo {
+					flush()
+				}
+				if len(batch) == 0 {
+					flushC = time.After(p.batchPeriod)
+				}
+				batch = append(batch, jd)
+				if len(batch) >= p.batchSize {
+					flush()
+				}
+			case <-flushC:
+				flush()
+			}
 		}
-
 	}()
 	return nil
 }
@@ -105,8 +149,84 @@ func (p *Processor) Stop(ctx context.Context) error {
 	return nil
 }
 
+// Task is the batch of jet drops of one pulse
 type Task struct {
-	JD *types.JetDrop
+	JDs []*types.JetDrop
+}
+
+// processTask saves jet drops of the task in one transaction,
+// if it fails every jet drop is processed separately, so the failed one doesn't affect others
+func (p *Processor) processTask(ctx context.Context, t Task) {
+	err := p.processBatch(ctx, t.JDs)
+	if err == nil {
+		return
+	}
+	belogger.FromContext(ctx).Errorf("%s, jet drops are processed one by one", err.Error())
+	for _, jd := range t.JDs {
+		p.processWithRetries(ctx, jd)
+	}
+}
+
+// processWithRetries processes the jet drop, failed jet drop is retried with exponentially growing intervals
+// until the processor is stopped or the number of attempts reaches the limit, then it's sent to the dead letters
+func (p *Processor) processWithRetries(ctx context.Context, jd *types.JetDrop) {
+	log := belogger.FromContext(ctx)
+	interval := p.retry.MinInterval
+	for attempt := 1; ; attempt++ {
+		err := p.process(ctx, jd)
+		if err == nil {
+			return
+		}
+		log.Error(err)
+		if p.retry.MaxAttempts > 0 && attempt >= p.retry.MaxAttempts {
+			p.sendToDeadLetters(ctx, jd, err, attempt)
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+		if atomic.LoadInt32(&p.active) == 0 {
+			// the jet drop isn't saved, so its pulse stays incomplete and is reloaded after restart
+			return
+		}
+		RetriesCounter.Inc()
+		interval *= 2
+		if p.retry.MaxInterval > 0 && interval > p.retry.MaxInterval {
+			interval = p.retry.MaxInterval
+		}
+	}
+}
+
+// processBatch saves jet drops of one pulse with their pulse and records in one transaction
+func (p *Processor) processBatch(ctx context.Context, jds []*types.JetDrop) error {
+	pd := jds[0].MainSection.Start.PulseData
+
+	logger := belogger.FromContext(ctx)
+	logger.Infof("Batch process start, pulse = %d, jetDrop amount = %d", pd.PulseNo, len(jds))
+	BatchSize.Observe(float64(len(jds)))
+
+	var pulseJetIDs []string
+	jetDrops := make([]models.JetDropData, 0, len(jds))
+	for _, jd := range jds {
+		if len(jd.MainSection.Start.PulseJetIDs) > 0 {
+			pulseJetIDs = jd.MainSection.Start.PulseJetIDs
+		}
+		jetDrops = append(jetDrops, jetDropToModel(jd))
+	}
+	err := p.storage.SaveJetDropsBatch(pulseToModel(pd), pulseJetIDs, jetDrops)
+	if err != nil {
+		return fmt.Errorf("cannot save jet drops batch: %s. pulse = %d, jetDrop amount = %d", err.Error(), pd.PulseNo, len(jds))
+	}
+
+	for _, jd := range jetDrops {
+		p.controller.SetJetDropData(pd, jd.JetDrop.JetID)
+	}
+	p.saveLastSavedPulse(ctx, int64(pd.PulseNo))
+	logger.Infof("Processed batch: pulseNumber = %d, jetDrop amount = %d", pd.PulseNo, len(jds))
+	return nil
 }
 
 func (p *Processor) process(ctx context.Context, jd *types.JetDrop) error {
@@ -116,17 +236,93 @@ func (p *Processor) process(ctx context.Context, jd *types.JetDrop) error {
 	logger := belogger.FromContext(ctx)
 	logger.Infof("Process start, pulse = %d, jetDrop = %v, record amount = %d", pd.PulseNo, ms.Start.JetDropPrefix, len(jd.MainSection.Records))
 
-	mp := models.Pulse{
+	mp := pulseToModel(pd)
+	err := p.storage.SavePulse(mp)
+	if err != nil {
+		return fmt.Errorf("cannot save pulse data: %s. pulse = %+v", err.Error(), mp)
+	}
+
+	if len(ms.Start.PulseJetIDs) > 0 {
+		err = p.storage.SavePulseJets(mp.PulseNumber, ms.Start.PulseJetIDs)
+		if err != nil {
+			return fmt.Errorf("cannot save pulse jets: %s. pulse = %d", err.Error(), mp.PulseNumber)
+		}
+	}
+
+	data := jetDropToModel(jd)
+	mjd, mrs, mes := data.JetDrop, data.Records, data.Extensions
+	err = p.storage.SaveJetDropData(mjd, mrs, mp.PulseNumber)
+	if err != nil {
+		return fmt.Errorf("cannot save jetDrop data: %s. jetDrop:{jetID: %s, pulseNumber: %d}, record amount = %d",
+			err.Error(), mjd.JetID, mjd.PulseNumber, len(mrs))
+	}
+
+	if len(mes) > 0 {
+		err = p.storage.SaveRecordExtensions(mes)
+		if err != nil {
+			return fmt.Errorf("cannot save record extensions: %s. jetDrop:{jetID: %s, pulseNumber: %d}, extension amount = %d",
+				err.Error(), mjd.JetID, mjd.PulseNumber, len(mes))
+		}
+	}
+	p.controller.SetJetDropData(pd, mjd.JetID)
+	p.saveLastSavedPulse(ctx, mp.PulseNumber)
+	logger.Infof("Processed: pulseNumber = %d, jetID = %v", pd.PulseNo, mjd.JetID)
+	return nil
+}
+
+// loadLastSavedPulse gets the checkpoint saved before restart, so it doesn't go back with reloaded pulses
+func (p *Processor) loadLastSavedPulse(ctx context.Context) {
+	if p.checkpoints == nil {
+		return
+	}
+	checkpoint, err := p.checkpoints.GetCheckpoint(models.LastSavedPulseCheckpoint)
+	if err != nil {
+		if !gorm.IsRecordNotFoundError(err) {
+			belogger.FromContext(ctx).Errorf("Can't get the last saved pulse: %s", err)
+		}
+		return
+	}
+	p.lastSavedPulseMu.Lock()
+	defer p.lastSavedPulseMu.Unlock()
+	p.lastSavedPulse = checkpoint.PulseNumber
+}
+
+// saveLastSavedPulse checkpoints the pulse after its jet drops are saved if it's greater than the checkpointed one
+func (p *Processor) saveLastSavedPulse(ctx context.Context, pulseNumber int64) {
+	if p.checkpoints == nil {
+		return
+	}
+	p.lastSavedPulseMu.Lock()
+	defer p.lastSavedPulseMu.Unlock()
+	if pulseNumber <= p.lastSavedPulse {
+		return
+	}
+	err := p.checkpoints.SaveCheckpoint(models.Checkpoint{
+		Name:        models.LastSavedPulseCheckpoint,
+		PulseNumber: pulseNumber,
+		Timestamp:   time.Now().Unix(),
+	})
+	if err != nil {
+		belogger.FromContext(ctx).Errorf("Can't save the last saved pulse %d: %s", pulseNumber, err)
+		return
+	}
+	p.lastSavedPulse = pulseNumber
+}
+
+func pulseToModel(pd types.Pulse) models.Pulse {
+	return models.Pulse{
 		PulseNumber:     pd.PulseNo,
 		PrevPulseNumber: pd.PrevPulseNumber,
 		NextPulseNumber: pd.NextPulseNumber,
 		IsComplete:      false,
 		Timestamp:       pd.PulseTimestamp,
 	}
-	err := p.storage.SavePulse(mp)
-	if err != nil {
-		return fmt.Errorf("cannot save pulse data: %s. pulse = %+v", err.Error(), mp)
-	}
+}
+
+// jetDropToModel converts the jet drop to the models of the jet drop, its records and record extensions
+func jetDropToModel(jd *types.JetDrop) models.JetDropData {
+	ms := jd.MainSection
+	pd := ms.Start.PulseData
 
 	var firstPrevHash []byte
 	var secondPrevHash []byte
@@ -153,10 +349,17 @@ func (p *Processor) process(ctx context.Context, jd *types.JetDrop) error {
 		mrs = append(mrs, models.Record{
 			Reference:           models.ReferenceFromTypes(r.Ref),
 			Type:                models.RecordTypeFromTypes(r.Type),
+			Subtype:             models.RecordSubtypeFromTypes(r.Subtype),
 			ObjectReference:     models.ReferenceFromTypes(r.ObjectReference),
 			PrototypeReference:  models.ReferenceFromTypes(r.PrototypeReference),
 			Payload:             r.RecordPayload,
 			PrevRecordReference: models.ReferenceFromTypes(r.PrevRecordReference),
+			ReasonReference:     models.ReferenceFromTypes(r.ReasonReference),
+			RequestReference:    models.ReferenceFromTypes(r.RequestReference),
+			Caller:              models.ReferenceFromTypes(r.Caller),
+			Method:              r.Method,
+			Immutable:           r.Immutable,
+			APIRequestID:        r.APIRequestID,
 			Hash:                r.Hash,
 			RawData:             r.RawData,
 			JetID:               mjd.JetID,
@@ -165,12 +368,20 @@ func (p *Processor) process(ctx context.Context, jd *types.JetDrop) error {
 			Timestamp:           mjd.Timestamp,
 		})
 	}
-	err = p.storage.SaveJetDropData(mjd, mrs, mp.PulseNumber)
-	if err != nil {
-		return fmt.Errorf("cannot save jetDrop data: %s. jetDrop:{jetID: %s, pulseNumber: %d}, record amount = %d",
-			err.Error(), mjd.JetID, mjd.PulseNumber, len(mrs))
+
+	var mes []models.RecordExtension
+	for _, section := range jd.Sections {
+		additional, ok := section.(types.AdditionalSection)
+		if !ok {
+			continue
+		}
+		for _, e := range additional.RecordExtensions {
+			mes = append(mes, models.RecordExtension{
+				RecordReference: models.ReferenceFromTypes(e.RecordReference),
+				Kind:            models.RecordExtensionKindFromTypes(e.Kind),
+				Data:            e.Data,
+			})
+		}
 	}
-	p.controller.SetJetDropData(pd, mjd.JetID)
-	logger.Infof("Processed: pulseNumber = %d, jetID = %v", pd.PulseNo, mjd.JetID)
-	return nil
+	return models.JetDropData{JetDrop: mjd, Records: mrs, Extensions: mes}
 }
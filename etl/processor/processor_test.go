@@ -3,14 +3,26 @@
 package processor
 
 import (
+	"bytes"
+	"encoding/base64"
 	"errors"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/gen"
+	insrecord "github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/insolar/insolar/pulse"
 
+	"github.com/insolar/block-explorer/api"
+	"github.com/insolar/block-explorer/configuration"
+	beexporter "github.com/insolar/block-explorer/etl/exporter"
 	"github.com/insolar/block-explorer/etl/models"
+	"github.com/insolar/block-explorer/etl/transformer"
 	"github.com/insolar/block-explorer/instrumentation/belogger"
 	"github.com/insolar/block-explorer/testutils"
 
@@ -33,13 +45,12 @@ func TestNewProcessor(t *testing.T) {
 	pulseSaves := int32(0)
 	jetDropSaves := int32(0)
 	sm := mock.NewStorageSetterMock(t)
-	sm.SavePulseMock.Set(func(pulse models.Pulse) (err error) {
+	sm.SaveJetDropsBatchMock.Set(func(pulse models.Pulse, pulseJetIDs []string, jetDrops []models.JetDropData) (err error) {
 		atomic.AddInt32(&pulseSaves, 1)
-		return nil
-	})
-	sm.SaveJetDropDataMock.Set(func(jetDrop models.JetDrop, records []models.Record, pulseNumber int64) (err error) {
-		atomic.AddInt32(&jetDropSaves, 1)
-		wgStorage.Done()
+		for range jetDrops {
+			atomic.AddInt32(&jetDropSaves, 1)
+			wgStorage.Done()
+		}
 		return nil
 	})
 
@@ -51,7 +62,7 @@ func TestNewProcessor(t *testing.T) {
 		wgController.Done()
 	})
 
-	p := NewProcessor(trm, sm, contr, 3)
+	p := NewProcessor(trm, sm, nil, nil, contr, configuration.Processor{Workers: 3})
 	require.NotNil(t, p)
 
 	require.NoError(t, p.Start(ctx))
@@ -116,7 +127,7 @@ func TestProcessor_process_EmptyPrev(t *testing.T) {
 		require.Equal(t, jd.MainSection.Start.JetDropPrefix, jetID)
 	})
 
-	p := NewProcessor(trm, sm, contr, 3)
+	p := NewProcessor(trm, sm, nil, nil, contr, configuration.Processor{Workers: 3})
 	require.NotNil(t, p)
 
 	err := p.process(ctx, &jd)
@@ -159,7 +170,7 @@ func TestProcessor_process_SeveralPrev(t *testing.T) {
 		require.Equal(t, jd.MainSection.Start.JetDropPrefix, jetID)
 	})
 
-	p := NewProcessor(trm, sm, contr, 3)
+	p := NewProcessor(trm, sm, nil, nil, contr, configuration.Processor{Workers: 3})
 	require.NotNil(t, p)
 
 	err := p.process(ctx, &jd)
@@ -191,7 +202,7 @@ func TestProcessor_process_StorageSaveJetDropErr(t *testing.T) {
 
 	contr := mock.NewControllerMock(t)
 
-	p := NewProcessor(trm, sm, contr, 3)
+	p := NewProcessor(trm, sm, nil, nil, contr, configuration.Processor{Workers: 3})
 	require.NotNil(t, p)
 
 	err := p.process(ctx, &jd)
@@ -219,7 +230,7 @@ func TestProcessor_process_StorageSavePulseErr(t *testing.T) {
 
 	contr := mock.NewControllerMock(t)
 
-	p := NewProcessor(trm, sm, contr, 3)
+	p := NewProcessor(trm, sm, nil, nil, contr, configuration.Processor{Workers: 3})
 	require.NotNil(t, p)
 
 	err := p.process(ctx, &jd)
@@ -227,3 +238,244 @@ func TestProcessor_process_StorageSavePulseErr(t *testing.T) {
 
 	require.Equal(t, uint64(1), sm.SavePulseAfterCounter())
 }
+
+func TestProcessor_process_RecordExtensions(t *testing.T) {
+	ctx := belogger.TestContext(t)
+	record := testutils.CreateRecordCanonical()
+	jd := testutils.CreateJetDropCanonical([]types.Record{record})
+	extension := types.RecordExtension{
+		RecordReference: record.Ref,
+		Kind:            types.MEMORYEXTENSION,
+		Data:            testutils.GenerateRandBytes(),
+	}
+	jd.Sections = []types.Section{types.AdditionalSection{RecordExtensions: []types.RecordExtension{extension}}}
+
+	trm := mock.NewTransformerMock(t)
+	trm.GetJetDropsChannelMock.Return(nil)
+
+	sm := mock.NewStorageSetterMock(t)
+	sm.SavePulseMock.Return(nil)
+	sm.SaveJetDropDataMock.Return(nil)
+	sm.SaveRecordExtensionsMock.Set(func(extensions []models.RecordExtension) (err error) {
+		require.Equal(t, []models.RecordExtension{{
+			RecordReference: models.Reference(record.Ref),
+			Kind:            models.MemoryExtension,
+			Data:            extension.Data,
+		}}, extensions)
+		return nil
+	})
+
+	contr := mock.NewControllerMock(t)
+	contr.SetJetDropDataMock.Return()
+
+	p := NewProcessor(trm, sm, nil, nil, contr, configuration.Processor{Workers: 3})
+	require.NotNil(t, p)
+
+	err := p.process(ctx, &jd)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(1), sm.SaveRecordExtensionsAfterCounter())
+	require.Equal(t, uint64(1), contr.SetJetDropDataAfterCounter())
+}
+
+func TestProcessor_process_PulseJets(t *testing.T) {
+	ctx := belogger.TestContext(t)
+	jd := testutils.CreateJetDropCanonical([]types.Record{testutils.CreateRecordCanonical()})
+	jd.MainSection.Start.PulseJetIDs = []string{"0", "10", "11"}
+
+	trm := mock.NewTransformerMock(t)
+	trm.GetJetDropsChannelMock.Return(nil)
+
+	sm := mock.NewStorageSetterMock(t)
+	sm.SavePulseMock.Return(nil)
+	sm.SaveJetDropDataMock.Return(nil)
+	sm.SavePulseJetsMock.Set(func(pulseNumber int64, jetIDs []string) (err error) {
+		require.Equal(t, int64(jd.MainSection.Start.PulseData.PulseNo), pulseNumber)
+		require.Equal(t, []string{"0", "10", "11"}, jetIDs)
+		return nil
+	})
+
+	contr := mock.NewControllerMock(t)
+	contr.SetJetDropDataMock.Return()
+
+	p := NewProcessor(trm, sm, nil, nil, contr, configuration.Processor{Workers: 3})
+	require.NotNil(t, p)
+
+	err := p.process(ctx, &jd)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(1), sm.SavePulseJetsAfterCounter())
+	require.Equal(t, uint64(1), contr.SetJetDropDataAfterCounter())
+}
+
+func TestProcessor_Start_Batches(t *testing.T) {
+	ctx := belogger.TestContext(t)
+	JDC := make(chan *types.JetDrop)
+	trm := mock.NewTransformerMock(t)
+	trm.GetJetDropsChannelMock.Return(JDC)
+
+	firstPulse := int64(gen.PulseNumber())
+	secondPulse := firstPulse + 10
+	wgStorage := sync.WaitGroup{}
+	wgStorage.Add(3)
+	sm := mock.NewStorageSetterMock(t)
+	sm.SaveJetDropsBatchMock.Set(func(pulse models.Pulse, pulseJetIDs []string, jetDrops []models.JetDropData) (err error) {
+		defer wgStorage.Done()
+		switch sm.SaveJetDropsBatchBeforeCounter() {
+		case 1:
+			require.Equal(t, firstPulse, pulse.PulseNumber)
+			require.Equal(t, []string{"0", "1"}, pulseJetIDs)
+			require.Len(t, jetDrops, 2)
+		case 2:
+			require.Equal(t, firstPulse, pulse.PulseNumber)
+			require.Len(t, jetDrops, 1)
+		default:
+			require.Equal(t, secondPulse, pulse.PulseNumber)
+			require.Len(t, jetDrops, 1)
+		}
+		return nil
+	})
+
+	contr := mock.NewControllerMock(t)
+	contr.SetJetDropDataMock.Return()
+
+	// the batch period is long, so batches are flushed by the size and the pulse change only
+	p := NewProcessor(trm, sm, nil, nil, contr, configuration.Processor{Workers: 1, BatchSize: 2, BatchPeriod: time.Hour})
+	require.NoError(t, p.Start(ctx))
+
+	for i, pn := range []int64{firstPulse, firstPulse, firstPulse, secondPulse} {
+		jd := testutils.CreateJetDropCanonical([]types.Record{testutils.CreateRecordCanonical()})
+		jd.MainSection.Start.PulseData.PulseNo = pn
+		jd.MainSection.Start.JetDropPrefix = strconv.Itoa(i)
+		jd.MainSection.Start.PulseJetIDs = []string{"0", "1"}
+		JDC <- &jd
+	}
+	close(JDC)
+
+	wgStorage.Wait()
+	require.NoError(t, p.Stop(ctx))
+	require.Equal(t, uint64(3), sm.SaveJetDropsBatchAfterCounter())
+	require.Equal(t, uint64(4), contr.SetJetDropDataAfterCounter())
+}
+
+func TestProcessor_processTask_Fallback(t *testing.T) {
+	ctx := belogger.TestContext(t)
+	good := testutils.CreateJetDropCanonical([]types.Record{testutils.CreateRecordCanonical()})
+	bad := testutils.CreateJetDropCanonical([]types.Record{testutils.CreateRecordCanonical()})
+	bad.MainSection.Start.PulseData = good.MainSection.Start.PulseData
+	bad.MainSection.Start.JetDropPrefix = "1"
+
+	trm := mock.NewTransformerMock(t)
+	trm.GetJetDropsChannelMock.Return(nil)
+
+	sm := mock.NewStorageSetterMock(t)
+	sm.SaveJetDropsBatchMock.Return(errors.New("test error"))
+	sm.SavePulseMock.Return(nil)
+	sm.SaveJetDropDataMock.Set(func(jetDrop models.JetDrop, records []models.Record, pulseNumber int64) (err error) {
+		if jetDrop.JetID == "1" {
+			return errors.New("test error")
+		}
+		return nil
+	})
+
+	contr := mock.NewControllerMock(t)
+	contr.SetJetDropDataMock.Set(func(pulse types.Pulse, jetID string) {
+		require.Equal(t, good.MainSection.Start.JetDropPrefix, jetID)
+	})
+
+	dls := mock.NewDeadLetterStorageMock(t)
+	dls.SaveDeadLetterMock.Set(func(deadLetter models.DeadLetter) (err error) {
+		require.Equal(t, "1", deadLetter.JetID)
+		return nil
+	})
+
+	p := NewProcessor(trm, sm, dls, nil, contr, configuration.Processor{Workers: 1, Retry: configuration.Retry{MaxAttempts: 2}})
+	p.active = 1
+	p.processTask(ctx, Task{JDs: []*types.JetDrop{&good, &bad}})
+
+	require.Equal(t, uint64(1), sm.SaveJetDropsBatchAfterCounter())
+	require.Equal(t, uint64(3), sm.SaveJetDropDataAfterCounter())
+	require.Equal(t, uint64(1), contr.SetJetDropDataAfterCounter())
+	require.Equal(t, uint64(1), dls.SaveDeadLetterAfterCounter())
+}
+
+func TestProcessor_jetDropToModel_ExtensionPayloads(t *testing.T) {
+	ctx := belogger.TestContext(t)
+	pn := insolar.PulseNumber(pulse.MinTimePulse + 10)
+	codeBlob := testutils.GenerateRandBytes()
+	code := testutils.GenerateRecordsSilence(1)[0]
+	code.Record.ID = gen.IDWithPulse(pn)
+	code.Record.JetID = insolar.ZeroJetID
+	code.Record.Virtual.Union = &insrecord.Virtual_Code{Code: &insrecord.Code{Code: codeBlob}}
+	largeMemory := bytes.Repeat([]byte{1}, 128*1024)
+	state := testutils.GenerateVirtualActivateRecord(pn, gen.ID(), gen.ID())
+	state.Record.JetID = insolar.ZeroJetID
+	state.Record.Virtual.GetActivate().Memory = largeMemory
+
+	jetDrops, err := transformer.Transform(ctx, &types.PlatformPulseData{
+		Pulse:   &exporter.FullPulse{PulseNumber: pn, Jets: []exporter.JetDropContinue{{JetID: insolar.ZeroJetID}}},
+		Records: []*exporter.Record{code, state},
+	})
+	require.NoError(t, err)
+	require.Len(t, jetDrops, 1)
+	data := jetDropToModel(jetDrops[0])
+	require.Len(t, data.Extensions, 2)
+
+	payloads := map[string][]byte{
+		string(code.Record.ID.Bytes()):  codeBlob,
+		string(state.Record.ID.Bytes()): largeMemory,
+	}
+	require.Len(t, data.Records, 2)
+	for _, record := range data.Records {
+		expected := payloads[string(record.Reference)]
+		require.Equal(t, expected, record.Payload, "records keep their payloads besides the extensions")
+		require.Equal(t, base64.StdEncoding.EncodeToString(expected), *api.RecordToAPI(record).Payload, "REST payload")
+		require.Equal(t, expected, beexporter.RecordToResponse(record).Payload, "exporter payload")
+	}
+}
+
+func TestProcessor_SaveLastSavedPulse(t *testing.T) {
+	ctx := belogger.TestContext(t)
+	trm := mock.NewTransformerMock(t)
+	trm.GetJetDropsChannelMock.Return(nil)
+
+	failedPulse := int64(1030)
+	sm := mock.NewStorageSetterMock(t)
+	sm.SaveJetDropsBatchMock.Set(func(pulse models.Pulse, pulseJetIDs []string, jetDrops []models.JetDropData) (err error) {
+		if pulse.PulseNumber == failedPulse {
+			return errors.New("test error")
+		}
+		return nil
+	})
+	sm.SavePulseMock.Return(nil)
+	sm.SaveJetDropDataMock.Return(nil)
+	sm.SaveRecordExtensionsMock.Return(nil)
+	contr := mock.NewControllerMock(t)
+	contr.SetJetDropDataMock.Return()
+
+	checkpoints := mock.NewCheckpointStorageMock(t)
+	checkpoints.GetCheckpointMock.Expect(models.LastSavedPulseCheckpoint).
+		Return(models.Checkpoint{Name: models.LastSavedPulseCheckpoint, PulseNumber: 1000}, nil)
+	var saved []int64
+	checkpoints.SaveCheckpointMock.Set(func(checkpoint models.Checkpoint) (err error) {
+		require.Equal(t, models.LastSavedPulseCheckpoint, checkpoint.Name)
+		saved = append(saved, checkpoint.PulseNumber)
+		return nil
+	})
+
+	p := NewProcessor(trm, sm, nil, checkpoints, contr, configuration.Processor{Workers: 1})
+	p.loadLastSavedPulse(ctx)
+	jetDrop := func(pn int64) *types.JetDrop {
+		jd := testutils.CreateJetDropCanonical([]types.Record{testutils.CreateRecordCanonical()})
+		jd.MainSection.Start.PulseData.PulseNo = pn
+		return &jd
+	}
+
+	require.NoError(t, p.processBatch(ctx, []*types.JetDrop{jetDrop(990)}))
+	require.Empty(t, saved, "reloaded pulse before the checkpoint doesn't move it back")
+	require.NoError(t, p.processBatch(ctx, []*types.JetDrop{jetDrop(1020)}))
+	require.Error(t, p.processBatch(ctx, []*types.JetDrop{jetDrop(failedPulse)}))
+	require.NoError(t, p.process(ctx, jetDrop(1010)))
+	require.NoError(t, p.process(ctx, jetDrop(1040)))
+	require.Equal(t, []int64{1020, 1040}, saved, "only pulses with saved jet drops are checkpointed")
+}
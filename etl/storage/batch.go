@@ -0,0 +1,209 @@
+package storage
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/insolar/block-explorer/etl/models"
+)
+
+// maxStatementParams is the limit of bind parameters in one postgres statement
+const maxStatementParams = 65535
+
+// SaveJetDropsBatch saves provided pulse with its jet tree, jet drops, records and record extensions to db
+// in one transaction using multi-row inserts. Already saved jet drops, records and extensions are updated,
+// jet_drop_amount and record_amount of the pulse are increased by new jet drops only.
+func (s *Storage) SaveJetDropsBatch(pulse models.Pulse, pulseJetIDs []string, jetDrops []models.JetDropData) error {
+	timer := prometheus.NewTimer(SaveJetDropsBatchDuration)
+	defer timer.ObserveDuration()
+
+	// the same row can't be upserted twice by one statement, the last copy wins
+	jetDrops = uniqueJetDrops(jetDrops)
+	var drops, records, extensions []interface{}
+	recordAmounts := make(map[string]int, len(jetDrops))
+	for i := range jetDrops {
+		jd := &jetDrops[i]
+		drops = append(drops, &jd.JetDrop)
+		recordAmounts[jd.JetDrop.JetID] = len(jd.Records)
+		for j := range jd.Records {
+			records = append(records, &jd.Records[j])
+		}
+		for j := range jd.Extensions {
+			extensions = append(extensions, &jd.Extensions[j])
+		}
+	}
+	records = uniqueRows(records, func(row interface{}) string { return string(row.(*models.Record).Reference) })
+	extensions = uniqueRows(extensions, func(row interface{}) string {
+		e := row.(*models.RecordExtension)
+		return string(e.RecordReference) + string(e.Kind)
+	})
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if err := savePulse(tx, pulse); err != nil {
+			return err
+		}
+		if err := savePulseJets(tx, pulse.PulseNumber, pulseJetIDs); err != nil {
+			return err
+		}
+
+		if len(drops) == 0 {
+			return nil
+		}
+		var newJetDrops, newRecords int
+		err := forEachInsert(tx, drops, "ON CONFLICT (pulse_number, jet_id) DO UPDATE SET "+updateColumns(tx, drops[0])+
+			" RETURNING jet_id, (xmax = 0) AS inserted", func(query string, args []interface{}) error {
+			rows, err := tx.CommonDB().Query(query, args...)
+			if err != nil {
+				return err
+			}
+			defer rows.Close()
+			for rows.Next() {
+				var jetID string
+				var inserted bool
+				if err := rows.Scan(&jetID, &inserted); err != nil {
+					return err
+				}
+				if inserted {
+					newJetDrops++
+					newRecords += recordAmounts[jetID]
+				}
+			}
+			return rows.Err()
+		})
+		if err != nil {
+			return errors.Wrap(err, "error while saving jetDrops")
+		}
+
+		if len(records) > 0 {
+			err = forEachInsert(tx, records, "ON CONFLICT (reference) DO UPDATE SET "+updateColumns(tx, records[0]),
+				func(query string, args []interface{}) error {
+					_, err := tx.CommonDB().Exec(query, args...)
+					return err
+				})
+			if err != nil {
+				return errors.Wrap(err, "error while saving records")
+			}
+		}
+
+		if len(extensions) > 0 {
+			err = forEachInsert(tx, extensions, "ON CONFLICT (record_reference, kind) DO UPDATE SET data = EXCLUDED.data",
+				func(query string, args []interface{}) error {
+					_, err := tx.CommonDB().Exec(query, args...)
+					return err
+				})
+			if err != nil {
+				return errors.Wrap(err, "error while saving record extensions")
+			}
+		}
+
+		if newJetDrops == 0 {
+			return nil
+		}
+		err = tx.Model(&models.Pulse{PulseNumber: pulse.PulseNumber}).
+			UpdateColumns(map[string]interface{}{
+				"jet_drop_amount": gorm.Expr("jet_drop_amount + ?", newJetDrops),
+				"record_amount":   gorm.Expr("record_amount + ?", newRecords),
+			}).Error
+		if err != nil {
+			return errors.Wrap(err, "error to update pulse data")
+		}
+		return nil
+	})
+}
+
+func uniqueJetDrops(jetDrops []models.JetDropData) []models.JetDropData {
+	index := make(map[string]int, len(jetDrops))
+	result := make([]models.JetDropData, 0, len(jetDrops))
+	for _, jd := range jetDrops {
+		if i, ok := index[jd.JetDrop.JetID]; ok {
+			result[i] = jd
+			continue
+		}
+		index[jd.JetDrop.JetID] = len(result)
+		result = append(result, jd)
+	}
+	return result
+}
+
+func uniqueRows(rows []interface{}, key func(row interface{}) string) []interface{} {
+	index := make(map[string]int, len(rows))
+	result := make([]interface{}, 0, len(rows))
+	for _, row := range rows {
+		k := key(row)
+		if i, ok := index[k]; ok {
+			result[i] = row
+			continue
+		}
+		index[k] = len(result)
+		result = append(result, row)
+	}
+	return result
+}
+
+// insertFields returns the columns of the model which are inserted to db
+func insertFields(tx *gorm.DB, row interface{}) []*gorm.Field {
+	var fields []*gorm.Field
+	for _, f := range tx.NewScope(row).Fields() {
+		if !f.IsIgnored && f.IsNormal {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// updateColumns returns the SET clause which updates all not primary key columns of the model by inserted values
+func updateColumns(tx *gorm.DB, row interface{}) string {
+	var columns []string
+	for _, f := range insertFields(tx, row) {
+		if !f.IsPrimaryKey {
+			column := tx.Dialect().Quote(f.DBName)
+			columns = append(columns, column+" = EXCLUDED."+column)
+		}
+	}
+	return strings.Join(columns, ", ")
+}
+
+// forEachInsert splits rows of the same model to multi-row INSERT statements with the suffix,
+// so every statement fits the limit of bind parameters, and calls exec for every statement.
+// Statements use postgres placeholders and have to be executed by the underlying connection,
+// gorm would expand byte slices of the named types to lists.
+func forEachInsert(tx *gorm.DB, rows []interface{}, suffix string, exec func(query string, args []interface{}) error) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	scope := tx.NewScope(rows[0])
+	fields := insertFields(tx, rows[0])
+	columns := make([]string, 0, len(fields))
+	for _, f := range fields {
+		columns = append(columns, scope.Quote(f.DBName))
+	}
+	rowsPerStatement := maxStatementParams / len(columns)
+
+	for start := 0; start < len(rows); start += rowsPerStatement {
+		end := start + rowsPerStatement
+		if end > len(rows) {
+			end = len(rows)
+		}
+		values := make([]string, 0, end-start)
+		args := make([]interface{}, 0, (end-start)*len(columns))
+		placeholders := make([]string, len(columns))
+		for _, row := range rows[start:end] {
+			for i, f := range insertFields(tx, row) {
+				args = append(args, f.Field.Interface())
+				placeholders[i] = "$" + strconv.Itoa(len(args))
+			}
+			values = append(values, "("+strings.Join(placeholders, ", ")+")")
+		}
+		query := fmt.Sprintf("INSERT INTO %s (%s) VALUES %s %s",
+			scope.QuotedTableName(), strings.Join(columns, ", "), strings.Join(values, ", "), suffix)
+		if err := exec(query, args); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
@@ -81,18 +82,93 @@ func (s *Storage) updateJD(jetDrop models.JetDrop, records []models.Record) erro
 	})
 }
 
+// SaveRecordExtensions saves provided extensions of the saved records to db in one transaction.
+func (s *Storage) SaveRecordExtensions(extensions []models.RecordExtension) error {
+	timer := prometheus.NewTimer(SaveRecordExtensionsDuration)
+	defer timer.ObserveDuration()
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		for _, extension := range extensions {
+			if err := tx.Save(&extension).Error; err != nil { // nolint
+				return errors.Wrap(err, "error while saving record extension")
+			}
+		}
+		return nil
+	})
+}
+
+// GetRecordExtensions returns extensions of records with provided references from db.
+func (s *Storage) GetRecordExtensions(refs []models.Reference) ([]models.RecordExtension, error) {
+	timer := prometheus.NewTimer(GetRecordExtensionsDuration)
+	defer timer.ObserveDuration()
+
+	var extensions []models.RecordExtension
+	if len(refs) == 0 {
+		return extensions, nil
+	}
+	references := make([][]byte, 0, len(refs))
+	for _, r := range refs {
+		references = append(references, r)
+	}
+	err := s.db.Where("record_reference IN (?)", references).Order("record_reference asc").Order("kind asc").Find(&extensions).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "error while selecting record extensions")
+	}
+	return extensions, nil
+}
+
 // SavePulse saves provided pulse to db.
 func (s *Storage) SavePulse(pulse models.Pulse) error {
 	timer := prometheus.NewTimer(SavePulseDuration)
 	defer timer.ObserveDuration()
 
-	err := s.db.Set("gorm:insert_option", ""+
+	return savePulse(s.db, pulse)
+}
+
+func savePulse(db *gorm.DB, pulse models.Pulse) error {
+	err := db.Set("gorm:insert_option", ""+
 		"ON CONFLICT (pulse_number) DO UPDATE SET prev_pulse_number=EXCLUDED.prev_pulse_number, "+
 		"next_pulse_number=EXCLUDED.next_pulse_number, timestamp=EXCLUDED.timestamp",
 	).Create(&pulse).Error
 	return errors.Wrap(err, "error while saving pulse")
 }
 
+// SavePulseJets saves provided jet tree of the pulse to db, already saved jets are skipped.
+func (s *Storage) SavePulseJets(pulseNumber int64, jetIDs []string) error {
+	timer := prometheus.NewTimer(SavePulseJetsDuration)
+	defer timer.ObserveDuration()
+
+	return savePulseJets(s.db, pulseNumber, jetIDs)
+}
+
+func savePulseJets(db *gorm.DB, pulseNumber int64, jetIDs []string) error {
+	if len(jetIDs) == 0 {
+		return nil
+	}
+	values := make([]string, 0, len(jetIDs))
+	args := make([]interface{}, 0, len(jetIDs)*2)
+	for _, jetID := range jetIDs {
+		values = append(values, "(?, ?)")
+		args = append(args, pulseNumber, jetID)
+	}
+	err := db.Exec("INSERT INTO pulse_jets (pulse_number, jet_id) VALUES "+strings.Join(values, ", ")+
+		" ON CONFLICT DO NOTHING", args...).Error
+	return errors.Wrap(err, "error while saving pulse jets")
+}
+
+// GetPulseJets returns the jet tree of the pulse from db, it's empty if the jet tree wasn't saved.
+func (s *Storage) GetPulseJets(pulseNumber int64) ([]models.PulseJet, error) {
+	timer := prometheus.NewTimer(GetPulseJetsDuration)
+	defer timer.ObserveDuration()
+
+	var jets []models.PulseJet
+	err := s.db.Where("pulse_number = ?", pulseNumber).Order("jet_id asc").Find(&jets).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while selecting jets of pulse %d", pulseNumber)
+	}
+	return jets, nil
+}
+
 // CompletePulse update pulse with provided number to completeness in db.
 func (s *Storage) CompletePulse(pulseNumber int64) error {
 	timer := prometheus.NewTimer(CompletePulseDuration)
@@ -135,6 +211,38 @@ func (s *Storage) SequencePulse(pulseNumber int64) error {
 	})
 }
 
+// DeletePulses deletes pulses with pulse numbers between fromPulseNumber and toPulseNumber inclusive
+// with their jet drops, records and audit findings in one transaction.
+// Pulses after the range are not sequential anymore, so they are reset to not sequential.
+func (s *Storage) DeletePulses(fromPulseNumber, toPulseNumber int64) error {
+	timer := prometheus.NewTimer(DeletePulsesDuration)
+	defer timer.ObserveDuration()
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		inRange := "pulse_number >= ? AND pulse_number <= ?"
+		if err := tx.Where(inRange, fromPulseNumber, toPulseNumber).Delete(&models.Record{}).Error; err != nil {
+			return errors.Wrap(err, "error while deleting records")
+		}
+		if err := tx.Where(inRange, fromPulseNumber, toPulseNumber).Delete(&models.JetDrop{}).Error; err != nil {
+			return errors.Wrap(err, "error while deleting jet drops")
+		}
+		if err := tx.Where(inRange, fromPulseNumber, toPulseNumber).Delete(&models.AuditFinding{}).Error; err != nil {
+			return errors.Wrap(err, "error while deleting audit findings")
+		}
+		if err := tx.Where(inRange, fromPulseNumber, toPulseNumber).Delete(&models.DeadLetter{}).Error; err != nil {
+			return errors.Wrap(err, "error while deleting dead letters")
+		}
+		if err := tx.Where(inRange, fromPulseNumber, toPulseNumber).Delete(&models.Pulse{}).Error; err != nil {
+			return errors.Wrap(err, "error while deleting pulses")
+		}
+		err := tx.Model(&models.Pulse{}).Where("pulse_number > ?", toPulseNumber).
+			UpdateColumn("is_sequential", false).Error
+		if err != nil {
+			return errors.Wrap(err, "error while resetting pulses to not sequential")
+		}
+		return nil
+	})
+}
+
 // GetJetDrops returns records with provided reference from db.
 func (s *Storage) GetRecord(ref models.Reference) (models.Record, error) {
 	timer := prometheus.NewTimer(GetRecordDuration)
@@ -144,6 +252,51 @@ func (s *Storage) GetRecord(ref models.Reference) (models.Record, error) {
 	return record, err
 }
 
+// GetCallTree returns the request with provided reference, requests caused by it recursively
+// up to maxDepth levels of nesting and results of all of them.
+func (s *Storage) GetCallTree(requestReference models.Reference, maxDepth int) ([]models.Record, error) {
+	timer := prometheus.NewTimer(GetCallTreeDuration)
+	defer timer.ObserveDuration()
+
+	var request models.Record
+	err := s.db.Where("reference = ?", []byte(requestReference)).Where("type = ?", models.Request).First(&request).Error
+	if err != nil {
+		return nil, err
+	}
+
+	tree := []models.Record{request}
+	visited := map[string]struct{}{string(request.Reference): {}}
+	level := [][]byte{request.Reference}
+	for depth := 0; len(level) > 0; depth++ {
+		var results []models.Record
+		err := s.db.Where("request_reference IN (?)", level).Where("type = ?", models.Result).Find(&results).Error
+		if err != nil {
+			return nil, errors.Wrap(err, "error while selecting results")
+		}
+		tree = append(tree, results...)
+		if depth >= maxDepth {
+			break
+		}
+
+		var requests []models.Record
+		err = s.db.Where("reason_reference IN (?)", level).Where("type = ?", models.Request).
+			Order("pulse_number asc").Order("jet_id asc").Order("\"order\" asc").Find(&requests).Error
+		if err != nil {
+			return nil, errors.Wrap(err, "error while selecting requests")
+		}
+		level = nil
+		for _, r := range requests {
+			if _, ok := visited[string(r.Reference)]; ok {
+				continue
+			}
+			visited[string(r.Reference)] = struct{}{}
+			tree = append(tree, r)
+			level = append(level, r.Reference)
+		}
+	}
+	return tree, nil
+}
+
 func CheckIndex(i string) (int, int, error) {
 	index := strings.Split(i, ":")
 	if len(index) != 2 {
@@ -172,6 +325,25 @@ func filterByPulse(query *gorm.DB, pulseNumberLt, pulseNumberGt *int64) *gorm.DB
 	return query
 }
 
+func filterByRecordFields(query *gorm.DB, filter models.RecordFilter) *gorm.DB {
+	if filter.Subtype != nil {
+		query = query.Where("subtype = ?", *filter.Subtype)
+	}
+	if filter.Caller != nil {
+		query = query.Where("caller = ?", []byte(filter.Caller))
+	}
+	if filter.Method != nil {
+		query = query.Where("method = ?", *filter.Method)
+	}
+	if filter.Immutable != nil {
+		query = query.Where("immutable = ?", *filter.Immutable)
+	}
+	if filter.APIRequestID != nil {
+		query = query.Where("api_request_id = ?", *filter.APIRequestID)
+	}
+	return query
+}
+
 func filterByPulseNumber(query *gorm.DB, pulseNumberLte, pulseNumberLt, pulseNumberGte, pulseNumberGt *int64) *gorm.DB {
 	if pulseNumberLte != nil {
 		query = query.Where("pulse_number <= ?", *pulseNumberLte)
@@ -217,6 +389,20 @@ func filterByTimestamp(query *gorm.DB, timestampLte, timestampGte *int64) *gorm.
 	return query
 }
 
+// filterRecords filters records by prototypes, object references and types if they are not empty
+func filterRecords(query *gorm.DB, prototypes, objectReferences [][]byte, recordTypes []string) *gorm.DB {
+	if len(prototypes) > 0 {
+		query = query.Where("records.prototype_reference IN (?)", prototypes)
+	}
+	if len(objectReferences) > 0 {
+		query = query.Where("records.object_reference IN (?)", objectReferences)
+	}
+	if len(recordTypes) > 0 {
+		query = query.Where("records.type IN (?)", recordTypes)
+	}
+	return query
+}
+
 func sortRecordsByDirection(query *gorm.DB, sortByIndexAsc bool) *gorm.DB {
 	if sortByIndexAsc {
 		query = query.Order("pulse_number asc").Order("\"order\" asc")
@@ -255,7 +441,7 @@ func getPulses(query *gorm.DB, limit, offset int) ([]models.Pulse, int, error) {
 }
 
 // GetLifeline returns records for provided object reference, ordered by pulse number and order fields.
-func (s *Storage) GetLifeline(objRef []byte, fromIndex *string, pulseNumberLt, pulseNumberGt, timestampLte, timestampGte *int64, limit, offset int, sortByIndexAsc bool) ([]models.Record, int, error) {
+func (s *Storage) GetLifeline(objRef []byte, fromIndex *string, pulseNumberLt, pulseNumberGt, timestampLte, timestampGte *int64, limit, offset int, sortByIndexAsc bool, filter models.RecordFilter) ([]models.Record, int, error) {
 	timer := prometheus.NewTimer(GetLifelineDuration)
 	defer timer.ObserveDuration()
 
@@ -265,6 +451,8 @@ func (s *Storage) GetLifeline(objRef []byte, fromIndex *string, pulseNumberLt, p
 
 	query = filterByTimestamp(query, timestampLte, timestampGte)
 
+	query = filterByRecordFields(query, filter)
+
 	var err error
 	if fromIndex != nil {
 		query, err = filterRecordsByIndex(query, *fromIndex, sortByIndexAsc)
@@ -379,7 +567,7 @@ func (s *Storage) updatePrevPulse(pulse models.Pulse) models.Pulse {
 }
 
 // GetRecordsByJetDrop returns records for provided jet drop, ordered by order field.
-func (s *Storage) GetRecordsByJetDrop(jetDropID models.JetDropID, fromIndex, recordType *string, limit, offset int) ([]models.Record, int, error) {
+func (s *Storage) GetRecordsByJetDrop(jetDropID models.JetDropID, fromIndex, recordType *string, limit, offset int, filter models.RecordFilter) ([]models.Record, int, error) {
 	timer := prometheus.NewTimer(GetRecordsByJetDropDuration)
 	defer timer.ObserveDuration()
 
@@ -389,6 +577,8 @@ func (s *Storage) GetRecordsByJetDrop(jetDropID models.JetDropID, fromIndex, rec
 		query = query.Where("type = ?", *recordType)
 	}
 
+	query = filterByRecordFields(query, filter)
+
 	var err error
 	if fromIndex != nil {
 		query, err = filterRecordsByIndex(query, *fromIndex, true)
@@ -560,26 +750,214 @@ func (s *Storage) GetJetDropsByJetID(jetID string, pulseNumberLte, pulseNumberLt
 	return jetDrops, int(total), nil
 }
 
-func (s *Storage) GetNextCompletePulseFilterByPrototypeReference(prevPulse int64, prototypes [][]byte) (models.Pulse, error) {
-	var pulse models.Pulse
-	db := s.db.Model(&pulse).Joins("JOIN records ON records.pulse_number = pulses.pulse_number").
-		Where("pulses.prev_pulse_number = ?", prevPulse).
-		Where("pulses.is_complete = ?", true).
-		Where("records.prototype_reference IN (?)", prototypes)
+// GetNextSequentialPulseFilterByRecords returns first sequential pulse with pulse number bigger then fromPulseNumber
+// that contains records matched by provided prototypes, object references and types from db.
+// Filters are ignored if they are empty.
+func (s *Storage) GetNextSequentialPulseFilterByRecords(fromPulseNumber int64, prototypes, objectReferences [][]byte, recordTypes []string) (models.Pulse, error) {
+	timer := prometheus.NewTimer(GetNextSequentialPulseFilterByRecordsDuration)
+	defer timer.ObserveDuration()
+
+	query := s.db.Where("pulses.pulse_number > ?", fromPulseNumber).
+		Where("pulses.is_sequential = ?", true)
+	if len(prototypes) > 0 || len(objectReferences) > 0 || len(recordTypes) > 0 {
+		records := s.db.Model(&models.Record{}).Select("1").
+			Where("records.pulse_number = pulses.pulse_number")
+		records = filterRecords(records, prototypes, objectReferences, recordTypes)
+		query = query.Where("EXISTS ?", records.SubQuery())
+	}
 
-	err := db.Limit(-1).Find(&pulse).Error
+	var pulses []models.Pulse
+	err := query.Order("pulses.pulse_number asc").Limit(1).Find(&pulses).Error
 	if err != nil {
-		return pulse, nil
+		return models.Pulse{}, err
+	}
+	if len(pulses) == 0 {
+		return models.Pulse{}, nil
 	}
+	return pulses[0], err
+}
 
-	var total int64
-	err = db.Count(&total).Error
+// GetNextSequentialPulse returns first sequential pulse with pulse number bigger then fromPulseNumber from db.
+func (s *Storage) GetNextSequentialPulse(fromPulseNumber int64) (models.Pulse, error) {
+	timer := prometheus.NewTimer(GetNextSequentialPulseDuration)
+	defer timer.ObserveDuration()
+
+	var pulses []models.Pulse
+	err := s.db.Where("pulse_number > ?", fromPulseNumber).
+		Where("is_sequential = ?", true).
+		Order("pulse_number asc").Limit(1).Find(&pulses).Error
 	if err != nil {
-		return pulse, nil
+		return models.Pulse{}, err
+	}
+	if len(pulses) == 0 {
+		return models.Pulse{}, nil
 	}
+	return pulses[0], err
+}
 
-	// need to change the record amount regarding by count
-	pulse.RecordAmount = total
+// GetRecordsByPulse returns records of the pulse placed after provided jet id and order, ordered by jet id and order fields.
+// Records are filtered by prototypes, object references and types if they are not empty.
+func (s *Storage) GetRecordsByPulse(pulseNumber int64, fromJetID string, fromOrder int, prototypes, objectReferences [][]byte, recordTypes []string, limit int) ([]models.Record, error) {
+	timer := prometheus.NewTimer(GetRecordsByPulseDuration)
+	defer timer.ObserveDuration()
 
-	return pulse, err
+	query := s.db.Model(&models.Record{}).Where("pulse_number = ?", pulseNumber).
+		Where("(jet_id > ? OR (jet_id = ? AND \"order\" > ?))", fromJetID, fromJetID, fromOrder)
+	query = filterRecords(query, prototypes, objectReferences, recordTypes)
+
+	records := []models.Record{}
+	err := query.Order("jet_id asc").Order("\"order\" asc").Limit(limit).Find(&records).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while select records for pulse %v from db", pulseNumber)
+	}
+	return records, nil
+}
+
+// GetRecordAmountsByPulse returns amounts of the pulse records grouped by jet id and prototype reference,
+// ordered by prototype reference and jet id fields.
+// Records are filtered by prototypes, object references and types if they are not empty.
+func (s *Storage) GetRecordAmountsByPulse(pulseNumber int64, prototypes, objectReferences [][]byte, recordTypes []string) ([]models.JetDropRecordAmount, error) {
+	timer := prometheus.NewTimer(GetRecordAmountsByPulseDuration)
+	defer timer.ObserveDuration()
+
+	query := s.db.Model(&models.Record{}).
+		Select("jet_id, prototype_reference, count(*) as record_amount").
+		Where("pulse_number = ?", pulseNumber)
+	query = filterRecords(query, prototypes, objectReferences, recordTypes)
+
+	amounts := []models.JetDropRecordAmount{}
+	err := query.Group("jet_id, prototype_reference").
+		Order("prototype_reference asc").Order("jet_id asc").
+		Scan(&amounts).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while count records of pulse %v in db", pulseNumber)
+	}
+	return amounts, nil
+}
+
+// SaveAuditFindings replaces audit findings of the pulse with provided ones in one transaction.
+func (s *Storage) SaveAuditFindings(pulseNumber int64, findings []models.AuditFinding) error {
+	timer := prometheus.NewTimer(SaveAuditFindingsDuration)
+	defer timer.ObserveDuration()
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("pulse_number = ?", pulseNumber).Delete(&models.AuditFinding{}).Error
+		if err != nil {
+			return errors.Wrapf(err, "error while deleting audit findings of pulse %v", pulseNumber)
+		}
+		for _, f := range findings {
+			f.PulseNumber = pulseNumber
+			if err := tx.Create(&f).Error; err != nil {
+				return errors.Wrapf(err, "error while saving audit finding of jet drop %s:%v", f.JetID, pulseNumber)
+			}
+		}
+		return nil
+	})
+}
+
+// SaveDeadLetter saves provided dead letter to db, the dead letter of the same jet drop is updated
+// with the new error and the attempts are added to its ones.
+func (s *Storage) SaveDeadLetter(deadLetter models.DeadLetter) error {
+	timer := prometheus.NewTimer(SaveDeadLetterDuration)
+	defer timer.ObserveDuration()
+
+	err := s.db.Set("gorm:insert_option", ""+
+		"ON CONFLICT (pulse_number, jet_id) DO UPDATE SET jet_drop=EXCLUDED.jet_drop, error=EXCLUDED.error, "+
+		"attempts=dead_letters.attempts+EXCLUDED.attempts, timestamp=EXCLUDED.timestamp",
+	).Create(&deadLetter).Error
+	return errors.Wrapf(err, "error while saving dead letter of jet drop %s:%v", deadLetter.JetID, deadLetter.PulseNumber)
+}
+
+// GetDeadLetters returns dead letters without jet drops ordered by id from db.
+func (s *Storage) GetDeadLetters(limit, offset int) ([]models.DeadLetter, error) {
+	timer := prometheus.NewTimer(GetDeadLettersDuration)
+	defer timer.ObserveDuration()
+
+	var deadLetters []models.DeadLetter
+	err := s.db.Select("id, pulse_number, jet_id, error, attempts, timestamp").
+		Order("id asc").Limit(limit).Offset(offset).Find(&deadLetters).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "error while selecting dead letters")
+	}
+	return deadLetters, nil
+}
+
+// GetDeadLetter returns dead letter with provided id from db.
+func (s *Storage) GetDeadLetter(id int64) (models.DeadLetter, error) {
+	timer := prometheus.NewTimer(GetDeadLetterDuration)
+	defer timer.ObserveDuration()
+
+	deadLetter := models.DeadLetter{}
+	err := s.db.Where("id = ?", id).First(&deadLetter).Error
+	return deadLetter, err
+}
+
+// DeleteDeadLetter deletes dead letter with provided id from db, gorm.ErrRecordNotFound is returned if there is no such dead letter.
+func (s *Storage) DeleteDeadLetter(id int64) error {
+	timer := prometheus.NewTimer(DeleteDeadLetterDuration)
+	defer timer.ObserveDuration()
+
+	result := s.db.Where("id = ?", id).Delete(&models.DeadLetter{})
+	if result.Error != nil {
+		return errors.Wrapf(result.Error, "error while deleting dead letter %d", id)
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// SaveCheckpoint saves provided checkpoint to db, the checkpoint with the same name is replaced.
+func (s *Storage) SaveCheckpoint(checkpoint models.Checkpoint) error {
+	timer := prometheus.NewTimer(SaveCheckpointDuration)
+	defer timer.ObserveDuration()
+
+	err := s.db.Set("gorm:insert_option", ""+
+		"ON CONFLICT (name) DO UPDATE SET pulse_number=EXCLUDED.pulse_number, timestamp=EXCLUDED.timestamp",
+	).Create(&checkpoint).Error
+	return errors.Wrapf(err, "error while saving checkpoint %s", checkpoint.Name)
+}
+
+// GetCheckpoint returns checkpoint with provided name from db.
+func (s *Storage) GetCheckpoint(name string) (models.Checkpoint, error) {
+	timer := prometheus.NewTimer(GetCheckpointDuration)
+	defer timer.ObserveDuration()
+
+	checkpoint := models.Checkpoint{}
+	err := s.db.Where("name = ?", name).First(&checkpoint).Error
+	return checkpoint, err
+}
+
+// SaveReloadAttempt saves the request of pulses range to db, attempts of already saved range are increased.
+func (s *Storage) SaveReloadAttempt(fromPulseNumber, toPulseNumber int64) (models.ReloadRange, error) {
+	timer := prometheus.NewTimer(SaveReloadAttemptDuration)
+	defer timer.ObserveDuration()
+
+	reloadRange := models.ReloadRange{}
+	err := s.db.Raw("INSERT INTO reload_ranges (from_pulse_number, to_pulse_number, attempts, timestamp) VALUES (?, ?, 1, ?) "+
+		"ON CONFLICT (from_pulse_number, to_pulse_number) DO UPDATE SET attempts=reload_ranges.attempts+1, timestamp=EXCLUDED.timestamp "+
+		"RETURNING *", fromPulseNumber, toPulseNumber, time.Now().Unix()).Scan(&reloadRange).Error
+	return reloadRange, errors.Wrapf(err, "error while saving reload of pulses %d - %d", fromPulseNumber, toPulseNumber)
+}
+
+// GetReloadRanges returns saved pulse ranges ordered by from pulse number asc and to pulse number desc.
+func (s *Storage) GetReloadRanges() ([]models.ReloadRange, error) {
+	timer := prometheus.NewTimer(GetReloadRangesDuration)
+	defer timer.ObserveDuration()
+
+	var reloadRanges []models.ReloadRange
+	err := s.db.Order("from_pulse_number asc").Order("to_pulse_number desc").Find(&reloadRanges).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "error while selecting reload ranges")
+	}
+	return reloadRanges, nil
+}
+
+// DeleteReloadRanges deletes pulse ranges up to provided pulse number inclusive from db.
+func (s *Storage) DeleteReloadRanges(toPulseNumber int64) error {
+	timer := prometheus.NewTimer(DeleteReloadRangesDuration)
+	defer timer.ObserveDuration()
+
+	err := s.db.Where("to_pulse_number <= ?", toPulseNumber).Delete(&models.ReloadRange{}).Error
+	return errors.Wrapf(err, "error while deleting reload ranges up to pulse %d", toPulseNumber)
 }
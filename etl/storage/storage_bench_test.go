@@ -34,3 +34,50 @@ func BenchmarkSaveJetDropData(b *testing.B) {
 		require.NoError(b, err)
 	}
 }
+
+// benchmarkJetDrops returns jet drops of the new pulse with the recordAmount records each,
+// the pulse benchmarks below save the same 20 jet drops with 2000 records per operation
+func benchmarkJetDrops(b *testing.B, jetDropAmount, recordAmount int) (models.Pulse, []models.JetDropData) {
+	testutils.TruncateTables(b, testDB, []interface{}{models.Record{}, models.JetDrop{}, models.Pulse{}})
+	pulse, err := testutils.InitPulseDB()
+	require.NoError(b, err)
+	jetDrops := make([]models.JetDropData, 0, jetDropAmount)
+	for i := 0; i < jetDropAmount; i++ {
+		jetDrop := testutils.InitJetDropDB(pulse)
+		records := make([]models.Record, 0, recordAmount)
+		for j := 0; j < recordAmount; j++ {
+			records = append(records, testutils.InitRecordDB(jetDrop))
+		}
+		jetDrops = append(jetDrops, models.JetDropData{JetDrop: jetDrop, Records: records})
+	}
+	return pulse, jetDrops
+}
+
+// BenchmarkSavePulse_JetDropByJetDrop saves a pulse the way the processor saves single jet drops
+func BenchmarkSavePulse_JetDropByJetDrop(b *testing.B) {
+	s := NewStorage(testDB)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		pulse, jetDrops := benchmarkJetDrops(b, 20, 100)
+		b.StartTimer()
+
+		for _, jd := range jetDrops {
+			require.NoError(b, s.SavePulse(pulse))
+			require.NoError(b, s.SaveJetDropData(jd.JetDrop, jd.Records, pulse.PulseNumber))
+		}
+	}
+}
+
+// BenchmarkSavePulse_Batch saves a pulse in one transaction with multi-row inserts
+func BenchmarkSavePulse_Batch(b *testing.B) {
+	s := NewStorage(testDB)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		pulse, jetDrops := benchmarkJetDrops(b, 20, 100)
+		b.StartTimer()
+
+		require.NoError(b, s.SaveJetDropsBatch(pulse, nil, jetDrops))
+	}
+}
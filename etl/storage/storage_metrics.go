@@ -13,11 +13,31 @@ var (
 		Help:       "The duration of the SaveJetDropData function execution",
 		Objectives: quntitile,
 	})
+	SaveJetDropsBatchDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "gbe_storage_stats_SaveJetDropsBatchDuration",
+		Help:       "The duration of the SaveJetDropsBatch function execution",
+		Objectives: quntitile,
+	})
+	SaveRecordExtensionsDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "gbe_storage_stats_SaveRecordExtensionsDuration",
+		Help:       "The duration of the SaveRecordExtensions function execution",
+		Objectives: quntitile,
+	})
 	SavePulseDuration = prometheus.NewSummary(prometheus.SummaryOpts{
 		Name:       "gbe_storage_stats_SavePulseDuration",
 		Help:       "The duration of the SavePulse function execution",
 		Objectives: quntitile,
 	})
+	SavePulseJetsDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "gbe_storage_stats_SavePulseJetsDuration",
+		Help:       "The duration of the SavePulseJets function execution",
+		Objectives: quntitile,
+	})
+	GetPulseJetsDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "gbe_storage_stats_GetPulseJetsDuration",
+		Help:       "The duration of the GetPulseJets function execution",
+		Objectives: quntitile,
+	})
 	CompletePulseDuration = prometheus.NewSummary(prometheus.SummaryOpts{
 		Name:       "gbe_storage_stats_CompletePulseDuration",
 		Help:       "The duration of the CompletePulse function execution",
@@ -28,11 +48,26 @@ var (
 		Help:       "The duration of the SequencePulse function execution",
 		Objectives: quntitile,
 	})
+	DeletePulsesDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "gbe_storage_stats_DeletePulsesDuration",
+		Help:       "The duration of the DeletePulses function execution",
+		Objectives: quntitile,
+	})
 	GetRecordDuration = prometheus.NewSummary(prometheus.SummaryOpts{
 		Name:       "gbe_storage_stats_GetRecordDuration",
 		Help:       "The duration of the GetRecord function execution",
 		Objectives: quntitile,
 	})
+	GetRecordExtensionsDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "gbe_storage_stats_GetRecordExtensionsDuration",
+		Help:       "The duration of the GetRecordExtensions function execution",
+		Objectives: quntitile,
+	})
+	GetCallTreeDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "gbe_storage_stats_GetCallTreeDuration",
+		Help:       "The duration of the GetCallTree function execution",
+		Objectives: quntitile,
+	})
 	GetLifelineDuration = prometheus.NewSummary(prometheus.SummaryOpts{
 		Name:       "gbe_storage_stats_GetLifelineDuration",
 		Help:       "The duration of the GetLifeline function execution",
@@ -93,6 +128,76 @@ var (
 		Help:       "The duration of the GetJetDropsByJetID function execution",
 		Objectives: quntitile,
 	})
+	GetNextSequentialPulseFilterByRecordsDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "gbe_storage_stats_GetNextSequentialPulseFilterByRecordsDuration",
+		Help:       "The duration of the GetNextSequentialPulseFilterByRecords function execution",
+		Objectives: quntitile,
+	})
+	GetNextSequentialPulseDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "gbe_storage_stats_GetNextSequentialPulseDuration",
+		Help:       "The duration of the GetNextSequentialPulse function execution",
+		Objectives: quntitile,
+	})
+	GetRecordsByPulseDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "gbe_storage_stats_GetRecordsByPulseDuration",
+		Help:       "The duration of the GetRecordsByPulse function execution",
+		Objectives: quntitile,
+	})
+	GetRecordAmountsByPulseDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "gbe_storage_stats_GetRecordAmountsByPulseDuration",
+		Help:       "The duration of the GetRecordAmountsByPulse function execution",
+		Objectives: quntitile,
+	})
+	SaveAuditFindingsDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "gbe_storage_stats_SaveAuditFindingsDuration",
+		Help:       "The duration of the SaveAuditFindings function execution",
+		Objectives: quntitile,
+	})
+	SaveDeadLetterDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "gbe_storage_stats_SaveDeadLetterDuration",
+		Help:       "The duration of the SaveDeadLetter function execution",
+		Objectives: quntitile,
+	})
+	GetDeadLettersDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "gbe_storage_stats_GetDeadLettersDuration",
+		Help:       "The duration of the GetDeadLetters function execution",
+		Objectives: quntitile,
+	})
+	GetDeadLetterDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "gbe_storage_stats_GetDeadLetterDuration",
+		Help:       "The duration of the GetDeadLetter function execution",
+		Objectives: quntitile,
+	})
+	DeleteDeadLetterDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "gbe_storage_stats_DeleteDeadLetterDuration",
+		Help:       "The duration of the DeleteDeadLetter function execution",
+		Objectives: quntitile,
+	})
+	SaveCheckpointDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "gbe_storage_stats_SaveCheckpointDuration",
+		Help:       "The duration of the SaveCheckpoint function execution",
+		Objectives: quntitile,
+	})
+	GetCheckpointDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "gbe_storage_stats_GetCheckpointDuration",
+		Help:       "The duration of the GetCheckpoint function execution",
+		Objectives: quntitile,
+	})
+	SaveReloadAttemptDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "gbe_storage_stats_SaveReloadAttemptDuration",
+		Help:       "The duration of the SaveReloadAttempt function execution",
+		Objectives: quntitile,
+	})
+	GetReloadRangesDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "gbe_storage_stats_GetReloadRangesDuration",
+		Help:       "The duration of the GetReloadRanges function execution",
+		Objectives: quntitile,
+	})
+	DeleteReloadRangesDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "gbe_storage_stats_DeleteReloadRangesDuration",
+		Help:       "The duration of the DeleteReloadRanges function execution",
+		Objectives: quntitile,
+	})
 )
 
 // The storage function metrics
@@ -105,11 +210,18 @@ func (s Metrics) Refresh() {
 func (s Metrics) Metrics(p *metrics.Prometheus) []prometheus.Collector {
 	return []prometheus.Collector{
 		SaveJetDropDataDuration,
+		SaveJetDropsBatchDuration,
+		SaveRecordExtensionsDuration,
 		SavePulseDuration,
+		SavePulseJetsDuration,
+		GetPulseJetsDuration,
 		CompletePulseDuration,
 		SequencePulseDuration,
+		DeletePulsesDuration,
 		GetRecordDuration,
 		GetLifelineDuration,
+		GetRecordExtensionsDuration,
+		GetCallTreeDuration,
 		GetPulseDuration,
 		GetPulsesDuration,
 		GetRecordsByJetDropDuration,
@@ -121,5 +233,19 @@ func (s Metrics) Metrics(p *metrics.Prometheus) []prometheus.Collector {
 		GetJetDropsWithParamsDuration,
 		GetJetDropByIDDuration,
 		GetJetDropsByJetIDDuration,
+		GetNextSequentialPulseFilterByRecordsDuration,
+		GetNextSequentialPulseDuration,
+		GetRecordsByPulseDuration,
+		GetRecordAmountsByPulseDuration,
+		SaveAuditFindingsDuration,
+		SaveDeadLetterDuration,
+		GetDeadLettersDuration,
+		GetDeadLetterDuration,
+		DeleteDeadLetterDuration,
+		SaveCheckpointDuration,
+		GetCheckpointDuration,
+		SaveReloadAttemptDuration,
+		GetReloadRangesDuration,
+		DeleteReloadRangesDuration,
 	}
 }
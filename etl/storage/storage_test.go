@@ -678,7 +678,7 @@ func TestStorage_GetLifeline(t *testing.T) {
 
 	expectedRecords := []models.Record{genRecords[2], genRecords[1], genRecords[0]}
 
-	records, total, err := s.GetLifeline(objRef.Bytes(), nil, nil, nil, nil, nil, 20, 0, false)
+	records, total, err := s.GetLifeline(objRef.Bytes(), nil, nil, nil, nil, nil, 20, 0, false, models.RecordFilter{})
 	require.NoError(t, err)
 	require.Equal(t, 3, total)
 	require.Equal(t, expectedRecords, records)
@@ -687,7 +687,7 @@ func TestStorage_GetLifeline(t *testing.T) {
 func TestStorage_GetLifeline_ObjNotExist(t *testing.T) {
 	s := NewStorage(testDB)
 
-	records, total, err := s.GetLifeline(gen.Reference().Bytes(), nil, nil, nil, nil, nil, 20, 0, false)
+	records, total, err := s.GetLifeline(gen.Reference().Bytes(), nil, nil, nil, nil, nil, 20, 0, false, models.RecordFilter{})
 	require.NoError(t, err)
 	require.Equal(t, 0, total)
 	require.Empty(t, records)
@@ -712,7 +712,7 @@ func TestStorage_GetLifeline_Index(t *testing.T) {
 	expectedRecords := []models.Record{genRecords[1], genRecords[0]}
 
 	index := fmt.Sprintf("%d:%d", pulse.PulseNumber, genRecords[1].Order)
-	records, total, err := s.GetLifeline(objRef.Bytes(), &index, nil, nil, nil, nil, 20, 0, false)
+	records, total, err := s.GetLifeline(objRef.Bytes(), &index, nil, nil, nil, nil, 20, 0, false, models.RecordFilter{})
 	require.NoError(t, err)
 	require.Equal(t, 2, total)
 	require.Equal(t, expectedRecords, records)
@@ -726,7 +726,7 @@ func TestStorage_GetLifeline_Index_NotExist(t *testing.T) {
 	objRef := gen.Reference()
 
 	index := fmt.Sprintf("%d:%d", pulseNumber, 10)
-	records, total, err := s.GetLifeline(objRef.Bytes(), &index, nil, nil, nil, nil, 20, 0, false)
+	records, total, err := s.GetLifeline(objRef.Bytes(), &index, nil, nil, nil, nil, 20, 0, false, models.RecordFilter{})
 	require.NoError(t, err)
 	require.Equal(t, 0, total)
 	require.Empty(t, records)
@@ -752,7 +752,7 @@ func TestStorage_GetLifeline_IndexLimit(t *testing.T) {
 
 	limit := 2
 	index := fmt.Sprintf("%d:%d", pulse.PulseNumber, genRecords[3].Order)
-	records, total, err := s.GetLifeline(objRef.Bytes(), &index, nil, nil, nil, nil, limit, 0, false)
+	records, total, err := s.GetLifeline(objRef.Bytes(), &index, nil, nil, nil, nil, limit, 0, false, models.RecordFilter{})
 	require.NoError(t, err)
 	require.Equal(t, 4, total)
 	require.Equal(t, expectedRecords, records)
@@ -778,7 +778,7 @@ func TestStorage_GetLifeline_IndexOffset(t *testing.T) {
 
 	offset := 2
 	index := fmt.Sprintf("%d:%d", pulse.PulseNumber, genRecords[3].Order)
-	records, total, err := s.GetLifeline(objRef.Bytes(), &index, nil, nil, nil, nil, 20, offset, false)
+	records, total, err := s.GetLifeline(objRef.Bytes(), &index, nil, nil, nil, nil, 20, offset, false, models.RecordFilter{})
 	require.NoError(t, err)
 	require.Equal(t, 4, total)
 	require.Equal(t, expectedRecords, records)
@@ -804,7 +804,7 @@ func TestStorage_GetLifeline_IndexLimit_Asc(t *testing.T) {
 
 	limit := 2
 	index := fmt.Sprintf("%d:%d", pulse.PulseNumber, genRecords[2].Order)
-	records, total, err := s.GetLifeline(objRef.Bytes(), &index, nil, nil, nil, nil, limit, 0, true)
+	records, total, err := s.GetLifeline(objRef.Bytes(), &index, nil, nil, nil, nil, limit, 0, true, models.RecordFilter{})
 	require.NoError(t, err)
 	require.Equal(t, 3, total)
 	require.Equal(t, expectedRecords, records)
@@ -829,7 +829,7 @@ func TestStorage_GetLifeline_IndexOffset_Asc(t *testing.T) {
 	expectedRecords := []models.Record{genRecords[3], genRecords[4]}
 
 	index := fmt.Sprintf("%d:%d", pulse.PulseNumber, genRecords[1].Order)
-	records, total, err := s.GetLifeline(objRef.Bytes(), &index, nil, nil, nil, nil, 20, 2, true)
+	records, total, err := s.GetLifeline(objRef.Bytes(), &index, nil, nil, nil, nil, 20, 2, true, models.RecordFilter{})
 	require.NoError(t, err)
 	require.Equal(t, 4, total)
 	require.Equal(t, expectedRecords, records)
@@ -847,7 +847,7 @@ func TestStorage_GetLifeline_TimestampRange(t *testing.T) {
 	timestampGte := int64(pulses[0].pulse.Timestamp)
 	records, total, err := s.GetLifeline(
 		pulses[1].records[0].ObjectReference, nil,
-		nil, nil, &timestampLte, &timestampGte, 20, 0, false)
+		nil, nil, &timestampLte, &timestampGte, 20, 0, false, models.RecordFilter{})
 	require.NoError(t, err)
 	require.Equal(t, 4, total)
 	require.Equal(t, expectedRecords, records)
@@ -863,7 +863,7 @@ func TestStorage_GetLifeline_PulseRange(t *testing.T) {
 
 	records, total, err := s.GetLifeline(
 		pulses[1].records[0].ObjectReference, nil,
-		&pulses[2].pulse.PulseNumber, &pulses[0].pulse.PulseNumber, nil, nil, 20, 0, false)
+		&pulses[2].pulse.PulseNumber, &pulses[0].pulse.PulseNumber, nil, nil, 20, 0, false, models.RecordFilter{})
 	require.NoError(t, err)
 	require.Equal(t, 3, total)
 	require.Equal(t, expectedRecords, records)
@@ -877,7 +877,7 @@ func TestStorage_GetLifeline_PulseRange_SamePulse(t *testing.T) {
 
 	records, total, err := s.GetLifeline(
 		pulses[1].records[0].ObjectReference, nil,
-		&pulses[2].pulse.PulseNumber, &pulses[2].pulse.PulseNumber, nil, nil, 20, 0, false)
+		&pulses[2].pulse.PulseNumber, &pulses[2].pulse.PulseNumber, nil, nil, 20, 0, false, models.RecordFilter{})
 	require.NoError(t, err)
 	require.Equal(t, 0, total)
 	require.Empty(t, records)
@@ -893,7 +893,7 @@ func TestStorage_GetLifeline_PulseRange_Limit(t *testing.T) {
 
 	records, total, err := s.GetLifeline(
 		pulses[1].records[0].ObjectReference, nil,
-		&pulses[2].pulse.PulseNumber, &pulses[0].pulse.PulseNumber, nil, nil, 2, 0, false)
+		&pulses[2].pulse.PulseNumber, &pulses[0].pulse.PulseNumber, nil, nil, 2, 0, false, models.RecordFilter{})
 	require.NoError(t, err)
 	require.Equal(t, 3, total)
 	require.Equal(t, expectedRecords, records)
@@ -909,7 +909,7 @@ func TestStorage_GetLifeline_PulseRange_LimitOffset(t *testing.T) {
 
 	records, total, err := s.GetLifeline(
 		pulses[1].records[0].ObjectReference, nil,
-		&pulses[2].pulse.PulseNumber, &pulses[0].pulse.PulseNumber, nil, nil, 2, 1, false)
+		&pulses[2].pulse.PulseNumber, &pulses[0].pulse.PulseNumber, nil, nil, 2, 1, false, models.RecordFilter{})
 	require.NoError(t, err)
 	require.Equal(t, 4, total)
 	require.Equal(t, expectedRecords, records)
@@ -925,7 +925,7 @@ func TestStorage_GetLifeline_PulseRange_Limit_Asc(t *testing.T) {
 
 	records, total, err := s.GetLifeline(
 		pulses[1].records[0].ObjectReference, nil,
-		&pulses[2].pulse.PulseNumber, &pulses[0].pulse.PulseNumber, nil, nil, 2, 0, true)
+		&pulses[2].pulse.PulseNumber, &pulses[0].pulse.PulseNumber, nil, nil, 2, 0, true, models.RecordFilter{})
 	require.NoError(t, err)
 	require.Equal(t, 3, total)
 	require.Equal(t, expectedRecords, records)
@@ -942,7 +942,7 @@ func TestStorage_GetLifeline_Index_PulseRange(t *testing.T) {
 	index := fmt.Sprintf("%d:%d", pulses[2].pulse.PulseNumber, pulses[2].records[1].Order)
 	records, total, err := s.GetLifeline(
 		pulses[1].records[0].ObjectReference, &index,
-		&pulses[3].pulse.PulseNumber, &pulses[0].pulse.PulseNumber, nil, nil, 20, 0, false)
+		&pulses[3].pulse.PulseNumber, &pulses[0].pulse.PulseNumber, nil, nil, 20, 0, false, models.RecordFilter{})
 	require.NoError(t, err)
 	require.Equal(t, 5, total)
 	require.Equal(t, expectedRecords, records)
@@ -959,7 +959,7 @@ func TestStorage_GetLifeline_AllParams(t *testing.T) {
 	index := fmt.Sprintf("%d:%d", pulses[2].pulse.PulseNumber, pulses[2].records[1].Order)
 	records, total, err := s.GetLifeline(
 		pulses[1].records[0].ObjectReference, &index,
-		&pulses[3].pulse.PulseNumber, &pulses[0].pulse.PulseNumber, nil, nil, 3, 1, false)
+		&pulses[3].pulse.PulseNumber, &pulses[0].pulse.PulseNumber, nil, nil, 3, 1, false, models.RecordFilter{})
 	require.NoError(t, err)
 	require.Equal(t, 5, total)
 	require.Equal(t, expectedRecords, records)
@@ -973,7 +973,7 @@ func TestStorage_GetLifeline_PulseRange_Empty(t *testing.T) {
 
 	records, total, err := s.GetLifeline(
 		pulses[1].records[0].ObjectReference, nil,
-		&pulses[0].pulse.PulseNumber, &pulses[3].pulse.PulseNumber, nil, nil, 20, 0, false)
+		&pulses[0].pulse.PulseNumber, &pulses[3].pulse.PulseNumber, nil, nil, 20, 0, false, models.RecordFilter{})
 	require.NoError(t, err)
 	require.Equal(t, 0, total)
 	require.Empty(t, records)
@@ -1651,7 +1651,7 @@ func TestStorage_GetRecordsByJetDrop(t *testing.T) {
 
 	jetDropID := *models.NewJetDropID(jetDrop1.JetID, int64(pulse.PulseNumber))
 	t.Run("happy", func(t *testing.T) {
-		records, total, err := s.GetRecordsByJetDrop(jetDropID, nil, nil, 1000, 0)
+		records, total, err := s.GetRecordsByJetDrop(jetDropID, nil, nil, 1000, 0, models.RecordFilter{})
 		require.NoError(t, err)
 		require.Equal(t, 3, total)
 		require.Len(t, records, 3)
@@ -1662,14 +1662,14 @@ func TestStorage_GetRecordsByJetDrop(t *testing.T) {
 
 	t.Run("type", func(t *testing.T) {
 		recType := string(models.Result)
-		records, total, err := s.GetRecordsByJetDrop(jetDropID, nil, &recType, 1000, 0)
+		records, total, err := s.GetRecordsByJetDrop(jetDropID, nil, &recType, 1000, 0, models.RecordFilter{})
 		require.NoError(t, err)
 		require.Equal(t, 1, total)
 		require.Equal(t, []models.Record{recordResult}, records)
 	})
 
 	t.Run("limit", func(t *testing.T) {
-		records, total, err := s.GetRecordsByJetDrop(jetDropID, nil, nil, 2, 0)
+		records, total, err := s.GetRecordsByJetDrop(jetDropID, nil, nil, 2, 0, models.RecordFilter{})
 		require.NoError(t, err)
 		require.Equal(t, 3, total)
 		require.Len(t, records, 2)
@@ -1678,7 +1678,7 @@ func TestStorage_GetRecordsByJetDrop(t *testing.T) {
 	})
 
 	t.Run("offset", func(t *testing.T) {
-		records, total, err := s.GetRecordsByJetDrop(jetDropID, nil, nil, 1000, 1)
+		records, total, err := s.GetRecordsByJetDrop(jetDropID, nil, nil, 1000, 1, models.RecordFilter{})
 		require.NoError(t, err)
 		require.Equal(t, 3, total)
 		require.Len(t, records, 2)
@@ -1688,7 +1688,7 @@ func TestStorage_GetRecordsByJetDrop(t *testing.T) {
 
 	t.Run("from_index", func(t *testing.T) {
 		index := fmt.Sprintf("%d:%d", pulse.PulseNumber, recordState1.Order)
-		records, total, err := s.GetRecordsByJetDrop(jetDropID, &index, nil, 1000, 0)
+		records, total, err := s.GetRecordsByJetDrop(jetDropID, &index, nil, 1000, 0, models.RecordFilter{})
 		require.NoError(t, err)
 		require.Equal(t, 2, total)
 		require.Len(t, records, 2)
@@ -1699,7 +1699,7 @@ func TestStorage_GetRecordsByJetDrop(t *testing.T) {
 	t.Run("empty", func(t *testing.T) {
 		jetDropEmpty := testutils.InitJetDropDB(pulse)
 		jetDropIDEmpty := *models.NewJetDropID(jetDropEmpty.JetID, int64(pulse.PulseNumber))
-		records, total, err := s.GetRecordsByJetDrop(jetDropIDEmpty, nil, nil, 1000, 0)
+		records, total, err := s.GetRecordsByJetDrop(jetDropIDEmpty, nil, nil, 1000, 0, models.RecordFilter{})
 		require.NoError(t, err)
 		require.Equal(t, 0, total)
 		require.Empty(t, records)
@@ -2204,19 +2204,21 @@ func TestStorage_GetJetDropsByJetId_MultipleCounts(t *testing.T) {
 	}
 }
 
-func TestStorage_GetNextCompletePulseFilterByPrototypeReference(t *testing.T) {
+func TestStorage_GetNextSequentialPulseFilterByRecords(t *testing.T) {
 	defer testutils.TruncateTables(t, testDB, []interface{}{models.Record{}, models.JetDrop{}, models.Pulse{}})
 	s := NewStorage(testDB)
 
 	pulse, err := testutils.InitPulseDB()
 	require.NoError(t, err)
 	pulse.IsComplete = true
+	pulse.IsSequential = true
 	err = testutils.CreatePulse(testDB, pulse)
 	require.NoError(t, err)
 
 	jetDrop := testutils.InitJetDropDB(pulse)
 	firstRecord := testutils.InitRecordDB(jetDrop)
 	secondRecord := testutils.InitRecordDB(jetDrop)
+	secondRecord.Type = models.Request
 	require.NotEqual(t, firstRecord.PrototypeReference, secondRecord.PrototypeReference)
 	require.NotEmpty(t, firstRecord.PrototypeReference)
 	require.NotEmpty(t, secondRecord.PrototypeReference)
@@ -2224,25 +2226,734 @@ func TestStorage_GetNextCompletePulseFilterByPrototypeReference(t *testing.T) {
 	err = s.SaveJetDropData(jetDrop, []models.Record{firstRecord, secondRecord}, pulse.PulseNumber)
 	require.NoError(t, err)
 
-	dbPulse, err := s.GetNextCompletePulseFilterByPrototypeReference(pulse.PrevPulseNumber, [][]byte{firstRecord.PrototypeReference})
+	// the next sequential pulse without records of the filters
+	nextPulse, err := testutils.InitNextPulseDB(pulse.PulseNumber)
+	require.NoError(t, err)
+	nextPulse.IsComplete = true
+	nextPulse.IsSequential = true
+	err = testutils.CreatePulse(testDB, nextPulse)
+	require.NoError(t, err)
+
+	from := pulse.PrevPulseNumber
+
+	dbPulse, err := s.GetNextSequentialPulseFilterByRecords(from, [][]byte{firstRecord.PrototypeReference}, nil, nil)
 	require.NoError(t, err)
-	require.NotEmpty(t, dbPulse)
 	require.Equal(t, pulse.PulseNumber, dbPulse.PulseNumber)
-	require.Equal(t, int64(1), dbPulse.RecordAmount)
+	require.Equal(t, int64(2), dbPulse.RecordAmount)
 
-	dbPulse, err = s.GetNextCompletePulseFilterByPrototypeReference(pulse.PrevPulseNumber, [][]byte{secondRecord.PrototypeReference})
+	dbPulse, err = s.GetNextSequentialPulseFilterByRecords(from, nil, [][]byte{secondRecord.ObjectReference}, nil)
 	require.NoError(t, err)
-	require.NotEmpty(t, dbPulse)
 	require.Equal(t, pulse.PulseNumber, dbPulse.PulseNumber)
-	require.Equal(t, int64(1), dbPulse.RecordAmount)
 
-	dbPulse, err = s.GetNextCompletePulseFilterByPrototypeReference(pulse.PrevPulseNumber, [][]byte{firstRecord.PrototypeReference, secondRecord.PrototypeReference})
+	dbPulse, err = s.GetNextSequentialPulseFilterByRecords(from, nil, nil, []string{string(models.Request)})
 	require.NoError(t, err)
-	require.NotEmpty(t, dbPulse)
 	require.Equal(t, pulse.PulseNumber, dbPulse.PulseNumber)
-	require.Equal(t, int64(2), dbPulse.RecordAmount)
 
-	dbPulse, err = s.GetNextCompletePulseFilterByPrototypeReference(pulse.PrevPulseNumber, [][]byte{[]byte("hello")})
+	dbPulse, err = s.GetNextSequentialPulseFilterByRecords(from, [][]byte{firstRecord.PrototypeReference}, [][]byte{secondRecord.ObjectReference}, nil)
+	require.NoError(t, err)
+	require.Empty(t, dbPulse)
+
+	dbPulse, err = s.GetNextSequentialPulseFilterByRecords(from, nil, nil, []string{string(models.Result)})
 	require.NoError(t, err)
 	require.Empty(t, dbPulse)
+
+	dbPulse, err = s.GetNextSequentialPulseFilterByRecords(pulse.PulseNumber, [][]byte{firstRecord.PrototypeReference}, nil, nil)
+	require.NoError(t, err)
+	require.Empty(t, dbPulse)
+
+	// without filters any sequential pulse matches
+	dbPulse, err = s.GetNextSequentialPulseFilterByRecords(pulse.PulseNumber, nil, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, nextPulse.PulseNumber, dbPulse.PulseNumber)
+}
+
+func TestStorage_GetNextSequentialPulseFilterByRecords_NotSequential(t *testing.T) {
+	defer testutils.TruncateTables(t, testDB, []interface{}{models.Record{}, models.JetDrop{}, models.Pulse{}})
+	s := NewStorage(testDB)
+
+	pulse, err := testutils.InitPulseDB()
+	require.NoError(t, err)
+	pulse.IsComplete = true
+	err = testutils.CreatePulse(testDB, pulse)
+	require.NoError(t, err)
+
+	jetDrop := testutils.InitJetDropDB(pulse)
+	record := testutils.InitRecordDB(jetDrop)
+	err = s.SaveJetDropData(jetDrop, []models.Record{record}, pulse.PulseNumber)
+	require.NoError(t, err)
+
+	dbPulse, err := s.GetNextSequentialPulseFilterByRecords(pulse.PrevPulseNumber, [][]byte{record.PrototypeReference}, nil, nil)
+	require.NoError(t, err)
+	require.Empty(t, dbPulse)
+}
+
+func TestStorage_GetNextSequentialPulse(t *testing.T) {
+	defer testutils.TruncateTables(t, testDB, []interface{}{models.Record{}, models.JetDrop{}, models.Pulse{}})
+	s := NewStorage(testDB)
+
+	pulse := models.Pulse{
+		PulseNumber:  int64(gen.PulseNumber().AsUint32()),
+		IsSequential: true,
+	}
+	notSequentialPulse := models.Pulse{
+		PulseNumber: pulse.PulseNumber + 10,
+	}
+	expectedPulse := models.Pulse{
+		PulseNumber:  pulse.PulseNumber + 20,
+		IsSequential: true,
+	}
+
+	err := testutils.CreatePulse(testDB, pulse)
+	require.NoError(t, err)
+	err = testutils.CreatePulse(testDB, notSequentialPulse)
+	require.NoError(t, err)
+	err = testutils.CreatePulse(testDB, expectedPulse)
+	require.NoError(t, err)
+
+	res, err := s.GetNextSequentialPulse(pulse.PulseNumber)
+	require.NoError(t, err)
+	require.Equal(t, expectedPulse, res)
+
+	res, err = s.GetNextSequentialPulse(expectedPulse.PulseNumber)
+	require.NoError(t, err)
+	require.Equal(t, models.Pulse{}, res)
+}
+
+func TestStorage_GetRecordsByPulse(t *testing.T) {
+	defer testutils.TruncateTables(t, testDB, []interface{}{models.Record{}, models.JetDrop{}, models.Pulse{}})
+	s := NewStorage(testDB)
+
+	pulse, err := testutils.InitPulseDB()
+	require.NoError(t, err)
+	err = testutils.CreatePulse(testDB, pulse)
+	require.NoError(t, err)
+
+	firstJetDrop := testutils.InitJetDropDB(pulse)
+	firstJetDrop.JetID = "0"
+	secondJetDrop := testutils.InitJetDropDB(pulse)
+	secondJetDrop.JetID = "1"
+
+	var records []models.Record
+	for _, jd := range []models.JetDrop{firstJetDrop, secondJetDrop} {
+		err = testutils.CreateJetDrop(testDB, jd)
+		require.NoError(t, err)
+		for i := 0; i < 3; i++ {
+			record := testutils.InitRecordDB(jd)
+			record.Order = i
+			if len(records) == 1 {
+				record.Type = models.Request
+			}
+			err = testutils.CreateRecord(testDB, record)
+			require.NoError(t, err)
+			records = append(records, record)
+		}
+	}
+
+	t.Run("all", func(t *testing.T) {
+		res, err := s.GetRecordsByPulse(pulse.PulseNumber, "", -1, nil, nil, nil, 100)
+		require.NoError(t, err)
+		require.Equal(t, records, res)
+	})
+
+	t.Run("from index with limit", func(t *testing.T) {
+		res, err := s.GetRecordsByPulse(pulse.PulseNumber, "0", 1, nil, nil, nil, 2)
+		require.NoError(t, err)
+		require.Equal(t, records[2:4], res)
+	})
+
+	t.Run("filtered", func(t *testing.T) {
+		res, err := s.GetRecordsByPulse(pulse.PulseNumber, "", -1,
+			[][]byte{records[0].PrototypeReference, records[1].PrototypeReference, records[4].PrototypeReference},
+			[][]byte{records[0].ObjectReference, records[1].ObjectReference},
+			[]string{string(models.State)},
+			100,
+		)
+		require.NoError(t, err)
+		require.Equal(t, []models.Record{records[0]}, res)
+	})
+}
+
+func TestStorage_GetRecordAmountsByPulse(t *testing.T) {
+	defer testutils.TruncateTables(t, testDB, []interface{}{models.Record{}, models.JetDrop{}, models.Pulse{}})
+	s := NewStorage(testDB)
+
+	pulse, err := testutils.InitPulseDB()
+	require.NoError(t, err)
+	err = testutils.CreatePulse(testDB, pulse)
+	require.NoError(t, err)
+
+	firstJetDrop := testutils.InitJetDropDB(pulse)
+	firstJetDrop.JetID = "0"
+	secondJetDrop := testutils.InitJetDropDB(pulse)
+	secondJetDrop.JetID = "1"
+
+	prototype := gen.Reference().Bytes()
+	for _, jd := range []models.JetDrop{firstJetDrop, secondJetDrop} {
+		err = testutils.CreateJetDrop(testDB, jd)
+		require.NoError(t, err)
+		for i := 0; i < 2; i++ {
+			record := testutils.InitRecordDB(jd)
+			record.PrototypeReference = prototype
+			record.Order = i
+			err = testutils.CreateRecord(testDB, record)
+			require.NoError(t, err)
+		}
+	}
+	otherRecord := testutils.InitRecordDB(secondJetDrop)
+	otherRecord.Order = 2
+	err = testutils.CreateRecord(testDB, otherRecord)
+	require.NoError(t, err)
+
+	amounts, err := s.GetRecordAmountsByPulse(pulse.PulseNumber, nil, nil, nil)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []models.JetDropRecordAmount{
+		{JetID: "0", PrototypeReference: prototype, RecordAmount: 2},
+		{JetID: "1", PrototypeReference: prototype, RecordAmount: 2},
+		{JetID: "1", PrototypeReference: otherRecord.PrototypeReference, RecordAmount: 1},
+	}, amounts)
+
+	amounts, err = s.GetRecordAmountsByPulse(pulse.PulseNumber, [][]byte{prototype}, nil, nil)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []models.JetDropRecordAmount{
+		{JetID: "0", PrototypeReference: prototype, RecordAmount: 2},
+		{JetID: "1", PrototypeReference: prototype, RecordAmount: 2},
+	}, amounts)
+
+	amounts, err = s.GetRecordAmountsByPulse(pulse.PulseNumber, nil, [][]byte{otherRecord.ObjectReference}, []string{string(models.State)})
+	require.NoError(t, err)
+	require.Equal(t, []models.JetDropRecordAmount{
+		{JetID: "1", PrototypeReference: otherRecord.PrototypeReference, RecordAmount: 1},
+	}, amounts)
+
+	amounts, err = s.GetRecordAmountsByPulse(pulse.PulseNumber + 10, nil, nil, nil)
+	require.NoError(t, err)
+	require.Empty(t, amounts)
+}
+
+func TestStorage_SaveAuditFindings(t *testing.T) {
+	defer testutils.TruncateTables(t, testDB, []interface{}{models.AuditFinding{}})
+	s := NewStorage(testDB)
+
+	pulseNumber := int64(gen.PulseNumber().AsUint32())
+	otherFinding := models.AuditFinding{PulseNumber: pulseNumber + 10, JetID: "0", Kind: models.MissingParent}
+	err := s.SaveAuditFindings(otherFinding.PulseNumber, []models.AuditFinding{otherFinding})
+	require.NoError(t, err)
+
+	err = s.SaveAuditFindings(pulseNumber, []models.AuditFinding{
+		{JetID: "0", Kind: models.MissingParent},
+		{JetID: "1", Kind: models.PrevHashMismatch},
+	})
+	require.NoError(t, err)
+
+	expected := models.AuditFinding{PulseNumber: pulseNumber, JetID: "1", Kind: models.PrevHashMismatch, Details: "details"}
+	err = s.SaveAuditFindings(pulseNumber, []models.AuditFinding{expected})
+	require.NoError(t, err)
+
+	var findings []models.AuditFinding
+	err = testDB.Where("pulse_number = ?", pulseNumber).Find(&findings).Error
+	require.NoError(t, err)
+	require.Len(t, findings, 1)
+	expected.ID = findings[0].ID
+	require.Equal(t, expected, findings[0])
+
+	err = testDB.Where("pulse_number = ?", otherFinding.PulseNumber).Find(&findings).Error
+	require.NoError(t, err)
+	require.Len(t, findings, 1)
+}
+
+func TestStorage_DeletePulses(t *testing.T) {
+	defer testutils.TruncateTables(t, testDB, []interface{}{models.Record{}, models.JetDrop{}, models.Pulse{}, models.AuditFinding{}, models.DeadLetter{}})
+	s := NewStorage(testDB)
+
+	var pulses []models.Pulse
+	pulseNumber := int64(gen.PulseNumber().AsUint32())
+	for i := int64(0); i < 4; i++ {
+		pulse := models.Pulse{PulseNumber: pulseNumber + i*10, PrevPulseNumber: pulseNumber + (i-1)*10, IsComplete: true, IsSequential: true}
+		err := testutils.CreatePulse(testDB, pulse)
+		require.NoError(t, err)
+		jetDrop := testutils.InitJetDropDB(pulse)
+		err = testutils.CreateJetDrop(testDB, jetDrop)
+		require.NoError(t, err)
+		err = testutils.CreateRecord(testDB, testutils.InitRecordDB(jetDrop))
+		require.NoError(t, err)
+		err = s.SaveAuditFindings(pulse.PulseNumber, []models.AuditFinding{{JetID: jetDrop.JetID, Kind: models.MissingParent}})
+		require.NoError(t, err)
+		err = s.SaveDeadLetter(models.DeadLetter{PulseNumber: pulse.PulseNumber, JetID: jetDrop.JetID, Attempts: 1})
+		require.NoError(t, err)
+		pulses = append(pulses, pulse)
+	}
+
+	err := s.DeletePulses(pulses[1].PulseNumber, pulses[2].PulseNumber)
+	require.NoError(t, err)
+
+	var pulsesInDB []models.Pulse
+	err = testDB.Order("pulse_number asc").Find(&pulsesInDB).Error
+	require.NoError(t, err)
+	require.Len(t, pulsesInDB, 2)
+	require.Equal(t, pulses[0], pulsesInDB[0])
+	require.Equal(t, pulses[3].PulseNumber, pulsesInDB[1].PulseNumber)
+	require.True(t, pulsesInDB[1].IsComplete)
+	require.False(t, pulsesInDB[1].IsSequential)
+
+	for _, table := range []interface{}{&[]models.JetDrop{}, &[]models.Record{}, &[]models.AuditFinding{}, &[]models.DeadLetter{}} {
+		var count int
+		err = testDB.Model(table).Where("pulse_number >= ? AND pulse_number <= ?", pulses[1].PulseNumber, pulses[2].PulseNumber).Count(&count).Error
+		require.NoError(t, err)
+		require.Equal(t, 0, count)
+		err = testDB.Model(table).Count(&count).Error
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+	}
+}
+
+func TestStorage_GetCallTree(t *testing.T) {
+	defer testutils.TruncateTables(t, testDB, []interface{}{models.Record{}, models.JetDrop{}, models.Pulse{}})
+	s := NewStorage(testDB)
+
+	pulse, err := testutils.InitPulseDB()
+	require.NoError(t, err)
+	err = testutils.CreatePulse(testDB, pulse)
+	require.NoError(t, err)
+	jetDrop := testutils.InitJetDropDB(pulse)
+	err = testutils.CreateJetDrop(testDB, jetDrop)
+	require.NoError(t, err)
+
+	order := 0
+	create := func(recordType models.RecordType, reason, request models.Reference) models.Record {
+		order++
+		record := testutils.InitRecordDB(jetDrop)
+		record.Type = recordType
+		record.Order = order
+		record.ReasonReference = reason
+		record.RequestReference = request
+		err := testutils.CreateRecord(testDB, record)
+		require.NoError(t, err)
+		return record
+	}
+
+	root := create(models.Request, nil, nil)
+	rootResult := create(models.Result, nil, root.Reference)
+	nested := create(models.Request, root.Reference, nil)
+	nestedResult := create(models.Result, nil, nested.Reference)
+	deep := create(models.Request, nested.Reference, nil)
+	create(models.Request, nil, nil)
+
+	t.Run("full tree", func(t *testing.T) {
+		tree, err := s.GetCallTree(root.Reference, 10)
+		require.NoError(t, err)
+		require.Equal(t, []models.Record{root, rootResult, nested, nestedResult, deep}, tree)
+	})
+
+	t.Run("limited depth", func(t *testing.T) {
+		tree, err := s.GetCallTree(root.Reference, 1)
+		require.NoError(t, err)
+		require.Equal(t, []models.Record{root, rootResult, nested, nestedResult}, tree)
+	})
+
+	t.Run("not a request", func(t *testing.T) {
+		_, err := s.GetCallTree(rootResult.Reference, 10)
+		require.Error(t, err)
+		require.True(t, gorm.IsRecordNotFoundError(err))
+	})
+}
+
+func TestStorage_GetRecordsByJetDrop_Filter(t *testing.T) {
+	defer testutils.TruncateTables(t, testDB, []interface{}{models.Record{}, models.JetDrop{}, models.Pulse{}})
+	s := NewStorage(testDB)
+
+	pulse, err := testutils.InitPulseDB()
+	require.NoError(t, err)
+	err = testutils.CreatePulse(testDB, pulse)
+	require.NoError(t, err)
+	jetDrop := testutils.InitJetDropDB(pulse)
+	err = testutils.CreateJetDrop(testDB, jetDrop)
+	require.NoError(t, err)
+
+	caller := gen.ID().Bytes()
+	var records []models.Record
+	for i, subtype := range []models.RecordSubtype{models.SubtypeIncomingRequest, models.SubtypeOutgoingRequest, models.SubtypeOutgoingRequest} {
+		record := testutils.InitRecordDB(jetDrop)
+		record.Type = models.Request
+		record.Subtype = subtype
+		record.Order = i
+		record.Caller = caller
+		record.Method = "Transfer"
+		record.Immutable = i == 2
+		record.APIRequestID = fmt.Sprintf("api-request-%d", i)
+		err = testutils.CreateRecord(testDB, record)
+		require.NoError(t, err)
+		records = append(records, record)
+	}
+
+	jetDropID := models.NewJetDropID(jetDrop.JetID, jetDrop.PulseNumber)
+	subtype := models.SubtypeOutgoingRequest
+	method := "Transfer"
+	immutable := false
+	apiRequestID := "api-request-0"
+	otherMethod := "Other"
+	tests := []struct {
+		name     string
+		filter   models.RecordFilter
+		expected []models.Record
+	}{
+		{"no filter", models.RecordFilter{}, records},
+		{"subtype", models.RecordFilter{Subtype: &subtype}, records[1:]},
+		{"caller and method", models.RecordFilter{Caller: caller, Method: &method}, records},
+		{"subtype and immutable", models.RecordFilter{Subtype: &subtype, Immutable: &immutable}, records[1:2]},
+		{"api request id", models.RecordFilter{APIRequestID: &apiRequestID}, records[:1]},
+		{"other method", models.RecordFilter{Method: &otherMethod}, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			received, total, err := s.GetRecordsByJetDrop(*jetDropID, nil, nil, 20, 0, test.filter)
+			require.NoError(t, err)
+			require.Equal(t, len(test.expected), total)
+			if test.expected == nil {
+				require.Empty(t, received)
+				return
+			}
+			require.Equal(t, test.expected, received)
+		})
+	}
+}
+
+func TestStorage_GetLifeline_FilterBySubtype(t *testing.T) {
+	defer testutils.TruncateTables(t, testDB, []interface{}{models.Record{}, models.JetDrop{}, models.Pulse{}})
+	s := NewStorage(testDB)
+
+	pulse, err := testutils.InitPulseDB()
+	require.NoError(t, err)
+	err = testutils.CreatePulse(testDB, pulse)
+	require.NoError(t, err)
+	jetDrop := testutils.InitJetDropDB(pulse)
+	err = testutils.CreateJetDrop(testDB, jetDrop)
+	require.NoError(t, err)
+
+	objRef := gen.ID().Bytes()
+	var records []models.Record
+	for i, subtype := range []models.RecordSubtype{models.SubtypeActivate, models.SubtypeAmend, models.SubtypeDeactivate} {
+		record := testutils.InitRecordDB(jetDrop)
+		record.Subtype = subtype
+		record.ObjectReference = objRef
+		record.Order = i
+		err = testutils.CreateRecord(testDB, record)
+		require.NoError(t, err)
+		records = append(records, record)
+	}
+
+	subtype := models.SubtypeDeactivate
+	received, total, err := s.GetLifeline(objRef, nil, nil, nil, nil, nil, 20, 0, false, models.RecordFilter{Subtype: &subtype})
+	require.NoError(t, err)
+	require.Equal(t, 1, total)
+	require.Equal(t, records[2:], received)
+}
+
+func TestStorage_RecordsWithoutLifeline(t *testing.T) {
+	defer testutils.TruncateTables(t, testDB, []interface{}{models.Record{}, models.JetDrop{}, models.Pulse{}})
+	s := NewStorage(testDB)
+
+	pulse, err := testutils.InitPulseDB()
+	require.NoError(t, err)
+	err = testutils.CreatePulse(testDB, pulse)
+	require.NoError(t, err)
+	jetDrop := testutils.InitJetDropDB(pulse)
+	err = testutils.CreateJetDrop(testDB, jetDrop)
+	require.NoError(t, err)
+
+	objRef := gen.ID().Bytes()
+	state := testutils.InitRecordDB(jetDrop)
+	state.ObjectReference = objRef
+	err = testutils.CreateRecord(testDB, state)
+	require.NoError(t, err)
+	code := testutils.InitRecordDB(jetDrop)
+	code.Type = models.Code
+	code.Subtype = models.SubtypeCode
+	code.ObjectReference = objRef
+	code.Order = 2
+	err = testutils.CreateRecord(testDB, code)
+	require.NoError(t, err)
+
+	records, total, err := s.GetLifeline(objRef, nil, nil, nil, nil, nil, 20, 0, false, models.RecordFilter{})
+	require.NoError(t, err)
+	require.Equal(t, 1, total)
+	require.Equal(t, []models.Record{state}, records)
+
+	recordType := string(models.Code)
+	jetDropID := models.NewJetDropID(jetDrop.JetID, jetDrop.PulseNumber)
+	records, total, err = s.GetRecordsByJetDrop(*jetDropID, nil, &recordType, 20, 0, models.RecordFilter{})
+	require.NoError(t, err)
+	require.Equal(t, 1, total)
+	require.Equal(t, []models.Record{code}, records)
+}
+
+func TestStorage_RecordExtensions(t *testing.T) {
+	defer testutils.TruncateTables(t, testDB, []interface{}{models.RecordExtension{}, models.Record{}, models.JetDrop{}, models.Pulse{}})
+	s := NewStorage(testDB)
+
+	pulse, err := testutils.InitPulseDB()
+	require.NoError(t, err)
+	err = testutils.CreatePulse(testDB, pulse)
+	require.NoError(t, err)
+	jetDrop := testutils.InitJetDropDB(pulse)
+	err = testutils.CreateJetDrop(testDB, jetDrop)
+	require.NoError(t, err)
+	record := testutils.InitRecordDB(jetDrop)
+	err = testutils.CreateRecord(testDB, record)
+	require.NoError(t, err)
+	otherRecord := testutils.InitRecordDB(jetDrop)
+	otherRecord.Order = 2
+	err = testutils.CreateRecord(testDB, otherRecord)
+	require.NoError(t, err)
+
+	extension := models.RecordExtension{RecordReference: record.Reference, Kind: models.MemoryExtension, Data: testutils.GenerateRandBytes()}
+	otherExtension := models.RecordExtension{RecordReference: otherRecord.Reference, Kind: models.CodeExtension, Data: testutils.GenerateRandBytes()}
+	err = s.SaveRecordExtensions([]models.RecordExtension{extension, otherExtension})
+	require.NoError(t, err)
+	// saving again replaces extensions
+	extension.Data = testutils.GenerateRandBytes()
+	err = s.SaveRecordExtensions([]models.RecordExtension{extension})
+	require.NoError(t, err)
+
+	extensions, err := s.GetRecordExtensions([]models.Reference{record.Reference})
+	require.NoError(t, err)
+	require.Equal(t, []models.RecordExtension{extension}, extensions)
+
+	extensions, err = s.GetRecordExtensions([]models.Reference{record.Reference, otherRecord.Reference})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []models.RecordExtension{extension, otherExtension}, extensions)
+
+	extensions, err = s.GetRecordExtensions(nil)
+	require.NoError(t, err)
+	require.Empty(t, extensions)
+
+	err = s.SaveRecordExtensions([]models.RecordExtension{{RecordReference: gen.ID().Bytes(), Kind: models.CodeExtension}})
+	require.Error(t, err, "extension of unknown record")
+
+	err = s.DeletePulses(pulse.PulseNumber, pulse.PulseNumber)
+	require.NoError(t, err)
+	var count int
+	err = testDB.Model(&models.RecordExtension{}).Count(&count).Error
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+}
+
+func TestStorage_PulseJets(t *testing.T) {
+	defer testutils.TruncateTables(t, testDB, []interface{}{models.PulseJet{}, models.Pulse{}})
+	s := NewStorage(testDB)
+
+	pulse, err := testutils.InitPulseDB()
+	require.NoError(t, err)
+	err = testutils.CreatePulse(testDB, pulse)
+	require.NoError(t, err)
+
+	jets, err := s.GetPulseJets(pulse.PulseNumber)
+	require.NoError(t, err)
+	require.Empty(t, jets)
+
+	err = s.SavePulseJets(pulse.PulseNumber, []string{"10", "0", "11"})
+	require.NoError(t, err)
+	// jets are saved by every jet drop of the pulse
+	err = s.SavePulseJets(pulse.PulseNumber, []string{"10", "0", "11"})
+	require.NoError(t, err)
+
+	jets, err = s.GetPulseJets(pulse.PulseNumber)
+	require.NoError(t, err)
+	require.Equal(t, []models.PulseJet{
+		{PulseNumber: pulse.PulseNumber, JetID: "0"},
+		{PulseNumber: pulse.PulseNumber, JetID: "10"},
+		{PulseNumber: pulse.PulseNumber, JetID: "11"},
+	}, jets)
+}
+
+func TestStorage_SavePulseJets_NoPulse(t *testing.T) {
+	defer testutils.TruncateTables(t, testDB, []interface{}{models.PulseJet{}, models.Pulse{}})
+	s := NewStorage(testDB)
+
+	err := s.SavePulseJets(1000, []string{"0", "1"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "error while saving pulse jets")
+}
+
+func TestStorage_DeadLetters(t *testing.T) {
+	defer testutils.TruncateTables(t, testDB, []interface{}{models.DeadLetter{}})
+	s := NewStorage(testDB)
+
+	first := models.DeadLetter{PulseNumber: 1000, JetID: "0", JetDrop: testutils.GenerateRandBytes(), Error: "first error", Attempts: 3, Timestamp: 100}
+	second := models.DeadLetter{PulseNumber: 1010, JetID: "1", JetDrop: testutils.GenerateRandBytes(), Error: "second error", Attempts: 3, Timestamp: 110}
+	require.NoError(t, s.SaveDeadLetter(first))
+	require.NoError(t, s.SaveDeadLetter(second))
+
+	deadLetters, err := s.GetDeadLetters(10, 0)
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 2)
+	require.Equal(t, first.Error, deadLetters[0].Error)
+	require.Empty(t, deadLetters[0].JetDrop)
+	require.Equal(t, second.Error, deadLetters[1].Error)
+
+	deadLetters, err = s.GetDeadLetters(10, 1)
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+	require.Equal(t, second.PulseNumber, deadLetters[0].PulseNumber)
+
+	deadLetter, err := s.GetDeadLetter(deadLetters[0].ID)
+	require.NoError(t, err)
+	second.ID = deadLetters[0].ID
+	require.Equal(t, second, deadLetter)
+
+	again := models.DeadLetter{PulseNumber: 1010, JetID: "1", JetDrop: testutils.GenerateRandBytes(), Error: "again error", Attempts: 3, Timestamp: 120}
+	require.NoError(t, s.SaveDeadLetter(again))
+	deadLetters, err = s.GetDeadLetters(10, 0)
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 2, "dead letter of the same jet drop is updated")
+	deadLetter, err = s.GetDeadLetter(second.ID)
+	require.NoError(t, err)
+	again.ID, again.Attempts = second.ID, 6
+	require.Equal(t, again, deadLetter)
+
+	require.NoError(t, s.DeleteDeadLetter(second.ID))
+	_, err = s.GetDeadLetter(second.ID)
+	require.True(t, gorm.IsRecordNotFoundError(err))
+	err = s.DeleteDeadLetter(second.ID)
+	require.True(t, gorm.IsRecordNotFoundError(err))
+}
+
+func TestStorage_SaveJetDropsBatch(t *testing.T) {
+	defer testutils.TruncateTables(t, testDB, []interface{}{models.RecordExtension{}, models.Record{}, models.JetDrop{}, models.PulseJet{}, models.Pulse{}})
+	s := NewStorage(testDB)
+
+	pulse, err := testutils.InitPulseDB()
+	require.NoError(t, err)
+
+	firstJetDrop := testutils.InitJetDropDB(pulse)
+	firstRecord := testutils.InitRecordDB(firstJetDrop)
+	secondRecord := testutils.InitRecordDB(firstJetDrop)
+	secondRecord.Order = 2
+	extension := models.RecordExtension{RecordReference: firstRecord.Reference, Kind: models.MemoryExtension, Data: testutils.GenerateRandBytes()}
+	secondJetDrop := testutils.InitJetDropDB(pulse)
+	thirdRecord := testutils.InitRecordDB(secondJetDrop)
+
+	err = s.SaveJetDropsBatch(pulse, []string{firstJetDrop.JetID, secondJetDrop.JetID}, []models.JetDropData{
+		{JetDrop: firstJetDrop, Records: []models.Record{firstRecord, secondRecord}, Extensions: []models.RecordExtension{extension}},
+		{JetDrop: secondJetDrop, Records: []models.Record{thirdRecord}},
+	})
+	require.NoError(t, err)
+
+	jetDropInDB := []models.JetDrop{}
+	err = testDB.Find(&jetDropInDB).Error
+	require.NoError(t, err)
+	require.Len(t, jetDropInDB, 2)
+	require.Contains(t, jetDropInDB, firstJetDrop)
+	require.Contains(t, jetDropInDB, secondJetDrop)
+
+	recordInDB := []models.Record{}
+	err = testDB.Find(&recordInDB).Error
+	require.NoError(t, err)
+	require.Len(t, recordInDB, 3)
+	require.Contains(t, recordInDB, firstRecord)
+	require.Contains(t, recordInDB, secondRecord)
+	require.Contains(t, recordInDB, thirdRecord)
+
+	extensions, err := s.GetRecordExtensions([]models.Reference{firstRecord.Reference})
+	require.NoError(t, err)
+	require.Equal(t, []models.RecordExtension{extension}, extensions)
+
+	jets, err := s.GetPulseJets(pulse.PulseNumber)
+	require.NoError(t, err)
+	require.Len(t, jets, 2)
+
+	expectedPulse := pulse
+	expectedPulse.JetDropAmount = 2
+	expectedPulse.RecordAmount = 3
+	pulseInDB, err := s.GetPulse(pulse.PulseNumber)
+	require.NoError(t, err)
+	require.EqualValues(t, expectedPulse, pulseInDB)
+}
+
+func TestStorage_SaveJetDropsBatch_UpdateExisted(t *testing.T) {
+	defer testutils.TruncateTables(t, testDB, []interface{}{models.Record{}, models.JetDrop{}, models.Pulse{}})
+	s := NewStorage(testDB)
+
+	pulse, err := testutils.InitPulseDB()
+	require.NoError(t, err)
+	err = testutils.CreatePulse(testDB, pulse)
+	require.NoError(t, err)
+
+	savedJetDrop := testutils.InitJetDropDB(pulse)
+	savedRecord := testutils.InitRecordDB(savedJetDrop)
+	err = s.SaveJetDropData(savedJetDrop, []models.Record{savedRecord}, pulse.PulseNumber)
+	require.NoError(t, err)
+
+	// the saved jet drop comes again with the changed record and the same jet drop twice in the batch
+	savedJetDrop.Hash = testutils.GenerateRandBytes()
+	savedRecord.Payload = testutils.GenerateRandBytes()
+	newJetDrop := testutils.InitJetDropDB(pulse)
+	newRecord := testutils.InitRecordDB(newJetDrop)
+	err = s.SaveJetDropsBatch(pulse, nil, []models.JetDropData{
+		{JetDrop: savedJetDrop, Records: []models.Record{savedRecord}},
+		{JetDrop: newJetDrop, Records: []models.Record{newRecord}},
+		{JetDrop: newJetDrop, Records: []models.Record{newRecord}},
+	})
+	require.NoError(t, err)
+
+	jetDropInDB := models.JetDrop{}
+	err = testDB.Where("jet_id = ?", savedJetDrop.JetID).First(&jetDropInDB).Error
+	require.NoError(t, err)
+	require.Equal(t, savedJetDrop, jetDropInDB)
+	recordInDB, err := s.GetRecord(savedRecord.Reference)
+	require.NoError(t, err)
+	require.Equal(t, savedRecord, recordInDB)
+
+	expectedPulse := pulse
+	expectedPulse.JetDropAmount = 2
+	expectedPulse.RecordAmount = 2
+	pulseInDB, err := s.GetPulse(pulse.PulseNumber)
+	require.NoError(t, err)
+	require.EqualValues(t, expectedPulse, pulseInDB)
+}
+
+func TestStorage_Checkpoints(t *testing.T) {
+	defer testutils.TruncateTables(t, testDB, []interface{}{models.Checkpoint{}})
+	s := NewStorage(testDB)
+
+	_, err := s.GetCheckpoint(models.LastSavedPulseCheckpoint)
+	require.True(t, gorm.IsRecordNotFoundError(err))
+
+	checkpoint := models.Checkpoint{Name: models.LastSavedPulseCheckpoint, PulseNumber: 1000, Timestamp: 100}
+	require.NoError(t, s.SaveCheckpoint(checkpoint))
+	checkpoint.PulseNumber, checkpoint.Timestamp = 1010, 110
+	require.NoError(t, s.SaveCheckpoint(checkpoint))
+
+	saved, err := s.GetCheckpoint(models.LastSavedPulseCheckpoint)
+	require.NoError(t, err)
+	require.Equal(t, checkpoint, saved)
+}
+
+func TestStorage_ReloadRanges(t *testing.T) {
+	defer testutils.TruncateTables(t, testDB, []interface{}{models.ReloadRange{}})
+	s := NewStorage(testDB)
+
+	reloadRange, err := s.SaveReloadAttempt(1000, 1050)
+	require.NoError(t, err)
+	require.Equal(t, 1, reloadRange.Attempts)
+	reloadRange, err = s.SaveReloadAttempt(1000, 1050)
+	require.NoError(t, err)
+	require.Equal(t, 2, reloadRange.Attempts)
+	_, err = s.SaveReloadAttempt(1000, 1080)
+	require.NoError(t, err)
+	_, err = s.SaveReloadAttempt(990, 1000)
+	require.NoError(t, err)
+
+	reloadRanges, err := s.GetReloadRanges()
+	require.NoError(t, err)
+	require.Len(t, reloadRanges, 3)
+	require.Equal(t, int64(990), reloadRanges[0].FromPulseNumber)
+	require.Equal(t, int64(1080), reloadRanges[1].ToPulseNumber)
+	require.Equal(t, int64(1050), reloadRanges[2].ToPulseNumber)
+	require.Equal(t, 2, reloadRanges[2].Attempts)
+
+	require.NoError(t, s.DeleteReloadRanges(1050))
+	reloadRanges, err = s.GetReloadRanges()
+	require.NoError(t, err)
+	require.Len(t, reloadRanges, 1)
+	require.Equal(t, int64(1080), reloadRanges[0].ToPulseNumber)
 }
@@ -19,6 +19,10 @@ var (
 		Name: "gbe_transformer_records",
 		Help: "The number of transformed records",
 	})
+	SkippedRecords = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "gbe_transformer_skipped_records",
+		Help: "The number of skipped records of unknown types",
+	}, []string{"type"})
 	Errors = prometheus.NewCounter(prometheus.CounterOpts{
 		Name: "gbe_transformer_errors",
 		Help: "The number of errors received during data transforming",
@@ -36,6 +40,7 @@ func (s Metrics) Metrics(p *metrics.Prometheus) []prometheus.Collector {
 		FromTransformerDataQueue,
 		TransformedRecords,
 		TransformedPulses,
+		SkippedRecords,
 		Errors,
 	}
 }
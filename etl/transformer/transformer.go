@@ -2,6 +2,8 @@ package transformer
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/insolar/insolar/pulse"
 
@@ -27,19 +29,24 @@ func Transform(ctx context.Context, jd *types.PlatformPulseData) ([]*types.JetDr
 		return nil, err
 	}
 
+	pulseJets := make(map[insolar.JetID]struct{}, len(jd.Pulse.Jets))
+	pulseJetIDs := make([]string, 0, len(jd.Pulse.Jets))
 	for _, jet := range jd.Pulse.Jets {
-		jetid := jet.JetID
-		if _, ok := m[jetid]; ok {
-			continue
+		pulseJets[jet.JetID] = struct{}{}
+		pulseJetIDs = append(pulseJetIDs, jetIDToString(jet.JetID))
+	}
+	for jetID, records := range m {
+		if _, ok := pulseJets[jetID]; !ok {
+			belogger.FromContext(ctx).Errorf("%d records of jet %s are skipped, the jet is not in the jet tree of pulse %d",
+				len(records), jetID.DebugString(), pulseData.PulseNo)
 		}
-		m[jetid] = nil
 	}
 
 	result := make([]*types.JetDrop, 0)
 	for _, jet := range jd.Pulse.Jets {
 		jetid := jet.JetID
 		records := m[jetid]
-		localJetDrop := getJetDrop(ctx, jetid, records, pulseData, jet.Hash, jet.PrevDropHashes)
+		localJetDrop := getJetDrop(ctx, jetid, records, pulseData, pulseJetIDs, jet.Hash, jet.PrevDropHashes)
 		if localJetDrop == nil {
 			continue
 		}
@@ -49,12 +56,9 @@ func Transform(ctx context.Context, jd *types.PlatformPulseData) ([]*types.JetDr
 	return result, nil
 }
 
-func getJetDrop(ctx context.Context, jetID insolar.JetID, records []types.Record, pulseData types.Pulse, hash []byte, prevDropHash [][]byte) *types.JetDrop {
+func getJetDrop(ctx context.Context, jetID insolar.JetID, records []types.Record, pulseData types.Pulse, pulseJetIDs []string, hash []byte, prevDropHash [][]byte) *types.JetDrop {
 	sections := make([]types.Section, 0)
-	var prefix string
-	if jetID.IsValid() {
-		prefix = converter.JetIDToString(jetID)
-	}
+	prefix := jetIDToString(jetID)
 
 	records, err := sortRecords(records)
 	if err != nil {
@@ -62,9 +66,15 @@ func getJetDrop(ctx context.Context, jetID insolar.JetID, records []types.Record
 		return nil
 	}
 
+	extensions := RecordExtensions(records)
+	if len(extensions) > 0 {
+		sections = append(sections, types.AdditionalSection{RecordExtensions: extensions})
+	}
+
 	mainSection := &types.MainSection{
 		Start: types.DropStart{
 			PulseData:           pulseData,
+			PulseJetIDs:         pulseJetIDs,
 			JetDropPrefix:       prefix,
 			JetDropPrefixLength: uint(len(prefix)),
 		},
@@ -83,6 +93,32 @@ func getJetDrop(ctx context.Context, jetID insolar.JetID, records []types.Record
 	return &localJetDrop
 }
 
+// maxInlineMemorySize is the max size of the state record memory which is kept in the record payload
+const maxInlineMemorySize = 64 * 1024
+
+// RecordExtensions copies code blobs and large memory of the records to the record extensions,
+// the records keep their payloads, so they are served unchanged by the API and the exporter
+func RecordExtensions(records []types.Record) []types.RecordExtension {
+	var extensions []types.RecordExtension
+	for _, r := range records {
+		var kind types.RecordExtensionKind
+		switch {
+		case r.Type == types.CODE && len(r.RecordPayload) > 0:
+			kind = types.CODEEXTENSION
+		case r.Type == types.STATE && len(r.RecordPayload) > maxInlineMemorySize:
+			kind = types.MEMORYEXTENSION
+		default:
+			continue
+		}
+		extensions = append(extensions, types.RecordExtension{
+			RecordReference: r.Ref,
+			Kind:            kind,
+			Data:            r.RecordPayload,
+		})
+	}
+	return extensions
+}
+
 // sortRecords sorts state records for every object in order of change
 func sortRecords(records []types.Record) ([]types.Record, error) {
 	lenBefore := len(records)
@@ -151,6 +187,14 @@ func initRecordsMapsByObj(records []types.Record) (
 	return recordsByObjAndPrevRef, recordsByObjAndRef, notStateRecords
 }
 
+// jetIDToString returns the prefix of the jet, it's empty for the root jet
+func jetIDToString(jetID insolar.JetID) string {
+	if !jetID.IsValid() {
+		return ""
+	}
+	return converter.JetIDToString(jetID)
+}
+
 func restoreInsolarID(b []byte) string {
 	if instrumentation.IsEmpty(b) {
 		b = nil
@@ -190,6 +234,7 @@ func getRecords(jd *types.PlatformPulseData) (map[insolar.JetID][]types.Record,
 		if err != nil {
 			if err == UnsupportedRecordTypeError {
 				// just skip this records
+				SkippedRecords.WithLabelValues(virtualRecordKind(r.Record.Virtual)).Inc()
 				continue
 			}
 			return res, err
@@ -200,18 +245,40 @@ func getRecords(jd *types.PlatformPulseData) (map[insolar.JetID][]types.Record,
 	}
 
 	return res, nil
+}
 
-	// TODO: maybe ne need to check the records jetID's with jd.Pulse.Jets
+// virtualRecordKind returns the name of the virtual record type for the metrics
+func virtualRecordKind(virtual ins_record.Virtual) string {
+	if virtual.Union == nil {
+		return "empty"
+	}
+	return strings.TrimPrefix(fmt.Sprintf("%T", virtual.Union), "*record.Virtual_")
+}
+
+// RecordFromRawData transforms the platform record from its raw data kept by the canonical record
+func RecordFromRawData(rawData []byte) (types.Record, error) {
+	r := &exporter.Record{}
+	if err := r.Unmarshal(rawData); err != nil {
+		return types.Record{}, errors.Wrap(err, "cannot unmarshal record raw data")
+	}
+	return transferToCanonicalRecord(r)
 }
 
 func transferToCanonicalRecord(r *exporter.Record) (types.Record, error) {
 	var (
 		recordType          types.RecordType
+		recordSubtype       types.RecordSubtype
 		ref                 types.Reference
 		objectReference     types.Reference
 		prototypeReference  types.Reference = make([]byte, 0)
 		prevRecordReference types.Reference = make([]byte, 0)
+		reasonReference     types.Reference = make([]byte, 0)
+		requestReference    types.Reference = make([]byte, 0)
+		caller              types.Reference = make([]byte, 0)
 		recordPayload       []byte          = make([]byte, 0)
+		method              string
+		immutable           bool
+		apiRequestID        string
 		hash                []byte
 		rawData             []byte
 		order               uint32
@@ -231,6 +298,7 @@ func transferToCanonicalRecord(r *exporter.Record) (types.Record, error) {
 	switch virtual.Union.(type) {
 	case *ins_record.Virtual_Activate:
 		recordType = types.STATE
+		recordSubtype = types.ACTIVATE
 		activate := virtual.GetActivate()
 		prototypeReference = activate.Image.Bytes()
 		recordPayload = activate.Memory
@@ -240,6 +308,7 @@ func transferToCanonicalRecord(r *exporter.Record) (types.Record, error) {
 
 	case *ins_record.Virtual_Amend:
 		recordType = types.STATE
+		recordSubtype = types.AMEND
 		amend := virtual.GetAmend()
 		prototypeReference = amend.Image.Bytes()
 		recordPayload = amend.Memory
@@ -250,38 +319,87 @@ func transferToCanonicalRecord(r *exporter.Record) (types.Record, error) {
 
 	case *ins_record.Virtual_Deactivate:
 		recordType = types.STATE
+		recordSubtype = types.DEACTIVATE
 		deactivate := virtual.GetDeactivate()
 		prevRecordReference = deactivate.PrevStateID().Bytes()
 
 	case *ins_record.Virtual_Result:
 		recordType = types.RESULT
+		recordSubtype = types.RESULTRECORD
 		result := virtual.GetResult()
 		recordPayload = result.Payload
+		requestReference = result.Request.GetLocal().Bytes()
 		if r.Record.ID.Pulse() == pulse.MinTimePulse {
 			objectReference = result.GetObject().Bytes()
 		}
 
 	case *ins_record.Virtual_IncomingRequest:
 		recordType = types.REQUEST
+		recordSubtype = types.INCOMINGREQUEST
 		incomingRequest := virtual.GetIncomingRequest()
+		if !incomingRequest.Reason.IsEmpty() {
+			reasonReference = incomingRequest.Reason.GetLocal().Bytes()
+		}
+		if !incomingRequest.Caller.IsEmpty() {
+			caller = incomingRequest.Caller.GetLocal().Bytes()
+		}
+		method = incomingRequest.Method
+		immutable = incomingRequest.Immutable
+		apiRequestID = incomingRequest.APIRequestID
 		if r.Record.ID.Pulse() == pulse.MinTimePulse {
 			objectReference = genesisrefs.GenesisRef(incomingRequest.Method).GetLocal().Bytes()
 		}
 
 	case *ins_record.Virtual_OutgoingRequest:
 		recordType = types.REQUEST
+		recordSubtype = types.OUTGOINGREQUEST
+		outgoingRequest := virtual.GetOutgoingRequest()
+		if !outgoingRequest.Reason.IsEmpty() {
+			reasonReference = outgoingRequest.Reason.GetLocal().Bytes()
+		}
+		if !outgoingRequest.Caller.IsEmpty() {
+			caller = outgoingRequest.Caller.GetLocal().Bytes()
+		}
+		method = outgoingRequest.Method
+		immutable = outgoingRequest.Immutable
+		apiRequestID = outgoingRequest.APIRequestID
+
+	case *ins_record.Virtual_Code:
+		recordType = types.CODE
+		recordSubtype = types.CODERECORD
+		recordPayload = virtual.GetCode().Code
+
+	case *ins_record.Virtual_PendingFilament:
+		recordType = types.PENDINGFILAMENT
+		recordSubtype = types.PENDINGFILAMENTRECORD
+		pendingFilament := virtual.GetPendingFilament()
+		if pendingFilament.PreviousRecord != nil {
+			prevRecordReference = pendingFilament.PreviousRecord.Bytes()
+		}
+
+	case *ins_record.Virtual_Genesis:
+		recordType = types.GENESIS
+		recordSubtype = types.GENESISRECORD
+		recordPayload = virtual.GetGenesis().Hash
 
 	default:
-		// skip unnecessary record
+		// skip unknown record
 		return types.Record{}, UnsupportedRecordTypeError
 	}
 
 	retRecord := types.Record{
 		Type:                recordType,
+		Subtype:             recordSubtype,
 		Ref:                 ref,
 		ObjectReference:     objectReference,
 		PrototypeReference:  prototypeReference,
 		PrevRecordReference: prevRecordReference,
+		ReasonReference:     reasonReference,
+		RequestReference:    requestReference,
+		Caller:              caller,
+		Method:              method,
+		Immutable:           immutable,
+		APIRequestID:        apiRequestID,
 		RecordPayload:       recordPayload,
 		Hash:                hash,
 		RawData:             rawData,
@@ -4,8 +4,10 @@ package transformer
 
 import (
 	"bytes"
+	"context"
 	"testing"
 
+	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/gen"
 	ins_record "github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/ledger/heavy/exporter"
@@ -168,11 +170,7 @@ func TestTransform_sortRecords_ErrorSameRecord(t *testing.T) {
 func TestTransform_transferToCanonicalRecord_SkipUnsortedRecord(t *testing.T) {
 	unsupportedRecord := &exporter.Record{
 		Record: ins_record.Material{
-			Virtual: ins_record.Virtual{
-				Union: &ins_record.Virtual_Genesis{
-					Genesis: new(ins_record.Genesis),
-				},
-			},
+			Virtual:  ins_record.Virtual{},
 			ID:       gen.IDWithPulse(gen.PulseNumber()),
 			ObjectID: gen.ID(),
 		},
@@ -180,4 +178,190 @@ func TestTransform_transferToCanonicalRecord_SkipUnsortedRecord(t *testing.T) {
 	r, err := transferToCanonicalRecord(unsupportedRecord)
 	require.True(t, err == UnsupportedRecordTypeError, "record should be an unsupported")
 	require.Empty(t, r)
+	require.Equal(t, "empty", virtualRecordKind(unsupportedRecord.Record.Virtual))
+}
+
+func TestTransform_transferToCanonicalRecord_RecordsWithoutLifeline(t *testing.T) {
+	prevRecord := gen.ID()
+	tests := []struct {
+		name                string
+		virtual             ins_record.Virtual
+		recordType          types.RecordType
+		subtype             types.RecordSubtype
+		payload             []byte
+		prevRecordReference types.Reference
+	}{
+		{
+			name:       "code",
+			virtual:    ins_record.Wrap(&ins_record.Code{Code: []byte("code")}),
+			recordType: types.CODE,
+			subtype:    types.CODERECORD,
+			payload:    []byte("code"),
+		},
+		{
+			name:                "pending filament",
+			virtual:             ins_record.Wrap(&ins_record.PendingFilament{RecordID: gen.ID(), PreviousRecord: &prevRecord}),
+			recordType:          types.PENDINGFILAMENT,
+			subtype:             types.PENDINGFILAMENTRECORD,
+			prevRecordReference: prevRecord.Bytes(),
+		},
+		{
+			name:       "genesis",
+			virtual:    ins_record.Wrap(&ins_record.Genesis{Hash: []byte("hash")}),
+			recordType: types.GENESIS,
+			subtype:    types.GENESISRECORD,
+			payload:    []byte("hash"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			objectID := gen.ID()
+			r, err := transferToCanonicalRecord(&exporter.Record{
+				Record: ins_record.Material{
+					Virtual:  test.virtual,
+					ID:       gen.IDWithPulse(gen.PulseNumber()),
+					ObjectID: objectID,
+				},
+			})
+			require.NoError(t, err)
+			require.Equal(t, test.recordType, r.Type)
+			require.Equal(t, test.subtype, r.Subtype)
+			require.Equal(t, types.Reference(objectID.Bytes()), r.ObjectReference)
+			if test.payload == nil {
+				require.Empty(t, r.RecordPayload)
+			} else {
+				require.Equal(t, test.payload, r.RecordPayload)
+			}
+			if test.prevRecordReference == nil {
+				require.Empty(t, r.PrevRecordReference)
+			} else {
+				require.Equal(t, test.prevRecordReference, r.PrevRecordReference)
+			}
+		})
+	}
+}
+
+func TestTransform_transferToCanonicalRecord_CallReferences(t *testing.T) {
+	reason := gen.Reference()
+	request := gen.Reference()
+	tests := []struct {
+		name             string
+		virtual          ins_record.Virtual
+		reasonReference  types.Reference
+		requestReference types.Reference
+	}{
+		{
+			name:            "incoming request",
+			virtual:         ins_record.Wrap(&ins_record.IncomingRequest{Reason: reason}),
+			reasonReference: reason.GetLocal().Bytes(),
+		},
+		{
+			name:            "outgoing request",
+			virtual:         ins_record.Wrap(&ins_record.OutgoingRequest{Reason: reason}),
+			reasonReference: reason.GetLocal().Bytes(),
+		},
+		{
+			name:             "result",
+			virtual:          ins_record.Wrap(&ins_record.Result{Request: request}),
+			requestReference: request.GetLocal().Bytes(),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r, err := transferToCanonicalRecord(&exporter.Record{
+				Record: ins_record.Material{
+					Virtual:  test.virtual,
+					ID:       gen.IDWithPulse(gen.PulseNumber()),
+					ObjectID: gen.ID(),
+				},
+			})
+			require.NoError(t, err)
+			if test.reasonReference == nil {
+				require.Empty(t, r.ReasonReference)
+			} else {
+				require.Equal(t, test.reasonReference, r.ReasonReference)
+			}
+			if test.requestReference == nil {
+				require.Empty(t, r.RequestReference)
+			} else {
+				require.Equal(t, test.requestReference, r.RequestReference)
+			}
+		})
+	}
+}
+
+func TestTransform_transferToCanonicalRecord_Subtype(t *testing.T) {
+	caller := gen.Reference()
+	tests := []struct {
+		name      string
+		virtual   ins_record.Virtual
+		subtype   types.RecordSubtype
+		isRequest bool
+	}{
+		{"activate", ins_record.Wrap(&ins_record.Activate{}), types.ACTIVATE, false},
+		{"amend", ins_record.Wrap(&ins_record.Amend{}), types.AMEND, false},
+		{"deactivate", ins_record.Wrap(&ins_record.Deactivate{}), types.DEACTIVATE, false},
+		{"result", ins_record.Wrap(&ins_record.Result{}), types.RESULTRECORD, false},
+		{"incoming request", ins_record.Wrap(&ins_record.IncomingRequest{
+			Caller: caller, Method: "Transfer", Immutable: true, APIRequestID: "api-request",
+		}), types.INCOMINGREQUEST, true},
+		{"outgoing request", ins_record.Wrap(&ins_record.OutgoingRequest{
+			Caller: caller, Method: "Transfer", Immutable: true, APIRequestID: "api-request",
+		}), types.OUTGOINGREQUEST, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r, err := transferToCanonicalRecord(&exporter.Record{
+				Record: ins_record.Material{
+					Virtual:  test.virtual,
+					ID:       gen.IDWithPulse(gen.PulseNumber()),
+					ObjectID: gen.ID(),
+				},
+			})
+			require.NoError(t, err)
+			require.Equal(t, test.subtype, r.Subtype)
+			if test.isRequest {
+				require.Equal(t, types.Reference(caller.GetLocal().Bytes()), r.Caller)
+				require.Equal(t, "Transfer", r.Method)
+				require.True(t, r.Immutable)
+				require.Equal(t, "api-request", r.APIRequestID)
+			} else {
+				require.Empty(t, r.Caller)
+				require.Empty(t, r.Method)
+				require.False(t, r.Immutable)
+				require.Empty(t, r.APIRequestID)
+			}
+		})
+	}
+}
+
+func TestTransform_RecordExtensions(t *testing.T) {
+	code := types.Record{Type: types.CODE, Ref: gen.ID().Bytes(), RecordPayload: []byte("code")}
+	largeMemory := bytes.Repeat([]byte{1}, maxInlineMemorySize+1)
+	largeState := types.Record{Type: types.STATE, Ref: gen.ID().Bytes(), RecordPayload: largeMemory}
+	smallState := types.Record{Type: types.STATE, Ref: gen.ID().Bytes(), RecordPayload: []byte("memory")}
+	request := types.Record{Type: types.REQUEST, Ref: gen.ID().Bytes(), RecordPayload: largeMemory}
+
+	extensions := RecordExtensions([]types.Record{code, largeState, smallState, request})
+
+	require.Equal(t, []types.RecordExtension{
+		{RecordReference: code.Ref, Kind: types.CODEEXTENSION, Data: []byte("code")},
+		{RecordReference: largeState.Ref, Kind: types.MEMORYEXTENSION, Data: largeMemory},
+	}, extensions)
+	require.Equal(t, []byte("code"), code.RecordPayload, "payloads are kept in the records")
+	require.Equal(t, largeMemory, largeState.RecordPayload)
+}
+
+func TestTransform_getJetDrop_PulseJetIDs(t *testing.T) {
+	ctx := context.Background()
+	pulseJetIDs := []string{
+		jetIDToString(*insolar.NewJetID(1, []byte{0})),
+		jetIDToString(*insolar.NewJetID(1, []byte{128})),
+	}
+	require.Equal(t, []string{"0", "1"}, pulseJetIDs)
+
+	jetDrop := getJetDrop(ctx, *insolar.NewJetID(1, []byte{128}), nil, types.Pulse{PulseNo: 1000}, pulseJetIDs, nil, nil)
+	require.NotNil(t, jetDrop)
+	require.Equal(t, pulseJetIDs, jetDrop.MainSection.Start.PulseJetIDs)
+	require.Equal(t, "1", jetDrop.MainSection.Start.JetDropPrefix)
 }
@@ -29,18 +29,24 @@ type Section interface {
 
 func (m MainSection) IsSection() bool { return true }
 
+func (a AdditionalSection) IsSection() bool { return true }
+
 type MainSection struct {
 	Start        DropStart
 	DropContinue DropContinue
 	Records      []Record
 }
 
+// AdditionalSection contains copies of the code and large memory of the main section records
 type AdditionalSection struct {
-	RecordExtensions []Record
+	RecordExtensions []RecordExtension
 }
 
+// DropStart describes the jet drop and its pulse.
+// PulseJetIDs is the jet tree of the pulse reported by the platform, jet drops are expected for every jet of it.
 type DropStart struct {
 	PulseData           Pulse
+	PulseJetIDs         []string
 	JetDropPrefix       string
 	JetDropPrefixLength uint
 }
@@ -64,18 +70,86 @@ const (
 	STATE RecordType = iota
 	REQUEST
 	RESULT
+	// records without lifeline semantics
+	CODE
+	PENDINGFILAMENT
+	GENESIS
+)
+
+// RecordSubtype is the exact kind of the virtual record
+type RecordSubtype int
+
+const (
+	ACTIVATE RecordSubtype = iota
+	AMEND
+	DEACTIVATE
+	INCOMINGREQUEST
+	OUTGOINGREQUEST
+	RESULTRECORD
+	CODERECORD
+	PENDINGFILAMENTRECORD
+	GENESISRECORD
 )
 
 // Reference based on Insolar.Reference
 type Reference []byte
+
+// Record is the canonical record.
+// ReasonReference is the incoming request which caused the request, it's set for requests only.
+// RequestReference is the request of the result, it's set for results only.
+// Caller, Method, Immutable and APIRequestID are copied from requests, they're empty for other records.
 type Record struct {
 	Type                RecordType
+	Subtype             RecordSubtype
 	Ref                 Reference
 	ObjectReference     Reference
 	PrototypeReference  Reference
 	PrevRecordReference Reference
+	ReasonReference     Reference
+	RequestReference    Reference
+	Caller              Reference
+	Method              string
+	Immutable           bool
+	APIRequestID        string
 	RecordPayload       []byte
 	Hash                []byte
 	RawData             []byte
 	Order               uint32
 }
+
+type RecordExtensionKind int
+
+const (
+	// code extension is the code blob of the code record
+	CODEEXTENSION RecordExtensionKind = iota
+	// memory extension is the large memory of the state record
+	MEMORYEXTENSION
+)
+
+// RecordExtension is the copy of the code or large memory of the record with reference RecordReference
+type RecordExtension struct {
+	RecordReference Reference
+	Kind            RecordExtensionKind
+	Data            []byte
+}
+
+// ReingestProgress represents the state of the re-ingestion of a pulse range
+type ReingestProgress struct {
+	FromPulseNumber int64 `json:"from_pulse_number"`
+	ToPulseNumber   int64 `json:"to_pulse_number"`
+	// SequentialPulseNumber is the last sequential pulse, the re-ingestion is done when it reaches ToPulseNumber
+	SequentialPulseNumber int64 `json:"sequential_pulse_number"`
+	Done                  bool  `json:"done"`
+}
+
+// DeadLetter represents the jet drop which failed to persist after all retries,
+// JetDrop is set only when the single dead letter is requested
+type DeadLetter struct {
+	ID          int64    `json:"id"`
+	PulseNumber int64    `json:"pulse_number"`
+	JetID       string   `json:"jet_id"`
+	Error       string   `json:"error"`
+	Attempts    int      `json:"attempts"`
+	Timestamp   int64    `json:"timestamp"`
+	JetDrop     *JetDrop `json:"jet_drop,omitempty"`
+}
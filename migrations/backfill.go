@@ -0,0 +1,106 @@
+package migrations
+
+import (
+	"fmt"
+	"strings"
+
+	ins_record "github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/block-explorer/etl/models"
+)
+
+const backfillBatchSize = 1000
+
+// backfillUpdate sets the fields of the batch of records by one statement, the request fields of the records
+// which aren't requests are left as is
+const backfillUpdate = `UPDATE records SET subtype = v.subtype,
+	caller = COALESCE(v.caller, records.caller),
+	method = COALESCE(v.method, records.method),
+	immutable = COALESCE(v.immutable, records.immutable),
+	api_request_id = COALESCE(v.api_request_id, records.api_request_id)
+FROM (VALUES %s) AS v(reference, subtype, caller, method, immutable, api_request_id)
+WHERE records.reference = v.reference`
+
+const backfillRow = "(?::bytea, ?::text, ?::bytea, ?::text, ?::boolean, ?::text)"
+
+// backfillRecordSubtypes fills the subtype and the request fields of the stored records from their raw data.
+// The records are read in batches ordered by the primary key and every batch is updated by one statement.
+// It doesn't use the transformer, the migration must stay the same when the transformer changes.
+func backfillRecordSubtypes(tx *gorm.DB) error {
+	type Record struct {
+		Reference models.Reference
+		RawData   []byte
+	}
+	var lastReference models.Reference = []byte{}
+	for {
+		var records []Record
+		err := tx.Table("records").Select("reference, raw_data").Where("reference > ?", []byte(lastReference)).
+			Order("reference asc").Limit(backfillBatchSize).Find(&records).Error
+		if err != nil {
+			return errors.Wrap(err, "error while selecting records")
+		}
+		var rows []string
+		var values []interface{}
+		for _, r := range records {
+			var raw exporter.Record
+			if err := raw.Unmarshal(r.RawData); err != nil {
+				// records with broken raw data are reported by the audit, there is nothing to backfill from
+				continue
+			}
+			fields := recordSubtypeFields(raw.Record.Virtual)
+			if fields == nil {
+				continue
+			}
+			rows = append(rows, backfillRow)
+			values = append(values, []byte(r.Reference), fields["subtype"], fields["caller"], fields["method"],
+				fields["immutable"], fields["api_request_id"])
+		}
+		if len(rows) > 0 {
+			err = tx.Exec(fmt.Sprintf(backfillUpdate, strings.Join(rows, ", ")), values...).Error
+			if err != nil {
+				return errors.Wrapf(err, "error while updating records after %v", lastReference)
+			}
+		}
+		if len(records) < backfillBatchSize {
+			return nil
+		}
+		lastReference = records[len(records)-1].Reference
+	}
+}
+
+func recordSubtypeFields(virtual ins_record.Virtual) map[string]interface{} {
+	var request *ins_record.IncomingRequest
+	fields := map[string]interface{}{}
+	switch v := virtual.Union.(type) {
+	case *ins_record.Virtual_Activate:
+		fields["subtype"] = models.SubtypeActivate
+	case *ins_record.Virtual_Amend:
+		fields["subtype"] = models.SubtypeAmend
+	case *ins_record.Virtual_Deactivate:
+		fields["subtype"] = models.SubtypeDeactivate
+	case *ins_record.Virtual_Result:
+		fields["subtype"] = models.SubtypeResult
+	case *ins_record.Virtual_IncomingRequest:
+		fields["subtype"] = models.SubtypeIncomingRequest
+		request = v.IncomingRequest
+	case *ins_record.Virtual_OutgoingRequest:
+		fields["subtype"] = models.SubtypeOutgoingRequest
+		request = (*ins_record.IncomingRequest)(v.OutgoingRequest)
+	default:
+		return nil
+	}
+	if request != nil {
+		caller := []byte{}
+		if !request.Caller.IsEmpty() {
+			caller = request.Caller.GetLocal().Bytes()
+		}
+		fields["caller"] = caller
+		fields["method"] = request.Method
+		fields["immutable"] = request.Immutable
+		fields["api_request_id"] = request.APIRequestID
+	}
+	return fields
+}
@@ -0,0 +1,104 @@
+// +build integration
+
+package migrations_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/pulse"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/gormigrate.v1"
+
+	"github.com/insolar/block-explorer/etl/models"
+	"github.com/insolar/block-explorer/migrations"
+	"github.com/insolar/block-explorer/testutils"
+)
+
+func TestBackfillRecordSubtypes(t *testing.T) {
+	dbName := "test_db"
+	dbPassword := "secret"
+	pool, resource, poolCleaner := testutils.RunDBInDocker(dbName, dbPassword)
+	defer poolCleaner()
+	dbURL := fmt.Sprintf("postgres://postgres:%s@localhost:%s/%s?sslmode=disable", dbPassword, resource.GetPort("5432/tcp"), dbName)
+	var db *gorm.DB
+	err := pool.Retry(func() error {
+		var err error
+		db, err = gorm.Open("postgres", dbURL)
+		if err != nil {
+			return err
+		}
+		return db.Exec("select 1").Error
+	})
+	require.NoError(t, err)
+	defer db.Close()
+
+	m := gormigrate.New(db, migrations.MigrationOptions(), migrations.Migrations())
+	require.NoError(t, m.MigrateTo("202009280000"))
+
+	type Record struct {
+		Reference models.Reference
+		RawData   []byte
+	}
+	save := func(reference models.Reference, rawData []byte) {
+		require.NoError(t, db.Table("records").Create(&Record{Reference: reference, RawData: rawData}).Error)
+	}
+
+	request := testutils.GenerateRequestRecord(pulse.MinTimePulse, gen.ID())
+	incoming := request.Record.Virtual.GetIncomingRequest()
+	incoming.Caller = gen.Reference()
+	incoming.Immutable = true
+	incoming.APIRequestID = "api request"
+	requestData, err := request.Marshal()
+	require.NoError(t, err)
+	requestReference := models.Reference(request.Record.ID.Bytes())
+	save(requestReference, requestData)
+
+	// the records don't fit one batch
+	var activateReferences []models.Reference
+	for i := 0; i < 1500; i++ {
+		activate := testutils.GenerateVirtualActivateRecord(pulse.MinTimePulse, gen.ID(), gen.ID())
+		data, err := activate.Marshal()
+		require.NoError(t, err)
+		reference := models.Reference(activate.Record.ID.Bytes())
+		save(reference, data)
+		activateReferences = append(activateReferences, reference)
+	}
+	brokenReference := models.Reference(gen.ID().Bytes())
+	save(brokenReference, []byte{1, 2, 3})
+
+	require.NoError(t, m.MigrateTo("202010050000"))
+
+	type BackfilledRecord struct {
+		Subtype      models.RecordSubtype
+		Caller       models.Reference
+		Method       string
+		Immutable    bool
+		APIRequestID string
+	}
+	get := func(reference models.Reference) BackfilledRecord {
+		var r BackfilledRecord
+		require.NoError(t, db.Table("records").Where("reference = ?", []byte(reference)).First(&r).Error)
+		return r
+	}
+
+	require.Equal(t, BackfilledRecord{
+		Subtype:      models.SubtypeIncomingRequest,
+		Caller:       incoming.Caller.GetLocal().Bytes(),
+		Method:       incoming.Method,
+		Immutable:    true,
+		APIRequestID: "api request",
+	}, get(requestReference))
+	for _, reference := range activateReferences {
+		r := get(reference)
+		require.Equal(t, models.SubtypeActivate, r.Subtype)
+		require.Empty(t, r.Caller, "request fields of other records aren't filled")
+	}
+	require.Empty(t, get(brokenReference).Subtype, "record with broken raw data is skipped")
+
+	var count int
+	require.NoError(t, db.Table("records").Where("subtype = ?", models.SubtypeActivate).Count(&count).Error)
+	require.Equal(t, len(activateReferences), count)
+}
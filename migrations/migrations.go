@@ -85,6 +85,181 @@ func Migrations() []*gormigrate.Migration {
 				return tx.DropTableIfExists("records", "jet_drops", "pulses").Error
 			},
 		},
+		{
+			ID: "202009150000",
+			Migrate: func(tx *gorm.DB) error {
+				// indexes for the exporter pulse subscriptions filtered by records
+				if err := tx.Table("records").AddIndex(
+					"idx_record_prototypereference_pulsenumber", "prototype_reference", "pulse_number").Error; err != nil {
+					return err
+				}
+				if err := tx.Table("records").AddIndex(
+					"idx_record_type_pulsenumber", "type", "pulse_number").Error; err != nil {
+					return err
+				}
+				return nil
+			},
+			Rollback: func(tx *gorm.DB) error {
+				if err := tx.Table("records").RemoveIndex("idx_record_prototypereference_pulsenumber").Error; err != nil {
+					return err
+				}
+				return tx.Table("records").RemoveIndex("idx_record_type_pulsenumber").Error
+			},
+		},
+		{
+			ID: "202009210000",
+			Migrate: func(tx *gorm.DB) error {
+				type AuditFinding struct {
+					ID          int64 `gorm:"primary_key"`
+					PulseNumber int64
+					JetID       string
+					Kind        models.AuditFindingKind
+					Details     string
+					Timestamp   int64
+				}
+				if err := tx.CreateTable(&AuditFinding{}).Error; err != nil {
+					return err
+				}
+				if err := tx.Model(&AuditFinding{}).AddIndex("idx_auditfinding_pulsenumber_jetid", "pulse_number", "jet_id").Error; err != nil {
+					return err
+				}
+				return nil
+			},
+			Rollback: func(tx *gorm.DB) error {
+				return tx.DropTableIfExists("audit_findings").Error
+			},
+		},
+		{
+			ID: "202009280000",
+			Migrate: func(tx *gorm.DB) error {
+				type Record struct {
+					ReasonReference  models.Reference
+					RequestReference models.Reference
+				}
+				if err := tx.AutoMigrate(&Record{}).Error; err != nil {
+					return err
+				}
+				// indexes for the call tree of requests
+				if err := tx.Model(&Record{}).AddIndex("idx_record_reasonreference", "reason_reference").Error; err != nil {
+					return err
+				}
+				if err := tx.Model(&Record{}).AddIndex("idx_record_requestreference", "request_reference").Error; err != nil {
+					return err
+				}
+				return nil
+			},
+			Rollback: func(tx *gorm.DB) error {
+				if err := tx.Table("records").DropColumn("reason_reference").Error; err != nil {
+					return err
+				}
+				return tx.Table("records").DropColumn("request_reference").Error
+			},
+		},
+		{
+			ID: "202010050000",
+			Migrate: func(tx *gorm.DB) error {
+				type Record struct {
+					Subtype      models.RecordSubtype
+					Caller       models.Reference
+					Method       string
+					Immutable    bool
+					APIRequestID string
+				}
+				if err := tx.AutoMigrate(&Record{}).Error; err != nil {
+					return err
+				}
+				return backfillRecordSubtypes(tx)
+			},
+			Rollback: func(tx *gorm.DB) error {
+				for _, column := range []string{"subtype", "caller", "method", "immutable", "api_request_id"} {
+					if err := tx.Table("records").DropColumn(column).Error; err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		},
+		{
+			ID: "202010120000",
+			Migrate: func(tx *gorm.DB) error {
+				type RecordExtension struct {
+					RecordReference models.Reference           `gorm:"primary_key;auto_increment:false"`
+					Kind            models.RecordExtensionKind `gorm:"type:varchar(255);primary_key;auto_increment:false"`
+					Data            []byte
+				}
+				if err := tx.CreateTable(&RecordExtension{}).Error; err != nil {
+					return err
+				}
+				if err := tx.Model(&RecordExtension{}).AddForeignKey("record_reference", "records(reference)", "CASCADE", "CASCADE").Error; err != nil {
+					return err
+				}
+				return nil
+			},
+			Rollback: func(tx *gorm.DB) error {
+				return tx.DropTableIfExists("record_extensions").Error
+			},
+		},
+		{
+			ID: "202010190000",
+			Migrate: func(tx *gorm.DB) error {
+				type PulseJet struct {
+					PulseNumber int64  `gorm:"primary_key;auto_increment:false"`
+					JetID       string `gorm:"type:varchar(255);primary_key;auto_increment:false"`
+				}
+				if err := tx.CreateTable(&PulseJet{}).Error; err != nil {
+					return err
+				}
+				if err := tx.Model(&PulseJet{}).AddForeignKey("pulse_number", "pulses(pulse_number)", "CASCADE", "CASCADE").Error; err != nil {
+					return err
+				}
+				return nil
+			},
+			Rollback: func(tx *gorm.DB) error {
+				return tx.DropTableIfExists("pulse_jets").Error
+			},
+		},
+		{
+			ID: "202010260000",
+			Migrate: func(tx *gorm.DB) error {
+				type DeadLetter struct {
+					ID          int64 `gorm:"primary_key"`
+					PulseNumber int64
+					JetID       string
+					JetDrop     []byte
+					Error       string
+					Attempts    int
+					Timestamp   int64
+				}
+				if err := tx.CreateTable(&DeadLetter{}).Error; err != nil {
+					return err
+				}
+				// a jet drop failing again after the reload of its pulse updates its dead letter instead of adding a new one
+				return tx.Model(&DeadLetter{}).AddUniqueIndex("dead_letters_pulse_number_jet_id_idx", "pulse_number", "jet_id").Error
+			},
+			Rollback: func(tx *gorm.DB) error {
+				return tx.DropTableIfExists("dead_letters").Error
+			},
+		},
+		{
+			ID: "202011020000",
+			Migrate: func(tx *gorm.DB) error {
+				type Checkpoint struct {
+					Name        string `gorm:"primary_key;auto_increment:false"`
+					PulseNumber int64
+					Timestamp   int64
+				}
+				type ReloadRange struct {
+					FromPulseNumber int64 `gorm:"primary_key;auto_increment:false"`
+					ToPulseNumber   int64 `gorm:"primary_key;auto_increment:false"`
+					Attempts        int
+					Timestamp       int64
+				}
+				return tx.CreateTable(&Checkpoint{}, &ReloadRange{}).Error
+			},
+			Rollback: func(tx *gorm.DB) error {
+				return tx.DropTableIfExists("checkpoints", "reload_ranges").Error
+			},
+		},
 	}
 }
 
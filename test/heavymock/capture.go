@@ -0,0 +1,144 @@
+package heavymock
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// full names of the captured methods of the heavy node exporter
+const (
+	PulseExportMethod             = "/exporter.PulseExporter/Export"
+	PulseTopSyncPulseMethod       = "/exporter.PulseExporter/TopSyncPulse"
+	PulseNextFinalizedPulseMethod = "/exporter.PulseExporter/NextFinalizedPulse"
+	RecordExportMethod            = "/exporter.RecordExporter/Export"
+)
+
+type marshaler interface {
+	Marshal() ([]byte, error)
+}
+
+// Exchange is one captured call of the heavy node exporter: the request, all received responses
+// and the status the call ended with
+type Exchange struct {
+	Method    string     `json:"method"`
+	Request   []byte     `json:"request"`
+	Responses [][]byte   `json:"responses,omitempty"`
+	Code      codes.Code `json:"code,omitempty"`
+	Message   string     `json:"message,omitempty"`
+}
+
+// Err returns the captured error of the call, nil means the call was successful
+func (e *Exchange) Err() error {
+	if e.Code == codes.OK {
+		return nil
+	}
+	return status.Error(e.Code, e.Message)
+}
+
+func newExchange(method string, request marshaler, responses []marshaler, err error) (Exchange, error) {
+	ex := Exchange{Method: method}
+	var mErr error
+	if ex.Request, mErr = request.Marshal(); mErr != nil {
+		return Exchange{}, errors.Wrap(mErr, "cannot marshal request")
+	}
+	for _, r := range responses {
+		data, mErr := r.Marshal()
+		if mErr != nil {
+			return Exchange{}, errors.Wrap(mErr, "cannot marshal response")
+		}
+		ex.Responses = append(ex.Responses, data)
+	}
+	if err != nil {
+		st := status.Convert(err)
+		ex.Code, ex.Message = st.Code(), st.Message()
+	}
+	return ex, nil
+}
+
+// CaptureWriter appends captured exchanges to the file as JSON lines
+type CaptureWriter struct {
+	mux     sync.Mutex
+	file    *os.File
+	encoder *json.Encoder
+}
+
+func NewCaptureWriter(path string) (*CaptureWriter, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot create capture file %s", path)
+	}
+	return &CaptureWriter{file: file, encoder: json.NewEncoder(file)}, nil
+}
+
+// Write appends the exchange to the file
+func (w *CaptureWriter) Write(ex Exchange) error {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	return errors.Wrap(w.encoder.Encode(ex), "cannot write exchange")
+}
+
+func (w *CaptureWriter) Close() error {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	return w.file.Close()
+}
+
+// Capture holds exchanges of the capture file grouped by the method and the request.
+// Calls with the same request get the captured exchanges in the captured order,
+// the last exchange is repeated after the others are used.
+type Capture struct {
+	mux       sync.Mutex
+	exchanges map[string][]*Exchange
+}
+
+func LoadCapture(path string) (*Capture, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot open capture file %s", path)
+	}
+	defer file.Close()
+
+	capture := &Capture{exchanges: map[string][]*Exchange{}}
+	decoder := json.NewDecoder(file)
+	for {
+		ex := &Exchange{}
+		err := decoder.Decode(ex)
+		if err == io.EOF {
+			return capture, nil
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot read capture file %s", path)
+		}
+		key := exchangeKey(ex.Method, ex.Request)
+		capture.exchanges[key] = append(capture.exchanges[key], ex)
+	}
+}
+
+// Next returns the next captured exchange of the request, codes.NotFound error is returned if the request wasn't captured
+func (c *Capture) Next(method string, request marshaler) (*Exchange, error) {
+	data, err := request.Marshal()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot marshal request: %s", err)
+	}
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	key := exchangeKey(method, data)
+	exchanges := c.exchanges[key]
+	if len(exchanges) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no captured exchange of %s for request %v", method, request)
+	}
+	if len(exchanges) > 1 {
+		c.exchanges[key] = exchanges[1:]
+	}
+	return exchanges[0], nil
+}
+
+func exchangeKey(method string, request []byte) string {
+	return method + ":" + string(request)
+}
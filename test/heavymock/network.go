@@ -0,0 +1,440 @@
+package heavymock
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/insolar/block-explorer/testutils"
+)
+
+// Network simulates the heavy node of the network described by the scenario.
+// The gRPC server has to accept connections by the listener wrapped by Listen, so the connections can be dropped.
+type Network struct {
+	pulses      []*simulatedPulse
+	pulsePeriod time.Duration
+	startedAt   time.Time
+
+	mux    sync.Mutex
+	faults map[faultKey]int
+	// conns are the accepted connections by the client addresses
+	conns map[string]net.Conn
+}
+
+type simulatedPulse struct {
+	pulse   *exporter.FullPulse
+	records []*exporter.Record
+	faults  []ScenarioFault
+}
+
+type faultKey struct {
+	pulse int
+	fault int
+}
+
+// NewNetwork generates pulses, jet trees and records of the scenario, the pulses are finalized from now on
+func NewNetwork(s *Scenario) (*Network, error) {
+	if len(s.Pulses) == 0 {
+		return nil, errors.New("scenario has no pulses")
+	}
+	jets := map[string][]byte{}
+	for _, jet := range s.Jets {
+		if _, err := parseJetID(jet); err != nil {
+			return nil, err
+		}
+		jets[jet] = nil
+	}
+
+	n := &Network{pulsePeriod: s.PulsePeriod, startedAt: time.Now(), faults: map[faultKey]int{}, conns: map[string]net.Conn{}}
+	pn, prev := s.StartPulse, insolar.PulseNumber(0)
+	for i, sp := range s.Pulses {
+		if i > 0 {
+			pn += insolar.PulseNumber(s.PulseDelta) * insolar.PulseNumber(1+sp.Gap)
+		}
+		prevHashes, err := changeJetTree(jets, sp.Split, sp.Merge)
+		if err != nil {
+			return nil, errors.Wrapf(err, "pulse %d", pn)
+		}
+		for j, fault := range sp.Faults {
+			if err := validateFault(fault); err != nil {
+				return nil, errors.Wrapf(err, "pulse %d", pn)
+			}
+			times := fault.Times
+			if times == 0 {
+				times = 1
+			}
+			n.faults[faultKey{pulse: i, fault: j}] = times
+		}
+
+		timestamp, err := pn.AsApproximateTime()
+		if err != nil {
+			return nil, errors.Wrapf(err, "pulse %d", pn)
+		}
+		p := &simulatedPulse{
+			pulse: &exporter.FullPulse{
+				PulseNumber:     pn,
+				PrevPulseNumber: prev,
+				NextPulseNumber: pn + insolar.PulseNumber(s.PulseDelta),
+				PulseTimestamp:  timestamp.UnixNano(),
+			},
+			faults: sp.Faults,
+		}
+		for _, jet := range sortedJets(jets) {
+			jetID, _ := parseJetID(jet)
+			hash := jetDropHash(pn, jet)
+			p.pulse.Jets = append(p.pulse.Jets, exporter.JetDropContinue{
+				JetID:          jetID,
+				Hash:           hash,
+				PrevDropHashes: prevHashes[jet],
+			})
+			jets[jet] = hash
+
+			records := testutils.GenerateRecordsSilence(sp.Records)
+			for _, r := range records {
+				r.Record.ID = *insolar.NewID(pn, testutils.GenerateRandBytes())
+				r.Record.JetID = jetID
+				r.ShouldIterateFrom = nil
+			}
+			p.records = append(p.records, records...)
+		}
+		for j, r := range p.records {
+			r.RecordNumber = uint32(j + 1)
+		}
+		n.pulses = append(n.pulses, p)
+		prev = pn
+	}
+	return n, nil
+}
+
+// changeJetTree splits and merges the jets of the tree and returns the previous jet drop hashes of the new tree
+func changeJetTree(jets map[string][]byte, split, merge []string) (map[string][][]byte, error) {
+	prevHashes := map[string][][]byte{}
+	for jet, hash := range jets {
+		if hash != nil {
+			prevHashes[jet] = [][]byte{hash}
+		}
+	}
+	for _, jet := range split {
+		hash, ok := jets[jet]
+		if !ok {
+			return nil, errors.Errorf("cannot split jet %q, it isn't in the jet tree", jet)
+		}
+		if len(jet) >= insolar.JetMaximumDepth {
+			return nil, errors.Errorf("cannot split jet %q, it has the maximum depth", jet)
+		}
+		delete(jets, jet)
+		delete(prevHashes, jet)
+		for _, child := range []string{jet + "0", jet + "1"} {
+			jets[child] = nil
+			if hash != nil {
+				prevHashes[child] = [][]byte{hash}
+			}
+		}
+	}
+	for _, jet := range merge {
+		left, right := jet+"0", jet+"1"
+		leftHash, okLeft := jets[left]
+		rightHash, okRight := jets[right]
+		if !okLeft || !okRight {
+			return nil, errors.Errorf("cannot merge jet %q, its children aren't in the jet tree", jet)
+		}
+		delete(jets, left)
+		delete(jets, right)
+		delete(prevHashes, left)
+		delete(prevHashes, right)
+		jets[jet] = nil
+		if leftHash != nil && rightHash != nil {
+			prevHashes[jet] = [][]byte{leftHash, rightHash}
+		}
+	}
+	return prevHashes, nil
+}
+
+func validateFault(fault ScenarioFault) error {
+	switch fault.Call {
+	case FaultNextPulse, FaultRecords:
+	default:
+		return errors.Errorf("unknown fault call %q", fault.Call)
+	}
+	switch fault.Error {
+	case FaultNotFound, FaultNotFinal, FaultRateLimit, FaultDrop:
+	case FaultSkip:
+		if fault.Call != FaultNextPulse {
+			return errors.Errorf("fault %q is applicable to %q calls only", FaultSkip, FaultNextPulse)
+		}
+	default:
+		return errors.Errorf("unknown fault error %q", fault.Error)
+	}
+	return nil
+}
+
+// parseJetID converts the jet prefix in bits to the jet id
+func parseJetID(jet string) (insolar.JetID, error) {
+	if len(jet) > insolar.JetMaximumDepth {
+		return insolar.JetID{}, errors.Errorf("jet %q is too deep", jet)
+	}
+	prefix := make([]byte, insolar.JetPrefixSize)
+	for i, bit := range jet {
+		switch bit {
+		case '0':
+		case '1':
+			prefix[i/8] |= 1 << uint(7-i%8)
+		default:
+			return insolar.JetID{}, errors.Errorf("jet %q has to consist of 0 and 1", jet)
+		}
+	}
+	return *insolar.NewJetID(uint8(len(jet)), prefix), nil
+}
+
+func sortedJets(jets map[string][]byte) []string {
+	result := make([]string, 0, len(jets))
+	for jet := range jets {
+		result = append(result, jet)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func jetDropHash(pn insolar.PulseNumber, jet string) []byte {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%d:%s", pn, jet)))
+	return hash[:]
+}
+
+// Pulses returns all pulses of the scenario
+func (n *Network) Pulses() []*exporter.FullPulse {
+	result := make([]*exporter.FullPulse, 0, len(n.pulses))
+	for _, p := range n.pulses {
+		result = append(result, p.pulse)
+	}
+	return result
+}
+
+// Records returns all records of the pulse
+func (n *Network) Records(pn insolar.PulseNumber) []*exporter.Record {
+	if i, ok := n.find(pn); ok {
+		return n.pulses[i].records
+	}
+	return nil
+}
+
+// PulseExporter returns the pulse exporter of the simulated heavy node
+func (n *Network) PulseExporter() exporter.PulseExporterServer {
+	return &networkPulseExporter{n}
+}
+
+// RecordExporter returns the record exporter of the simulated heavy node
+func (n *Network) RecordExporter() exporter.RecordExporterServer {
+	return &networkRecordExporter{n}
+}
+
+// finalized returns the number of the finalized pulses
+func (n *Network) finalized() int {
+	if n.pulsePeriod == 0 {
+		return len(n.pulses)
+	}
+	count := 1 + int(time.Since(n.startedAt)/n.pulsePeriod)
+	if count > len(n.pulses) {
+		return len(n.pulses)
+	}
+	return count
+}
+
+func (n *Network) find(pn insolar.PulseNumber) (int, bool) {
+	i := sort.Search(len(n.pulses), func(i int) bool {
+		return n.pulses[i].pulse.PulseNumber >= pn
+	})
+	return i, i < len(n.pulses) && n.pulses[i].pulse.PulseNumber == pn
+}
+
+// fault returns the fault of the call concerning the pulse and counts it
+func (n *Network) fault(i int, call string) *ScenarioFault {
+	n.mux.Lock()
+	defer n.mux.Unlock()
+	for j, fault := range n.pulses[i].faults {
+		key := faultKey{pulse: i, fault: j}
+		if fault.Call == call && n.faults[key] > 0 {
+			n.faults[key]--
+			return &n.pulses[i].faults[j]
+		}
+	}
+	return nil
+}
+
+// faultError returns the error of the fault, the connection of the call is closed if the fault drops it
+func (n *Network) faultError(ctx context.Context, fault *ScenarioFault) error {
+	switch fault.Error {
+	case FaultNotFound:
+		return pulse.ErrNotFound
+	case FaultNotFinal:
+		return exporter.ErrNotFinalPulseData
+	case FaultRateLimit:
+		return status.Error(codes.ResourceExhausted, exporter.RateLimitExceededMsg)
+	default:
+		return n.drop(ctx)
+	}
+}
+
+// drop closes the connection of the call, so the client gets the error of the broken transport
+func (n *Network) drop(ctx context.Context) error {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Internal, "cannot drop the connection, the call has no peer")
+	}
+	n.mux.Lock()
+	conn, ok := n.conns[p.Addr.String()]
+	n.mux.Unlock()
+	if !ok {
+		return status.Error(codes.Internal, "cannot drop the connection, the server doesn't listen by Network.Listen")
+	}
+	conn.Close()
+	return status.Error(codes.Unavailable, "connection is dropped")
+}
+
+// Listen wraps the listener of the gRPC server, so the network can drop the accepted connections
+func (n *Network) Listen(listener net.Listener) net.Listener {
+	return &networkListener{Listener: listener, n: n}
+}
+
+type networkListener struct {
+	net.Listener
+	n *Network
+}
+
+func (l *networkListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	key := conn.RemoteAddr().String()
+	l.n.mux.Lock()
+	l.n.conns[key] = conn
+	l.n.mux.Unlock()
+	return &networkConn{Conn: conn, n: l.n, key: key}, nil
+}
+
+// networkConn forgets the connection when it's closed
+type networkConn struct {
+	net.Conn
+	n   *Network
+	key string
+}
+
+func (c *networkConn) Close() error {
+	c.n.mux.Lock()
+	delete(c.n.conns, c.key)
+	c.n.mux.Unlock()
+	return c.Conn.Close()
+}
+
+type networkPulseExporter struct {
+	n *Network
+}
+
+func (e *networkPulseExporter) Export(request *exporter.GetPulses, stream exporter.PulseExporter_ExportServer) error {
+	finalized := e.n.finalized()
+	i, _ := e.n.find(request.PulseNumber + 1)
+	for sent := uint32(0); i < finalized && (request.Count == 0 || sent < request.Count); i, sent = i+1, sent+1 {
+		p := e.n.pulses[i].pulse
+		err := stream.Send(&exporter.Pulse{PulseNumber: p.PulseNumber, Entropy: p.Entropy, PulseTimestamp: p.PulseTimestamp})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *networkPulseExporter) TopSyncPulse(context.Context, *exporter.GetTopSyncPulse) (*exporter.TopSyncPulseResponse, error) {
+	return &exporter.TopSyncPulseResponse{
+		PulseNumber: uint32(e.n.pulses[e.n.finalized()-1].pulse.PulseNumber),
+	}, nil
+}
+
+func (e *networkPulseExporter) NextFinalizedPulse(ctx context.Context, request *exporter.GetNextFinalizedPulse) (*exporter.FullPulse, error) {
+	finalized := e.n.finalized()
+	i, _ := e.n.find(insolar.PulseNumber(request.PulseNo + 1))
+	if i >= finalized {
+		return nil, pulse.ErrNotFound
+	}
+	if fault := e.n.fault(i, FaultNextPulse); fault != nil {
+		if fault.Error != FaultSkip {
+			return nil, e.n.faultError(ctx, fault)
+		}
+		if i++; i >= finalized {
+			return nil, pulse.ErrNotFound
+		}
+	}
+	p := *e.n.pulses[i].pulse
+	return &p, nil
+}
+
+type networkRecordExporter struct {
+	n *Network
+}
+
+func (e *networkRecordExporter) Export(request *exporter.GetRecords, stream exporter.RecordExporter_ExportServer) error {
+	finalized := e.n.finalized()
+	i, ok := e.n.find(request.PulseNumber)
+	if i >= finalized {
+		return exporter.ErrNotFinalPulseData
+	}
+	if !ok {
+		// the pulse is in the gap, so it has no records
+		return stream.Send(&exporter.Record{ShouldIterateFrom: e.nextNotEmpty(i - 1)})
+	}
+
+	records := e.n.pulses[i].records
+	from := int(request.RecordNumber)
+	if from > len(records) {
+		from = len(records)
+	}
+	to := len(records)
+	if request.Count > 0 && from+int(request.Count) < to {
+		to = from + int(request.Count)
+	}
+
+	if fault := e.n.fault(i, FaultRecords); fault != nil {
+		if from+fault.After < to {
+			to = from + fault.After
+		}
+		for _, r := range records[from:to] {
+			if err := stream.Send(r); err != nil {
+				return err
+			}
+		}
+		return e.n.faultError(stream.Context(), fault)
+	}
+
+	for _, r := range records[from:to] {
+		if err := stream.Send(r); err != nil {
+			return err
+		}
+	}
+	if to == len(records) && (request.Count == 0 || to-from < int(request.Count)) {
+		return stream.Send(&exporter.Record{ShouldIterateFrom: e.nextNotEmpty(i)})
+	}
+	return nil
+}
+
+// nextNotEmpty returns the first pulse with records after the pulse, it's the pulse to iterate records from
+func (e *networkRecordExporter) nextNotEmpty(i int) *insolar.PulseNumber {
+	for j := i + 1; j < len(e.n.pulses); j++ {
+		if len(e.n.pulses[j].records) > 0 {
+			next := e.n.pulses[j].pulse.PulseNumber
+			return &next
+		}
+	}
+	next := e.n.pulses[len(e.n.pulses)-1].pulse.NextPulseNumber
+	return &next
+}
@@ -0,0 +1,129 @@
+package heavymock
+
+import (
+	"context"
+	"io"
+
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/insolar/block-explorer/instrumentation/belogger"
+)
+
+// RecordingPulseExporter proxies the pulse exporter calls to the heavy node and captures them
+type RecordingPulseExporter struct {
+	client  exporter.PulseExporterClient
+	capture *CaptureWriter
+}
+
+func NewRecordingPulseExporter(client exporter.PulseExporterClient, capture *CaptureWriter) *RecordingPulseExporter {
+	return &RecordingPulseExporter{client: client, capture: capture}
+}
+
+func (r *RecordingPulseExporter) Export(request *exporter.GetPulses, stream exporter.PulseExporter_ExportServer) error {
+	ctx := stream.Context()
+	upstream, err := r.client.Export(upstreamContext(ctx), request)
+	if err != nil {
+		captureExchange(ctx, r.capture, PulseExportMethod, request, nil, err)
+		return err
+	}
+	var responses []marshaler
+	for {
+		pulse, err := upstream.Recv()
+		if err == io.EOF {
+			captureExchange(ctx, r.capture, PulseExportMethod, request, responses, nil)
+			return nil
+		}
+		if err != nil {
+			captureExchange(ctx, r.capture, PulseExportMethod, request, responses, err)
+			return err
+		}
+		responses = append(responses, pulse)
+		if err := stream.Send(pulse); err != nil {
+			// the block explorer closed the stream, the received part is captured with the error
+			captureExchange(ctx, r.capture, PulseExportMethod, request, responses, err)
+			return err
+		}
+	}
+}
+
+func (r *RecordingPulseExporter) TopSyncPulse(ctx context.Context, request *exporter.GetTopSyncPulse) (*exporter.TopSyncPulseResponse, error) {
+	response, err := r.client.TopSyncPulse(upstreamContext(ctx), request)
+	captureExchange(ctx, r.capture, PulseTopSyncPulseMethod, request, responsesOf(response, err), err)
+	return response, err
+}
+
+func (r *RecordingPulseExporter) NextFinalizedPulse(ctx context.Context, request *exporter.GetNextFinalizedPulse) (*exporter.FullPulse, error) {
+	response, err := r.client.NextFinalizedPulse(upstreamContext(ctx), request)
+	captureExchange(ctx, r.capture, PulseNextFinalizedPulseMethod, request, responsesOf(response, err), err)
+	return response, err
+}
+
+// RecordingRecordExporter proxies the record exporter calls to the heavy node and captures them
+type RecordingRecordExporter struct {
+	client  exporter.RecordExporterClient
+	capture *CaptureWriter
+}
+
+func NewRecordingRecordExporter(client exporter.RecordExporterClient, capture *CaptureWriter) *RecordingRecordExporter {
+	return &RecordingRecordExporter{client: client, capture: capture}
+}
+
+func (r *RecordingRecordExporter) Export(request *exporter.GetRecords, stream exporter.RecordExporter_ExportServer) error {
+	ctx := stream.Context()
+	upstream, err := r.client.Export(upstreamContext(ctx), request)
+	if err != nil {
+		captureExchange(ctx, r.capture, RecordExportMethod, request, nil, err)
+		return err
+	}
+	var responses []marshaler
+	for {
+		rec, err := upstream.Recv()
+		if err == io.EOF {
+			captureExchange(ctx, r.capture, RecordExportMethod, request, responses, nil)
+			return nil
+		}
+		if err != nil {
+			captureExchange(ctx, r.capture, RecordExportMethod, request, responses, err)
+			return err
+		}
+		responses = append(responses, rec)
+		if err := stream.Send(rec); err != nil {
+			// the block explorer closed the stream, the received part is captured with the error
+			captureExchange(ctx, r.capture, RecordExportMethod, request, responses, err)
+			return err
+		}
+	}
+}
+
+// upstreamContext passes the client version of the block explorer to the heavy node, the heavy node validates it
+func upstreamContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	for _, key := range []string{exporter.KeyClientType, exporter.KeyClientVersionHeavy} {
+		for _, value := range md.Get(key) {
+			ctx = metadata.AppendToOutgoingContext(ctx, key, value)
+		}
+	}
+	return ctx
+}
+
+func responsesOf(response marshaler, err error) []marshaler {
+	if err != nil {
+		return nil
+	}
+	return []marshaler{response}
+}
+
+// captureExchange writes the exchange to the capture, failures are logged only, so the proxied call isn't affected
+func captureExchange(ctx context.Context, capture *CaptureWriter, method string, request marshaler, responses []marshaler, err error) {
+	ex, mErr := newExchange(method, request, responses, err)
+	if mErr == nil {
+		mErr = capture.Write(ex)
+	}
+	if mErr != nil {
+		belogger.FromContext(ctx).Errorf("cannot capture %s: %s", method, mErr)
+	}
+}
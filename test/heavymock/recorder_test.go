@@ -0,0 +1,156 @@
+// +build unit
+
+package heavymock
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/insolar/insolar/pulse"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/insolar/block-explorer/etl/connection"
+	"github.com/insolar/block-explorer/testutils"
+)
+
+type testPulseExporter struct{}
+
+func (testPulseExporter) Export(*exporter.GetPulses, exporter.PulseExporter_ExportServer) error {
+	return status.Error(codes.Unimplemented, "not implemented")
+}
+
+func (testPulseExporter) TopSyncPulse(context.Context, *exporter.GetTopSyncPulse) (*exporter.TopSyncPulseResponse, error) {
+	return &exporter.TopSyncPulseResponse{PulseNumber: pulse.MinTimePulse + 10}, nil
+}
+
+func (testPulseExporter) NextFinalizedPulse(ctx context.Context, in *exporter.GetNextFinalizedPulse) (*exporter.FullPulse, error) {
+	if in.PulseNo >= pulse.MinTimePulse+10 {
+		return nil, status.Error(codes.Unknown, "[NextFinalizedPulse] failed to get next pulse: pulse not found")
+	}
+	return &exporter.FullPulse{PulseNumber: pulse.MinTimePulse + 10, PrevPulseNumber: pulse.MinTimePulse}, nil
+}
+
+type exporterClients struct {
+	pulses  exporter.PulseExporterClient
+	records exporter.RecordExporterClient
+}
+
+func connect(t *testing.T, server *testutils.TestGRPCServer) (exporterClients, *grpc.ClientConn) {
+	conn, err := connection.NewGRPCClientConnection(context.Background(), connection.GetClientConfiguration(server.Address))
+	require.NoError(t, err)
+	return exporterClients{
+		pulses:  exporter.NewPulseExporterClient(conn.GetGRPCConn()),
+		records: exporter.NewRecordExporterClient(conn.GetGRPCConn()),
+	}, conn.GetGRPCConn()
+}
+
+type exportResult struct {
+	records []*exporter.Record
+	err     error
+}
+
+func exportRecords(t *testing.T, client exporter.RecordExporterClient, request *exporter.GetRecords) exportResult {
+	stream, err := client.Export(context.Background(), request)
+	require.NoError(t, err)
+	var result exportResult
+	for {
+		rec, err := stream.Recv()
+		if err == io.EOF {
+			return result
+		}
+		if err != nil {
+			result.err = err
+			return result
+		}
+		result.records = append(result.records, rec)
+	}
+}
+
+// calls makes the same calls to the heavy node or to the replay and returns their results
+func calls(t *testing.T, clients exporterClients) []interface{} {
+	ctx := context.Background()
+	var results []interface{}
+	next, err := clients.pulses.NextFinalizedPulse(ctx, &exporter.GetNextFinalizedPulse{PulseNo: pulse.MinTimePulse})
+	results = append(results, next, err)
+	next, err = clients.pulses.NextFinalizedPulse(ctx, &exporter.GetNextFinalizedPulse{PulseNo: pulse.MinTimePulse + 10})
+	results = append(results, next, status.Convert(err).Proto())
+	top, err := clients.pulses.TopSyncPulse(ctx, &exporter.GetTopSyncPulse{})
+	results = append(results, top, err)
+	stream, err := clients.pulses.Export(ctx, &exporter.GetPulses{Count: 1})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	results = append(results, status.Convert(err).Proto())
+	exported := exportRecords(t, clients.records, &exporter.GetRecords{PulseNumber: pulse.MinTimePulse + 10})
+	results = append(results, exported.records, exported.err)
+	return results
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "heavymock")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	captureFile := filepath.Join(dir, "capture.jsonl")
+
+	// heavy node
+	heavy := testutils.CreateTestGRPCServer(t, nil)
+	importer := NewHeavymockImporter()
+	RegisterHeavymockImporterServer(heavy.Server, importer)
+	exporter.RegisterRecordExporterServer(heavy.Server, NewRecordExporter(importer))
+	exporter.RegisterPulseExporterServer(heavy.Server, testPulseExporter{})
+	heavy.Serve(t)
+	defer heavy.Server.Stop()
+	heavyClients, heavyConn := connect(t, heavy)
+	defer heavyConn.Close()
+
+	pn := insolar.PulseNumber(pulse.MinTimePulse + 10)
+	nextPN := pn + 10
+	require.NoError(t, ImportRecords(NewHeavymockImporterClient(heavyConn), []*exporter.Record{
+		{RecordNumber: 1, Record: record.Material{ID: *insolar.NewID(pn, nil)}},
+		{RecordNumber: 2, Record: record.Material{ID: *insolar.NewID(pn, nil)}, ShouldIterateFrom: &nextPN},
+	}))
+
+	// recorder
+	capture, err := NewCaptureWriter(captureFile)
+	require.NoError(t, err)
+	recorder := testutils.CreateTestGRPCServer(t, nil)
+	exporter.RegisterPulseExporterServer(recorder.Server, NewRecordingPulseExporter(heavyClients.pulses, capture))
+	exporter.RegisterRecordExporterServer(recorder.Server, NewRecordingRecordExporter(heavyClients.records, capture))
+	recorder.Serve(t)
+	recorderClients, recorderConn := connect(t, recorder)
+	recorded := calls(t, recorderClients)
+	recorderConn.Close()
+	recorder.Server.Stop()
+	require.NoError(t, capture.Close())
+
+	exported := recorded[len(recorded)-2].([]*exporter.Record)
+	require.Len(t, exported, 3)
+	require.Equal(t, &nextPN, exported[1].ShouldIterateFrom)
+
+	// replay
+	loaded, err := LoadCapture(captureFile)
+	require.NoError(t, err)
+	replay := testutils.CreateTestGRPCServer(t, nil)
+	exporter.RegisterPulseExporterServer(replay.Server, NewReplayPulseExporter(loaded))
+	exporter.RegisterRecordExporterServer(replay.Server, NewReplayRecordExporter(loaded))
+	replay.Serve(t)
+	defer replay.Server.Stop()
+	replayClients, replayConn := connect(t, replay)
+	defer replayConn.Close()
+
+	require.Equal(t, recorded, calls(t, replayClients))
+	// the last captured exchange is repeated
+	require.Equal(t, recorded, calls(t, replayClients))
+
+	_, err = replayClients.pulses.NextFinalizedPulse(context.Background(), &exporter.GetNextFinalizedPulse{PulseNo: 1})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
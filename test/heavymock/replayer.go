@@ -0,0 +1,104 @@
+package heavymock
+
+import (
+	"context"
+
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReplayPulseExporter serves the captured pulse exporter calls instead of the heavy node
+type ReplayPulseExporter struct {
+	capture *Capture
+}
+
+func NewReplayPulseExporter(capture *Capture) *ReplayPulseExporter {
+	return &ReplayPulseExporter{capture: capture}
+}
+
+func (r *ReplayPulseExporter) Export(request *exporter.GetPulses, stream exporter.PulseExporter_ExportServer) error {
+	ex, err := r.capture.Next(PulseExportMethod, request)
+	if err != nil {
+		return err
+	}
+	for _, data := range ex.Responses {
+		pulse := &exporter.Pulse{}
+		if err := unmarshalResponse(pulse, data); err != nil {
+			return err
+		}
+		if err := stream.Send(pulse); err != nil {
+			return err
+		}
+	}
+	return ex.Err()
+}
+
+func (r *ReplayPulseExporter) TopSyncPulse(ctx context.Context, request *exporter.GetTopSyncPulse) (*exporter.TopSyncPulseResponse, error) {
+	ex, err := r.capture.Next(PulseTopSyncPulseMethod, request)
+	if err != nil {
+		return nil, err
+	}
+	if err := ex.Err(); err != nil {
+		return nil, err
+	}
+	response := &exporter.TopSyncPulseResponse{}
+	return response, unmarshalUnaryResponse(response, ex)
+}
+
+func (r *ReplayPulseExporter) NextFinalizedPulse(ctx context.Context, request *exporter.GetNextFinalizedPulse) (*exporter.FullPulse, error) {
+	ex, err := r.capture.Next(PulseNextFinalizedPulseMethod, request)
+	if err != nil {
+		return nil, err
+	}
+	if err := ex.Err(); err != nil {
+		return nil, err
+	}
+	response := &exporter.FullPulse{}
+	return response, unmarshalUnaryResponse(response, ex)
+}
+
+// ReplayRecordExporter serves the captured record exporter calls instead of the heavy node,
+// the records are sent as they were captured including the ShouldIterateFrom markers
+type ReplayRecordExporter struct {
+	capture *Capture
+}
+
+func NewReplayRecordExporter(capture *Capture) *ReplayRecordExporter {
+	return &ReplayRecordExporter{capture: capture}
+}
+
+func (r *ReplayRecordExporter) Export(request *exporter.GetRecords, stream exporter.RecordExporter_ExportServer) error {
+	ex, err := r.capture.Next(RecordExportMethod, request)
+	if err != nil {
+		return err
+	}
+	for _, data := range ex.Responses {
+		record := &exporter.Record{}
+		if err := unmarshalResponse(record, data); err != nil {
+			return err
+		}
+		if err := stream.Send(record); err != nil {
+			return err
+		}
+	}
+	return ex.Err()
+}
+
+type unmarshaler interface {
+	Unmarshal(data []byte) error
+}
+
+func unmarshalResponse(msg unmarshaler, data []byte) error {
+	if err := msg.Unmarshal(data); err != nil {
+		return status.Errorf(codes.DataLoss, "cannot unmarshal captured response: %s", err)
+	}
+	return nil
+}
+
+func unmarshalUnaryResponse(msg unmarshaler, ex *Exchange) error {
+	if len(ex.Responses) != 1 {
+		return status.Errorf(codes.DataLoss, "captured %s has %d responses instead of one", ex.Method, len(ex.Responses))
+	}
+	return unmarshalResponse(msg, ex.Responses[0])
+}
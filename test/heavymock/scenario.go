@@ -0,0 +1,148 @@
+package heavymock
+
+import (
+	"io/ioutil"
+	"time"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/pulse"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// kinds of the scenario fault calls
+const (
+	// FaultNextPulse fails NextFinalizedPulse calls returning the pulse
+	FaultNextPulse = "next_pulse"
+	// FaultRecords fails Export calls of the records of the pulse
+	FaultRecords = "records"
+)
+
+// kinds of the scenario fault errors
+const (
+	// FaultNotFound returns pulse.ErrNotFound
+	FaultNotFound = "not_found"
+	// FaultNotFinal returns exporter.ErrNotFinalPulseData
+	FaultNotFinal = "not_final"
+	// FaultRateLimit returns the rate limit error of the heavy node
+	FaultRateLimit = "rate_limit"
+	// FaultDrop closes the connection of the call
+	FaultDrop = "drop"
+	// FaultSkip returns the next pulse instead of the pulse, it's applicable to the next_pulse calls only
+	FaultSkip = "skip"
+)
+
+// Scenario describes the network simulated by the heavymock over many pulses.
+// Jets are written as their prefixes in bits, the empty string is the root jet.
+type Scenario struct {
+	// StartPulse is the number of the first pulse, its previous pulse number is 0
+	StartPulse insolar.PulseNumber `yaml:"start_pulse"`
+	// PulseDelta is the distance between the pulse numbers
+	PulseDelta uint16 `yaml:"pulse_delta"`
+	// PulsePeriod is the time after which the next pulse is finalized, all pulses are finalized at once if it's zero
+	PulsePeriod time.Duration `yaml:"pulse_period"`
+	// Jets is the jet tree of the first pulse
+	Jets   []string        `yaml:"jets"`
+	Pulses []ScenarioPulse `yaml:"pulses"`
+}
+
+// ScenarioPulse describes the changes of the network in one pulse
+type ScenarioPulse struct {
+	// Gap is the number of the pulse deltas skipped before the pulse, the network was idle
+	Gap int `yaml:"gap"`
+	// Split lists the jets split in the pulse into their children
+	Split []string `yaml:"split"`
+	// Merge lists the jets whose children are merged in the pulse
+	Merge []string `yaml:"merge"`
+	// Records is the number of records in every jet drop, the pulse is empty if it's zero
+	Records int             `yaml:"records"`
+	Faults  []ScenarioFault `yaml:"faults"`
+}
+
+// ScenarioFault fails the calls of the heavy node concerning the pulse
+type ScenarioFault struct {
+	// Call is FaultNextPulse or FaultRecords
+	Call string `yaml:"call"`
+	// Error is one of FaultNotFound, FaultNotFinal, FaultRateLimit, FaultDrop and FaultSkip
+	Error string `yaml:"error"`
+	// Times is the number of the failed calls, 1 if it's zero
+	Times int `yaml:"times"`
+	// After is the number of records sent before the error, the client may not receive them if the connection is dropped
+	After int `yaml:"after"`
+}
+
+// NewScenario returns the scenario starting from the root jet at pulse.MinTimePulse,
+// the pulses are added by the builder methods
+func NewScenario() *Scenario {
+	s := &Scenario{}
+	s.setDefaults()
+	return s
+}
+
+// LoadScenario reads the scenario from the yaml file
+func LoadScenario(path string) (*Scenario, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot read scenario %s", path)
+	}
+	s := &Scenario{}
+	if err := yaml.UnmarshalStrict(data, s); err != nil {
+		return nil, errors.Wrapf(err, "cannot parse scenario %s", path)
+	}
+	s.setDefaults()
+	return s, nil
+}
+
+func (s *Scenario) setDefaults() {
+	if s.StartPulse == 0 {
+		s.StartPulse = pulse.MinTimePulse
+	}
+	if s.PulseDelta == 0 {
+		s.PulseDelta = 10
+	}
+	if s.Jets == nil {
+		s.Jets = []string{""}
+	}
+}
+
+// AddPulses adds count pulses with records in every jet drop
+func (s *Scenario) AddPulses(count, records int) *Scenario {
+	for i := 0; i < count; i++ {
+		s.Pulses = append(s.Pulses, ScenarioPulse{Records: records})
+	}
+	return s
+}
+
+// Split splits the jets in the last pulse
+func (s *Scenario) Split(jets ...string) *Scenario {
+	p := s.lastPulse()
+	p.Split = append(p.Split, jets...)
+	return s
+}
+
+// Merge merges the children of the jets in the last pulse
+func (s *Scenario) Merge(jets ...string) *Scenario {
+	p := s.lastPulse()
+	p.Merge = append(p.Merge, jets...)
+	return s
+}
+
+// WithGap skips deltas pulse deltas before the last pulse
+func (s *Scenario) WithGap(deltas int) *Scenario {
+	s.lastPulse().Gap = deltas
+	return s
+}
+
+// WithFault fails the calls concerning the last pulse
+func (s *Scenario) WithFault(fault ScenarioFault) *Scenario {
+	p := s.lastPulse()
+	p.Faults = append(p.Faults, fault)
+	return s
+}
+
+func (s *Scenario) lastPulse() *ScenarioPulse {
+	if len(s.Pulses) == 0 {
+		s.Pulses = append(s.Pulses, ScenarioPulse{})
+	}
+	return &s.Pulses[len(s.Pulses)-1]
+}
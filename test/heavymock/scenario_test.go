@@ -0,0 +1,188 @@
+// +build unit
+
+package heavymock
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	insolarPulse "github.com/insolar/insolar/pulse"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/insolar/block-explorer/instrumentation/converter"
+	"github.com/insolar/block-explorer/testutils"
+)
+
+func jetIDs(p *exporter.FullPulse) []string {
+	var result []string
+	for _, jet := range p.Jets {
+		result = append(result, converter.JetIDToString(jet.JetID))
+	}
+	return result
+}
+
+func startNetwork(t *testing.T, s *Scenario) (*Network, exporterClients) {
+	network, err := NewNetwork(s)
+	require.NoError(t, err)
+	server := testutils.CreateTestGRPCServer(t, nil)
+	server.Listener = network.Listen(server.Listener)
+	exporter.RegisterPulseExporterServer(server.Server, network.PulseExporter())
+	exporter.RegisterRecordExporterServer(server.Server, network.RecordExporter())
+	server.Serve(t)
+	t.Cleanup(server.Server.Stop)
+	clients, conn := connect(t, server)
+	t.Cleanup(func() { conn.Close() })
+	return network, clients
+}
+
+func TestLoadScenario(t *testing.T) {
+	s, err := LoadScenario("testdata/scenario.yaml")
+	require.NoError(t, err)
+	require.Len(t, s.Pulses, 10)
+	require.Equal(t, ScenarioFault{Call: FaultRecords, Error: FaultDrop, After: 1}, s.Pulses[7].Faults[0])
+
+	network, err := NewNetwork(s)
+	require.NoError(t, err)
+	pulses := network.Pulses()
+	require.Len(t, pulses, 10)
+	require.EqualValues(t, 0, pulses[0].PrevPulseNumber)
+	require.EqualValues(t, insolarPulse.MinTimePulse+30, pulses[3].PulseNumber)
+	require.EqualValues(t, insolarPulse.MinTimePulse+70, pulses[4].PulseNumber, "gap of 3 pulses")
+	require.Equal(t, pulses[3].PulseNumber, pulses[4].PrevPulseNumber)
+
+	require.Equal(t, []string{""}, jetIDs(pulses[0]))
+	require.Equal(t, []string{"0", "1"}, jetIDs(pulses[1]))
+	require.Equal(t, []string{"00", "01", "1"}, jetIDs(pulses[2]))
+	require.Equal(t, []string{"0", "1"}, jetIDs(pulses[5]))
+	require.Equal(t, []string{""}, jetIDs(pulses[8]))
+
+	require.Len(t, network.Records(pulses[2].PulseNumber), 3)
+	require.Empty(t, network.Records(pulses[3].PulseNumber))
+	require.Nil(t, network.Records(pulses[3].PulseNumber+10))
+}
+
+func TestLoadScenario_Errors(t *testing.T) {
+	_, err := LoadScenario("testdata/not_existing.yaml")
+	require.Error(t, err)
+
+	_, err = NewNetwork(NewScenario())
+	require.Error(t, err)
+	_, err = NewNetwork(NewScenario().AddPulses(1, 0).Split("1"))
+	require.Error(t, err)
+	_, err = NewNetwork(NewScenario().AddPulses(1, 0).Merge(""))
+	require.Error(t, err)
+	_, err = NewNetwork(NewScenario().AddPulses(1, 0).WithFault(ScenarioFault{Call: FaultRecords, Error: FaultSkip}))
+	require.Error(t, err)
+	_, err = NewNetwork(NewScenario().AddPulses(1, 0).WithFault(ScenarioFault{Call: FaultNextPulse, Error: "unknown"}))
+	require.Error(t, err)
+	_, err = NewNetwork(&Scenario{Jets: []string{"2"}, Pulses: []ScenarioPulse{{}}})
+	require.Error(t, err)
+}
+
+func TestNetwork_JetDropHashes(t *testing.T) {
+	network, err := NewNetwork(NewScenario().AddPulses(2, 0).Split("").AddPulses(1, 0).Merge(""))
+	require.NoError(t, err)
+	pulses := network.Pulses()
+
+	root := pulses[0].Jets[0]
+	require.Empty(t, root.PrevDropHashes)
+	left, right := pulses[1].Jets[0], pulses[1].Jets[1]
+	require.Equal(t, [][]byte{root.Hash}, left.PrevDropHashes)
+	require.Equal(t, [][]byte{root.Hash}, right.PrevDropHashes)
+	require.Equal(t, [][]byte{left.Hash, right.Hash}, pulses[2].Jets[0].PrevDropHashes)
+}
+
+func TestNetwork_NextFinalizedPulse(t *testing.T) {
+	ctx := context.Background()
+	network, clients := startNetwork(t, NewScenario().AddPulses(2, 1).
+		WithFault(ScenarioFault{Call: FaultNextPulse, Error: FaultRateLimit}).
+		WithFault(ScenarioFault{Call: FaultNextPulse, Error: FaultSkip}).
+		AddPulses(1, 1).WithGap(2))
+	pulses := network.Pulses()
+
+	p, err := clients.pulses.NextFinalizedPulse(ctx, &exporter.GetNextFinalizedPulse{PulseNo: 0})
+	require.NoError(t, err)
+	require.Equal(t, pulses[0], p)
+
+	_, err = clients.pulses.NextFinalizedPulse(ctx, &exporter.GetNextFinalizedPulse{PulseNo: int64(p.PulseNumber)})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Contains(t, err.Error(), exporter.RateLimitExceededMsg)
+
+	p, err = clients.pulses.NextFinalizedPulse(ctx, &exporter.GetNextFinalizedPulse{PulseNo: int64(p.PulseNumber)})
+	require.NoError(t, err)
+	require.Equal(t, pulses[2], p, "pulse is skipped")
+
+	p, err = clients.pulses.NextFinalizedPulse(ctx, &exporter.GetNextFinalizedPulse{PulseNo: int64(pulses[0].PulseNumber)})
+	require.NoError(t, err)
+	require.Equal(t, pulses[1], p)
+
+	_, err = clients.pulses.NextFinalizedPulse(ctx, &exporter.GetNextFinalizedPulse{PulseNo: int64(pulses[2].PulseNumber)})
+	require.Contains(t, err.Error(), pulse.ErrNotFound.Error())
+
+	top, err := clients.pulses.TopSyncPulse(ctx, &exporter.GetTopSyncPulse{})
+	require.NoError(t, err)
+	require.EqualValues(t, pulses[2].PulseNumber, top.PulseNumber)
+}
+
+func TestNetwork_PulsePeriod(t *testing.T) {
+	ctx := context.Background()
+	s := NewScenario().AddPulses(2, 1)
+	s.PulsePeriod = time.Hour
+	network, clients := startNetwork(t, s)
+	pulses := network.Pulses()
+
+	_, err := clients.pulses.NextFinalizedPulse(ctx, &exporter.GetNextFinalizedPulse{PulseNo: int64(pulses[0].PulseNumber)})
+	require.Contains(t, err.Error(), pulse.ErrNotFound.Error())
+
+	result := exportRecords(t, clients.records, &exporter.GetRecords{PulseNumber: pulses[1].PulseNumber, Count: 10})
+	require.Contains(t, result.err.Error(), exporter.ErrNotFinalPulseData.Error())
+}
+
+func TestNetwork_ExportRecords(t *testing.T) {
+	network, clients := startNetwork(t, NewScenario().
+		AddPulses(1, 3).
+		WithFault(ScenarioFault{Call: FaultRecords, Error: FaultDrop, After: 1}).
+		WithFault(ScenarioFault{Call: FaultRecords, Error: FaultNotFinal}).
+		AddPulses(1, 0).
+		AddPulses(1, 1).WithGap(1))
+	pulses := network.Pulses()
+	records := network.Records(pulses[0].PulseNumber)
+	request := &exporter.GetRecords{PulseNumber: pulses[0].PulseNumber, Count: 2}
+
+	// the records sent before the connection is dropped may be lost with it
+	result := exportRecords(t, clients.records, request)
+	require.Equal(t, codes.Unavailable, status.Code(result.err))
+	require.NotContains(t, result.err.Error(), "connection is dropped", "transport is broken instead of returning the error")
+	require.LessOrEqual(t, len(result.records), 1)
+	for i, r := range result.records {
+		require.Equal(t, records[i], r)
+	}
+
+	result = exportRecords(t, clients.records, request)
+	require.Contains(t, result.err.Error(), exporter.ErrNotFinalPulseData.Error())
+	require.Empty(t, result.records)
+
+	result = exportRecords(t, clients.records, request)
+	require.NoError(t, result.err)
+	require.Equal(t, records[:2], result.records)
+
+	request.RecordNumber = 2
+	result = exportRecords(t, clients.records, request)
+	require.NoError(t, result.err)
+	require.Len(t, result.records, 2)
+	require.Equal(t, records[2], result.records[0])
+	require.Equal(t, pulses[2].PulseNumber, *result.records[1].ShouldIterateFrom, "empty pulse is skipped")
+
+	for _, pn := range []insolar.PulseNumber{pulses[1].PulseNumber, pulses[1].PulseNumber + 10} {
+		result = exportRecords(t, clients.records, &exporter.GetRecords{PulseNumber: pn, Count: 2})
+		require.NoError(t, result.err)
+		require.Len(t, result.records, 1)
+		require.Equal(t, pulses[2].PulseNumber, *result.records[0].ShouldIterateFrom)
+	}
+}
@@ -0,0 +1,82 @@
+// +build heavy_mock_integration
+
+package integration
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/block-explorer/etl/connection"
+	"github.com/insolar/block-explorer/etl/models"
+	"github.com/insolar/block-explorer/test/heavymock"
+	"github.com/insolar/block-explorer/testutils"
+	betest "github.com/insolar/block-explorer/testutils/betestsetup"
+	"github.com/insolar/block-explorer/testutils/clients"
+	"github.com/insolar/block-explorer/testutils/connectionmanager"
+)
+
+func TestIntegrationWithDb_RecordAndReplay(t *testing.T) {
+	t.Log("Process records captured from the heavy node and replayed by the heavymock")
+	dir, err := ioutil.TempDir("", "replay")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	captureFile := filepath.Join(dir, "capture.jsonl")
+
+	// record the traffic of the heavymock
+	heavy := &connectionmanager.ConnectionManager{}
+	heavy.Start(t)
+	heavy.StartDB(t)
+	defer heavy.Stop()
+
+	expRecords := testutils.GenerateRecordsFromOneJetSilence(3, 2)
+	require.NoError(t, heavymock.ImportRecords(heavy.ImporterClient, expRecords))
+
+	capture, err := heavymock.NewCaptureWriter(captureFile)
+	require.NoError(t, err)
+	heavyPulses := clients.GetTestPulseClient(1, nil)
+	heavyPulses.SetNextFinalizedPulseFunc(heavy.Importer)
+	recorder := testutils.CreateTestGRPCServer(t, nil)
+	exporter.RegisterPulseExporterServer(recorder.Server, heavymock.NewRecordingPulseExporter(heavyPulses, capture))
+	exporter.RegisterRecordExporterServer(recorder.Server, heavymock.NewRecordingRecordExporter(heavy.ExporterClient, capture))
+	recorder.Serve(t)
+	defer recorder.Server.Stop()
+	recorderConn, err := connection.NewGRPCClientConnection(context.Background(), connection.GetClientConfiguration(recorder.Address))
+	require.NoError(t, err)
+	defer recorderConn.GetGRPCConn().Close()
+
+	recorded := &BlockExplorerTestSuite{
+		ConMngr: heavy,
+		BE:      betest.NewBlockExplorer(exporter.NewRecordExporterClient(recorderConn.GetGRPCConn()), heavy.DB),
+	}
+	recorded.BE.PulseClient.SetPulseExporterClient(exporter.NewPulseExporterClient(recorderConn.GetGRPCConn()))
+	recorded.StartBE(t)
+	recorded.WaitRecordsCount(t, len(expRecords), 6000)
+	recorded.StopBE(t)
+	require.NoError(t, capture.Close())
+
+	// replay the captured traffic to the block explorer with the empty db
+	replay := &connectionmanager.ConnectionManager{}
+	replay.StartReplay(t, captureFile)
+	replay.StartDB(t)
+	defer replay.Stop()
+
+	replayed := &BlockExplorerTestSuite{
+		ConMngr: replay,
+		BE:      betest.NewBlockExplorer(replay.ExporterClient, replay.DB),
+	}
+	replayed.BE.PulseClient.SetPulseExporterClient(replay.PulseClient)
+	replayed.StartBE(t)
+	defer replayed.StopBE(t)
+	replayed.WaitRecordsCount(t, len(expRecords), 6000)
+
+	var recordedDrops, replayedDrops []models.JetDrop
+	require.NoError(t, heavy.DB.Order("pulse_number, jet_id").Find(&recordedDrops).Error)
+	require.NoError(t, replay.DB.Order("pulse_number, jet_id").Find(&replayedDrops).Error)
+	require.Equal(t, recordedDrops, replayedDrops)
+}
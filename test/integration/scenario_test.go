@@ -0,0 +1,66 @@
+// +build heavy_mock_integration
+
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/block-explorer/etl/models"
+	"github.com/insolar/block-explorer/test/heavymock"
+	betest "github.com/insolar/block-explorer/testutils/betestsetup"
+	"github.com/insolar/block-explorer/testutils/connectionmanager"
+)
+
+func TestIntegrationWithDb_Scenario(t *testing.T) {
+	t.Log("Pulses with jet splits, merges, empty pulses and gaps are complete despite the faults of the heavy node")
+	scenario, err := heavymock.LoadScenario("../heavymock/testdata/scenario.yaml")
+	require.NoError(t, err)
+	network, err := heavymock.NewNetwork(scenario)
+	require.NoError(t, err)
+
+	c := &connectionmanager.ConnectionManager{}
+	c.StartScenario(t, network)
+	c.StartDB(t)
+	defer c.Stop()
+
+	ts := &BlockExplorerTestSuite{
+		ConMngr: c,
+		BE:      betest.NewBlockExplorer(c.ExporterClient, c.DB),
+	}
+	ts.BE.PulseClient.SetPulseExporterClient(c.PulseClient)
+	ts.StartBE(t)
+	defer ts.StopBE(t)
+	require.NoError(t, ts.BE.StartController())
+
+	pulses := network.Pulses()
+	var expRecords int
+	for _, p := range pulses {
+		expRecords += len(network.Records(p.PulseNumber))
+	}
+	ts.WaitRecordsCount(t, expRecords, 60000)
+
+	lastPulse := int64(pulses[len(pulses)-1].PulseNumber)
+	var sequential models.Pulse
+	for i := 0; i < 600 && sequential.PulseNumber < lastPulse; i++ {
+		time.Sleep(100 * time.Millisecond)
+		sequential, err = ts.BE.Storage().GetSequentialPulse()
+		require.NoError(t, err)
+	}
+	require.Equal(t, lastPulse, sequential.PulseNumber, "all pulses have to be sequential")
+
+	for _, p := range pulses {
+		pulse, err := ts.BE.Storage().GetPulse(int64(p.PulseNumber))
+		require.NoError(t, err)
+		require.True(t, pulse.IsComplete)
+		require.Equal(t, int64(p.PrevPulseNumber), pulse.PrevPulseNumber)
+		require.EqualValues(t, len(p.Jets), pulse.JetDropAmount)
+		require.EqualValues(t, len(network.Records(p.PulseNumber)), pulse.RecordAmount)
+
+		jetDrops, err := ts.BE.Storage().GetJetDrops(models.Pulse{PulseNumber: int64(p.PulseNumber)})
+		require.NoError(t, err)
+		require.Len(t, jetDrops, len(p.Jets))
+	}
+}
@@ -28,6 +28,9 @@ type BlockExplorerTestSetUp struct {
 	trsf *transformer.MainNetTransformer
 	strg *storage.Storage
 	ctx  context.Context
+
+	// contStarted is true if the controller checks completeness of pulses and reloads missed data
+	contStarted bool
 }
 
 func NewBlockExplorer(exporterClient exporter.RecordExporterClient, db *gorm.DB) BlockExplorerTestSetUp {
@@ -49,8 +52,9 @@ var cfg = configuration.Controller{
 func (b *BlockExplorerTestSetUp) Start() error {
 	b.ctx = context.Background()
 
+	b.strg = storage.NewStorage(b.DB)
 	pulseExtractor := extractor.NewPlatformPulseExtractor(b.PulseClient)
-	b.extr = extractor.NewPlatformExtractor(100, 0, 100, 100, pulseExtractor, b.ExporterClient, func() {})
+	b.extr = extractor.NewPlatformExtractor(100, 0, 100, 100, pulseExtractor, b.ExporterClient, b.strg, func() {})
 	err := b.extr.Start(b.ctx)
 	if err != nil {
 		return err
@@ -62,12 +66,11 @@ func (b *BlockExplorerTestSetUp) Start() error {
 		return err
 	}
 
-	b.strg = storage.NewStorage(b.DB)
 	b.cont, err = controller.NewController(cfg, b.extr, b.strg, 2)
 	if err != nil {
 		return err
 	}
-	b.proc = processor.NewProcessor(b.trsf, b.strg, b.cont, 1)
+	b.proc = processor.NewProcessor(b.trsf, b.strg, b.strg, b.strg, b.cont, configuration.Processor{Workers: 1})
 	err = b.proc.Start(b.ctx)
 	if err != nil {
 		return err
@@ -76,8 +79,24 @@ func (b *BlockExplorerTestSetUp) Start() error {
 	return nil
 }
 
+// StartController starts the Controller checking completeness of pulses and reloading missed data,
+// it has to be called after Start
+func (b *BlockExplorerTestSetUp) StartController() error {
+	if err := b.cont.Start(b.ctx); err != nil {
+		return err
+	}
+	b.contStarted = true
+	return nil
+}
+
 // Stop Transformer and Processor
 func (b *BlockExplorerTestSetUp) Stop() error {
+	if b.contStarted {
+		if err := b.cont.Stop(b.ctx); err != nil {
+			return err
+		}
+		b.contStarted = false
+	}
 	if err := b.extr.Stop(b.ctx); err != nil {
 		return err
 	}
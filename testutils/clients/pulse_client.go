@@ -74,3 +74,10 @@ func (c *TestPulseClient) SetNextFinalizedPulseFunc(importer *heavymock.Importer
 		return GetFullPulse(p, jetDropContinue)
 	}
 }
+
+// SetPulseExporterClient forwards all calls to the client, e.g. to the heavymock replaying the captured traffic
+func (c *TestPulseClient) SetPulseExporterClient(client exporter.PulseExporterClient) {
+	c.export = client.Export
+	c.topSyncPulse = client.TopSyncPulse
+	c.NextFinalizedPulseFunc = client.NextFinalizedPulse
+}
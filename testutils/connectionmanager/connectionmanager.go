@@ -26,6 +26,7 @@ type ConnectionManager struct {
 	grpcServer     *testutils.TestGRPCServer
 	grpcClientConn *connection.GRPCClientConnection
 	ExporterClient exporter.RecordExporterClient
+	PulseClient    exporter.PulseExporterClient
 	ImporterClient heavymock.HeavymockImporterClient
 	Importer       *heavymock.ImporterServer
 	DB             *gorm.DB
@@ -55,6 +56,37 @@ func (c *ConnectionManager) Start(t testing.TB) {
 	c.ImporterClient = heavymock.NewHeavymockImporterClient(c.grpcClientConn.GetGRPCConn())
 }
 
+// Starts GRPC server replaying the captured heavy node traffic and initializes connection from GRPC clients
+func (c *ConnectionManager) StartReplay(t testing.TB, captureFile string) {
+	capture, err := heavymock.LoadCapture(captureFile)
+	require.NoError(t, err)
+	c.startExporters(t, testutils.CreateTestGRPCServer(t, nil), heavymock.NewReplayPulseExporter(capture), heavymock.NewReplayRecordExporter(capture))
+}
+
+// Starts GRPC server simulating the heavy node of the scenario network and initializes connection from GRPC clients
+func (c *ConnectionManager) StartScenario(t testing.TB, network *heavymock.Network) {
+	server := testutils.CreateTestGRPCServer(t, nil)
+	// the network drops the connections of the calls failed by FaultDrop
+	server.Listener = network.Listen(server.Listener)
+	c.startExporters(t, server, network.PulseExporter(), network.RecordExporter())
+}
+
+func (c *ConnectionManager) startExporters(t testing.TB, server *testutils.TestGRPCServer, pulses exporter.PulseExporterServer, records exporter.RecordExporterServer) {
+	var err error
+	c.grpcServer = server
+	exporter.RegisterRecordExporterServer(c.grpcServer.Server, records)
+	exporter.RegisterPulseExporterServer(c.grpcServer.Server, pulses)
+	c.grpcServer.Serve(t)
+
+	c.ctx = context.Background()
+	cfg := connection.GetClientConfiguration(c.grpcServer.Address)
+	c.grpcClientConn, err = connection.NewGRPCClientConnection(c.ctx, cfg)
+	require.NoError(t, err)
+
+	c.ExporterClient = exporter.NewRecordExporterClient(c.grpcClientConn.GetGRPCConn())
+	c.PulseClient = exporter.NewPulseExporterClient(c.grpcClientConn.GetGRPCConn())
+}
+
 // run postgres in docker and perform migrations
 func (c *ConnectionManager) StartDB(t testing.TB) {
 	db, poolCleaner, err := testutils.SetupDB()
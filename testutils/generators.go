@@ -13,6 +13,7 @@ import (
 	"github.com/insolar/insolar/insolar/jet"
 	insrecord "github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/pulse"
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/require"
@@ -43,6 +44,14 @@ func GenerateRequestRecord(pulse insolar.PulseNumber, objectID insolar.ID) *expo
 	return r
 }
 
+// GenerateRecordWithHashedID returns a request record with id calculated from the virtual record as the platform does
+func GenerateRecordWithHashedID(pulse insolar.PulseNumber, objectID insolar.ID) *exporter.Record {
+	r := GenerateRequestRecord(pulse, objectID)
+	hash := insrecord.HashVirtual(platformpolicy.NewPlatformCryptographyScheme().ReferenceHasher(), r.Record.Virtual)
+	r.Record.ID = *insolar.NewID(pulse, hash)
+	return r
+}
+
 func GenerateVirtualActivateRecord(pulse insolar.PulseNumber, objectID, requestID insolar.ID) (record *exporter.Record) {
 	r := GenerateRecordsSilence(1)[0]
 	id := gen.IDWithPulse(pulse)